@@ -39,6 +39,12 @@
 #define PRINCIPAL_OWNER_GRP_STR	"GROUP@"
 #define PRINCIPAL_EVERYONE_STR	"EVERYONE@"
 
+/*
+ * Prefixes identifying the type of a named principal
+ */
+#define PRINCIPAL_USER_PREFIX	"u:"
+#define PRINCIPAL_GROUP_PREFIX	"g:"
+
 /*
  * Characters representing access flags
  */
@@ -385,6 +391,63 @@ out:
 	return rc;
 }
 
+int
+daos_acl_principal_from_str(const char *principal_str,
+			    enum daos_acl_principal_type *type, char **name)
+{
+	const char	*name_str;
+	size_t		prefix_len = strlen(PRINCIPAL_USER_PREFIX);
+
+	if (principal_str == NULL || type == NULL || name == NULL) {
+		D_INFO("Invalid input ptr, principal_str=%p, type=%p, "
+		       "name=%p\n", principal_str, type, name);
+		return -DER_INVAL;
+	}
+
+	if (strncmp(principal_str, PRINCIPAL_OWNER_STR,
+		    DAOS_ACL_MAX_PRINCIPAL_BUF_LEN) == 0) {
+		*type = DAOS_ACL_OWNER;
+		*name = NULL;
+		return 0;
+	}
+	if (strncmp(principal_str, PRINCIPAL_OWNER_GRP_STR,
+		    DAOS_ACL_MAX_PRINCIPAL_BUF_LEN) == 0) {
+		*type = DAOS_ACL_OWNER_GROUP;
+		*name = NULL;
+		return 0;
+	}
+	if (strncmp(principal_str, PRINCIPAL_EVERYONE_STR,
+		    DAOS_ACL_MAX_PRINCIPAL_BUF_LEN) == 0) {
+		*type = DAOS_ACL_EVERYONE;
+		*name = NULL;
+		return 0;
+	}
+
+	if (strncmp(principal_str, PRINCIPAL_USER_PREFIX, prefix_len) == 0) {
+		*type = DAOS_ACL_USER;
+	} else if (strncmp(principal_str, PRINCIPAL_GROUP_PREFIX,
+			   prefix_len) == 0) {
+		*type = DAOS_ACL_GROUP;
+	} else {
+		D_INFO("Invalid principal string: %s\n", principal_str);
+		return -DER_INVAL;
+	}
+
+	name_str = principal_str + prefix_len;
+	if (!daos_acl_principal_is_valid(name_str)) {
+		D_INFO("Invalid principal name: %s\n", name_str);
+		return -DER_INVAL;
+	}
+
+	D_STRNDUP(*name, name_str, DAOS_ACL_MAX_PRINCIPAL_LEN);
+	if (*name == NULL) {
+		D_ERROR("Couldn't allocate principal name\n");
+		return -DER_NOMEM;
+	}
+
+	return 0;
+}
+
 static enum ace_str_state
 process_access_types(const char *str, uint8_t *access_types)
 {
@@ -31,38 +31,45 @@
 #include <daos_security.h>
 #include <cart/api.h>
 
-static int
-crt_proc_prop_daos_acl(crt_proc_t proc, struct daos_prop_entry *entry)
+int
+crt_proc_struct_daos_acl(crt_proc_t proc, struct daos_acl **data)
 {
 	int		rc;
-	struct daos_acl	*acl;
 	d_iov_t		iov;
 	crt_proc_op_t	proc_op;
 
+	if (proc == NULL || data == NULL)
+		return -DER_INVAL;
+
 	rc = crt_proc_get_op(proc, &proc_op);
 	if (rc != 0)
 		return rc;
 
-	if (entry->dpe_val_ptr == NULL) {
+	if (*data == NULL || proc_op == CRT_PROC_DECODE)
 		memset(&iov, 0, sizeof(iov));
-	} else {
-		acl = (struct daos_acl *)entry->dpe_val_ptr;
-		d_iov_set(&iov, entry->dpe_val_ptr,
-			daos_acl_get_size(acl));
-	}
+	else
+		d_iov_set(&iov, *data, daos_acl_get_size(*data));
 
 	rc = crt_proc_d_iov_t(proc, &iov);
 	if (rc != 0)
 		return rc;
 
 	if (proc_op == CRT_PROC_DECODE)
-		entry->dpe_val_ptr = iov.iov_buf;
+		*data = iov.iov_buf;
 	else if (proc_op == CRT_PROC_FREE)
-		entry->dpe_val_ptr = NULL;
+		*data = NULL;
 
 	return rc;
 }
 
+static int
+crt_proc_prop_daos_acl(crt_proc_t proc, struct daos_prop_entry *entry)
+{
+	struct daos_acl	**acl = (struct daos_acl **)&entry->dpe_val_ptr;
+
+	return crt_proc_struct_daos_acl(proc, acl);
+}
+
 static int
 crt_proc_prop_entries(crt_proc_t proc, daos_prop_t *prop)
 {
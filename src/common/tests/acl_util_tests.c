@@ -733,6 +733,72 @@ test_acl_principal_to_gid_getgrnam_buf_too_small(void **state)
 	free_test_group(grp);
 }
 
+static void
+test_principal_from_str_null(void **state)
+{
+	enum daos_acl_principal_type	type;
+	char				*name = NULL;
+
+	assert_int_equal(daos_acl_principal_from_str(NULL, &type, &name),
+			 -DER_INVAL);
+	assert_int_equal(daos_acl_principal_from_str("OWNER@", NULL, &name),
+			 -DER_INVAL);
+	assert_int_equal(daos_acl_principal_from_str("OWNER@", &type, NULL),
+			 -DER_INVAL);
+}
+
+static void
+check_principal_from_valid_str(const char *str,
+			       enum daos_acl_principal_type exp_type,
+			       const char *exp_name)
+{
+	enum daos_acl_principal_type	type;
+	char				*name = NULL;
+
+	assert_int_equal(daos_acl_principal_from_str(str, &type, &name), 0);
+
+	assert_int_equal(type, exp_type);
+	if (exp_name == NULL) {
+		assert_null(name);
+	} else {
+		assert_non_null(name);
+		assert_string_equal(name, exp_name);
+	}
+
+	D_FREE(name);
+}
+
+static void
+test_principal_from_str_valid(void **state)
+{
+	check_principal_from_valid_str("OWNER@", DAOS_ACL_OWNER, NULL);
+	check_principal_from_valid_str("GROUP@", DAOS_ACL_OWNER_GROUP, NULL);
+	check_principal_from_valid_str("EVERYONE@", DAOS_ACL_EVERYONE, NULL);
+	check_principal_from_valid_str("u:user@", DAOS_ACL_USER, "user@");
+	check_principal_from_valid_str("u:user@domain", DAOS_ACL_USER,
+				       "user@domain");
+	check_principal_from_valid_str("g:grp@", DAOS_ACL_GROUP, "grp@");
+}
+
+static void
+test_principal_from_str_invalid(void **state)
+{
+	enum daos_acl_principal_type	type;
+	char				*name = NULL;
+
+	assert_int_equal(daos_acl_principal_from_str("", &type, &name),
+			 -DER_INVAL);
+	assert_int_equal(daos_acl_principal_from_str("user@", &type, &name),
+			 -DER_INVAL);
+	assert_int_equal(daos_acl_principal_from_str("x:user@", &type, &name),
+			 -DER_INVAL);
+	assert_int_equal(daos_acl_principal_from_str("u:", &type, &name),
+			 -DER_INVAL);
+	assert_int_equal(daos_acl_principal_from_str("g:group", &type, &name),
+			 -DER_INVAL);
+	assert_null(name);
+}
+
 static void
 test_ace_from_str_null_str(void **state)
 {
@@ -1346,6 +1412,9 @@ main(void)
 		cmocka_unit_test(test_acl_principal_to_gid_getgrnam_err),
 		cmocka_unit_test(
 			test_acl_principal_to_gid_getgrnam_buf_too_small),
+		cmocka_unit_test(test_principal_from_str_null),
+		cmocka_unit_test(test_principal_from_str_valid),
+		cmocka_unit_test(test_principal_from_str_invalid),
 		cmocka_unit_test(test_ace_from_str_null_str),
 		cmocka_unit_test(test_ace_from_str_null_ptr),
 		cmocka_unit_test(test_ace_from_str_owner),
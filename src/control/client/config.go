@@ -28,6 +28,7 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 	yaml "gopkg.in/yaml.v2"
@@ -43,6 +44,7 @@ const (
 	defaultConfigPath = "etc/daos.yml"
 	defaultSystemName = "daos_server"
 	defaultPort       = 10000
+	defaultHostPort   = 10001
 )
 
 // External interface provides methods to support various os operations.
@@ -128,6 +130,11 @@ func GetConfig(log logging.Logger, ConfigPath string) (*Configuration, error) {
 	}
 	log.Debugf("DAOS Client config read from %s", config.Path)
 
+	if err := config.loadHostList(); err != nil {
+		return nil, errors.Wrapf(err, "processing hosts in config file %s",
+			config.Path)
+	}
+
 	return config, nil
 }
 
@@ -151,6 +158,42 @@ func (c *Configuration) parse(data []byte) error {
 	return yaml.Unmarshal(data, c)
 }
 
+// SetHostList expands the given comma separated host address patterns and
+// replaces any host list or host file previously set.
+func (c *Configuration) SetHostList(hostList string) error {
+	addrs, err := ParseHostList(hostList, defaultHostPort)
+	if err != nil {
+		return err
+	}
+	c.HostList = addrs
+	c.HostFile = ""
+
+	return nil
+}
+
+// SetHostFile reads host address patterns from the given host file, which
+// takes preference over any host list previously set.
+func (c *Configuration) SetHostFile(hostFile string) error {
+	addrs, err := ReadHostFile(hostFile, defaultHostPort)
+	if err != nil {
+		return err
+	}
+	c.HostList = addrs
+	c.HostFile = hostFile
+
+	return nil
+}
+
+// loadHostList expands host address patterns read from the config file,
+// populating the host list from the host file if one is specified.
+func (c *Configuration) loadHostList() error {
+	if c.HostFile != "" {
+		return c.SetHostFile(c.HostFile)
+	}
+
+	return c.SetHostList(strings.Join(c.HostList, ","))
+}
+
 // NewConfiguration creates a new instance of the Configuration struct
 // populated with defaults and default external interface.
 func NewConfiguration() *Configuration {
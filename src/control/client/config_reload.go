@@ -0,0 +1,148 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"golang.org/x/net/context"
+
+	"github.com/daos-stack/daos/src/control/common"
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+// ConfigReloadResult contains the changed settings, identified by their
+// config file keys, reported by a server after re-reading its config file,
+// or the error encountered when requesting the reload.
+type ConfigReloadResult struct {
+	Applied []string `json:"applied"`
+	Pending []string `json:"pending"`
+	Failed  []string `json:"failed"`
+	Err     error    `json:"-"`
+}
+
+// MarshalJSON encodes the result with any error rendered as a string.
+func (crr ConfigReloadResult) MarshalJSON() ([]byte, error) {
+	type toJSON ConfigReloadResult
+	return common.MarshalWithError(toJSON(crr), crr.Err)
+}
+
+func (crr ConfigReloadResult) String() string {
+	var buf bytes.Buffer
+
+	if crr.Err != nil {
+		return "\t" + crr.Err.Error() + "\n"
+	}
+
+	if len(crr.Applied)+len(crr.Pending)+len(crr.Failed) == 0 {
+		return "\tno changes\n"
+	}
+
+	for _, list := range []struct {
+		title string
+		keys  []string
+	}{
+		{"applied", crr.Applied},
+		{"pending restart", crr.Pending},
+		{"failed", crr.Failed},
+	} {
+		if len(list.keys) > 0 {
+			fmt.Fprintf(&buf, "\t%s: %s\n", list.title, strings.Join(list.keys, ", "))
+		}
+	}
+
+	return buf.String()
+}
+
+// ClientConfigReloadMap is an alias for config reload results reported by
+// servers connected to given client.
+type ClientConfigReloadMap map[string]ConfigReloadResult
+
+func (ccrm ClientConfigReloadMap) String() string {
+	var buf bytes.Buffer
+	servers := make([]string, 0, len(ccrm))
+
+	for server := range ccrm {
+		servers = append(servers, server)
+	}
+	sort.Strings(servers)
+
+	for _, server := range servers {
+		fmt.Fprintf(&buf, "%s:\n%s\n", server, ccrm[server])
+	}
+
+	return buf.String()
+}
+
+// configReloadRequest is to be called as a goroutine and returns result
+// containing changed settings over channel.
+func configReloadRequest(ctx context.Context, mc Control, req interface{}, ch chan ClientResult) {
+	reloadReq, ok := req.(*pb.ConfigReloadReq)
+	if !ok {
+		err := fmt.Errorf(msgTypeAssert, &pb.ConfigReloadReq{}, req)
+		ch <- ClientResult{mc.getAddress(), nil, err}
+		return
+	}
+
+	resp, err := mc.getCtlClient().ConfigReload(ctx, reloadReq)
+	if err != nil {
+		ch <- ClientResult{mc.getAddress(), nil, err}
+		return
+	}
+
+	ch <- ClientResult{mc.getAddress(), resp, nil}
+}
+
+// ConfigReload requests each server connected to re-read its config file
+// and apply the changes which don't require a restart.
+func (c *connList) ConfigReload(ctx context.Context) ClientConfigReloadMap {
+	cResults := c.makeRequests(ctx, &pb.ConfigReloadReq{}, configReloadRequest)
+	cReload := make(ClientConfigReloadMap)
+
+	for _, res := range cResults {
+		if res.Err != nil {
+			cReload[res.Address] = ConfigReloadResult{Err: res.Err}
+			continue
+		}
+
+		resp, ok := res.Value.(*pb.ConfigReloadResp)
+		if !ok {
+			cReload[res.Address] = ConfigReloadResult{
+				Err: fmt.Errorf(msgBadType, &pb.ConfigReloadResp{}, res.Value),
+			}
+			continue
+		}
+
+		cReload[res.Address] = ConfigReloadResult{
+			Applied: resp.Applied,
+			Pending: resp.Pending,
+			Failed:  resp.Failed,
+		}
+	}
+
+	return cReload
+}
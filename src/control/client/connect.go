@@ -29,6 +29,11 @@ import (
 	"sort"
 	"time"
 
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/daos-stack/daos/src/control/common"
 	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/logging"
@@ -40,6 +45,15 @@ const (
 	msgConnInactive = "socket connection is not active (%s)"
 )
 
+var (
+	// ErrRequestTimeout is the result for a server which did not respond
+	// before the request deadline.
+	ErrRequestTimeout = errors.New("timed out waiting for response")
+	// ErrRequestCanceled is the result for a server which had not
+	// responded when the request was canceled.
+	ErrRequestCanceled = errors.New("request canceled before response")
+)
+
 // Addresses is an alias for a slice of <ipv4/hostname>:<port> addresses.
 type Addresses []string
 
@@ -60,9 +74,15 @@ func (cr ClientResult) String() string {
 // ClientBioResult is a container for output of BIO health
 // query client requests.
 type ClientBioResult struct {
-	Address string
-	Stats   *pb.BioHealthResp
-	Err     error
+	Address string            `json:"addr"`
+	Stats   *pb.BioHealthResp `json:"stats,omitempty"`
+	Err     error             `json:"-"`
+}
+
+// MarshalJSON encodes the result with any error rendered as a string.
+func (cr ClientBioResult) MarshalJSON() ([]byte, error) {
+	type toJSON ClientBioResult
+	return common.MarshalWithError(toJSON(cr), cr.Err)
 }
 
 func (cr ClientBioResult) String() string {
@@ -122,9 +142,15 @@ func (cr ClientBioResult) String() string {
 // ClientSmdResult is a container for output of SMD dev list
 // query client requests.
 type ClientSmdResult struct {
-	Address string
-	Devs    *pb.SmdDevResp
-	Err     error
+	Address string         `json:"addr"`
+	Devs    *pb.SmdDevResp `json:"devs,omitempty"`
+	Err     error          `json:"-"`
+}
+
+// MarshalJSON encodes the result with any error rendered as a string.
+func (cr ClientSmdResult) MarshalJSON() ([]byte, error) {
+	type toJSON ClientSmdResult
+	return common.MarshalWithError(toJSON(cr), cr.Err)
 }
 
 func (cr ClientSmdResult) String() string {
@@ -230,6 +256,10 @@ func (c *controllerFactory) create(address string, cfg *security.TransportConfig
 
 // Connect is an external interface providing functionality across multiple
 // connected clients (controllers).
+//
+// Methods issuing requests to servers take a context which bounds the time
+// spent waiting for responses and allows outstanding requests to be
+// canceled.
 type Connect interface {
 	// SetTransportConfig sets the gRPC transport confguration
 	SetTransportConfig(*security.TransportConfig)
@@ -238,18 +268,31 @@ type Connect interface {
 	// GetActiveConns verifies states and removes inactive conns
 	GetActiveConns(ResultMap) ResultMap
 	ClearConns() ResultMap
-	StoragePrepare(*pb.StoragePrepareReq) ResultMap
-	StorageScan() (ClientCtrlrMap, ClientModuleMap, ClientPmemMap)
-	StorageFormat() (ClientCtrlrMap, ClientMountMap)
-	StorageUpdate(*pb.StorageUpdateReq) (ClientCtrlrMap, ClientModuleMap)
-	// TODO: implement Burnin client features
-	//StorageBurnIn() (ClientCtrlrMap, ClientModuleMap)
-	ListFeatures() ClientFeatureMap
-	KillRank(uuid string, rank uint32) ResultMap
-	PoolCreate(*PoolCreateReq) (*PoolCreateResp, error)
-	PoolDestroy(*PoolDestroyReq) error
-	BioHealthQuery(*pb.BioHealthReq) ResultQueryMap
-	SmdListDevs(*pb.SmdDevReq) ResultSmdMap
+	StoragePrepare(context.Context, *pb.StoragePrepareReq) ClientPrepareMap
+	StorageScan(context.Context) (ClientCtrlrMap, ClientModuleMap, ClientPmemMap)
+	StorageFormat(context.Context) (ClientCtrlrMap, ClientMountMap)
+	StorageUpdate(context.Context, *pb.StorageUpdateReq) (ClientCtrlrMap, ClientModuleMap)
+	StorageBurnIn(context.Context, *pb.StorageBurnInReq) ClientBurnInMap
+	ListFeatures(context.Context) ClientFeatureMap
+	InstanceQuery(context.Context) ClientInstanceMap
+	NetworkScan(ctx context.Context, provider string) ClientNetworkMap
+	ConfigReload(context.Context) ClientConfigReloadMap
+	SetLogMasks(context.Context, *SetLogMasksReq) ClientLogMasksMap
+	KillRank(ctx context.Context, uuid string, rank uint32) ClientKillRankMap
+	PoolCreate(context.Context, *PoolCreateReq) (*PoolCreateResp, error)
+	PoolDestroy(context.Context, *PoolDestroyReq) error
+	PoolQuery(context.Context, *PoolQueryReq) (*PoolQueryResp, error)
+	ListPools(context.Context, *ListPoolsReq) (*ListPoolsResp, error)
+	PoolGetACL(context.Context, *PoolGetACLReq) (*PoolACLResp, error)
+	PoolOverwriteACL(context.Context, *PoolOverwriteACLReq) (*PoolACLResp, error)
+	PoolUpdateACL(context.Context, *PoolUpdateACLReq) (*PoolACLResp, error)
+	PoolDeleteACL(context.Context, *PoolDeleteACLReq) (*PoolACLResp, error)
+	BioHealthQuery(context.Context, *pb.BioHealthReq) ResultQueryMap
+	SmdListDevs(context.Context, *pb.SmdDevReq) ResultSmdMap
+	SystemQuery(context.Context, *SystemQueryReq) (*SystemQueryResp, error)
+	SystemStop(context.Context, *SystemStopReq) (*SystemRankResp, error)
+	SystemStart(context.Context, *SystemStartReq) (*SystemRankResp, error)
+	LeaderQuery(context.Context, *LeaderQueryReq) (*LeaderQueryResp, error)
 }
 
 // connList is an implementation of Connect and stores controllers
@@ -259,6 +302,8 @@ type connList struct {
 	transportConfig *security.TransportConfig
 	factory         ControllerFactory
 	controllers     []Control
+	leader          Control  // cached management service leader
+	replicas        []string // reachable management service replicas
 }
 
 // SetTransportConfig sets the internal transport credentials to be passed
@@ -354,40 +399,66 @@ func (c *connList) ClearConns() ResultMap {
 		res := <-ch
 		results[res.Address] = res
 	}
+	c.clearLeader()
 	c.controllers = nil
 
 	return results
 }
 
+// hostError converts errors caused by the expiry or cancellation of a
+// request context, either locally or as reported by gRPC, into the errors
+// reported for a host which did not respond in time.
+func hostError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	cause := errors.Cause(err)
+	switch {
+	case cause == context.DeadlineExceeded, status.Code(cause) == codes.DeadlineExceeded:
+		return ErrRequestTimeout
+	case cause == context.Canceled, status.Code(cause) == codes.Canceled:
+		return ErrRequestCanceled
+	}
+
+	return err
+}
+
 // makeRequests performs supplied method over each controller in connList and
 // stores generic result object for each in map keyed on address.
-func (c *connList) makeRequests(
-	req interface{},
-	requestFn func(Control, interface{}, chan ClientResult)) ResultMap {
+//
+// If ctx is done before all controllers have responded, a timed out or
+// canceled result is stored for each of the outstanding addresses.
+func (c *connList) makeRequests(ctx context.Context, req interface{},
+	requestFn func(context.Context, Control, interface{}, chan ClientResult)) ResultMap {
 
 	cMap := make(ResultMap) // mapping of server host addresses to results
-	ch := make(chan ClientResult)
+	// buffered so requests completing after ctx is done don't block
+	ch := make(chan ClientResult, len(c.controllers))
 
 	addrs := []string{}
 	for _, mc := range c.controllers {
 		addrs = append(addrs, mc.getAddress())
-		go requestFn(mc, req, ch)
+		go requestFn(ctx, mc, req, ch)
 	}
 
-	for {
-		res := <-ch
-
-		// remove received address from list
-		for i, v := range addrs {
-			if v == res.Address {
-				addrs = append(addrs[:i], addrs[i+1:]...)
-				cMap[res.Address] = res
-				break
+	for len(addrs) > 0 {
+		select {
+		case <-ctx.Done():
+			for _, addr := range addrs {
+				cMap[addr] = ClientResult{addr, nil, hostError(ctx.Err())}
+			}
+			return cMap
+		case res := <-ch:
+			// remove received address from list
+			for i, v := range addrs {
+				if v == res.Address {
+					addrs = append(addrs[:i], addrs[i+1:]...)
+					res.Err = hostError(res.Err)
+					cMap[res.Address] = res
+					break
+				}
 			}
-		}
-
-		if len(addrs) == 0 {
-			break // received responses from all connections
 		}
 	}
 
@@ -24,11 +24,18 @@
 package client
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	. "google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/status"
 
 	. "github.com/daos-stack/daos/src/control/common"
 	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
@@ -153,26 +160,173 @@ func TestGetClearConns(t *testing.T) {
 	checkResults(t, MockServers, results, nil)
 }
 
+func TestMakeRequestsTimeout(t *testing.T) {
+	slowAddr := MockServers[0]
+
+	for name, tc := range map[string]struct {
+		timeout    time.Duration
+		cancel     bool
+		expSlowErr error
+	}{
+		"timed out": {
+			timeout:    10 * time.Millisecond,
+			expSlowErr: ErrRequestTimeout,
+		},
+		"canceled": {
+			timeout:    time.Minute,
+			cancel:     true,
+			expSlowErr: ErrRequestCanceled,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			cc := defaultClientSetup(log)
+
+			ctx, cancel := context.WithTimeout(context.Background(), tc.timeout)
+			defer cancel()
+
+			// slow server only responds once the request is done
+			requestFn := func(ctx context.Context, mc Control, req interface{}, ch chan ClientResult) {
+				if mc.getAddress() == slowAddr {
+					<-ctx.Done()
+				}
+				ch <- ClientResult{mc.getAddress(), nil, nil}
+			}
+			if tc.cancel {
+				go func() {
+					time.Sleep(10 * time.Millisecond)
+					cancel()
+				}()
+			}
+
+			results := cc.(*connList).makeRequests(ctx, nil, requestFn)
+
+			AssertEqual(t, len(results), len(MockServers), "unexpected number of results")
+			for _, addr := range MockServers {
+				var expErr error
+				if addr == slowAddr {
+					expErr = tc.expSlowErr
+				}
+				AssertEqual(t, results[addr].Err, expErr, "unexpected error for "+addr)
+			}
+		})
+	}
+}
+
 func TestListFeatures(t *testing.T) {
 	log, buf := logging.NewTestLogger(t.Name())
 	defer ShowBufferOnFailure(t, buf)()
 
 	cc := defaultClientSetup(log)
 
-	clientFeatures := cc.ListFeatures()
+	clientFeatures := cc.ListFeatures(context.Background())
 
 	AssertEqual(
 		t, clientFeatures, NewClientFM(MockFeatures, MockServers),
 		"unexpected client features returned")
 }
 
+func TestInstanceQuery(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	cc := defaultClientSetup(log)
+
+	clientInstances := cc.InstanceQuery(context.Background())
+
+	expected := make(ClientInstanceMap)
+	for _, addr := range MockServers {
+		expected[addr] = InstanceResult{MockInstances, nil}
+	}
+	AssertEqual(t, clientInstances, expected, "unexpected client instances returned")
+}
+
+func TestNetworkScan(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	cc := defaultClientSetup(log)
+
+	clientNetwork := cc.NetworkScan(context.Background(), "")
+
+	expected := make(ClientNetworkMap)
+	for _, addr := range MockServers {
+		expected[addr] = NetworkScanResult{MockFabricInterfaces, nil}
+	}
+	AssertEqual(t, clientNetwork, expected, "unexpected client network interfaces returned")
+
+	clientNetwork = cc.NetworkScan(context.Background(), "ofi+verbs")
+	for _, addr := range MockServers {
+		for _, fi := range clientNetwork[addr].Interfaces {
+			AssertEqual(t, fi.Provider, "ofi+verbs", "unexpected provider reported")
+		}
+	}
+}
+
+func TestConfigReload(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	cc := defaultClientSetup(log)
+
+	clientReload := cc.ConfigReload(context.Background())
+
+	expected := make(ClientConfigReloadMap)
+	for _, addr := range MockServers {
+		expected[addr] = ConfigReloadResult{
+			Applied: MockConfigReload.Applied,
+			Pending: MockConfigReload.Pending,
+		}
+	}
+	AssertEqual(t, clientReload, expected, "unexpected client config reload results returned")
+}
+
+func TestSetLogMasks(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	cc := defaultClientSetup(log)
+
+	for name, tc := range map[string]struct {
+		duration      time.Duration
+		expRevertTime *time.Time
+	}{
+		"permanent": {},
+		"time limited": {
+			duration:      10 * time.Minute,
+			expRevertTime: &MockRevertTime,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			clientMasks := cc.SetLogMasks(context.Background(), &SetLogMasksReq{
+				Level:    "DEBUG",
+				Duration: tc.duration,
+			})
+
+			expected := make(ClientLogMasksMap)
+			for _, addr := range MockServers {
+				expected[addr] = LogMasksResult{
+					ControlLevel: "DEBUG",
+					Instances: []*pb.InstanceLogMasks{
+						{Index: 0, Rank: 0, Masks: "DEBUG"},
+					},
+					RevertTime: tc.expRevertTime,
+				}
+			}
+			AssertEqual(t, clientMasks, expected, "unexpected client log masks results returned")
+		})
+	}
+}
+
 func TestStorageScan(t *testing.T) {
 	log, buf := logging.NewTestLogger(t.Name())
 	defer ShowBufferOnFailure(t, buf)()
 
 	cc := defaultClientSetup(log)
 
-	clientNvme, clientScm, clientPmem := cc.StorageScan()
+	clientNvme, clientScm, clientPmem := cc.StorageScan(context.Background())
 
 	AssertEqual(t, clientNvme, NewClientNvme(MockCtrlrs, MockServers),
 		"unexpected client NVMe SSD controllers returned")
@@ -205,7 +359,7 @@ func TestStorageFormat(t *testing.T) {
 			MockModuleResults, MockPmemDevices, MockMountResults, nil, tt.formatRet, nil, nil,
 			nil, nil)
 
-		cNvmeMap, cMountMap := cc.StorageFormat()
+		cNvmeMap, cMountMap := cc.StorageFormat(context.Background())
 
 		if tt.formatRet != nil {
 			for _, addr := range MockServers {
@@ -252,7 +406,7 @@ func TestStorageUpdate(t *testing.T) {
 			MockModuleResults, MockPmemDevices, MockMountResults, nil, nil, tt.updateRet, nil,
 			nil, nil)
 
-		cNvmeMap, cModuleMap := cc.StorageUpdate(new(pb.StorageUpdateReq))
+		cNvmeMap, cModuleMap := cc.StorageUpdate(context.Background(), new(pb.StorageUpdateReq))
 
 		if tt.updateRet != nil {
 			for _, addr := range MockServers {
@@ -278,6 +432,45 @@ func TestStorageUpdate(t *testing.T) {
 	}
 }
 
+func TestStorageBurnIn(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	tests := []struct {
+		burninRet error
+	}{
+		{
+			nil,
+		},
+		{
+			MockErr,
+		},
+	}
+
+	for _, tt := range tests {
+		cc := connectSetup(
+			log, Ready, MockFeatures, MockCtrlrs, MockCtrlrResults, MockModules,
+			MockModuleResults, MockPmemDevices, MockMountResults, nil, nil, nil, tt.burninRet,
+			nil, nil)
+
+		cBurnInMap := cc.StorageBurnIn(context.Background(), new(pb.StorageBurnInReq))
+
+		for _, addr := range MockServers {
+			expResult := StorageBurnInResult{
+				Crets:   MockCtrlrResults,
+				Mrets:   MockMountResults,
+				Results: MockBurnInResults,
+			}
+			if tt.burninRet != nil {
+				expResult = StorageBurnInResult{Err: tt.burninRet}
+			}
+
+			AssertEqual(t, cBurnInMap[addr], expResult,
+				"unexpected client burn-in results returned")
+		}
+	}
+}
+
 func TestKillRank(t *testing.T) {
 	log, buf := logging.NewTestLogger(t.Name())
 	defer ShowBufferOnFailure(t, buf)()
@@ -294,8 +487,370 @@ func TestKillRank(t *testing.T) {
 		cc := connectSetup(log, Ready, MockFeatures, MockCtrlrs, MockCtrlrResults, MockModules,
 			MockModuleResults, MockPmemDevices, MockMountResults, nil, nil, nil, nil, tt.killRet, nil)
 
-		resultMap := cc.KillRank("acd", 0)
+		resultMap := cc.KillRank(context.Background(), "acd", 0)
+
+		// request only issued to a single access point
+		AssertEqual(t, len(resultMap), 1, "unexpected number of results")
+		for _, res := range resultMap {
+			AssertEqual(t, res, KillRankResult{Rank: 0, Err: tt.killRet},
+				"unexpected kill-rank result")
+		}
+	}
+}
+
+func TestStoragePrepare(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	cc := defaultClientSetup(log)
+
+	prepareMap := cc.StoragePrepare(context.Background(), &pb.StoragePrepareReq{})
+
+	for _, addr := range MockServers {
+		AssertEqual(t, prepareMap[addr], StoragePrepareResult{
+			Nvme: &pb.PrepareNvmeResp{State: &MockSuccessState},
+			Scm:  &pb.PrepareScmResp{State: &MockSuccessState},
+		}, "unexpected prepare results")
+	}
+}
+
+func TestSystemQuery(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	cc := defaultClientSetup(log)
+
+	resp, err := cc.SystemQuery(context.Background(), &SystemQueryReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expResp := &SystemQueryResp{}
+	for _, m := range MockMembers {
+		expResp.Members = append(expResp.Members, &SystemMember{
+			Rank:             m.Rank,
+			UUID:             m.Uuid,
+			URI:              m.Uri,
+			Addr:             m.Addr,
+			State:            m.State,
+			LastSeen:         time.Unix(m.LastSeen, 0),
+			HeartbeatLatency: time.Duration(m.HeartbeatLatency),
+		})
+	}
+	AssertEqual(t, resp, expResp, "unexpected system query response")
+}
+
+func TestSystemStopStart(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	cc := defaultClientSetup(log)
+
+	resp, err := cc.SystemStop(context.Background(), &SystemStopReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var expResults []*RankResult
+	for _, rank := range []uint32{0, 1} {
+		for _, addr := range MockServers {
+			expResults = append(expResults, &RankResult{
+				Rank: rank, Address: addr, Action: "stop", Msg: "stopped",
+			})
+		}
+	}
+	AssertEqual(t, resp, &SystemRankResp{Results: expResults}, "unexpected system stop response")
+
+	if _, err := cc.SystemStop(context.Background(), &SystemStopReq{Ranks: []uint32{1, 5}}); err == nil {
+		t.Fatal("expected error stopping rank which is not a member")
+	}
+
+	// forced stops go ahead unordered if the management service can't
+	// be queried
+	resp, err = cc.SystemStop(context.Background(), &SystemStopReq{Ranks: []uint32{1, 5}, Force: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertTrue(t, strings.HasPrefix(resp.OrderSkipped, "query system members"),
+		"expected skipped ordering to be reported, got "+resp.OrderSkipped)
+	expResults = nil
+	for _, rank := range []uint32{1, 5} {
+		for _, addr := range MockServers {
+			expResults = append(expResults, &RankResult{
+				Rank: rank, Address: addr, Action: "stop", Msg: "stopped",
+			})
+		}
+	}
+	AssertEqual(t, resp.Results, expResults, "unexpected forced system stop results")
+
+	resp, err = cc.SystemStart(context.Background(), &SystemStartReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expResults = nil
+	for _, addr := range MockServers {
+		expResults = append(expResults, &RankResult{
+			Rank: 0, Address: addr, Action: "start", Errored: true, Msg: "failed",
+		})
+	}
+	AssertEqual(t, resp, &SystemRankResp{Results: expResults}, "unexpected system start response")
+
+	if _, err := NewConnect(log).SystemStop(context.Background(), &SystemStopReq{}); err == nil {
+		t.Fatal("expected error with no active connections")
+	}
+}
+
+func TestListPools(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	cc := defaultClientSetup(log)
+
+	resp, err := cc.ListPools(context.Background(), &ListPoolsReq{Sys: "daos_server"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	AssertEqual(t, resp, &ListPoolsResp{
+		Pools: []*PoolDiscovery{
+			{
+				UUID:        MockPools[0].Uuid,
+				SvcReplicas: MockPools[0].Svcreps,
+				ScmBytes:    MockPools[0].Scmbytes,
+				NvmeBytes:   MockPools[0].Nvmebytes,
+			},
+		},
+	}, "unexpected list pools response")
+}
+
+// mockLeaderSvcClient reports leader as the management service leader and
+// rejects pool queries unless addressed as the leader, a loopback leader
+// address refers to whichever server receives the request. Pool queries
+// fail as unavailable if the server is down, in which case any surviving
+// server is accepted as the new leader.
+type mockLeaderSvcClient struct {
+	pb.MgmtSvcClient
+	addr   string
+	leader *string
+	down   string
+}
+
+func (m *mockLeaderSvcClient) LeaderQuery(ctx context.Context, req *pb.LeaderQueryReq, o ...grpc.CallOption) (*pb.LeaderQueryResp, error) {
+	return &pb.LeaderQueryResp{CurrentLeader: *m.leader, Replicas: MockServers}, nil
+}
+
+func (m *mockLeaderSvcClient) PoolQuery(ctx context.Context, req *pb.PoolQueryReq, o ...grpc.CallOption) (*pb.PoolQueryResp, error) {
+	switch {
+	case m.addr == m.down:
+		return nil, status.Error(codes.Unavailable, "connection refused")
+	case *m.leader == m.down:
+		// surviving servers take over from a down leader
+	case m.addr != *m.leader && !isLoopback(*m.leader):
+		return nil, status.Error(codes.FailedPrecondition, "instance is not an access point")
+	}
+	return MockPoolQuery, nil
+}
+
+func TestServiceLeader(t *testing.T) {
+	for name, tc := range map[string]struct {
+		leader    string
+		newLeader string
+		down      string
+		expLeader string
+	}{
+		"connected leader": {
+			leader:    MockServers[1],
+			expLeader: MockServers[1],
+		},
+		"loopback leader": {
+			leader:    "localhost:10001",
+			expLeader: MockServers[0],
+		},
+		"unconnected leader": {
+			leader:    "1.2.3.6:10001",
+			expLeader: "1.2.3.6:10001",
+		},
+		"redirect to new leader": {
+			leader:    MockServers[0],
+			newLeader: MockServers[1],
+			expLeader: MockServers[1],
+		},
+		"fail over from unavailable leader": {
+			leader:    MockServers[0],
+			down:      MockServers[0],
+			expLeader: MockServers[1],
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			leader := tc.leader
+			cc := defaultMockConnect(log).(*connList)
+			for _, addr := range MockServers {
+				cc.controllers = append(cc.controllers, newMockControl(
+					log, addr, Ready, nil, nil, &mockLeaderSvcClient{
+						addr: addr, leader: &leader, down: tc.down,
+					}, nil))
+			}
+
+			if _, err := cc.PoolQuery(context.Background(), &PoolQueryReq{}); err != nil {
+				t.Fatal(err)
+			}
+			if tc.newLeader != "" {
+				leader = tc.newLeader
+				if _, err := cc.PoolQuery(context.Background(), &PoolQueryReq{}); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			AssertEqual(t, cc.leader.getAddress(), tc.expLeader, "unexpected leader")
+		})
+	}
+}
+
+func TestLeaderQuery(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	cc := defaultClientSetup(log)
+
+	resp, err := cc.LeaderQuery(context.Background(), &LeaderQueryReq{System: "daos_server"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	AssertEqual(t, resp, &LeaderQueryResp{
+		Leader:   MockServers[0],
+		Replicas: MockServers[:1],
+	}, "unexpected leader query response")
+
+	if _, err := NewConnect(log).LeaderQuery(context.Background(), &LeaderQueryReq{}); err == nil {
+		t.Fatal("expected error with no active connections")
+	}
+}
+
+func TestPoolQuery(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	cc := defaultClientSetup(log)
+
+	resp, err := cc.PoolQuery(context.Background(), &PoolQueryReq{UUID: MockPoolQuery.Uuid})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	AssertEqual(t, resp, &PoolQueryResp{
+		UUID:            MockPoolQuery.Uuid,
+		TotalTargets:    8,
+		ActiveTargets:   7,
+		DisabledTargets: 1,
+		Rebuild:         &PoolRebuildStatus{State: "busy", Objects: 42, Records: 1024},
+		Scm: &StorageUsageStats{
+			Total: 1 << 30, Free: 1 << 29, Min: 1 << 26, Max: 1 << 27, Mean: 3 << 25,
+		},
+		Nvme: &StorageUsageStats{},
+	}, "unexpected pool query response")
+}
+
+func TestPoolACL(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	cc := defaultClientSetup(log)
+	uuid := "12345678-1234-1234-1234-123456789abc"
+	newACL := []string{"A::user1@:rw"}
+
+	for name, tt := range map[string]struct {
+		call   func() (*PoolACLResp, error)
+		expACL []string
+		expErr error
+	}{
+		"get": {
+			call: func() (*PoolACLResp, error) {
+				return cc.PoolGetACL(context.Background(), &PoolGetACLReq{UUID: uuid})
+			},
+			expACL: MockACL,
+		},
+		"overwrite": {
+			call: func() (*PoolACLResp, error) {
+				return cc.PoolOverwriteACL(context.Background(), &PoolOverwriteACLReq{UUID: uuid, ACL: newACL})
+			},
+			expACL: newACL,
+		},
+		"update": {
+			call: func() (*PoolACLResp, error) {
+				return cc.PoolUpdateACL(context.Background(), &PoolUpdateACLReq{UUID: uuid, ACL: newACL})
+			},
+			expACL: append(append([]string{}, MockACL...), newACL...),
+		},
+		"delete fails": {
+			call: func() (*PoolACLResp, error) {
+				return cc.PoolDeleteACL(context.Background(), &PoolDeleteACLReq{UUID: uuid, Principal: "u:user1@"})
+			},
+			expErr: errors.New("DAOS returned error code: -1005\n"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp, err := tt.call()
+			if tt.expErr != nil {
+				ExpectError(t, err, tt.expErr.Error(), name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			AssertEqual(t, resp, &PoolACLResp{ACL: tt.expACL}, "unexpected ACL response")
+		})
+	}
+}
+
+func TestResultsJSON(t *testing.T) {
+	for name, tt := range map[string]struct {
+		in     interface{}
+		expOut string
+	}{
+		"network scan": {
+			in: ClientNetworkMap{
+				"host1": NetworkScanResult{Interfaces: MockFabricInterfaces[:1]},
+				"host2": NetworkScanResult{Err: MockErr},
+			},
+			expOut: `{"host1":{"interfaces":[{"provider":"ofi+sockets","device":"ib0",` +
+				`"cpuset":"0x000000ff,0xffff0000,0x00ffffff","nodeset":"0x00000001"}]},` +
+				`"host2":{"interfaces":null,"error":"unknown failure"}}`,
+		},
+		"config reload": {
+			in: ClientConfigReloadMap{
+				"host1": ConfigReloadResult{Applied: []string{"control_log_mask"}},
+				"host2": ConfigReloadResult{Err: MockErr},
+			},
+			expOut: `{"host1":{"applied":["control_log_mask"],"pending":null,"failed":null},` +
+				`"host2":{"applied":null,"pending":null,"failed":null,"error":"unknown failure"}}`,
+		},
+		"nvme controller results": {
+			in: ClientCtrlrMap{
+				"host1": CtrlrResults{Responses: MockCtrlrResults},
+				"host2": CtrlrResults{Err: MockErr},
+			},
+			expOut: `{"host1":{"results":[{"pciaddr":"0000:81:00.0","state":` +
+				`{"status":-4,"error":"example application error"}}]},` +
+				`"host2":{"error":"unknown failure"}}`,
+		},
+		"kill rank": {
+			in:     ClientKillRankMap{"host1": KillRankResult{Rank: 2, Err: MockErr}},
+			expOut: `{"host1":{"rank":2,"status":0,"error":"unknown failure"}}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			out, err := json.Marshal(tt.in)
+			if err != nil {
+				t.Fatal(err)
+			}
 
-		checkResults(t, Addresses{MockServers[0]}, resultMap, tt.killRet)
+			AssertEqual(t, string(out), tt.expOut, "unexpected JSON output")
+		})
 	}
 }
@@ -30,6 +30,7 @@ import (
 	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/security/acl"
 )
 
 // Control interface provides connection handling capabilities.
@@ -40,6 +41,7 @@ type Control interface {
 	getAddress() string
 	getCtlClient() pb.MgmtCtlClient
 	getSvcClient() pb.MgmtSvcClient
+	getACLClient() acl.AccessControlClient
 	logger() logging.Logger
 }
 
@@ -49,6 +51,7 @@ type Control interface {
 type control struct {
 	ctlClient pb.MgmtCtlClient
 	svcClient pb.MgmtSvcClient
+	aclClient acl.AccessControlClient
 	gconn     *grpc.ClientConn
 	log       logging.Logger
 }
@@ -76,6 +79,7 @@ func (c *control) connect(addr string, cfg *security.TransportConfig) (err error
 	}
 	c.ctlClient = pb.NewMgmtCtlClient(conn)
 	c.svcClient = pb.NewMgmtSvcClient(conn)
+	c.aclClient = acl.NewAccessControlClient(conn)
 	c.gconn = conn
 
 	return
@@ -102,5 +106,6 @@ func (c *control) connected() (state connectivity.State, ok bool) {
 // getAddress returns the target address of the connection.
 func (c *control) getAddress() string { return c.gconn.Target() }
 
-func (c *control) getCtlClient() pb.MgmtCtlClient { return c.ctlClient }
-func (c *control) getSvcClient() pb.MgmtSvcClient { return c.svcClient }
+func (c *control) getCtlClient() pb.MgmtCtlClient        { return c.ctlClient }
+func (c *control) getSvcClient() pb.MgmtSvcClient        { return c.svcClient }
+func (c *control) getACLClient() acl.AccessControlClient { return c.aclClient }
@@ -28,7 +28,6 @@ import (
 	"fmt"
 	"io"
 	"sort"
-	"time"
 
 	"golang.org/x/net/context"
 
@@ -84,10 +83,7 @@ func (cfm ClientFeatureMap) String() string {
 // listAllFeatures returns map of all supported management features.
 // listFeaturesRequest is to be called as a goroutine and returns result
 // containing supported server features over channel.
-func listFeaturesRequest(mc Control, i interface{}, ch chan ClientResult) {
-	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
-	defer cancel()
-
+func listFeaturesRequest(ctx context.Context, mc Control, i interface{}, ch chan ClientResult) {
 	stream, err := mc.getCtlClient().ListFeatures(ctx, &pb.EmptyReq{})
 	if err != nil {
 		ch <- ClientResult{mc.getAddress(), nil, err}
@@ -112,9 +108,9 @@ func listFeaturesRequest(mc Control, i interface{}, ch chan ClientResult) {
 }
 
 // ListFeatures returns supported management features for each server connected.
-func (c *connList) ListFeatures() ClientFeatureMap {
+func (c *connList) ListFeatures(ctx context.Context) ClientFeatureMap {
 	var err error
-	cResults := c.makeRequests(nil, listFeaturesRequest)
+	cResults := c.makeRequests(ctx, nil, listFeaturesRequest)
 	cFeatures := make(ClientFeatureMap) // mapping of server addresses to features
 
 	for _, res := range cResults {
@@ -0,0 +1,147 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/daos-stack/daos/src/control/common"
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+// nilRank is reported for instances which have not yet been assigned a rank.
+const nilRank = math.MaxUint32
+
+// InstanceResult contains the status of I/O server instances managed by a
+// server, or the error encountered when requesting them.
+type InstanceResult struct {
+	Instances []*pb.InstanceStatus `json:"instances"`
+	Err       error                `json:"-"`
+}
+
+// MarshalJSON encodes the result with any error rendered as a string.
+func (ir InstanceResult) MarshalJSON() ([]byte, error) {
+	type toJSON InstanceResult
+	return common.MarshalWithError(toJSON(ir), ir.Err)
+}
+
+func (ir InstanceResult) String() string {
+	var buf bytes.Buffer
+
+	if ir.Err != nil {
+		return ir.Err.Error()
+	}
+
+	for _, is := range ir.Instances {
+		rank := "unknown"
+		if is.Rank != nilRank {
+			rank = fmt.Sprintf("%d", is.Rank)
+		}
+		// servers predating instance states only report whether the
+		// process is running
+		state := is.State
+		if state == "" {
+			state = "stopped"
+			if is.Running {
+				state = "running"
+			}
+		}
+		fmt.Fprintf(&buf, "\tinstance %d: rank %s, %s, restarts %d\n",
+			is.Index, rank, state, is.Restarts)
+		if is.LastExit != "" {
+			fmt.Fprintf(&buf, "\t\tlast exit at %s: %s\n",
+				time.Unix(is.LastExitTime, 0).Format(time.RFC3339), is.LastExit)
+		}
+		for _, t := range is.Transitions {
+			fmt.Fprintf(&buf, "\t\t%s %s -> %s: %s\n",
+				time.Unix(0, t.Time).Format(time.RFC3339), t.From, t.To, t.Reason)
+		}
+	}
+
+	return buf.String()
+}
+
+// ClientInstanceMap is an alias for I/O server instance statuses reported
+// by servers connected to given client.
+type ClientInstanceMap map[string]InstanceResult
+
+func (cim ClientInstanceMap) String() string {
+	var buf bytes.Buffer
+	servers := make([]string, 0, len(cim))
+
+	for server := range cim {
+		servers = append(servers, server)
+	}
+	sort.Strings(servers)
+
+	for _, server := range servers {
+		fmt.Fprintf(&buf, "%s:\n%s\n", server, cim[server])
+	}
+
+	return buf.String()
+}
+
+// instanceQueryRequest is to be called as a goroutine and returns result
+// containing I/O server instance statuses over channel.
+func instanceQueryRequest(ctx context.Context, mc Control, i interface{}, ch chan ClientResult) {
+	resp, err := mc.getCtlClient().InstanceQuery(ctx, &pb.InstanceQueryReq{})
+	if err != nil {
+		ch <- ClientResult{mc.getAddress(), nil, err}
+		return
+	}
+
+	ch <- ClientResult{mc.getAddress(), resp.Instances, nil}
+}
+
+// InstanceQuery returns the status of I/O server instances, including
+// restart history, for each server connected.
+func (c *connList) InstanceQuery(ctx context.Context) ClientInstanceMap {
+	cResults := c.makeRequests(ctx, nil, instanceQueryRequest)
+	cInstances := make(ClientInstanceMap)
+
+	for _, res := range cResults {
+		if res.Err != nil {
+			cInstances[res.Address] = InstanceResult{nil, res.Err}
+			continue
+		}
+
+		instances, ok := res.Value.([]*pb.InstanceStatus)
+		if !ok {
+			cInstances[res.Address] = InstanceResult{
+				nil, fmt.Errorf(msgBadType, []*pb.InstanceStatus{}, res.Value),
+			}
+			continue
+		}
+
+		cInstances[res.Address] = InstanceResult{instances, nil}
+	}
+
+	return cInstances
+}
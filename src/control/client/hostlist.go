@@ -0,0 +1,260 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package client
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+)
+
+const (
+	hostFileComment = "#"
+	// maxHostListSize limits the number of addresses a single range
+	// expression can expand to, guarding against typos like node[1-1000000].
+	maxHostListSize = 16384
+)
+
+// ParseHostList expands a comma separated list of host address patterns into
+// a deduplicated list of <host>:<port> addresses.
+//
+// Each pattern may contain one or more bracketed range expressions, e.g.
+// "node[001-128,200]:10001" expands to node001:10001 ... node128:10001 and
+// node200:10001. Zero padding is taken from the lower bound of each range.
+// Addresses without an explicit port are given defaultPort.
+func ParseHostList(in string, defaultPort int) (Addresses, error) {
+	var addrs Addresses
+
+	patterns, err := splitHostPatterns(in)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pattern := range patterns {
+		hosts, err := expandHostPattern(pattern)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "invalid host pattern %q", pattern)
+		}
+
+		for _, host := range hosts {
+			addr, err := hostWithPort(host, defaultPort)
+			if err != nil {
+				return nil, errors.WithMessagef(err, "invalid host pattern %q", pattern)
+			}
+			if common.Include(addrs, addr) {
+				continue
+			}
+			if len(addrs) == maxHostListSize {
+				return nil, errors.Errorf("host list exceeds %d addresses",
+					maxHostListSize)
+			}
+			addrs = append(addrs, addr)
+		}
+	}
+
+	return addrs, nil
+}
+
+// ReadHostFile parses a host file containing one host address pattern (as
+// accepted by ParseHostList) per line and returns a deduplicated address list.
+//
+// Blank lines are ignored, as is anything following a "#" on a line.
+func ReadHostFile(path string, defaultPort int) (Addresses, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "opening host file")
+	}
+	defer f.Close()
+
+	var addrs Addresses
+	scanner := bufio.NewScanner(f)
+	for lineNum := 1; scanner.Scan(); lineNum++ {
+		line := scanner.Text()
+		if idx := strings.Index(line, hostFileComment); idx >= 0 {
+			line = line[:idx]
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		lineAddrs, err := ParseHostList(line, defaultPort)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "%s:%d", path, lineNum)
+		}
+		for _, addr := range lineAddrs {
+			if !common.Include(addrs, addr) {
+				addrs = append(addrs, addr)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.Wrap(err, "reading host file")
+	}
+
+	if len(addrs) == 0 {
+		return nil, errors.Errorf("no host addresses found in %s", path)
+	}
+
+	return addrs, nil
+}
+
+// splitHostPatterns splits input on commas which are not enclosed in a
+// bracketed range expression.
+func splitHostPatterns(in string) ([]string, error) {
+	var patterns []string
+	var depth, start int
+
+	for i, c := range in {
+		switch c {
+		case '[':
+			if depth > 0 {
+				return nil, errors.Errorf("nested '[' in %q", in)
+			}
+			depth++
+		case ']':
+			if depth == 0 {
+				return nil, errors.Errorf("unmatched ']' in %q", in)
+			}
+			depth--
+		case ',':
+			if depth == 0 {
+				patterns = append(patterns, in[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, errors.Errorf("unmatched '[' in %q", in)
+	}
+	patterns = append(patterns, in[start:])
+
+	nonEmpty := patterns[:0]
+	for _, p := range patterns {
+		if p = strings.TrimSpace(p); p != "" {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+
+	return nonEmpty, nil
+}
+
+// expandHostPattern expands the first range expression in pattern and
+// recurses to expand any that follow.
+func expandHostPattern(pattern string) ([]string, error) {
+	open := strings.Index(pattern, "[")
+	if open < 0 {
+		return []string{pattern}, nil
+	}
+	end := strings.Index(pattern[open:], "]")
+	if end < 0 {
+		return nil, errors.New("unmatched '['")
+	}
+	end += open
+
+	prefix, ranges, suffix := pattern[:open], pattern[open+1:end], pattern[end+1:]
+
+	suffixes, err := expandHostPattern(suffix)
+	if err != nil {
+		return nil, err
+	}
+
+	var hosts []string
+	for _, rng := range strings.Split(ranges, ",") {
+		values, err := expandRange(strings.TrimSpace(rng))
+		if err != nil {
+			return nil, err
+		}
+		if len(hosts)+len(values)*len(suffixes) > maxHostListSize {
+			return nil, errors.Errorf("range expression expands to more than %d hosts",
+				maxHostListSize)
+		}
+		for _, v := range values {
+			for _, s := range suffixes {
+				hosts = append(hosts, prefix+v+s)
+			}
+		}
+	}
+
+	return hosts, nil
+}
+
+// expandRange expands a single "N" or "N-M" range, zero padding results to
+// the width of the lower bound.
+func expandRange(rng string) ([]string, error) {
+	bounds := strings.Split(rng, "-")
+	if len(bounds) > 2 || bounds[0] == "" {
+		return nil, errors.Errorf("invalid range %q", rng)
+	}
+
+	lo, err := strconv.ParseUint(bounds[0], 10, 32)
+	if err != nil {
+		return nil, errors.Errorf("invalid range %q", rng)
+	}
+	hi := lo
+	if len(bounds) == 2 {
+		if hi, err = strconv.ParseUint(bounds[1], 10, 32); err != nil {
+			return nil, errors.Errorf("invalid range %q", rng)
+		}
+	}
+	if hi < lo {
+		return nil, errors.Errorf("invalid range %q: upper bound less than lower", rng)
+	}
+	if hi-lo >= maxHostListSize {
+		return nil, errors.Errorf("range %q expands to more than %d hosts",
+			rng, maxHostListSize)
+	}
+
+	width := len(bounds[0])
+	values := make([]string, 0, hi-lo+1)
+	for i := lo; i <= hi; i++ {
+		values = append(values, fmt.Sprintf("%0*d", width, i))
+	}
+
+	return values, nil
+}
+
+// hostWithPort validates any port supplied with host or appends defaultPort.
+func hostWithPort(host string, defaultPort int) (string, error) {
+	idx := strings.LastIndex(host, ":")
+	if idx < 0 {
+		return fmt.Sprintf("%s:%d", host, defaultPort), nil
+	}
+
+	if idx == 0 {
+		return "", errors.Errorf("missing hostname in %q", host)
+	}
+	port, err := strconv.Atoi(host[idx+1:])
+	if err != nil || port <= 0 || port > 65535 {
+		return "", errors.Errorf("invalid port in %q", host)
+	}
+
+	return host, nil
+}
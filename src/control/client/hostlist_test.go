@@ -0,0 +1,156 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package client
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	. "github.com/daos-stack/daos/src/control/common"
+)
+
+func TestParseHostList(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in     string
+		expOut Addresses
+		expErr error
+	}{
+		"empty": {
+			in: "",
+		},
+		"single host default port": {
+			in:     "foo",
+			expOut: Addresses{"foo:10001"},
+		},
+		"single host with port": {
+			in:     "foo:10000",
+			expOut: Addresses{"foo:10000"},
+		},
+		"list with duplicates": {
+			in:     "foo:10001, bar,foo,",
+			expOut: Addresses{"foo:10001", "bar:10001"},
+		},
+		"padded range": {
+			in:     "node[008-011]:10000",
+			expOut: Addresses{"node008:10000", "node009:10000", "node010:10000", "node011:10000"},
+		},
+		"unpadded range and single values": {
+			in:     "node[9-10,200]",
+			expOut: Addresses{"node9:10001", "node10:10001", "node200:10001"},
+		},
+		"multiple ranges": {
+			in:     "r[1-2]n[1-2]-ib0,node3",
+			expOut: Addresses{"r1n1-ib0:10001", "r1n2-ib0:10001", "r2n1-ib0:10001", "r2n2-ib0:10001", "node3:10001"},
+		},
+		"overlapping ranges": {
+			in:     "node[1-3],node[2-4]",
+			expOut: Addresses{"node1:10001", "node2:10001", "node3:10001", "node4:10001"},
+		},
+		"unmatched open bracket": {
+			in:     "node[1-3",
+			expErr: errors.New("unmatched '[' in \"node[1-3\""),
+		},
+		"unmatched close bracket": {
+			in:     "node1-3]",
+			expErr: errors.New("unmatched ']' in \"node1-3]\""),
+		},
+		"reversed range": {
+			in:     "node[3-1]",
+			expErr: errors.New("invalid host pattern \"node[3-1]\": invalid range \"3-1\": upper bound less than lower"),
+		},
+		"bad range": {
+			in:     "node[a-b]",
+			expErr: errors.New("invalid host pattern \"node[a-b]\": invalid range \"a-b\""),
+		},
+		"bad port": {
+			in:     "node1:port",
+			expErr: errors.New("invalid host pattern \"node1:port\": invalid port in \"node1:port\""),
+		},
+		"range too large": {
+			in:     "node[0-99999]",
+			expErr: errors.New("invalid host pattern \"node[0-99999]\": range \"0-99999\" expands to more than 16384 hosts"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			out, err := ParseHostList(tc.in, defaultHostPort)
+			if tc.expErr != nil {
+				ExpectError(t, err, tc.expErr.Error(), name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			AssertEqual(t, out, tc.expOut, name)
+		})
+	}
+}
+
+func TestReadHostFile(t *testing.T) {
+	for name, tc := range map[string]struct {
+		contents string
+		expOut   Addresses
+		expErr   string
+	}{
+		"hosts and comments": {
+			contents: "# compute nodes\nnode[1-2]:10000\n\n  node3 # spare\nnode1:10000\n",
+			expOut:   Addresses{"node1:10000", "node2:10000", "node3:10001"},
+		},
+		"only comments": {
+			contents: "# nothing here\n",
+			expErr:   "no host addresses found in %s",
+		},
+		"bad line": {
+			contents: "node1\nnode[2-\n",
+			expErr:   "%s:2: unmatched '[' in \"node[2-\"",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			f, err := ioutil.TempFile("", "hostfile")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.Remove(f.Name())
+			if _, err := f.WriteString(tc.contents); err != nil {
+				t.Fatal(err)
+			}
+			f.Close()
+
+			out, err := ReadHostFile(f.Name(), defaultHostPort)
+			if tc.expErr != "" {
+				ExpectError(t, err, fmt.Sprintf(tc.expErr, f.Name()), name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			AssertEqual(t, out, tc.expOut, name)
+		})
+	}
+}
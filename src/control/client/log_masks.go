@@ -0,0 +1,176 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/daos-stack/daos/src/control/common"
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+// SetLogMasksReq contains the log level to set on servers, restricted to
+// the given I/O server subsystems and ranks if specified. If Duration is
+// set, the previous levels are restored once it has elapsed.
+type SetLogMasksReq struct {
+	Level      string
+	Subsystems []string
+	Ranks      []uint32
+	Duration   time.Duration
+}
+
+// LogMasksResult contains the log levels set on a server, or the error
+// encountered when requesting the change.
+type LogMasksResult struct {
+	ControlLevel string                 `json:"control_level"`
+	Instances    []*pb.InstanceLogMasks `json:"instances"`
+	RevertTime   *time.Time             `json:"revert_time,omitempty"`
+	Err          error                  `json:"-"`
+}
+
+// MarshalJSON encodes the result with any error rendered as a string.
+func (lmr LogMasksResult) MarshalJSON() ([]byte, error) {
+	type toJSON LogMasksResult
+	return common.MarshalWithError(toJSON(lmr), lmr.Err)
+}
+
+func (lmr LogMasksResult) String() string {
+	var buf bytes.Buffer
+
+	if lmr.Err != nil {
+		return "\t" + lmr.Err.Error() + "\n"
+	}
+
+	if lmr.ControlLevel == "" && len(lmr.Instances) == 0 {
+		return "\tno changes\n"
+	}
+
+	controlLevel := lmr.ControlLevel
+	if controlLevel == "" {
+		controlLevel = "unchanged"
+	}
+	fmt.Fprintf(&buf, "\tdaos_server log level: %s\n", controlLevel)
+
+	for _, im := range lmr.Instances {
+		rank := "unknown"
+		if im.Rank != nilRank {
+			rank = fmt.Sprintf("%d", im.Rank)
+		}
+		fmt.Fprintf(&buf, "\tinstance %d: rank %s, log masks %s", im.Index, rank, im.Masks)
+		if im.Error != "" {
+			fmt.Fprintf(&buf, " failed: %s", im.Error)
+		}
+		fmt.Fprintln(&buf)
+	}
+
+	if lmr.RevertTime != nil {
+		fmt.Fprintf(&buf, "\tprevious levels restored at %s\n", lmr.RevertTime.Format(time.RFC3339))
+	}
+
+	return buf.String()
+}
+
+// ClientLogMasksMap is an alias for log level changes reported by servers
+// connected to given client.
+type ClientLogMasksMap map[string]LogMasksResult
+
+func (clm ClientLogMasksMap) String() string {
+	var buf bytes.Buffer
+	servers := make([]string, 0, len(clm))
+
+	for server := range clm {
+		servers = append(servers, server)
+	}
+	sort.Strings(servers)
+
+	for _, server := range servers {
+		fmt.Fprintf(&buf, "%s:\n%s\n", server, clm[server])
+	}
+
+	return buf.String()
+}
+
+// setLogMasksRequest is to be called as a goroutine and returns result
+// containing the log levels set over channel.
+func setLogMasksRequest(ctx context.Context, mc Control, req interface{}, ch chan ClientResult) {
+	setReq, ok := req.(*pb.ServerSetLogMasksReq)
+	if !ok {
+		err := fmt.Errorf(msgTypeAssert, &pb.ServerSetLogMasksReq{}, req)
+		ch <- ClientResult{mc.getAddress(), nil, err}
+		return
+	}
+
+	resp, err := mc.getCtlClient().ServerSetLogMasks(ctx, setReq)
+	if err != nil {
+		ch <- ClientResult{mc.getAddress(), nil, err}
+		return
+	}
+
+	ch <- ClientResult{mc.getAddress(), resp, nil}
+}
+
+// SetLogMasks requests each server connected to change the log level of
+// daos_server and the log masks of its running I/O server instances.
+func (c *connList) SetLogMasks(ctx context.Context, req *SetLogMasksReq) ClientLogMasksMap {
+	pbReq := &pb.ServerSetLogMasksReq{
+		Level:      req.Level,
+		Subsystems: req.Subsystems,
+		Ranks:      req.Ranks,
+		Duration:   int64(req.Duration),
+	}
+	cResults := c.makeRequests(ctx, pbReq, setLogMasksRequest)
+	cMasks := make(ClientLogMasksMap)
+
+	for _, res := range cResults {
+		if res.Err != nil {
+			cMasks[res.Address] = LogMasksResult{Err: res.Err}
+			continue
+		}
+
+		resp, ok := res.Value.(*pb.ServerSetLogMasksResp)
+		if !ok {
+			cMasks[res.Address] = LogMasksResult{
+				Err: fmt.Errorf(msgBadType, &pb.ServerSetLogMasksResp{}, res.Value),
+			}
+			continue
+		}
+
+		result := LogMasksResult{
+			ControlLevel: resp.ControlLevel,
+			Instances:    resp.Instances,
+		}
+		if resp.RevertTime != 0 {
+			revertTime := time.Unix(0, resp.RevertTime)
+			result.RevertTime = &revertTime
+		}
+		cMasks[res.Address] = result
+	}
+
+	return cMasks
+}
@@ -26,6 +26,7 @@ package client
 import (
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
@@ -37,6 +38,7 @@ import (
 	. "github.com/daos-stack/daos/src/control/common/storage"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/security/acl"
 )
 
 var (
@@ -69,6 +71,103 @@ var (
 			State:    &MockState,
 		},
 	}
+	MockBurnInResults = []*pb.BurnInResult{
+		{
+			Device: "0000:81:00.0",
+			Read: &pb.BurnInStats{
+				Iops: 1000,
+				Bw:   4000,
+				Latency: []*pb.LatencyPercentile{
+					{Percentile: 50, Nsec: 1500},
+					{Percentile: 99, Nsec: 5000},
+				},
+			},
+		},
+	}
+	MockInstances = []*pb.InstanceStatus{
+		{
+			Index:        0,
+			Rank:         0,
+			Running:      true,
+			Restarts:     1,
+			LastExit:     "process exited with 0",
+			LastExitTime: 1500000000,
+			State:        "joined",
+			Transitions: []*pb.InstanceTransition{
+				{
+					From:   "ready",
+					To:     "joined",
+					Time:   1500000060000000000,
+					Reason: "rank 0",
+				},
+			},
+		},
+	}
+	MockFabricInterfaces = []*pb.FabricInterface{
+		{
+			Provider: "ofi+sockets",
+			Device:   "ib0",
+			Numanode: 0,
+			Cpuset:   "0x000000ff,0xffff0000,0x00ffffff",
+			Nodeset:  "0x00000001",
+		},
+		{
+			Provider: "ofi+sockets",
+			Device:   "ib1",
+			Numanode: 1,
+			Cpuset:   "0xffffff00,0x0000ffff,0xff000000",
+			Nodeset:  "0x00000002",
+		},
+	}
+	MockRevertTime   = time.Unix(1580000000, 0)
+	MockConfigReload = &pb.ConfigReloadResp{
+		Applied: []string{"control_log_mask", "servers[0].log_mask"},
+		Pending: []string{"servers[0].fabric_iface"},
+	}
+	MockMembers = []*pb.SystemMember{
+		{
+			Rank:             0,
+			Uuid:             "b5e4ce5c-c4e4-41c1-8f2f-5e0d0a8b4c71",
+			Uri:              "ofi+sockets://127.0.0.1:31416",
+			Addr:             "127.0.0.1:10001",
+			State:            "Joined",
+			LastSeen:         1500000000,
+			HeartbeatLatency: 250000,
+		},
+		{
+			Rank:             1,
+			Uuid:             "0a5f6f0c-94d1-4c3b-9d5c-2f7d0b0d6a3e",
+			Uri:              "ofi+sockets://127.0.0.2:31416",
+			Addr:             "127.0.0.2:10001",
+			State:            "Joined",
+			LastSeen:         1500000000,
+			HeartbeatLatency: 300000,
+		},
+	}
+	MockPools = []*pb.ListPoolsResp_Pool{
+		{
+			Uuid:      "12345678-1234-1234-1234-123456789abc",
+			Svcreps:   []uint32{0, 1, 2},
+			Scmbytes:  1 << 30,
+			Nvmebytes: 10 << 30,
+		},
+	}
+	MockPoolQuery = &pb.PoolQueryResp{
+		Uuid:            "12345678-1234-1234-1234-123456789abc",
+		Totaltargets:    8,
+		Activetargets:   7,
+		Disabledtargets: 1,
+		Rebuild: &pb.PoolRebuildStatus{
+			State:   pb.PoolRebuildStatus_BUSY,
+			Objects: 42,
+			Records: 1024,
+		},
+		Scm: &pb.StorageUsageStats{
+			Total: 1 << 30, Free: 1 << 29, Min: 1 << 26, Max: 1 << 27, Mean: 3 << 25,
+		},
+		Nvme: &pb.StorageUsageStats{},
+	}
+	MockACL = []string{"A::OWNER@:rw", "A:G:GROUP@:r"}
 	MockErr = errors.New("unknown failure")
 )
 
@@ -140,8 +239,10 @@ func (m *mgmtCtlStorageBurnInClient) Recv() (*pb.StorageBurnInResp, error) {
 	m.alreadyCalled = true
 
 	return &pb.StorageBurnInResp{
-		Crets: m.ctrlrResults,
-		Mrets: m.mountResults,
+		Crets:    m.ctrlrResults,
+		Mrets:    m.mountResults,
+		Progress: &pb.BurnInProgress{Device: "0000:81:00.0", Status: "Jobs: 1"},
+		Results:  MockBurnInResults,
 	}, nil
 }
 
@@ -222,6 +323,98 @@ func (m *mockMgmtCtlClient) FetchFioConfigPaths(ctx context.Context, req *pb.Emp
 	return &mgmtCtlFetchFioConfigPathsClient{}, nil
 }
 
+func (m *mockMgmtCtlClient) InstanceQuery(ctx context.Context, req *pb.InstanceQueryReq, o ...grpc.CallOption) (*pb.InstanceQueryResp, error) {
+	return &pb.InstanceQueryResp{Instances: MockInstances}, nil
+}
+
+func (m *mockMgmtCtlClient) NetworkScan(ctx context.Context, req *pb.NetworkScanReq, o ...grpc.CallOption) (*pb.NetworkScanResp, error) {
+	resp := &pb.NetworkScanResp{}
+	for _, fi := range MockFabricInterfaces {
+		reported := *fi
+		if req.Provider != "" {
+			reported.Provider = req.Provider
+		}
+		resp.Interfaces = append(resp.Interfaces, &reported)
+	}
+
+	return resp, nil
+}
+
+func (m *mockMgmtCtlClient) ConfigReload(ctx context.Context, req *pb.ConfigReloadReq, o ...grpc.CallOption) (*pb.ConfigReloadResp, error) {
+	return MockConfigReload, nil
+}
+
+func (m *mockMgmtCtlClient) ServerSetLogMasks(ctx context.Context, req *pb.ServerSetLogMasksReq, o ...grpc.CallOption) (*pb.ServerSetLogMasksResp, error) {
+	resp := &pb.ServerSetLogMasksResp{ControlLevel: req.Level}
+	for _, is := range MockInstances {
+		resp.Instances = append(resp.Instances, &pb.InstanceLogMasks{
+			Index: is.Index,
+			Rank:  is.Rank,
+			Masks: req.Level,
+		})
+	}
+	if req.Duration > 0 {
+		resp.RevertTime = MockRevertTime.UnixNano()
+	}
+
+	return resp, nil
+}
+
+func (m *mockMgmtCtlClient) SystemQuery(ctx context.Context, req *pb.SystemQueryReq, o ...grpc.CallOption) (*pb.SystemQueryResp, error) {
+	if len(req.Ranks) == 0 {
+		return &pb.SystemQueryResp{Members: MockMembers}, nil
+	}
+
+	resp := &pb.SystemQueryResp{}
+	for _, rank := range req.Ranks {
+		var found bool
+		for _, member := range MockMembers {
+			if member.Rank == rank {
+				resp.Members = append(resp.Members, member)
+				found = true
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("rank %d not a system member", rank)
+		}
+	}
+
+	return resp, nil
+}
+
+func (m *mockMgmtCtlClient) SystemStop(ctx context.Context, req *pb.SystemStopReq, o ...grpc.CallOption) (*pb.SystemStopResp, error) {
+	// only the first mock rank hosts an MS replica
+	resp := &pb.SystemStopResp{}
+	for _, rank := range req.Ranks {
+		if (rank == 0) == req.Replicas {
+			resp.Results = append(resp.Results, &pb.RankResult{Rank: rank, Action: "stop", Msg: "stopped"})
+		}
+	}
+	return resp, nil
+}
+
+func (m *mockMgmtCtlClient) SystemStart(ctx context.Context, req *pb.SystemStartReq, o ...grpc.CallOption) (*pb.SystemStartResp, error) {
+	return &pb.SystemStartResp{
+		Results: []*pb.RankResult{{Rank: 0, Action: "start", Errored: true, Msg: "failed"}},
+	}, nil
+}
+
+func (m *mockMgmtCtlClient) SystemHeartbeat(ctx context.Context, req *pb.SystemHeartbeatReq, o ...grpc.CallOption) (*pb.SystemHeartbeatResp, error) {
+	return &pb.SystemHeartbeatResp{}, nil
+}
+
+func (m *mockMgmtCtlClient) SystemDbVote(ctx context.Context, req *pb.SystemDbVoteReq, o ...grpc.CallOption) (*pb.SystemDbVoteResp, error) {
+	return &pb.SystemDbVoteResp{}, nil
+}
+
+func (m *mockMgmtCtlClient) SystemDbAppend(ctx context.Context, req *pb.SystemDbAppendReq, o ...grpc.CallOption) (*pb.SystemDbAppendResp, error) {
+	return &pb.SystemDbAppendResp{}, nil
+}
+
+func (m *mockMgmtCtlClient) SystemDbSubmit(ctx context.Context, req *pb.SystemDbSubmitReq, o ...grpc.CallOption) (*pb.SystemDbSubmitResp, error) {
+	return &pb.SystemDbSubmitResp{}, nil
+}
+
 func newMockMgmtCtlClient(
 	features []*pb.Feature,
 	ctrlrs NvmeControllers,
@@ -255,6 +448,16 @@ func (m *mockMgmtSvcClient) PoolDestroy(ctx context.Context, req *pb.PoolDestroy
 	return &pb.PoolDestroyResp{}, nil
 }
 
+func (m *mockMgmtSvcClient) PoolQuery(ctx context.Context, req *pb.PoolQueryReq, o ...grpc.CallOption) (*pb.PoolQueryResp, error) {
+	// return successful pool query results
+	return MockPoolQuery, nil
+}
+
+func (m *mockMgmtSvcClient) ListPools(ctx context.Context, req *pb.ListPoolsReq, o ...grpc.CallOption) (*pb.ListPoolsResp, error) {
+	// return successful list pools results
+	return &pb.ListPoolsResp{Pools: MockPools}, nil
+}
+
 func (m *mockMgmtSvcClient) BioHealthQuery(
 	ctx context.Context,
 	req *pb.BioHealthReq,
@@ -290,10 +493,49 @@ func (m *mockMgmtSvcClient) KillRank(ctx context.Context, req *pb.DaosRank, o ..
 	return &pb.DaosResp{}, nil
 }
 
+func (m *mockMgmtSvcClient) LeaderQuery(ctx context.Context, req *pb.LeaderQueryReq, o ...grpc.CallOption) (*pb.LeaderQueryResp, error) {
+	return &pb.LeaderQueryResp{CurrentLeader: MockServers[0], Replicas: MockServers[:1]}, nil
+}
+
 func newMockMgmtSvcClient() pb.MgmtSvcClient {
 	return &mockMgmtSvcClient{}
 }
 
+type mockAccessControlClient struct{}
+
+func (m *mockAccessControlClient) SetPermissions(ctx context.Context, req *acl.EntryPermissions, o ...grpc.CallOption) (*acl.Response, error) {
+	return &acl.Response{}, nil
+}
+
+func (m *mockAccessControlClient) GetPermissions(ctx context.Context, req *acl.Entry, o ...grpc.CallOption) (*acl.Response, error) {
+	return &acl.Response{}, nil
+}
+
+func (m *mockAccessControlClient) DestroyAclEntry(ctx context.Context, req *acl.Entry, o ...grpc.CallOption) (*acl.Response, error) {
+	return &acl.Response{}, nil
+}
+
+func (m *mockAccessControlClient) PoolGetACL(ctx context.Context, req *acl.GetACLReq, o ...grpc.CallOption) (*acl.ACLResp, error) {
+	return &acl.ACLResp{Acl: MockACL}, nil
+}
+
+func (m *mockAccessControlClient) PoolOverwriteACL(ctx context.Context, req *acl.ModifyACLReq, o ...grpc.CallOption) (*acl.ACLResp, error) {
+	return &acl.ACLResp{Acl: req.GetAcl()}, nil
+}
+
+func (m *mockAccessControlClient) PoolUpdateACL(ctx context.Context, req *acl.ModifyACLReq, o ...grpc.CallOption) (*acl.ACLResp, error) {
+	return &acl.ACLResp{Acl: append(MockACL, req.GetAcl()...)}, nil
+}
+
+func (m *mockAccessControlClient) PoolDeleteACL(ctx context.Context, req *acl.DeleteACLReq, o ...grpc.CallOption) (*acl.ACLResp, error) {
+	// return DER_NONEXIST to indicate the principal has no entry
+	return &acl.ACLResp{Status: -1005}, nil
+}
+
+func newMockAccessControlClient() acl.AccessControlClient {
+	return &mockAccessControlClient{}
+}
+
 // implement mock/stub behaviour for Control
 type mockControl struct {
 	address    string
@@ -301,6 +543,7 @@ type mockControl struct {
 	connectRet error
 	ctlClient  pb.MgmtCtlClient
 	svcClient  pb.MgmtSvcClient
+	aclClient  acl.AccessControlClient
 	log        logging.Logger
 }
 
@@ -328,6 +571,10 @@ func (m *mockControl) getSvcClient() pb.MgmtSvcClient {
 	return m.svcClient
 }
 
+func (m *mockControl) getACLClient() acl.AccessControlClient {
+	return m.aclClient
+}
+
 func (m *mockControl) logger() logging.Logger {
 	return m.log
 }
@@ -335,9 +582,10 @@ func (m *mockControl) logger() logging.Logger {
 func newMockControl(
 	log logging.Logger,
 	address string, state connectivity.State, connectRet error,
-	cClient pb.MgmtCtlClient, sClient pb.MgmtSvcClient) Control {
+	cClient pb.MgmtCtlClient, sClient pb.MgmtSvcClient,
+	aClient acl.AccessControlClient) Control {
 
-	return &mockControl{address, state, connectRet, cClient, sClient, log}
+	return &mockControl{address, state, connectRet, cClient, sClient, aClient, log}
 }
 
 type mockControllerFactory struct {
@@ -367,8 +615,9 @@ func (m *mockControllerFactory) create(address string, cfg *security.TransportCo
 		m.scanRet, m.formatRet, m.updateRet, m.burninRet)
 
 	sClient := newMockMgmtSvcClient()
+	aClient := newMockAccessControlClient()
 
-	controller := newMockControl(m.log, address, m.state, m.connectRet, cClient, sClient)
+	controller := newMockControl(m.log, address, m.state, m.connectRet, cClient, sClient, aClient)
 
 	err := controller.connect(address, cfg)
 
@@ -384,6 +633,7 @@ func newMockConnect(
 	killRet error, connectRet error) Connect {
 
 	return &connList{
+		log: log,
 		factory: &mockControllerFactory{
 			state, MockFeatures, ctrlrs, ctrlrResults, modules,
 			moduleResults, pmems, mountResults, log, scanRet, formatRet,
@@ -0,0 +1,137 @@
+//
+// (C) Copyright 2018-2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"text/tabwriter"
+
+	"golang.org/x/net/context"
+
+	"github.com/daos-stack/daos/src/control/common"
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+// NetworkScanResult contains the fabric interfaces reported by a server, or
+// the error encountered when requesting them.
+type NetworkScanResult struct {
+	Interfaces []*pb.FabricInterface `json:"interfaces"`
+	Err        error                 `json:"-"`
+}
+
+// MarshalJSON encodes the result with any error rendered as a string.
+func (nsr NetworkScanResult) MarshalJSON() ([]byte, error) {
+	type toJSON NetworkScanResult
+	return common.MarshalWithError(toJSON(nsr), nsr.Err)
+}
+
+func (nsr NetworkScanResult) String() string {
+	var buf bytes.Buffer
+
+	if nsr.Err != nil {
+		return nsr.Err.Error()
+	}
+
+	if len(nsr.Interfaces) == 0 {
+		return "\tno fabric interfaces found\n"
+	}
+
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\tNUMA Node\tInterface\tProvider\tCPU Set")
+	for _, fi := range nsr.Interfaces {
+		fmt.Fprintf(w, "\t%d\t%s\t%s\t%s\n",
+			fi.Numanode, fi.Device, fi.Provider, fi.Cpuset)
+	}
+	w.Flush()
+
+	return buf.String()
+}
+
+// ClientNetworkMap is an alias for fabric interfaces reported by servers
+// connected to given client.
+type ClientNetworkMap map[string]NetworkScanResult
+
+func (cnm ClientNetworkMap) String() string {
+	var buf bytes.Buffer
+	servers := make([]string, 0, len(cnm))
+
+	for server := range cnm {
+		servers = append(servers, server)
+	}
+	sort.Strings(servers)
+
+	for _, server := range servers {
+		fmt.Fprintf(&buf, "%s:\n%s\n", server, cnm[server])
+	}
+
+	return buf.String()
+}
+
+// networkScanRequest is to be called as a goroutine and returns result
+// containing fabric interfaces over channel.
+func networkScanRequest(ctx context.Context, mc Control, req interface{}, ch chan ClientResult) {
+	scanReq, ok := req.(*pb.NetworkScanReq)
+	if !ok {
+		err := fmt.Errorf(msgTypeAssert, &pb.NetworkScanReq{}, req)
+		ch <- ClientResult{mc.getAddress(), nil, err}
+		return
+	}
+
+	resp, err := mc.getCtlClient().NetworkScan(ctx, scanReq)
+	if err != nil {
+		ch <- ClientResult{mc.getAddress(), nil, err}
+		return
+	}
+
+	ch <- ClientResult{mc.getAddress(), resp.Interfaces, nil}
+}
+
+// NetworkScan returns the fabric interfaces, with their NUMA affinity, on
+// each server connected. If provider is empty, each server reports the
+// provider from its own configuration.
+func (c *connList) NetworkScan(ctx context.Context, provider string) ClientNetworkMap {
+	cResults := c.makeRequests(ctx, &pb.NetworkScanReq{Provider: provider}, networkScanRequest)
+	cNetwork := make(ClientNetworkMap)
+
+	for _, res := range cResults {
+		if res.Err != nil {
+			cNetwork[res.Address] = NetworkScanResult{nil, res.Err}
+			continue
+		}
+
+		ifaces, ok := res.Value.([]*pb.FabricInterface)
+		if !ok {
+			cNetwork[res.Address] = NetworkScanResult{
+				nil, fmt.Errorf(msgBadType, []*pb.FabricInterface{}, res.Value),
+			}
+			continue
+		}
+
+		cNetwork[res.Address] = NetworkScanResult{ifaces, nil}
+	}
+
+	return cNetwork
+}
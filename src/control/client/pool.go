@@ -24,22 +24,192 @@
 package client
 
 import (
+	"net"
+	"strings"
+
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/daos-stack/daos/src/control/common"
 	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/security/acl"
 )
 
-// chooseServiceLeader will decide which connection to send request on.
+// isRedirect returns true if err indicates that the request was sent to a
+// server which is not the management service leader.
+func isRedirect(err error) bool {
+	return status.Code(errors.Cause(err)) == codes.FailedPrecondition
+}
+
+// isLoopback returns true if addr refers to the local host, in which case
+// it is only meaningful to the server which reported it.
+func isLoopback(addr string) bool {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+
+	return ip != nil && ip.IsLoopback()
+}
+
+// queryLeader asks each connected server in turn for the current management
+// service leader and returns the first response along with the connection
+// it was received on.
+func (c *connList) queryLeader(ctx context.Context, req *pb.LeaderQueryReq) (Control, *pb.LeaderQueryResp, error) {
+	if len(c.controllers) == 0 {
+		return nil, nil, errors.New("no active connections")
+	}
+
+	var err error
+	for _, mc := range c.controllers {
+		var resp *pb.LeaderQueryResp
+		resp, err = mc.getSvcClient().LeaderQuery(ctx, req)
+		if err == nil {
+			c.log.Debugf("DAOS leader query response from %s: %s\n",
+				mc.getAddress(), resp)
+			return mc, resp, nil
+		}
+		c.log.Debugf("DAOS leader query to %s failed: %s\n", mc.getAddress(), err)
+	}
+
+	return nil, nil, errors.Wrap(err, "querying management service leader")
+}
+
+// getServiceLeader returns a connection to the management service leader.
 //
-// Currently expect only one connection to be available and return that.
-func chooseServiceLeader(cs []Control) (Control, error) {
-	if len(cs) == 0 {
-		return nil, errors.New("no active connections")
+// The leader is discovered through a LeaderQuery on first use and cached for
+// subsequent requests, along with the replicas which were reachable at the
+// time to fail over to should the leader become unavailable.
+func (c *connList) getServiceLeader(ctx context.Context) (Control, error) {
+	if c.leader != nil {
+		return c.leader, nil
+	}
+
+	mc, resp, err := c.queryLeader(ctx, &pb.LeaderQueryReq{})
+	if err != nil {
+		return nil, err
+	}
+
+	c.replicas = c.replicas[:0]
+	for _, replica := range resp.GetReplicas() {
+		if !common.Include(resp.GetDownReplicas(), replica) {
+			c.replicas = append(c.replicas, replica)
+		}
+	}
+
+	leader := resp.GetCurrentLeader()
+	switch {
+	case leader == "":
+		return nil, errors.Errorf("%s reported no management service leader",
+			mc.getAddress())
+	case isLoopback(leader):
+		// leader is only known by its local address, which refers to
+		// the server which responded
+		c.leader = mc
+		return mc, nil
+	}
+
+	return c.setLeader(leader)
+}
+
+// setLeader caches the connection to the server at addr as the management
+// service leader, connecting to it if it isn't one of the connected servers.
+func (c *connList) setLeader(addr string) (Control, error) {
+	for _, mc := range c.controllers {
+		if mc.getAddress() == addr {
+			c.leader = mc
+			return mc, nil
+		}
+	}
+
+	c.log.Debugf("connecting to management service replica %s\n", addr)
+	mc, err := c.factory.create(addr, c.transportConfig)
+	if err != nil {
+		return nil, errors.Wrapf(err, "connecting to management service replica %s", addr)
+	}
+	c.leader = mc
+
+	return mc, nil
+}
+
+// withServiceLeader calls fn with a connection to the management service
+// leader.
+//
+// If the request is rejected because the server is no longer the leader,
+// the leader is rediscovered and the request retried once. If the leader
+// can't be reached, the request is retried on each of the other replicas
+// in turn until one of them can be.
+func (c *connList) withServiceLeader(ctx context.Context, fn func(Control) error) error {
+	mc, err := c.getServiceLeader(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = fn(mc)
+	switch {
+	case isRedirect(err):
+		c.log.Debugf("%s is not the management service leader: %s\n", mc.getAddress(), err)
+		c.clearLeader()
+
+		mc, err = c.getServiceLeader(ctx)
+		if err != nil {
+			return err
+		}
+
+		return fn(mc)
+	case status.Code(errors.Cause(err)) == codes.Unavailable:
+		failed := mc.getAddress()
+		for _, replica := range c.replicas {
+			if replica == failed {
+				continue
+			}
+			c.log.Debugf("%s unavailable, failing over to %s\n", failed, replica)
+			c.clearLeader()
+
+			mc, cErr := c.setLeader(replica)
+			if cErr != nil {
+				c.log.Debugf("%s\n", cErr)
+				continue
+			}
+
+			err = fn(mc)
+			if status.Code(errors.Cause(err)) != codes.Unavailable {
+				return err
+			}
+			failed = replica
+		}
 	}
 
-	// just return the first connection, expected to be the service leader
-	return cs[0], nil
+	return err
+}
+
+// clearLeader removes the cached leader, closing the connection to it if
+// it isn't one of the connected servers.
+func (c *connList) clearLeader() {
+	if c.leader == nil {
+		return
+	}
+
+	connected := false
+	for _, mc := range c.controllers {
+		if mc == c.leader {
+			connected = true
+			break
+		}
+	}
+	if !connected {
+		if err := c.leader.disconnect(); err != nil {
+			c.log.Debugf("disconnecting from %s: %s\n", c.leader.getAddress(), err)
+		}
+	}
+
+	c.leader = nil
 }
 
 // PoolCreateReq struct contains request
@@ -56,20 +226,15 @@ type PoolCreateReq struct {
 
 // PoolCreateResp struct contains response
 type PoolCreateResp struct {
-	Uuid    string
-	SvcReps string
+	Uuid    string `json:"uuid"`
+	SvcReps string `json:"svc_reps"`
 }
 
 // PoolCreate will create a DAOS pool using provided parameters and return
 // uuid, list of service replicas and error (including any DER code from DAOS).
 //
 // Isolate protobuf encapsulation in client and don't expose to calling code.
-func (c *connList) PoolCreate(req *PoolCreateReq) (*PoolCreateResp, error) {
-	mc, err := chooseServiceLeader(c.controllers)
-	if err != nil {
-		return nil, err
-	}
-
+func (c *connList) PoolCreate(ctx context.Context, req *PoolCreateReq) (*PoolCreateResp, error) {
 	rpcReq := &pb.PoolCreateReq{
 		Scmbytes: req.ScmBytes, Nvmebytes: req.NvmeBytes,
 		Ranks: req.RankList, Numsvcreps: req.NumSvcReps, Sys: req.Sys,
@@ -78,7 +243,11 @@ func (c *connList) PoolCreate(req *PoolCreateReq) (*PoolCreateResp, error) {
 
 	c.log.Debugf("Create DAOS pool request: %s\n", rpcReq)
 
-	rpcResp, err := mc.getSvcClient().PoolCreate(context.Background(), rpcReq)
+	var rpcResp *pb.PoolCreateResp
+	err := c.withServiceLeader(ctx, func(mc Control) (err error) {
+		rpcResp, err = mc.getSvcClient().PoolCreate(ctx, rpcReq)
+		return
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -105,17 +274,16 @@ type PoolDestroyReq struct {
 // error (including any DER code from DAOS).
 //
 // Isolate protobuf encapsulation in client and don't expose to calling code.
-func (c *connList) PoolDestroy(req *PoolDestroyReq) error {
-	mc, err := chooseServiceLeader(c.controllers)
-	if err != nil {
-		return err
-	}
-
+func (c *connList) PoolDestroy(ctx context.Context, req *PoolDestroyReq) error {
 	rpcReq := &pb.PoolDestroyReq{Uuid: req.Uuid, Force: req.Force}
 
 	c.log.Debugf("Destroy DAOS pool request: %s\n", rpcReq)
 
-	rpcResp, err := mc.getSvcClient().PoolDestroy(context.Background(), rpcReq)
+	var rpcResp *pb.PoolDestroyResp
+	err := c.withServiceLeader(ctx, func(mc Control) (err error) {
+		rpcResp, err = mc.getSvcClient().PoolDestroy(ctx, rpcReq)
+		return
+	})
 	if err != nil {
 		return err
 	}
@@ -129,3 +297,281 @@ func (c *connList) PoolDestroy(req *PoolDestroyReq) error {
 
 	return nil
 }
+
+// ListPoolsReq struct contains request
+type ListPoolsReq struct {
+	Sys string
+}
+
+// PoolDiscovery describes a pool known to the management service.
+type PoolDiscovery struct {
+	UUID        string   `json:"uuid"`
+	SvcReplicas []uint32 `json:"svc_replicas"`
+	ScmBytes    uint64   `json:"scm_bytes"`
+	NvmeBytes   uint64   `json:"nvme_bytes"`
+	// Error is set if the pool sizes couldn't be determined.
+	Error string `json:"error,omitempty"`
+}
+
+// ListPoolsResp struct contains response
+type ListPoolsResp struct {
+	Pools []*PoolDiscovery `json:"pools"`
+}
+
+// ListPools will list the DAOS pools known to the management service along
+// with their service replicas and sizes.
+//
+// Isolate protobuf encapsulation in client and don't expose to calling code.
+func (c *connList) ListPools(ctx context.Context, req *ListPoolsReq) (*ListPoolsResp, error) {
+	rpcReq := &pb.ListPoolsReq{Sys: req.Sys}
+
+	c.log.Debugf("List DAOS pools request: %s\n", rpcReq)
+
+	var rpcResp *pb.ListPoolsResp
+	err := c.withServiceLeader(ctx, func(mc Control) (err error) {
+		rpcResp, err = mc.getSvcClient().ListPools(ctx, rpcReq)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.log.Debugf("List DAOS pools response: %s\n", rpcResp)
+
+	if rpcResp.GetStatus() != 0 {
+		return nil, errors.Errorf("DAOS returned error code: %d\n",
+			rpcResp.GetStatus())
+	}
+
+	resp := &ListPoolsResp{Pools: make([]*PoolDiscovery, 0, len(rpcResp.GetPools()))}
+	for _, p := range rpcResp.GetPools() {
+		resp.Pools = append(resp.Pools, &PoolDiscovery{
+			UUID:        p.GetUuid(),
+			SvcReplicas: p.GetSvcreps(),
+			ScmBytes:    p.GetScmbytes(),
+			NvmeBytes:   p.GetNvmebytes(),
+			Error:       p.GetError(),
+		})
+	}
+
+	return resp, nil
+}
+
+// PoolQueryReq struct contains request
+type PoolQueryReq struct {
+	UUID string
+}
+
+// StorageUsageStats represents usage statistics for a storage tier across
+// the targets of a pool.
+type StorageUsageStats struct {
+	Total uint64 `json:"total"`
+	Free  uint64 `json:"free"`
+	Min   uint64 `json:"min"`
+	Max   uint64 `json:"max"`
+	Mean  uint64 `json:"mean"`
+}
+
+// PoolRebuildStatus represents the rebuild status of a pool.
+type PoolRebuildStatus struct {
+	Status  int32  `json:"status"`
+	State   string `json:"state"`
+	Objects uint64 `json:"objects"`
+	Records uint64 `json:"records"`
+}
+
+// PoolQueryResp struct contains response
+type PoolQueryResp struct {
+	UUID            string             `json:"uuid"`
+	TotalTargets    uint32             `json:"total_targets"`
+	ActiveTargets   uint32             `json:"active_targets"`
+	DisabledTargets uint32             `json:"disabled_targets"`
+	Rebuild         *PoolRebuildStatus `json:"rebuild"`
+	Scm             *StorageUsageStats `json:"scm"`
+	Nvme            *StorageUsageStats `json:"nvme"`
+}
+
+func storageUsageStatsFromPB(pbStats *pb.StorageUsageStats) *StorageUsageStats {
+	return &StorageUsageStats{
+		Total: pbStats.GetTotal(),
+		Free:  pbStats.GetFree(),
+		Min:   pbStats.GetMin(),
+		Max:   pbStats.GetMax(),
+		Mean:  pbStats.GetMean(),
+	}
+}
+
+// PoolQuery will query a DAOS pool identified by its uuid and return space,
+// target and rebuild details along with any error (including any DER code
+// from DAOS).
+//
+// Isolate protobuf encapsulation in client and don't expose to calling code.
+func (c *connList) PoolQuery(ctx context.Context, req *PoolQueryReq) (*PoolQueryResp, error) {
+	rpcReq := &pb.PoolQueryReq{Uuid: req.UUID}
+
+	c.log.Debugf("Query DAOS pool request: %s\n", rpcReq)
+
+	var rpcResp *pb.PoolQueryResp
+	err := c.withServiceLeader(ctx, func(mc Control) (err error) {
+		rpcResp, err = mc.getSvcClient().PoolQuery(ctx, rpcReq)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.log.Debugf("Query DAOS pool response: %s\n", rpcResp)
+
+	if rpcResp.GetStatus() != 0 {
+		return nil, errors.Errorf("DAOS returned error code: %d\n",
+			rpcResp.GetStatus())
+	}
+
+	rebuild := rpcResp.GetRebuild()
+
+	return &PoolQueryResp{
+		UUID:            rpcResp.GetUuid(),
+		TotalTargets:    rpcResp.GetTotaltargets(),
+		ActiveTargets:   rpcResp.GetActivetargets(),
+		DisabledTargets: rpcResp.GetDisabledtargets(),
+		Rebuild: &PoolRebuildStatus{
+			Status:  rebuild.GetStatus(),
+			State:   strings.ToLower(rebuild.GetState().String()),
+			Objects: rebuild.GetObjects(),
+			Records: rebuild.GetRecords(),
+		},
+		Scm:  storageUsageStatsFromPB(rpcResp.GetScm()),
+		Nvme: storageUsageStatsFromPB(rpcResp.GetNvme()),
+	}, nil
+}
+
+// PoolGetACLReq struct contains request
+type PoolGetACLReq struct {
+	UUID string
+}
+
+// PoolACLResp struct contains the resulting Access Control List of a pool,
+// as Access Control Entries in short string format.
+type PoolACLResp struct {
+	ACL []string `json:"acl"`
+}
+
+// aclRespFromPB checks the status of an ACL response and extracts the ACL.
+func aclRespFromPB(rpcResp *acl.ACLResp) (*PoolACLResp, error) {
+	if rpcResp.GetStatus() != 0 {
+		return nil, errors.Errorf("DAOS returned error code: %d\n",
+			rpcResp.GetStatus())
+	}
+
+	return &PoolACLResp{ACL: rpcResp.GetAcl()}, nil
+}
+
+// PoolGetACL will fetch the Access Control List of a DAOS pool identified by
+// its uuid.
+//
+// Isolate protobuf encapsulation in client and don't expose to calling code.
+func (c *connList) PoolGetACL(ctx context.Context, req *PoolGetACLReq) (*PoolACLResp, error) {
+	rpcReq := &acl.GetACLReq{Uuid: req.UUID}
+
+	c.log.Debugf("Get DAOS pool ACL request: %s\n", rpcReq)
+
+	var rpcResp *acl.ACLResp
+	err := c.withServiceLeader(ctx, func(mc Control) (err error) {
+		rpcResp, err = mc.getACLClient().PoolGetACL(ctx, rpcReq)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.log.Debugf("Get DAOS pool ACL response: %s\n", rpcResp)
+
+	return aclRespFromPB(rpcResp)
+}
+
+// PoolOverwriteACLReq struct contains request
+type PoolOverwriteACLReq struct {
+	UUID string
+	ACL  []string
+}
+
+// PoolOverwriteACL will replace the Access Control List of a DAOS pool
+// identified by its uuid with the supplied entries.
+//
+// Isolate protobuf encapsulation in client and don't expose to calling code.
+func (c *connList) PoolOverwriteACL(ctx context.Context, req *PoolOverwriteACLReq) (*PoolACLResp, error) {
+	rpcReq := &acl.ModifyACLReq{Uuid: req.UUID, Acl: req.ACL}
+
+	c.log.Debugf("Overwrite DAOS pool ACL request: %s\n", rpcReq)
+
+	var rpcResp *acl.ACLResp
+	err := c.withServiceLeader(ctx, func(mc Control) (err error) {
+		rpcResp, err = mc.getACLClient().PoolOverwriteACL(ctx, rpcReq)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.log.Debugf("Overwrite DAOS pool ACL response: %s\n", rpcResp)
+
+	return aclRespFromPB(rpcResp)
+}
+
+// PoolUpdateACLReq struct contains request
+type PoolUpdateACLReq struct {
+	UUID string
+	ACL  []string
+}
+
+// PoolUpdateACL will add or replace entries in the Access Control List of a
+// DAOS pool identified by its uuid.
+//
+// Isolate protobuf encapsulation in client and don't expose to calling code.
+func (c *connList) PoolUpdateACL(ctx context.Context, req *PoolUpdateACLReq) (*PoolACLResp, error) {
+	rpcReq := &acl.ModifyACLReq{Uuid: req.UUID, Acl: req.ACL}
+
+	c.log.Debugf("Update DAOS pool ACL request: %s\n", rpcReq)
+
+	var rpcResp *acl.ACLResp
+	err := c.withServiceLeader(ctx, func(mc Control) (err error) {
+		rpcResp, err = mc.getACLClient().PoolUpdateACL(ctx, rpcReq)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.log.Debugf("Update DAOS pool ACL response: %s\n", rpcResp)
+
+	return aclRespFromPB(rpcResp)
+}
+
+// PoolDeleteACLReq struct contains request
+type PoolDeleteACLReq struct {
+	UUID      string
+	Principal string
+}
+
+// PoolDeleteACL will remove the entry for a principal from the Access Control
+// List of a DAOS pool identified by its uuid.
+//
+// Isolate protobuf encapsulation in client and don't expose to calling code.
+func (c *connList) PoolDeleteACL(ctx context.Context, req *PoolDeleteACLReq) (*PoolACLResp, error) {
+	rpcReq := &acl.DeleteACLReq{Uuid: req.UUID, Principal: req.Principal}
+
+	c.log.Debugf("Delete DAOS pool ACL entry request: %s\n", rpcReq)
+
+	var rpcResp *acl.ACLResp
+	err := c.withServiceLeader(ctx, func(mc Control) (err error) {
+		rpcResp, err = mc.getACLClient().PoolDeleteACL(ctx, rpcReq)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.log.Debugf("Delete DAOS pool ACL entry response: %s\n", rpcResp)
+
+	return aclRespFromPB(rpcResp)
+}
@@ -24,22 +24,73 @@
 package client
 
 import (
+	"bytes"
+	"fmt"
+	"sort"
+
 	"golang.org/x/net/context"
 
+	"github.com/daos-stack/daos/src/control/common"
 	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 )
 
+// KillRankResult contains the status returned when terminating a rank, or
+// the error encountered when requesting it.
+type KillRankResult struct {
+	Rank   uint32 `json:"rank"`
+	Status int32  `json:"status"`
+	Err    error  `json:"-"`
+}
+
+// MarshalJSON encodes the result with any error rendered as a string.
+func (krr KillRankResult) MarshalJSON() ([]byte, error) {
+	type toJSON KillRankResult
+	return common.MarshalWithError(toJSON(krr), krr.Err)
+}
+
+func (krr KillRankResult) String() string {
+	if krr.Err != nil {
+		return "error: " + krr.Err.Error()
+	}
+
+	return fmt.Sprintf("rank %d status %d", krr.Rank, krr.Status)
+}
+
+// ClientKillRankMap is an alias for kill-rank results from servers connected
+// to given client keyed on address.
+type ClientKillRankMap map[string]KillRankResult
+
+func (ckm ClientKillRankMap) String() string {
+	var buf bytes.Buffer
+	servers := make([]string, 0, len(ckm))
+
+	for server := range ckm {
+		servers = append(servers, server)
+	}
+	sort.Strings(servers)
+
+	for _, server := range servers {
+		fmt.Fprintf(&buf, "%s:\n\t%s\n", server, ckm[server])
+	}
+
+	return buf.String()
+}
+
 // KillRank Will terminate server running at given rank on pool specified by
 // uuid. Request will only be issued to a single access point.
-func (c *connList) KillRank(uuid string, rank uint32) ResultMap {
-	results := make(ResultMap)
+func (c *connList) KillRank(ctx context.Context, uuid string, rank uint32) ClientKillRankMap {
+	results := make(ClientKillRankMap)
 	mc := c.controllers[0]
 
-	resp, err := mc.getSvcClient().KillRank(context.Background(),
+	result := KillRankResult{Rank: rank}
+	resp, err := mc.getSvcClient().KillRank(ctx,
 		&pb.DaosRank{PoolUuid: uuid, Rank: rank})
-
-	result := ClientResult{mc.getAddress(), resp, err}
-	results[result.Address] = result
+	if err != nil {
+		result.Err = err
+	} else {
+		result.Status = resp.GetStatus()
+	}
+	results[mc.getAddress()] = result
 
 	return results
 }
@@ -28,11 +28,14 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 
+	"github.com/daos-stack/daos/src/control/common"
 	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	types "github.com/daos-stack/daos/src/control/common/storage"
 )
@@ -131,9 +134,72 @@ type StorageResult struct {
 	scmPmem   types.PmemResults
 }
 
+// StoragePrepareResult contains the results of preparing NVMe and SCM
+// storage on a server, or the error encountered when requesting it.
+type StoragePrepareResult struct {
+	Nvme *pb.PrepareNvmeResp `json:"nvme,omitempty"`
+	Scm  *pb.PrepareScmResp  `json:"scm,omitempty"`
+	Err  error               `json:"-"`
+}
+
+// MarshalJSON encodes the result with any error rendered as a string.
+func (spr StoragePrepareResult) MarshalJSON() ([]byte, error) {
+	type toJSON StoragePrepareResult
+	return common.MarshalWithError(toJSON(spr), spr.Err)
+}
+
+func (spr StoragePrepareResult) String() string {
+	var buf bytes.Buffer
+
+	if spr.Err != nil {
+		return spr.Err.Error()
+	}
+
+	if spr.Nvme != nil {
+		fmt.Fprintf(&buf, "\tNVMe Status:%s", spr.Nvme.GetState().GetStatus())
+		if msg := spr.Nvme.GetState().GetError(); msg != "" {
+			fmt.Fprintf(&buf, " Error:%s", msg)
+		}
+		fmt.Fprintf(&buf, "\n")
+	}
+
+	if spr.Scm != nil {
+		fmt.Fprintf(&buf, "\tSCM Status:%s", spr.Scm.GetState().GetStatus())
+		if msg := spr.Scm.GetState().GetError(); msg != "" {
+			fmt.Fprintf(&buf, " Error:%s", msg)
+		}
+		if msg := spr.Scm.GetState().GetInfo(); msg != "" {
+			fmt.Fprintf(&buf, " Info:%s", msg)
+		}
+		fmt.Fprintf(&buf, "\n%s", types.PmemDevices(spr.Scm.GetPmems()))
+	}
+
+	return buf.String()
+}
+
+// ClientPrepareMap is an alias for results of preparing storage on servers
+// connected to given client keyed on address.
+type ClientPrepareMap map[string]StoragePrepareResult
+
+func (cpm ClientPrepareMap) String() string {
+	var buf bytes.Buffer
+	servers := make([]string, 0, len(cpm))
+
+	for server := range cpm {
+		servers = append(servers, server)
+	}
+	sort.Strings(servers)
+
+	for _, server := range servers {
+		fmt.Fprintf(&buf, "%s:\n%s\n", server, cpm[server])
+	}
+
+	return buf.String()
+}
+
 // storagePrepareRequest returns results of SCM and NVMe prepare actions
 // on a remote server by calling over gRPC channel.
-func storagePrepareRequest(mc Control, req interface{}, ch chan ClientResult) {
+func storagePrepareRequest(ctx context.Context, mc Control, req interface{}, ch chan ClientResult) {
 	prepareReq, ok := req.(*pb.StoragePrepareReq)
 	if !ok {
 		err := errors.Errorf(msgTypeAssert, &pb.StoragePrepareReq{}, req)
@@ -143,7 +209,7 @@ func storagePrepareRequest(mc Control, req interface{}, ch chan ClientResult) {
 		return // type err
 	}
 
-	resp, err := mc.getCtlClient().StoragePrepare(context.Background(), prepareReq)
+	resp, err := mc.getCtlClient().StoragePrepare(ctx, prepareReq)
 	if err != nil {
 		ch <- ClientResult{mc.getAddress(), nil, err} // return comms error
 		return
@@ -154,16 +220,38 @@ func storagePrepareRequest(mc Control, req interface{}, ch chan ClientResult) {
 
 // StoragePrepare returns details of nonvolatile storage devices attached to each
 // remote server. Data received over channel from requests running in parallel.
-func (c *connList) StoragePrepare(req *pb.StoragePrepareReq) ResultMap {
-	return c.makeRequests(req, storagePrepareRequest)
+func (c *connList) StoragePrepare(ctx context.Context, req *pb.StoragePrepareReq) ClientPrepareMap {
+	cResults := c.makeRequests(ctx, req, storagePrepareRequest)
+	cPrepareResults := make(ClientPrepareMap) // srv address:prepare results
+
+	for _, res := range cResults {
+		if res.Err != nil {
+			cPrepareResults[res.Address] = StoragePrepareResult{Err: res.Err}
+			continue
+		}
+
+		resp, ok := res.Value.(*pb.StoragePrepareResp)
+		if !ok {
+			err := fmt.Errorf(msgBadType, &pb.StoragePrepareResp{}, res.Value)
+
+			cPrepareResults[res.Address] = StoragePrepareResult{Err: err}
+			continue
+		}
+
+		cPrepareResults[res.Address] = StoragePrepareResult{
+			Nvme: resp.GetNvme(), Scm: resp.GetScm(),
+		}
+	}
+
+	return cPrepareResults
 }
 
 // storageScan/etc/equest returns all discovered SCM and NVMe storage devices
 // discovered on a remote server by calling over gRPC channel.
-func storageScanRequest(mc Control, req interface{}, ch chan ClientResult) {
+func storageScanRequest(ctx context.Context, mc Control, req interface{}, ch chan ClientResult) {
 	sRes := StorageResult{}
 
-	resp, err := mc.getCtlClient().StorageScan(context.Background(), &pb.StorageScanReq{})
+	resp, err := mc.getCtlClient().StorageScan(ctx, &pb.StorageScanReq{})
 	if err != nil {
 		ch <- ClientResult{mc.getAddress(), nil, err} // return comms error
 		return
@@ -201,8 +289,8 @@ func storageScanRequest(mc Control, req interface{}, ch chan ClientResult) {
 // remote server. Critical storage device health information is also returned
 // for all NVMe SSDs discovered. Data received over channel from requests
 // running in parallel.
-func (c *connList) StorageScan() (ClientCtrlrMap, ClientModuleMap, ClientPmemMap) {
-	cResults := c.makeRequests(nil, storageScanRequest)
+func (c *connList) StorageScan(ctx context.Context) (ClientCtrlrMap, ClientModuleMap, ClientPmemMap) {
+	cResults := c.makeRequests(ctx, nil, storageScanRequest)
 	cCtrlrs := make(ClientCtrlrMap)   // mapping of server address to NVMe SSDs
 	cModules := make(ClientModuleMap) // mapping of server address to SCM modules
 	cPmems := make(ClientPmemMap)     // mapping of server address to PMEM device files
@@ -237,16 +325,11 @@ func (c *connList) StorageScan() (ClientCtrlrMap, ClientModuleMap, ClientPmemMap
 // remote server over gRPC.
 //
 // Calls control StorageFormat routine which activates StorageFormat service rpc
-// and returns an open stream handle. Receive on stream and send ClientResult
-// over channel for each.
-func StorageFormatRequest(mc Control, parms interface{}, ch chan ClientResult) {
+// and returns an open stream handle. Results are accumulated from each message
+// received on stream and a single ClientResult is sent over channel.
+func StorageFormatRequest(ctx context.Context, mc Control, parms interface{}, ch chan ClientResult) {
 	sRes := StorageResult{}
 
-	// Maximum time limit for format is 2hrs to account for lengthy low
-	// level formatting of multiple devices sequentially.
-	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Minute)
-	defer cancel()
-
 	stream, err := mc.getCtlClient().StorageFormat(ctx, &pb.StorageFormatReq{})
 	if err != nil {
 		ch <- ClientResult{mc.getAddress(), nil, err}
@@ -265,17 +348,17 @@ func StorageFormatRequest(mc Control, parms interface{}, ch chan ClientResult) {
 			return // recv err
 		}
 
-		sRes.nvmeCtrlr.Responses = resp.Crets
-		sRes.scmMount.Responses = resp.Mrets
-
-		ch <- ClientResult{mc.getAddress(), sRes, nil}
+		sRes.nvmeCtrlr.Responses = append(sRes.nvmeCtrlr.Responses, resp.Crets...)
+		sRes.scmMount.Responses = append(sRes.scmMount.Responses, resp.Mrets...)
 	}
+
+	ch <- ClientResult{mc.getAddress(), sRes, nil}
 }
 
 // StorageFormat prepares nonvolatile storage devices attached to each
 // remote server in the connection list for use with DAOS.
-func (c *connList) StorageFormat() (ClientCtrlrMap, ClientMountMap) {
-	cResults := c.makeRequests(nil, StorageFormatRequest)
+func (c *connList) StorageFormat(ctx context.Context) (ClientCtrlrMap, ClientMountMap) {
+	cResults := c.makeRequests(ctx, nil, StorageFormatRequest)
 	cCtrlrResults := make(ClientCtrlrMap) // srv address:NVMe SSDs
 	cMountResults := make(ClientMountMap) // srv address:SCM mounts
 
@@ -307,18 +390,11 @@ func (c *connList) StorageFormat() (ClientCtrlrMap, ClientMountMap) {
 // devices on a remote server by calling over gRPC channel.
 //
 // Calls control storageUpdate routine which activates StorageUpdate service rpc
-// and returns an open stream handle. Receive on stream and send ClientResult
-// over channel for each.
-func storageUpdateRequest(
-	mc Control, req interface{}, ch chan ClientResult) {
-
+// and returns an open stream handle. Results are accumulated from each message
+// received on stream and a single ClientResult is sent over channel.
+func storageUpdateRequest(ctx context.Context, mc Control, req interface{}, ch chan ClientResult) {
 	sRes := StorageResult{}
 
-	// Maximum time limit for update is 2hrs to account for lengthy firmware
-	// updates of multiple devices sequentially.
-	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Minute)
-	defer cancel()
-
 	var updateReq *pb.StorageUpdateReq
 	switch v := req.(type) {
 	case *pb.StorageUpdateReq:
@@ -351,19 +427,19 @@ func storageUpdateRequest(
 			return // recv err
 		}
 
-		sRes.nvmeCtrlr.Responses = resp.Crets
-		sRes.scmModule.Responses = resp.Mrets
-
-		ch <- ClientResult{mc.getAddress(), sRes, nil}
+		sRes.nvmeCtrlr.Responses = append(sRes.nvmeCtrlr.Responses, resp.Crets...)
+		sRes.scmModule.Responses = append(sRes.scmModule.Responses, resp.Mrets...)
 	}
+
+	ch <- ClientResult{mc.getAddress(), sRes, nil}
 }
 
 // StorageUpdate prepares nonvolatile storage devices attached to each
 // remote server in the connection list for use with DAOS.
-func (c *connList) StorageUpdate(req *pb.StorageUpdateReq) (
+func (c *connList) StorageUpdate(ctx context.Context, req *pb.StorageUpdateReq) (
 	ClientCtrlrMap, ClientModuleMap) {
 
-	cResults := c.makeRequests(req, storageUpdateRequest)
+	cResults := c.makeRequests(ctx, req, storageUpdateRequest)
 	cCtrlrResults := make(ClientCtrlrMap)   // srv address:NVMe SSDs
 	cModuleResults := make(ClientModuleMap) // srv address:SCM modules
 
@@ -392,8 +468,6 @@ func (c *connList) StorageUpdate(req *pb.StorageUpdateReq) (
 	return cCtrlrResults, cModuleResults
 }
 
-// TODO: implement burnin in a similar way to format
-
 // FetchFioConfigPaths retrieves absolute file paths for fio configurations
 // residing in spdk fio_plugin directory on server.
 func (c *control) FetchFioConfigPaths() (paths []string, err error) {
@@ -418,34 +492,159 @@ func (c *control) FetchFioConfigPaths() (paths []string, err error) {
 	return
 }
 
-// BurnInNvme runs burn-in validation on NVMe Namespace and returns cmd output
-// in a stream to the gRPC consumer.
-func (c *control) BurnInNvme(pciAddr string, configPath string) (
-	reports []string, err error) {
+// StorageBurnInResult contains the state of each device exercised during
+// burn-in on a server along with the workload statistics parsed from fio.
+type StorageBurnInResult struct {
+	Crets   types.NvmeControllerResults `json:"nvme_results,omitempty"`
+	Mrets   types.ScmMountResults       `json:"scm_results,omitempty"`
+	Results []*pb.BurnInResult          `json:"results,omitempty"`
+	Err     error                       `json:"-"`
+}
 
-	// Maximum time limit for BurnIn is 2hrs
-	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Minute)
-	defer cancel()
+// MarshalJSON encodes the result with any error rendered as a string.
+func (sbr StorageBurnInResult) MarshalJSON() ([]byte, error) {
+	type toJSON StorageBurnInResult
+	return common.MarshalWithError(toJSON(sbr), sbr.Err)
+}
+
+func formatLatency(lats []*pb.LatencyPercentile) string {
+	strs := make([]string, 0, len(lats))
+	for _, lat := range lats {
+		strs = append(strs, fmt.Sprintf("p%g=%dns", lat.Percentile, lat.Nsec))
+	}
+
+	return strings.Join(strs, " ")
+}
+
+func (sbr StorageBurnInResult) String() string {
+	var buf bytes.Buffer
+
+	if sbr.Err != nil {
+		return sbr.Err.Error()
+	}
 
-	req := &pb.BurninNvmeReq{
-		Fioconfig: &pb.FilePath{Path: configPath},
+	buf.WriteString(sbr.Crets.String())
+	buf.WriteString(sbr.Mrets.String())
+
+	if len(sbr.Results) == 0 {
+		return buf.String()
+	}
+
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "\tDevice\tOp\tIOPS\tBW(KiB/s)\tErrors\tLatency")
+	for _, res := range sbr.Results {
+		for _, op := range []struct {
+			name  string
+			stats *pb.BurnInStats
+		}{
+			{"read", res.Read}, {"write", res.Write},
+		} {
+			if op.stats == nil {
+				continue
+			}
+			fmt.Fprintf(w, "\t%s\t%s\t%.2f\t%d\t%d\t%s\n",
+				res.Device, op.name, op.stats.Iops, op.stats.Bw,
+				res.Errors, formatLatency(op.stats.Latency))
+		}
+	}
+	w.Flush()
+
+	return buf.String()
+}
+
+// ClientBurnInMap is an alias for burn-in results from servers connected to
+// given client keyed on address.
+type ClientBurnInMap map[string]StorageBurnInResult
+
+func (cbm ClientBurnInMap) String() string {
+	var buf bytes.Buffer
+	servers := make([]string, 0, len(cbm))
+
+	for server := range cbm {
+		servers = append(servers, server)
+	}
+	sort.Strings(servers)
+
+	for _, server := range servers {
+		fmt.Fprintf(&buf, "%s:\n%s\n", server, cbm[server])
 	}
-	_, err = c.getCtlClient().StorageBurnIn(
-		ctx, &pb.StorageBurnInReq{Nvme: req})
+
+	return buf.String()
+}
+
+// storageBurnInRequest runs burn-in workloads on nonvolatile storage devices
+// on a remote server by calling over gRPC channel.
+//
+// Progress messages received on the stream are logged as they arrive and
+// device results are accumulated until the stream is closed, a single
+// ClientResult is then sent over channel.
+func storageBurnInRequest(ctx context.Context, mc Control, req interface{}, ch chan ClientResult) {
+	burnInReq, ok := req.(*pb.StorageBurnInReq)
+	if !ok {
+		err := errors.Errorf(msgTypeAssert, &pb.StorageBurnInReq{}, req)
+
+		mc.logger().Errorf(err.Error())
+		ch <- ClientResult{mc.getAddress(), nil, err}
+		return // type err
+	}
+
+	stream, err := mc.getCtlClient().StorageBurnIn(ctx, burnInReq)
 	if err != nil {
-		return
+		mc.logger().Errorf(err.Error())
+		ch <- ClientResult{mc.getAddress(), nil, err}
+		return // stream err
 	}
-	//	var report *pb.BurnInNvmeReport
-	//	for {
-	//		report, err = stream.Recv()
-	//		if err == io.EOF {
-	//			err = nil
-	//			break
-	//		} else if err != nil {
-	//			return
-	//		}
-	//		fmt.Println(report.Report)
-	//		reports = append(reports, report.Report)
-	//	}
-	return
+
+	sbr := StorageBurnInResult{}
+	for {
+		resp, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			err := errors.Wrapf(err, msgStreamRecv, stream)
+			mc.logger().Errorf(err.Error())
+			ch <- ClientResult{mc.getAddress(), nil, err}
+			return // recv err
+		}
+
+		if p := resp.GetProgress(); p != nil {
+			mc.logger().Infof("%s: %s: %s",
+				mc.getAddress(), p.Device, p.Status)
+		}
+
+		sbr.Crets = append(sbr.Crets, resp.Crets...)
+		sbr.Mrets = append(sbr.Mrets, resp.Mrets...)
+		sbr.Results = append(sbr.Results, resp.Results...)
+	}
+
+	ch <- ClientResult{mc.getAddress(), sbr, nil}
+}
+
+// StorageBurnIn runs fio workloads against nonvolatile storage devices
+// attached to each remote server in the connection list to validate them
+// before use with DAOS.
+func (c *connList) StorageBurnIn(ctx context.Context, req *pb.StorageBurnInReq) ClientBurnInMap {
+	cResults := c.makeRequests(ctx, req, storageBurnInRequest)
+	cBurnInResults := make(ClientBurnInMap) // srv address:burn-in results
+
+	for _, res := range cResults {
+		if res.Err != nil {
+			cBurnInResults[res.Address] = StorageBurnInResult{Err: res.Err}
+			continue
+		}
+
+		burnInRes, ok := res.Value.(StorageBurnInResult)
+		if !ok {
+			err := fmt.Errorf(
+				msgTypeAssert, StorageBurnInResult{}, res.Value)
+
+			cBurnInResults[res.Address] = StorageBurnInResult{Err: err}
+			continue
+		}
+
+		cBurnInResults[res.Address] = burnInRes
+	}
+
+	return cBurnInResults
 }
@@ -31,37 +31,35 @@ import (
 
 // BioHealthQuery will return all BIO device health and I/O error stats for
 // given device UUID
-func (c *connList) BioHealthQuery(req *pb.BioHealthReq) ResultQueryMap {
+func (c *connList) BioHealthQuery(ctx context.Context, req *pb.BioHealthReq) ResultQueryMap {
 	results := make(ResultQueryMap)
 
-	mc, err := chooseServiceLeader(c.controllers)
-	if err != nil {
-		results[""] = ClientBioResult{"", nil, err}
-		return results
-	}
+	var addr string
+	var resp *pb.BioHealthResp
+	err := c.withServiceLeader(ctx, func(mc Control) (err error) {
+		addr = mc.getAddress()
+		resp, err = mc.getSvcClient().BioHealthQuery(ctx, req)
+		return
+	})
 
-	resp, err := mc.getSvcClient().BioHealthQuery(context.Background(), req)
-
-	result := ClientBioResult{mc.getAddress(), resp, err}
-	results[result.Address] = result
+	results[addr] = ClientBioResult{addr, resp, err}
 
 	return results
 }
 
 // SmdListDevs will list all devices in SMD device table
-func (c *connList) SmdListDevs(req *pb.SmdDevReq) ResultSmdMap {
+func (c *connList) SmdListDevs(ctx context.Context, req *pb.SmdDevReq) ResultSmdMap {
 	results := make(ResultSmdMap)
 
-	mc, err := chooseServiceLeader(c.controllers)
-	if err != nil {
-		results[""] = ClientSmdResult{"", nil, err}
-		return results
-	}
-
-	resp, err := mc.getSvcClient().SmdListDevs(context.Background(), req)
+	var addr string
+	var resp *pb.SmdDevResp
+	err := c.withServiceLeader(ctx, func(mc Control) (err error) {
+		addr = mc.getAddress()
+		resp, err = mc.getSvcClient().SmdListDevs(ctx, req)
+		return
+	})
 
-	result := ClientSmdResult{mc.getAddress(), resp, err}
-	results[result.Address] = result
+	results[addr] = ClientSmdResult{addr, resp, err}
 
 	return results
 }
@@ -0,0 +1,365 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package client
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+// SystemMember contains details of a data-plane instance which has joined
+// the DAOS system.
+type SystemMember struct {
+	Rank             uint32        `json:"rank"`
+	UUID             string        `json:"uuid"`
+	URI              string        `json:"uri"`
+	Addr             string        `json:"addr"`
+	State            string        `json:"state"`
+	LastSeen         time.Time     `json:"last_seen"`
+	HeartbeatLatency time.Duration `json:"heartbeat_latency"`
+}
+
+// SystemQueryReq contains the ranks to query, all members are returned if
+// no ranks are specified.
+type SystemQueryReq struct {
+	Ranks []uint32
+}
+
+// SystemQueryResp contains the queried system members.
+type SystemQueryResp struct {
+	Members []*SystemMember `json:"members"`
+}
+
+// SystemQuery requests details of DAOS system membership from the
+// management service.
+//
+// Isolate protobuf encapsulation in client and don't expose to calling code.
+func (c *connList) SystemQuery(ctx context.Context, req *SystemQueryReq) (*SystemQueryResp, error) {
+	rpcReq := &pb.SystemQueryReq{Ranks: req.Ranks}
+
+	c.log.Debugf("DAOS system query request: %s\n", rpcReq)
+
+	var rpcResp *pb.SystemQueryResp
+	err := c.withServiceLeader(ctx, func(mc Control) (err error) {
+		rpcResp, err = mc.getCtlClient().SystemQuery(ctx, rpcReq)
+		return
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c.log.Debugf("DAOS system query response: %s\n", rpcResp)
+
+	resp := &SystemQueryResp{}
+	for _, m := range rpcResp.GetMembers() {
+		member := &SystemMember{
+			Rank:             m.GetRank(),
+			UUID:             m.GetUuid(),
+			URI:              m.GetUri(),
+			Addr:             m.GetAddr(),
+			State:            m.GetState(),
+			HeartbeatLatency: time.Duration(m.GetHeartbeatLatency()),
+		}
+		if m.GetLastSeen() != 0 {
+			member.LastSeen = time.Unix(m.GetLastSeen(), 0)
+		}
+		resp.Members = append(resp.Members, member)
+	}
+
+	return resp, nil
+}
+
+// LeaderQueryReq contains the name of the system to query.
+type LeaderQueryReq struct {
+	System string
+}
+
+// LeaderQueryResp contains the address of the current management service
+// leader and of each of the replicas, along with those replicas which could
+// not be reached.
+type LeaderQueryResp struct {
+	Leader       string   `json:"current_leader"`
+	Replicas     []string `json:"replicas"`
+	DownReplicas []string `json:"down_replicas"`
+}
+
+// LeaderQuery requests the current management service leader and replica
+// set from the first connected server to respond.
+//
+// Isolate protobuf encapsulation in client and don't expose to calling code.
+func (c *connList) LeaderQuery(ctx context.Context, req *LeaderQueryReq) (*LeaderQueryResp, error) {
+	_, rpcResp, err := c.queryLeader(ctx, &pb.LeaderQueryReq{System: req.System})
+	if err != nil {
+		return nil, err
+	}
+
+	return &LeaderQueryResp{
+		Leader:       rpcResp.GetCurrentLeader(),
+		Replicas:     rpcResp.GetReplicas(),
+		DownReplicas: rpcResp.GetDownReplicas(),
+	}, nil
+}
+
+// ParseRanks expands a comma separated list of ranks and rank ranges, e.g.
+// "0-3,8", into a sorted, deduplicated list of ranks.
+func ParseRanks(in string) ([]uint32, error) {
+	var ranks []uint32
+	seen := make(map[uint32]bool)
+
+	for _, rng := range strings.Split(in, ",") {
+		values, err := expandRange(strings.TrimSpace(rng))
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range values {
+			rank, err := strconv.ParseUint(v, 10, 32)
+			if err != nil {
+				return nil, errors.Errorf("invalid rank %q", v)
+			}
+			if !seen[uint32(rank)] {
+				seen[uint32(rank)] = true
+				ranks = append(ranks, uint32(rank))
+			}
+		}
+	}
+	sort.Slice(ranks, func(i, j int) bool { return ranks[i] < ranks[j] })
+
+	return ranks, nil
+}
+
+// RankResult describes the outcome of an action performed on a rank.
+type RankResult struct {
+	Rank    uint32 `json:"rank"`
+	Address string `json:"addr"`
+	Action  string `json:"action"`
+	Errored bool   `json:"errored"`
+	Msg     string `json:"msg"`
+}
+
+// SystemRankResp contains the per-rank results of a system action and the
+// errors from any hosts which could not be asked to perform it.
+type SystemRankResp struct {
+	Results    []*RankResult     `json:"results"`
+	HostErrors map[string]string `json:"host_errors,omitempty"`
+	// reason the action wasn't ordered by system state, if it wasn't
+	OrderSkipped string `json:"order_skipped,omitempty"`
+}
+
+// addResults adds the rank results returned by each host.
+func (resp *SystemRankResp) addResults(cResults ResultMap) {
+	for _, res := range cResults {
+		if res.Err == nil {
+			pbResults, ok := res.Value.([]*pb.RankResult)
+			if !ok {
+				res.Err = fmt.Errorf(msgBadType, []*pb.RankResult{}, res.Value)
+			} else {
+				for _, r := range pbResults {
+					resp.Results = append(resp.Results, &RankResult{
+						Rank:    r.GetRank(),
+						Address: res.Address,
+						Action:  r.GetAction(),
+						Errored: r.GetErrored(),
+						Msg:     r.GetMsg(),
+					})
+				}
+				continue
+			}
+		}
+
+		if resp.HostErrors == nil {
+			resp.HostErrors = make(map[string]string)
+		}
+		resp.HostErrors[res.Address] = res.Err.Error()
+	}
+
+	sort.Slice(resp.Results, func(i, j int) bool {
+		if resp.Results[i].Rank == resp.Results[j].Rank {
+			return resp.Results[i].Address < resp.Results[j].Address
+		}
+		return resp.Results[i].Rank < resp.Results[j].Rank
+	})
+}
+
+// SystemStopReq contains the ranks to stop, all ranks are stopped if none
+// are specified. Ranks are killed immediately if Force is set.
+type SystemStopReq struct {
+	Ranks []uint32
+	Force bool
+}
+
+// systemStopRequest is to be called as a goroutine and returns results of
+// stopping ranks managed by the server over channel.
+func systemStopRequest(ctx context.Context, mc Control, req interface{}, ch chan ClientResult) {
+	stopReq, ok := req.(*pb.SystemStopReq)
+	if !ok {
+		ch <- ClientResult{mc.getAddress(), nil, fmt.Errorf(msgBadType, &pb.SystemStopReq{}, req)}
+		return
+	}
+
+	resp, err := mc.getCtlClient().SystemStop(ctx, stopReq)
+	if err != nil {
+		ch <- ClientResult{mc.getAddress(), nil, err}
+		return
+	}
+
+	ch <- ClientResult{mc.getAddress(), resp.GetResults(), nil}
+}
+
+// systemStopPhases returns the requests made to stop the given members in
+// order: first the ranks not hosting pool service replicas, then those that
+// do, with the instances hosting Management Service replicas stopped last.
+// Phases with no ranks to stop are omitted.
+func systemStopPhases(members []*SystemMember, pools []*PoolDiscovery, force bool) []*pb.SystemStopReq {
+	svcRanks := make(map[uint32]bool)
+	for _, pool := range pools {
+		for _, rank := range pool.SvcReplicas {
+			svcRanks[rank] = true
+		}
+	}
+
+	var all, others, services []uint32
+	for _, member := range members {
+		all = append(all, member.Rank)
+		if svcRanks[member.Rank] {
+			services = append(services, member.Rank)
+		} else {
+			others = append(others, member.Rank)
+		}
+	}
+
+	var phases []*pb.SystemStopReq
+	for _, ranks := range [][]uint32{others, services} {
+		if len(ranks) != 0 {
+			phases = append(phases, &pb.SystemStopReq{Ranks: ranks, Force: force})
+		}
+	}
+	if len(all) != 0 {
+		phases = append(phases, &pb.SystemStopReq{Ranks: all, Force: force, Replicas: true})
+	}
+
+	return phases
+}
+
+// systemStopOrder returns the requests made to stop the requested ranks in
+// order, see systemStopPhases(), using the system members and pool service
+// ranks obtained from the management service.
+func (c *connList) systemStopOrder(ctx context.Context, req *SystemStopReq) ([]*pb.SystemStopReq, error) {
+	members, err := c.SystemQuery(ctx, &SystemQueryReq{Ranks: req.Ranks})
+	if err != nil {
+		return nil, errors.Wrap(err, "query system members")
+	}
+	pools, err := c.ListPools(ctx, &ListPoolsReq{})
+	if err != nil {
+		return nil, errors.Wrap(err, "query pool service ranks")
+	}
+
+	return systemStopPhases(members.Members, pools.Pools, req.Force), nil
+}
+
+// SystemStop requests each connected server to stop the I/O server
+// instances with the requested ranks, which must be system members.
+//
+// The members and the ranks hosting pool service replicas are obtained from
+// the management service so that pool services are stopped after the ranks
+// not hosting them, and Management Service replicas are stopped last.
+//
+// A forced stop doesn't depend on the management service. If it can't be
+// queried, the requested ranks are stopped without regard to pool services
+// and the reason is reported in the response.
+func (c *connList) SystemStop(ctx context.Context, req *SystemStopReq) (*SystemRankResp, error) {
+	if len(c.controllers) == 0 {
+		return nil, errors.New("no active connections")
+	}
+
+	resp := &SystemRankResp{}
+	phases, err := c.systemStopOrder(ctx, req)
+	if err != nil {
+		if !req.Force {
+			return nil, err
+		}
+		c.log.Debugf("DAOS system stop order unavailable: %s\n", err)
+		resp.OrderSkipped = err.Error()
+		phases = []*pb.SystemStopReq{
+			{Ranks: req.Ranks, Force: true},
+			{Ranks: req.Ranks, Force: true, Replicas: true},
+		}
+	}
+
+	for _, rpcReq := range phases {
+		c.log.Debugf("DAOS system stop request: %s\n", rpcReq)
+
+		resp.addResults(c.makeRequests(ctx, rpcReq, systemStopRequest))
+	}
+
+	return resp, nil
+}
+
+// SystemStartReq contains the ranks to start, all stopped ranks are
+// started if none are specified.
+type SystemStartReq struct {
+	Ranks []uint32
+}
+
+// systemStartRequest is to be called as a goroutine and returns results of
+// starting ranks managed by the server over channel.
+func systemStartRequest(ctx context.Context, mc Control, req interface{}, ch chan ClientResult) {
+	startReq, ok := req.(*pb.SystemStartReq)
+	if !ok {
+		ch <- ClientResult{mc.getAddress(), nil, fmt.Errorf(msgBadType, &pb.SystemStartReq{}, req)}
+		return
+	}
+
+	resp, err := mc.getCtlClient().SystemStart(ctx, startReq)
+	if err != nil {
+		ch <- ClientResult{mc.getAddress(), nil, err}
+		return
+	}
+
+	ch <- ClientResult{mc.getAddress(), resp.GetResults(), nil}
+}
+
+// SystemStart requests each connected server to start previously stopped
+// I/O server instances with the requested ranks.
+func (c *connList) SystemStart(ctx context.Context, req *SystemStartReq) (*SystemRankResp, error) {
+	if len(c.controllers) == 0 {
+		return nil, errors.New("no active connections")
+	}
+
+	rpcReq := &pb.SystemStartReq{Ranks: req.Ranks}
+
+	c.log.Debugf("DAOS system start request: %s\n", rpcReq)
+
+	resp := &SystemRankResp{}
+	resp.addResults(c.makeRequests(ctx, rpcReq, systemStartRequest))
+
+	return resp, nil
+}
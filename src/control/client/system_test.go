@@ -0,0 +1,121 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package client
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	. "github.com/daos-stack/daos/src/control/common"
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+func TestParseRanks(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in     string
+		expOut []uint32
+		expErr error
+	}{
+		"single rank": {
+			in:     "3",
+			expOut: []uint32{3},
+		},
+		"ranges and duplicates": {
+			in:     "8, 0-3,2-4",
+			expOut: []uint32{0, 1, 2, 3, 4, 8},
+		},
+		"empty": {
+			in:     "",
+			expErr: errors.New("invalid range \"\""),
+		},
+		"bad rank": {
+			in:     "0-3,x",
+			expErr: errors.New("invalid range \"x\""),
+		},
+		"reversed range": {
+			in:     "3-1",
+			expErr: errors.New("invalid range \"3-1\": upper bound less than lower"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			out, err := ParseRanks(tc.in)
+			if tc.expErr != nil {
+				ExpectError(t, err, tc.expErr.Error(), name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			AssertEqual(t, out, tc.expOut, name)
+		})
+	}
+}
+
+func TestSystemStopPhases(t *testing.T) {
+	members := []*SystemMember{{Rank: 0}, {Rank: 1}, {Rank: 2}, {Rank: 3}}
+
+	for name, tc := range map[string]struct {
+		members   []*SystemMember
+		pools     []*PoolDiscovery
+		expPhases []*pb.SystemStopReq
+	}{
+		"no members": {},
+		"no pools": {
+			members: members,
+			expPhases: []*pb.SystemStopReq{
+				{Ranks: []uint32{0, 1, 2, 3}},
+				{Ranks: []uint32{0, 1, 2, 3}, Replicas: true},
+			},
+		},
+		"pool services stopped after other ranks": {
+			members: members,
+			pools: []*PoolDiscovery{
+				{UUID: "a", SvcReplicas: []uint32{1}},
+				{UUID: "b", SvcReplicas: []uint32{3, 1}},
+			},
+			expPhases: []*pb.SystemStopReq{
+				{Ranks: []uint32{0, 2}},
+				{Ranks: []uint32{1, 3}},
+				{Ranks: []uint32{0, 1, 2, 3}, Replicas: true},
+			},
+		},
+		"only pool service ranks": {
+			members: members[1:2],
+			pools: []*PoolDiscovery{
+				{UUID: "a", SvcReplicas: []uint32{0, 1, 2}},
+			},
+			expPhases: []*pb.SystemStopReq{
+				{Ranks: []uint32{1}},
+				{Ranks: []uint32{1}, Replicas: true},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			AssertEqual(t, systemStopPhases(tc.members, tc.pools, false), tc.expPhases,
+				"unexpected stop phases")
+		})
+	}
+}
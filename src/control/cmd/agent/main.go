@@ -158,7 +158,7 @@ func agentMain(log *logging.LeveledLogger, opts *cliOptions) error {
 	}
 
 	drpcServer.RegisterRPCModule(NewSecurityModule(config.TransportConfig))
-	drpcServer.RegisterRPCModule(&mgmtModule{config.AccessPoints[0], config.TransportConfig})
+	drpcServer.RegisterRPCModule(newMgmtModule(config.AccessPoints, config.TransportConfig))
 
 	err = drpcServer.Start()
 	if err != nil {
@@ -28,11 +28,14 @@ package main
 import "C"
 
 import (
+	"sync"
+
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 
+	"github.com/daos-stack/daos/src/control/common"
 	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/drpc"
 	log "github.com/daos-stack/daos/src/control/logging"
@@ -48,9 +51,16 @@ const (
 // Management Service proxy, handling dRPCs sent by libdaos by forwarding them
 // to MS.
 type mgmtModule struct {
-	// The access point
-	ap   string
+	// The access points
+	aps  []string
 	tcfg *security.TransportConfig
+
+	sync.Mutex
+	leader string // cached address of the MS leader
+}
+
+func newMgmtModule(aps []string, tcfg *security.TransportConfig) *mgmtModule {
+	return &mgmtModule{aps: aps, tcfg: tcfg}
 }
 
 func (mod *mgmtModule) HandleCall(cli *drpc.Client, method int32, req []byte) ([]byte, error) {
@@ -68,31 +78,111 @@ func (mod *mgmtModule) ID() int32 {
 	return mgmtModuleID
 }
 
+// withConnection dials addr and calls fn with a client for the MS on it.
+func (mod *mgmtModule) withConnection(addr string, fn func(pb.MgmtSvcClient) error) error {
+	dialOpt, err := security.DialOptionForTransportConfig(mod.tcfg)
+	if err != nil {
+		return err
+	}
+	opts := []grpc.DialOption{dialOpt}
+
+	conn, err := grpc.Dial(addr, opts...)
+	if err != nil {
+		return errors.Wrapf(err, "dial %s", addr)
+	}
+	defer conn.Close()
+
+	return fn(pb.NewMgmtSvcClient(conn))
+}
+
+// getLeader returns the cached address of the MS leader, discovering the
+// leader with a LeaderQuery to each access point in turn if it isn't known.
+// The first access point is assumed to lead if none of them respond.
+func (mod *mgmtModule) getLeader() string {
+	mod.Lock()
+	defer mod.Unlock()
+
+	if mod.leader != "" {
+		return mod.leader
+	}
+
+	for _, ap := range mod.aps {
+		var resp *pb.LeaderQueryResp
+		err := mod.withConnection(ap, func(client pb.MgmtSvcClient) (err error) {
+			resp, err = client.LeaderQuery(context.Background(), &pb.LeaderQueryReq{})
+			return
+		})
+		if err != nil {
+			log.Debugf("LeaderQuery %s: %v", ap, err)
+			continue
+		}
+		if resp.CurrentLeader != "" {
+			mod.leader = resp.CurrentLeader
+			log.Debugf("MS leader %s", mod.leader)
+			return mod.leader
+		}
+	}
+
+	return mod.aps[0]
+}
+
+// setLeader caches addr as the address of the MS leader.
+func (mod *mgmtModule) setLeader(addr string) {
+	mod.Lock()
+	defer mod.Unlock()
+
+	mod.leader = addr
+}
+
+// clearLeader drops the cached MS leader so it is rediscovered on next use.
+func (mod *mgmtModule) clearLeader() {
+	mod.Lock()
+	defer mod.Unlock()
+
+	mod.leader = ""
+}
+
 func (mod *mgmtModule) handleGetAttachInfo(reqb []byte) ([]byte, error) {
 	req := &pb.GetAttachInfoReq{}
 	if err := proto.Unmarshal(reqb, req); err != nil {
 		return nil, errors.Wrap(err, "unmarshal GetAttachInfo request")
 	}
 
-	log.Debugf("GetAttachInfo %s %v", mod.ap, *req)
+	getAttachInfo := func(ms string) (resp *pb.GetAttachInfoResp, err error) {
+		log.Debugf("GetAttachInfo %s %v", ms, *req)
 
-	dialOpt, err := security.DialOptionForTransportConfig(mod.tcfg)
-	if err != nil {
-		return nil, err
+		err = mod.withConnection(ms, func(client pb.MgmtSvcClient) (err error) {
+			resp, err = client.GetAttachInfo(context.Background(), req)
+			return
+		})
+		return resp, errors.Wrapf(err, "GetAttachInfo %s %v", ms, *req)
 	}
-	opts := []grpc.DialOption{dialOpt}
 
-	conn, err := grpc.Dial(mod.ap, opts...)
+	ms := mod.getLeader()
+	resp, err := getAttachInfo(ms)
 	if err != nil {
-		return nil, errors.Wrapf(err, "dial %s", mod.ap)
+		// The leader may have changed or become unavailable since it
+		// was cached, so rediscover it and fail over to each of the
+		// other access points in turn until one of them responds.
+		log.Debugf("%v", err)
+		mod.clearLeader()
+
+		tried := []string{ms}
+		for _, candidate := range append([]string{mod.getLeader()}, mod.aps...) {
+			if common.Include(tried, candidate) {
+				continue
+			}
+			tried = append(tried, candidate)
+
+			if resp, err = getAttachInfo(candidate); err == nil {
+				mod.setLeader(candidate)
+				break
+			}
+			log.Debugf("%v", err)
+		}
 	}
-	defer conn.Close()
-
-	client := pb.NewMgmtSvcClient(conn)
-
-	resp, err := client.GetAttachInfo(context.Background(), req)
 	if err != nil {
-		return nil, errors.Wrapf(err, "GetAttachInfo %s %v", mod.ap, *req)
+		return nil, err
 	}
 
 	respb, err := proto.Marshal(resp)
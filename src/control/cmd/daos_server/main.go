@@ -41,8 +41,9 @@ type mainOpts struct {
 	JSON  bool `short:"j" long:"json" description:"Enable JSON output"`
 
 	// Define subcommands
-	Storage storageCmd `command:"storage" description:"Perform tasks related to locally-attached storage"`
-	Start   startCmd   `command:"start" description:"Start daos_server"`
+	Storage    storageCmd    `command:"storage" description:"Perform tasks related to locally-attached storage"`
+	Start      startCmd      `command:"start" description:"Start daos_server"`
+	Superblock superblockCmd `command:"superblock" description:"Inspect and repair I/O server instance superblocks"`
 }
 
 type cmdLogger interface {
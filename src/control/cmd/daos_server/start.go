@@ -28,7 +28,6 @@ import (
 
 	"github.com/pkg/errors"
 
-	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server"
 )
@@ -49,6 +48,10 @@ type startCmd struct {
 	Attach      *string `short:"a" long:"attach_info" description:"Attach info patch (to support non-PMIx client)"`
 	SocketDir   string  `short:"d" long:"socket_dir" description:"Location for all daos_server & daos_io_server sockets"`
 	Insecure    bool    `short:"i" long:"insecure" description:"allow for insecure connections"`
+
+	RecreateSuperblocks bool `long:"recreate-superblocks" description:"Recreate I/O server superblocks which can't be read"`
+	RecreateForce       bool `long:"recreate-force" description:"Recreate superblocks even if pool files are present"`
+	RecreateResetRank   bool `long:"recreate-reset-rank" description:"Don't keep the UUID and rank of recreated superblocks"`
 }
 
 func (cmd *startCmd) setCLIOverrides() error {
@@ -74,6 +77,15 @@ func (cmd *startCmd) setCLIOverrides() error {
 	if cmd.Attach != nil {
 		cmd.config.WithAttachInfo(*cmd.Attach)
 	}
+	if cmd.RecreateSuperblocks {
+		cmd.config.RecreateSuperblocks.Enabled = true
+	}
+	if cmd.RecreateForce {
+		cmd.config.RecreateSuperblocks.Force = true
+	}
+	if cmd.RecreateResetRank {
+		cmd.config.RecreateSuperblocks.ResetRank = true
+	}
 
 	host, err := os.Hostname()
 	if err != nil {
@@ -131,10 +143,12 @@ func (cmd *startCmd) configureLogging() error {
 
 	// Set log file for default logger if specified in config.
 	if cmd.config.ControlLogFile != "" {
-		f, err := common.AppendFile(cmd.config.ControlLogFile)
+		f, err := logging.OpenLogFile(cmd.config.ControlLogFile)
 		if err != nil {
 			return errors.WithMessage(err, "create log file")
 		}
+		// Allow the server to switch log files on config reload.
+		cmd.config.WithControlLogWriter(f)
 
 		cmd.log.Infof("%s logging to file %s",
 			os.Args[0], cmd.config.ControlLogFile)
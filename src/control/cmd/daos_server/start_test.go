@@ -271,6 +271,24 @@ func TestStartOptions(t *testing.T) {
 				return cfg.WithTransportConfig(insecureTransport)
 			},
 		},
+		"Recreate Superblocks": {
+			argList: []string{"--recreate-superblocks"},
+			expCfgFn: func(cfg *server.Configuration) *server.Configuration {
+				return cfg.WithRecreateSuperblocks(server.SuperblockRecreation{
+					Enabled: true,
+				})
+			},
+		},
+		"Recreate Superblocks (forced, reset rank)": {
+			argList: []string{"--recreate-superblocks", "--recreate-force", "--recreate-reset-rank"},
+			expCfgFn: func(cfg *server.Configuration) *server.Configuration {
+				return cfg.WithRecreateSuperblocks(server.SuperblockRecreation{
+					Enabled:   true,
+					Force:     true,
+					ResetRank: true,
+				})
+			},
+		},
 	} {
 		t.Run(desc, func(t *testing.T) {
 			var logBuf bytes.Buffer
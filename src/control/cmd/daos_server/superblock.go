@@ -0,0 +1,166 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package main
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/server"
+	"github.com/daos-stack/daos/src/control/server/ioserver"
+)
+
+type superblockCmd struct {
+	Show   superblockShowCmd   `command:"show" description:"Display the superblocks of locally configured I/O server instances"`
+	Repair superblockRepairCmd `command:"repair" description:"Upgrade and fix the superblocks of locally configured I/O server instances"`
+}
+
+// instanceSelector limits superblock commands to a single configured instance.
+type instanceSelector struct {
+	Instance *uint `short:"i" long:"instance" description:"Index of the I/O server instance (default all)"`
+}
+
+// superblockPaths returns the superblock location of each selected instance,
+// keyed by instance index.
+func (is *instanceSelector) superblockPaths(cfg *server.Configuration) (map[uint]string, error) {
+	paths := make(map[uint]string)
+	for idx, srv := range cfg.Servers {
+		if is.Instance != nil && *is.Instance != uint(idx) {
+			continue
+		}
+		paths[uint(idx)] = server.SuperblockPath(srv.Storage.SCM.MountPoint)
+	}
+
+	if len(paths) == 0 {
+		if is.Instance != nil {
+			return nil, errors.Errorf("instance %d not found in config", *is.Instance)
+		}
+		return nil, errors.New("no I/O server instances in config")
+	}
+
+	return paths, nil
+}
+
+type superblockShowCmd struct {
+	logCmd
+	cfgCmd
+	instanceSelector
+}
+
+func (cmd *superblockShowCmd) Execute(args []string) error {
+	paths, err := cmd.superblockPaths(cmd.config)
+	if err != nil {
+		return err
+	}
+
+	var failed bool
+	for idx := uint(0); idx < uint(len(cmd.config.Servers)); idx++ {
+		path, ok := paths[idx]
+		if !ok {
+			continue
+		}
+
+		st, err := server.InspectSuperblock(path)
+		if err != nil {
+			cmd.log.Errorf("instance %d: %s", idx, err)
+			failed = true
+			continue
+		}
+
+		data, err := st.Superblock.Marshal()
+		if err != nil {
+			return err
+		}
+		cmd.log.Infof("instance %d superblock %s (status: %s):\n  %s", idx, path, st,
+			strings.Replace(strings.TrimSpace(string(data)), "\n", "\n  ", -1))
+		if !st.OK() {
+			failed = true
+		}
+	}
+
+	if failed {
+		return errors.New("superblock problems found, run superblock repair to fix them")
+	}
+
+	return nil
+}
+
+type superblockRepairCmd struct {
+	logCmd
+	cfgCmd
+	instanceSelector
+	Force  bool    `short:"f" long:"force" description:"Accept superblock contents which fail checksum verification"`
+	Rank   *uint32 `short:"r" long:"rank" description:"Set the instance rank (requires --instance)"`
+	System string  `short:"s" long:"system" description:"Set the system name"`
+}
+
+func (cmd *superblockRepairCmd) Execute(args []string) error {
+	if cmd.Rank != nil && cmd.Instance == nil {
+		return errors.New("--rank requires --instance")
+	}
+
+	paths, err := cmd.superblockPaths(cmd.config)
+	if err != nil {
+		return err
+	}
+
+	// The running server holds the superblocks in memory and would
+	// overwrite any repairs. It listens in the socket directory of each
+	// instance, which are separate when more than one is configured.
+	for _, srv := range cmd.config.Servers {
+		if err := server.CheckServerStopped(srv.SocketDir); err != nil {
+			return errors.Wrap(err, "superblocks can't be repaired")
+		}
+	}
+
+	opts := server.SuperblockRepairOpts{
+		Force:  cmd.Force,
+		System: cmd.System,
+	}
+	if cmd.Rank != nil {
+		opts.Rank = ioserver.NewRankPtr(*cmd.Rank)
+	}
+
+	for idx := uint(0); idx < uint(len(cmd.config.Servers)); idx++ {
+		path, ok := paths[idx]
+		if !ok {
+			continue
+		}
+
+		sb, err := server.RepairSuperblock(path, opts)
+		if err != nil {
+			return errors.Wrapf(err, "instance %d", idx)
+		}
+
+		rank := "unassigned"
+		if sb.ValidRank {
+			rank = sb.Rank.String()
+		}
+		cmd.log.Infof("instance %d superblock %s repaired (version %d, rank %s)",
+			idx, path, sb.Version, rank)
+	}
+
+	return nil
+}
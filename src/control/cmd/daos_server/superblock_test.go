@@ -0,0 +1,209 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server"
+	"github.com/daos-stack/daos/src/control/server/ioserver"
+)
+
+func TestSuperblockCommands(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	var logBuf bytes.Buffer
+	log := logging.NewCombinedLogger(t.Name(), &logBuf)
+	defer showBufOnFailure(t, logBuf)
+
+	cfg := genMinimalConfig().WithSocketDir(tmpDir)
+	cfg.Servers[0].WithScmMountPoint(tmpDir)
+
+	show := func() error {
+		cmd := &superblockShowCmd{}
+		cmd.setLog(log)
+		cmd.config = cfg
+		return cmd.Execute(nil)
+	}
+	repair := func(instance *uint, rank *uint32, force bool) error {
+		cmd := &superblockRepairCmd{Rank: rank, Force: force}
+		cmd.Instance = instance
+		cmd.setLog(log)
+		cmd.config = cfg
+		return cmd.Execute(nil)
+	}
+
+	if err := show(); err == nil {
+		t.Fatal("expected error showing missing superblock")
+	}
+
+	sbPath := server.SuperblockPath(tmpDir)
+	if err := server.WriteSuperblock(sbPath, &server.Superblock{
+		Version: 2,
+		UUID:    "e0e8c2c2-4a9e-4f1e-9f3c-5d0a4e1c2b3a",
+		System:  "daos_io_server",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := show(); err != nil {
+		t.Fatal(err)
+	}
+
+	// simulate a hand edit of the rank
+	data, err := ioutil.ReadFile(sbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data = bytes.Replace(data, []byte("validrank: false"), []byte("validrank: true"), 1)
+	if err := ioutil.WriteFile(sbPath, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := show(); err == nil {
+		t.Fatal("expected error showing edited superblock")
+	}
+
+	badInstance := uint(1)
+	testExpectedError(t, errors.New("instance 1 not found"), repair(&badInstance, nil, true))
+	rank := uint32(2)
+	testExpectedError(t, errors.New("--rank requires --instance"), repair(nil, &rank, true))
+	testExpectedError(t, errors.New("checksum mismatch"), repair(nil, nil, false))
+
+	// Superblocks aren't repaired while a server is running, a socket
+	// left behind by a server which has exited is ignored.
+	lis, err := net.ListenUnix("unix", &net.UnixAddr{
+		Name: filepath.Join(tmpDir, "daos_server.sock"),
+		Net:  "unix",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	instance := uint(0)
+	testExpectedError(t, errors.New("daos_server is running"), repair(&instance, &rank, true))
+	lis.SetUnlinkOnClose(false)
+	lis.Close()
+
+	if err := repair(&instance, &rank, true); err != nil {
+		t.Fatal(err)
+	}
+	if err := show(); err != nil {
+		t.Fatal(err)
+	}
+
+	sb, err := server.ReadSuperblock(sbPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !sb.ValidRank || *sb.Rank != ioserver.Rank(rank) {
+		t.Fatalf("expected valid rank %d after repair, got %+v", rank, sb)
+	}
+	if !strings.Contains(logBuf.String(), "repaired") {
+		t.Fatal("expected repair to be logged")
+	}
+}
+
+// TestSuperblockRepairMultiInstance checks that superblocks aren't repaired
+// while a server with several I/O server instances, each listening in its
+// own socket directory, is running.
+func TestSuperblockRepairMultiInstance(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	var logBuf bytes.Buffer
+	log := logging.NewCombinedLogger(t.Name(), &logBuf)
+	defer showBufOnFailure(t, logBuf)
+
+	var srvCfgs []*ioserver.Config
+	for _, dir := range []string{"mnt0", "mnt1"} {
+		mnt := filepath.Join(tmpDir, dir)
+		if err := os.Mkdir(mnt, 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := server.WriteSuperblock(server.SuperblockPath(mnt), &server.Superblock{
+			Version: 2,
+			UUID:    "e0e8c2c2-4a9e-4f1e-9f3c-5d0a4e1c2b3a",
+			System:  "daos_io_server",
+		}); err != nil {
+			t.Fatal(err)
+		}
+		srvCfgs = append(srvCfgs, ioserver.NewConfig().
+			WithScmClass("ram").
+			WithScmMountPoint(mnt).
+			WithFabricInterface("foo0"))
+	}
+	cfg := genMinimalConfig().
+		WithServers(srvCfgs...).
+		WithSocketDir(filepath.Join(tmpDir, "sock"))
+
+	repair := func() error {
+		cmd := &superblockRepairCmd{System: "renamed"}
+		cmd.setLog(log)
+		cmd.config = cfg
+		return cmd.Execute(nil)
+	}
+
+	// Only the second instance's socket is listening.
+	for _, srv := range cfg.Servers {
+		if err := os.MkdirAll(srv.SocketDir, 0700); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lis, err := net.ListenUnix("unix", &net.UnixAddr{
+		Name: filepath.Join(cfg.Servers[1].SocketDir, "daos_server.sock"),
+		Net:  "unix",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testExpectedError(t, errors.New("daos_server is running"), repair())
+	lis.Close()
+
+	if err := repair(); err != nil {
+		t.Fatal(err)
+	}
+	for _, srv := range cfg.Servers {
+		sb, err := server.ReadSuperblock(server.SuperblockPath(srv.Storage.SCM.MountPoint))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sb.System != "renamed" {
+			t.Fatalf("expected system name to be repaired, got %q", sb.System)
+		}
+	}
+}
@@ -24,14 +24,17 @@
 package main
 
 import (
-	"bufio"
 	"io"
 	"os"
 	"strings"
 
 	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/security/acl"
 )
 
+const aclCommentPrefix = acl.CommentPrefix
+
 // readACLFile reads in a file representing an ACL, and translates it into a
 // list of ACE strings.
 func readACLFile(aclFile string) ([]string, error) {
@@ -44,22 +47,59 @@ func readACLFile(aclFile string) ([]string, error) {
 	return parseACL(file)
 }
 
+// writeACLFile writes the ACE strings to a new file in the format accepted by
+// readACLFile.
+func writeACLFile(aclFile string, aces []string) error {
+	file, err := os.Create(aclFile)
+	if err != nil {
+		return errors.WithMessage(err, "creating ACL file")
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(formatACL(aces)); err != nil {
+		return errors.WithMessage(err, "writing ACL file")
+	}
+
+	return nil
+}
+
 // parseACL reads the content from io.Reader and puts the results into a list
 // of Access Control Entry strings.
-// Assumes that ACE strings are provided one per line.
+// Assumes that ACE strings are provided one per line. Lines beginning with
+// '#' are treated as comments. Entries are validated and returned in
+// canonical order.
 func parseACL(reader io.Reader) ([]string, error) {
-	aceList := make([]string, 0)
-	scanner := bufio.NewScanner(reader)
-	for scanner.Scan() {
-		if err := scanner.Err(); err != nil {
-			return nil, errors.WithMessage(err, "reading ACL file")
-		}
-
-		line := strings.TrimSpace(scanner.Text())
-		if line != "" {
-			aceList = append(aceList, line)
-		}
+	parsed, err := acl.ParseACL(reader)
+	if err != nil {
+		return nil, errors.WithMessage(err, "parsing ACL file")
+	}
+
+	return parsed.Strings(), nil
+}
+
+// validateACEs checks that a list of ACE strings is a valid ACL and returns
+// the entries in canonical order.
+func validateACEs(aceStrs []string) ([]string, error) {
+	parsed, err := acl.ParseACEStrings(aceStrs)
+	if err != nil {
+		return nil, err
+	}
+
+	return parsed.Strings(), nil
+}
+
+// formatACL returns the ACE strings one per line, under a comment header, in
+// the format accepted by parseACL.
+func formatACL(aces []string) string {
+	var buf strings.Builder
+
+	buf.WriteString(aclCommentPrefix + " Entries:\n")
+	if len(aces) == 0 {
+		buf.WriteString(aclCommentPrefix + "   None\n")
+	}
+	for _, ace := range aces {
+		buf.WriteString(ace + "\n")
 	}
 
-	return aceList, nil
+	return buf.String()
 }
@@ -92,7 +92,7 @@ func createTestFile(t *testing.T, filePath string, content string) {
 
 func TestReadACLFile_Success(t *testing.T) {
 	path := filepath.Join(os.TempDir(), "testACLFile.txt")
-	createTestFile(t, path, "A::OWNER@:rw\nA::user1@:rw\nA:g:group1@:r\n")
+	createTestFile(t, path, "A::OWNER@:rw\nA::user1@:rw\nA:G:group1@:r\n")
 	defer os.Remove(path)
 
 	expectedNumACEs := 3
@@ -183,16 +183,24 @@ func TestParseACL_WhitespaceExcluded(t *testing.T) {
 }
 
 func TestParseACL_MultiValidACE(t *testing.T) {
+	inputACEs := []string{
+		"A:G:GROUP@:r",
+		"A::OWNER@:rw",
+		"A:G:readers@:r",
+		"L:F:baduser@:rw",
+		"U:F:EVERYONE@:rw",
+	}
+	// Results are sorted into canonical order
 	expectedACEs := []string{
-		"A:g:GROUP@:r",
 		"A::OWNER@:rw",
-		"A:g:readers@:r",
-		"L:f:baduser@:rw",
-		"U:f:EVERYONE@:rw",
+		"L:F:baduser@:rw",
+		"A:G:GROUP@:r",
+		"A:G:readers@:r",
+		"U:F:EVERYONE@:rw",
 	}
 
 	mockFile := &mockReader{
-		text: strings.Join(expectedACEs, "\n"),
+		text: strings.Join(inputACEs, "\n"),
 	}
 
 	result, err := parseACL(mockFile)
@@ -239,3 +247,81 @@ func TestParseACL_ErrorReadingFile(t *testing.T) {
 			err.Error(), expectedError)
 	}
 }
+
+func TestParseACL_InvalidACE(t *testing.T) {
+	for name, text := range map[string]string{
+		"malformed":           "A::OWNER@:rw\nA::OWNER@\n",
+		"unknown flag":        "A:g:readers@:r\n",
+		"duplicate principal": "A::OWNER@:rw\nA::OWNER@:r\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			result, err := parseACL(&mockReader{text: text})
+
+			if result != nil {
+				t.Error("Expected nil result, but got a result")
+			}
+
+			if err == nil {
+				t.Fatal("Expected an error, got nil")
+			}
+		})
+	}
+}
+
+func TestParseACL_CommentsExcluded(t *testing.T) {
+	expectedACE := "A::OWNER@:rw"
+	mockFile := &mockReader{
+		text: "# Entries:\n" + expectedACE + "\n  # trailing comment\n",
+	}
+
+	result, err := parseACL(mockFile)
+
+	if err != nil {
+		t.Errorf("Expected no error, got '%s'", err.Error())
+	}
+
+	if len(result) != 1 {
+		t.Fatalf("Expected 1 result, got %d items", len(result))
+	}
+
+	if result[0] != expectedACE {
+		t.Errorf("Expected ACE '%s', got '%s'", expectedACE, result[0])
+	}
+}
+
+func TestFormatACL(t *testing.T) {
+	for name, tt := range map[string]struct {
+		acl    []string
+		expStr string
+	}{
+		"empty": {
+			expStr: "# Entries:\n#   None\n",
+		},
+		"entries": {
+			acl:    []string{"A::OWNER@:rw", "A:G:GROUP@:r"},
+			expStr: "# Entries:\nA::OWNER@:rw\nA:G:GROUP@:r\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			result := formatACL(tt.acl)
+			if result != tt.expStr {
+				t.Fatalf("Expected %q, got %q", tt.expStr, result)
+			}
+
+			// output must be readable as an ACL file
+			parsed, err := parseACL(&mockReader{text: result})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(parsed) != len(tt.acl) {
+				t.Fatalf("Expected %d parsed entries, got %d",
+					len(tt.acl), len(parsed))
+			}
+			for i := range parsed {
+				if parsed[i] != tt.acl[i] {
+					t.Errorf("Expected ACE '%s', got '%s'", tt.acl[i], parsed[i])
+				}
+			}
+		})
+	}
+}
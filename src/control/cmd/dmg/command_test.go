@@ -24,10 +24,14 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
 	"testing"
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
 
 	"github.com/daos-stack/daos/src/control/client"
 	"github.com/daos-stack/daos/src/control/common"
@@ -96,56 +100,131 @@ func (tc *testConn) ClearConns() client.ResultMap {
 	return nil
 }
 
-func (tc *testConn) StoragePrepare(req *pb.StoragePrepareReq) client.ResultMap {
+func (tc *testConn) StoragePrepare(ctx context.Context, req *pb.StoragePrepareReq) client.ClientPrepareMap {
 	tc.appendInvocation("StoragePrepare")
 	return nil
 }
 
-func (tc *testConn) StorageScan() (client.ClientCtrlrMap, client.ClientModuleMap, client.ClientPmemMap) {
+func (tc *testConn) StorageScan(ctx context.Context) (client.ClientCtrlrMap, client.ClientModuleMap, client.ClientPmemMap) {
 	tc.appendInvocation("StorageScan")
 	return nil, nil, nil
 }
 
-func (tc *testConn) StorageFormat() (client.ClientCtrlrMap, client.ClientMountMap) {
+func (tc *testConn) StorageFormat(ctx context.Context) (client.ClientCtrlrMap, client.ClientMountMap) {
 	tc.appendInvocation("StorageFormat")
 	return nil, nil
 }
 
-func (tc *testConn) StorageUpdate(req *pb.StorageUpdateReq) (client.ClientCtrlrMap, client.ClientModuleMap) {
+func (tc *testConn) StorageUpdate(ctx context.Context, req *pb.StorageUpdateReq) (client.ClientCtrlrMap, client.ClientModuleMap) {
 	tc.appendInvocation(fmt.Sprintf("StorageUpdate-%s", req))
 	return nil, nil
 }
 
-func (tc *testConn) ListFeatures() client.ClientFeatureMap {
+func (tc *testConn) StorageBurnIn(ctx context.Context, req *pb.StorageBurnInReq) client.ClientBurnInMap {
+	tc.appendInvocation(fmt.Sprintf("StorageBurnIn-%s", req))
+	return nil
+}
+
+func (tc *testConn) ListFeatures(ctx context.Context) client.ClientFeatureMap {
 	tc.appendInvocation("ListFeatures")
 	return nil
 }
 
-func (tc *testConn) KillRank(uuid string, rank uint32) client.ResultMap {
+func (tc *testConn) InstanceQuery(ctx context.Context) client.ClientInstanceMap {
+	tc.appendInvocation("InstanceQuery")
+	return nil
+}
+
+func (tc *testConn) NetworkScan(ctx context.Context, provider string) client.ClientNetworkMap {
+	tc.appendInvocation(fmt.Sprintf("NetworkScan-%s", provider))
+	return nil
+}
+
+func (tc *testConn) ConfigReload(ctx context.Context) client.ClientConfigReloadMap {
+	tc.appendInvocation("ConfigReload")
+	return nil
+}
+
+func (tc *testConn) SetLogMasks(ctx context.Context, req *client.SetLogMasksReq) client.ClientLogMasksMap {
+	tc.appendInvocation(fmt.Sprintf("SetLogMasks-%+v", req))
+	return nil
+}
+
+func (tc *testConn) KillRank(ctx context.Context, uuid string, rank uint32) client.ClientKillRankMap {
 	tc.appendInvocation(fmt.Sprintf("KillRank-uuid %s, rank %d", uuid, rank))
 	return nil
 }
 
-func (tc *testConn) PoolCreate(req *client.PoolCreateReq) (*client.PoolCreateResp, error) {
+func (tc *testConn) PoolCreate(ctx context.Context, req *client.PoolCreateReq) (*client.PoolCreateResp, error) {
 	tc.appendInvocation(fmt.Sprintf("PoolCreate-%+v", req))
 	return &client.PoolCreateResp{}, nil
 }
 
-func (tc *testConn) PoolDestroy(req *client.PoolDestroyReq) error {
+func (tc *testConn) PoolDestroy(ctx context.Context, req *client.PoolDestroyReq) error {
 	tc.appendInvocation(fmt.Sprintf("PoolDestroy-%+v", req))
 	return nil
 }
 
-func (tc *testConn) BioHealthQuery(req *pb.BioHealthReq) client.ResultQueryMap {
+func (tc *testConn) PoolQuery(ctx context.Context, req *client.PoolQueryReq) (*client.PoolQueryResp, error) {
+	tc.appendInvocation(fmt.Sprintf("PoolQuery-%+v", req))
+	return &client.PoolQueryResp{}, nil
+}
+
+func (tc *testConn) ListPools(ctx context.Context, req *client.ListPoolsReq) (*client.ListPoolsResp, error) {
+	tc.appendInvocation(fmt.Sprintf("ListPools-%+v", req))
+	return &client.ListPoolsResp{}, nil
+}
+
+func (tc *testConn) PoolGetACL(ctx context.Context, req *client.PoolGetACLReq) (*client.PoolACLResp, error) {
+	tc.appendInvocation(fmt.Sprintf("PoolGetACL-%+v", req))
+	return &client.PoolACLResp{}, nil
+}
+
+func (tc *testConn) PoolOverwriteACL(ctx context.Context, req *client.PoolOverwriteACLReq) (*client.PoolACLResp, error) {
+	tc.appendInvocation(fmt.Sprintf("PoolOverwriteACL-%+v", req))
+	return &client.PoolACLResp{}, nil
+}
+
+func (tc *testConn) PoolUpdateACL(ctx context.Context, req *client.PoolUpdateACLReq) (*client.PoolACLResp, error) {
+	tc.appendInvocation(fmt.Sprintf("PoolUpdateACL-%+v", req))
+	return &client.PoolACLResp{}, nil
+}
+
+func (tc *testConn) PoolDeleteACL(ctx context.Context, req *client.PoolDeleteACLReq) (*client.PoolACLResp, error) {
+	tc.appendInvocation(fmt.Sprintf("PoolDeleteACL-%+v", req))
+	return &client.PoolACLResp{}, nil
+}
+
+func (tc *testConn) BioHealthQuery(ctx context.Context, req *pb.BioHealthReq) client.ResultQueryMap {
 	tc.appendInvocation(fmt.Sprintf("BioHealthQuery-%s", req))
 	return nil
 }
 
-func (tc *testConn) SmdListDevs(req *pb.SmdDevReq) client.ResultSmdMap {
+func (tc *testConn) SmdListDevs(ctx context.Context, req *pb.SmdDevReq) client.ResultSmdMap {
 	tc.appendInvocation(fmt.Sprintf("SmdListDevs-%s", req))
 	return nil
 }
 
+func (tc *testConn) SystemQuery(ctx context.Context, req *client.SystemQueryReq) (*client.SystemQueryResp, error) {
+	tc.appendInvocation(fmt.Sprintf("SystemQuery-%+v", req))
+	return &client.SystemQueryResp{}, nil
+}
+
+func (tc *testConn) SystemStop(ctx context.Context, req *client.SystemStopReq) (*client.SystemRankResp, error) {
+	tc.appendInvocation(fmt.Sprintf("SystemStop-%+v", req))
+	return &client.SystemRankResp{}, nil
+}
+
+func (tc *testConn) SystemStart(ctx context.Context, req *client.SystemStartReq) (*client.SystemRankResp, error) {
+	tc.appendInvocation(fmt.Sprintf("SystemStart-%+v", req))
+	return &client.SystemRankResp{}, nil
+}
+
+func (tc *testConn) LeaderQuery(ctx context.Context, req *client.LeaderQueryReq) (*client.LeaderQueryResp, error) {
+	tc.appendInvocation(fmt.Sprintf("LeaderQuery-%+v", req))
+	return &client.LeaderQueryResp{}, nil
+}
+
 func (tc *testConn) SetTransportConfig(cfg *security.TransportConfig) {
 	tc.appendInvocation("SetTransportConfig")
 }
@@ -208,3 +287,39 @@ func TestNoCommand(t *testing.T) {
 	err := parseOpts([]string{}, &opts, conn, log)
 	testExpectedError(t, fmt.Errorf("Please specify one command"), err)
 }
+
+func TestTimeoutOption(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"Scan with timeout",
+			"--timeout 30s storage scan",
+			"ConnectClients StorageScan",
+			nil,
+		},
+		{
+			"Scan with bad timeout",
+			"--timeout soon storage scan",
+			"",
+			dmgTestErr("invalid argument for flag"),
+		},
+	})
+}
+
+func TestRequestTimeout(t *testing.T) {
+	for name, tc := range map[string]struct {
+		timeout time.Duration
+		cmd     flags.Commander
+		exp     time.Duration
+	}{
+		"default":                {cmd: &storageScanCmd{}, exp: defaultRequestTimeout},
+		"long running default":   {cmd: &storageFormatCmd{}, exp: defaultLongRequestTimeout},
+		"specified":              {timeout: time.Second, cmd: &storageScanCmd{}, exp: time.Second},
+		"specified long running": {timeout: time.Second, cmd: &storageUpdateCmd{}, exp: time.Second},
+	} {
+		t.Run(name, func(t *testing.T) {
+			opts := &cliOptions{Timeout: tc.timeout}
+			common.AssertEqual(t, requestTimeout(opts, tc.cmd), tc.exp,
+				"unexpected request timeout")
+		})
+	}
+}
@@ -0,0 +1,50 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package main
+
+import "os"
+
+// ConfigCmd is the struct representing the top-level config subcommand.
+type ConfigCmd struct {
+	Reload ConfigReloadCmd `command:"reload" alias:"r" description:"Re-read the config file on remote servers, applying changes that don't require a restart"`
+}
+
+// ConfigReloadCmd is the struct representing the command to reload the
+// config file of connected servers.
+type ConfigReloadCmd struct {
+	logCmd
+	connectedCmd
+	jsonOutputCmd
+}
+
+// Execute is run when ConfigReloadCmd activates
+func (c *ConfigReloadCmd) Execute(args []string) error {
+	results := c.conns.ConfigReload(c.ctx)
+	if c.jsonOutputEnabled() {
+		return c.outputJSON(os.Stdout, results)
+	}
+
+	c.log.Infof("Config reload results:\n%s", results)
+	return nil
+}
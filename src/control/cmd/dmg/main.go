@@ -24,9 +24,13 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
+	"io"
 	"os"
+	"os/signal"
 	"path"
-	"strings"
+	"time"
 
 	flags "github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
@@ -41,19 +45,73 @@ type (
 	// plane and therefore must have an
 	// implementation of client.Connect
 	connector interface {
-		setConns(client.Connect)
+		setConns(context.Context, client.Connect)
 	}
 
+	// ctx bounds the requests issued through conns
+	// and is canceled on timeout or interrupt
 	connectedCmd struct {
+		ctx   context.Context
 		conns client.Connect
 	}
 )
 
 // implement the interface
-func (cmd *connectedCmd) setConns(conns client.Connect) {
+func (cmd *connectedCmd) setConns(ctx context.Context, conns client.Connect) {
+	cmd.ctx = ctx
 	cmd.conns = conns
 }
 
+const (
+	// defaultRequestTimeout bounds the time spent waiting
+	// for responses when no timeout has been specified
+	defaultRequestTimeout = time.Minute
+	// defaultLongRequestTimeout is used instead by commands
+	// which perform lengthy operations on remote servers
+	defaultLongRequestTimeout = 120 * time.Minute
+)
+
+// this interface decorates a command whose requests
+// can take much longer than the default timeout
+// to complete e.g. storage format or firmware update
+type longRunner interface {
+	isLongRunning() bool
+}
+
+type longRunningCmd struct{}
+
+func (cmd *longRunningCmd) isLongRunning() bool {
+	return true
+}
+
+// requestTimeout returns the timeout to apply to requests issued
+// by cmd, a timeout specified on the command line takes precedence.
+func requestTimeout(opts *cliOptions, cmd flags.Commander) time.Duration {
+	if opts.Timeout > 0 {
+		return opts.Timeout
+	}
+	if lr, ok := cmd.(longRunner); ok && lr.isLongRunning() {
+		return defaultLongRequestTimeout
+	}
+
+	return defaultRequestTimeout
+}
+
+// cancelOnInterrupt cancels outstanding requests if SIGINT is received
+// before ctx is done, hosts yet to respond are then reported as such.
+func cancelOnInterrupt(ctx context.Context, log logging.Logger, cancel context.CancelFunc) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt)
+	defer signal.Stop(sigCh)
+
+	select {
+	case <-sigCh:
+		log.Info("interrupted, canceling outstanding requests")
+		cancel()
+	case <-ctx.Done():
+	}
+}
+
 type cmdLogger interface {
 	setLog(*logging.LeveledLogger)
 }
@@ -66,18 +124,51 @@ func (c *logCmd) setLog(log *logging.LeveledLogger) {
 	c.log = log
 }
 
+// this interface decorates a command which
+// can emit structured output when JSON output
+// has been requested
+type jsonOutputter interface {
+	enableJSONOutput(bool)
+}
+
+type jsonOutputCmd struct {
+	shouldEmitJSON bool
+}
+
+func (cmd *jsonOutputCmd) enableJSONOutput(emitJSON bool) {
+	cmd.shouldEmitJSON = emitJSON
+}
+
+func (cmd *jsonOutputCmd) jsonOutputEnabled() bool {
+	return cmd.shouldEmitJSON
+}
+
+// outputJSON writes the JSON encoding of in to out.
+func (cmd *jsonOutputCmd) outputJSON(out io.Writer, in interface{}) error {
+	data, err := json.MarshalIndent(in, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = out.Write(append(data, '\n'))
+	return err
+}
+
 type cliOptions struct {
-	HostList string `short:"l" long:"host-list" description:"comma separated list of addresses <ipv4addr/hostname:port>"`
-	Insecure bool   `short:"i" long:"insecure" description:"have dmg attempt to connect without certificates"`
-	Debug    bool   `short:"d" long:"debug" description:"enable debug output"`
-	JSON     bool   `short:"j" long:"json" description:"Enable JSON output"`
-	// TODO: implement host file parsing
-	HostFile   string     `short:"f" long:"host-file" description:"path of hostfile specifying list of addresses <ipv4addr/hostname:port>, if specified takes preference over HostList"`
-	ConfigPath string     `short:"o" long:"config-path" description:"Client config file path"`
-	Storage    storageCmd `command:"storage" alias:"st" description:"Perform tasks related to storage attached to remote servers"`
-	Service    SvcCmd     `command:"service" alias:"sv" description:"Perform distributed tasks related to DAOS system"`
-	Network    NetCmd     `command:"network" alias:"n" description:"Perform tasks related to network devices attached to remote servers"`
-	Pool       PoolCmd    `command:"pool" alias:"p" description:"Perform tasks related to DAOS pools"`
+	HostList   string        `short:"l" long:"host-list" description:"comma separated list of addresses <ipv4addr/hostname:port>, hostnames may contain range expressions e.g. node[001-128,200]:10001"`
+	Insecure   bool          `short:"i" long:"insecure" description:"have dmg attempt to connect without certificates"`
+	Debug      bool          `short:"d" long:"debug" description:"enable debug output"`
+	JSON       bool          `short:"j" long:"json" description:"Enable JSON output, command results are written to stdout as a single JSON document"`
+	HostFile   string        `short:"f" long:"host-file" description:"path of hostfile specifying list of addresses <ipv4addr/hostname:port>, one per line, if specified takes preference over HostList"`
+	ConfigPath string        `short:"o" long:"config-path" description:"Client config file path"`
+	Timeout    time.Duration `long:"timeout" description:"Maximum time to wait for responses from servers e.g. 30s, 5m (default 1m, 120m for storage format, update and burn-in)"`
+	Storage    storageCmd    `command:"storage" alias:"st" description:"Perform tasks related to storage attached to remote servers"`
+	Service    SvcCmd        `command:"service" alias:"sv" description:"Perform distributed tasks related to DAOS system"`
+	Network    NetCmd        `command:"network" alias:"n" description:"Perform tasks related to network devices attached to remote servers"`
+	Config     ConfigCmd     `command:"config" alias:"c" description:"Perform tasks related to the configuration of remote servers"`
+	Server     ServerCmd     `command:"server" alias:"se" description:"Perform tasks related to the running state of remote servers"`
+	Pool       PoolCmd       `command:"pool" alias:"p" description:"Perform tasks related to DAOS pools"`
+	System     SystemCmd     `command:"system" alias:"sy" description:"Perform distributed tasks related to DAOS system"`
 }
 
 // appSetup loads config file, processes cli overrides and connects clients.
@@ -88,11 +179,15 @@ func appSetup(log logging.Logger, opts *cliOptions, conns client.Connect) error
 	}
 
 	if opts.HostList != "" {
-		config.HostList = strings.Split(opts.HostList, ",")
+		if err := config.SetHostList(opts.HostList); err != nil {
+			return errors.WithMessage(err, "processing host list")
+		}
 	}
 
 	if opts.HostFile != "" {
-		return errors.New("hostfile option not implemented")
+		if err := config.SetHostFile(opts.HostFile); err != nil {
+			return errors.WithMessage(err, "processing host file")
+		}
 	}
 
 	if opts.Insecure == true {
@@ -128,23 +223,34 @@ func parseOpts(args []string, opts *cliOptions, conns client.Connect, log *loggi
 			return nil
 		}
 
+		if opts.JSON {
+			// Commands write a single JSON document to stdout,
+			// so send structured log messages to stderr instead.
+			log = logging.NewCombinedLogger("", os.Stderr).WithJSONOutput()
+		}
 		if opts.Debug {
 			log.WithLogLevel(logging.LogLevelDebug)
 			log.Debug("debug output enabled")
 		}
-		if opts.JSON {
-			log.WithJSONOutput()
-		}
 
 		if logCmd, ok := cmd.(cmdLogger); ok {
 			logCmd.setLog(log)
 		}
+		if jsonCmd, ok := cmd.(jsonOutputter); ok {
+			jsonCmd.enableJSONOutput(opts.JSON)
+		}
 
 		if err := appSetup(log, opts, conns); err != nil {
 			return err
 		}
+
+		ctx, cancel := context.WithTimeout(context.Background(),
+			requestTimeout(opts, cmd))
+		defer cancel()
+		go cancelOnInterrupt(ctx, log, cancel)
+
 		if wantsConn, ok := cmd.(connector); ok {
-			wantsConn.setConns(conns)
+			wantsConn.setConns(ctx, conns)
 		}
 		if err := cmd.Execute(args); err != nil {
 			return err
@@ -23,7 +23,29 @@
 
 package main
 
-// TODO: provide implementation to this placeholder
+import "os"
 
 // NetCmd is the struct representing the top-level network subcommand.
-type NetCmd struct{}
+type NetCmd struct {
+	Scan NetScanCmd `command:"scan" alias:"s" description:"Scan for fabric interfaces on remote servers, with NUMA affinity"`
+}
+
+// NetScanCmd is the struct representing the command to scan for fabric
+// interfaces on connected servers.
+type NetScanCmd struct {
+	logCmd
+	connectedCmd
+	jsonOutputCmd
+	Provider string `short:"p" long:"provider" description:"Fabric provider to report interfaces for, defaults to the provider in each server's config"`
+}
+
+// Execute is run when NetScanCmd activates
+func (n *NetScanCmd) Execute(args []string) error {
+	results := n.conns.NetworkScan(n.ctx, n.Provider)
+	if n.jsonOutputEnabled() {
+		return n.outputJSON(os.Stdout, results)
+	}
+
+	n.log.Infof("Network scan results:\n%s", results)
+	return nil
+}
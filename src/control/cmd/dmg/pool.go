@@ -24,9 +24,14 @@
 package main
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"os"
 	"os/user"
+	"strconv"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/inhies/go-bytesize"
 	"github.com/pkg/errors"
@@ -46,12 +51,20 @@ const (
 type PoolCmd struct {
 	Create  PoolCreateCmd  `command:"create" alias:"c" description:"Create a DAOS pool"`
 	Destroy PoolDestroyCmd `command:"destroy" alias:"d" description:"Destroy a DAOS pool"`
+	List    PoolListCmd    `command:"list" alias:"l" description:"List DAOS pools"`
+	Query   PoolQueryCmd   `command:"query" alias:"q" description:"Query a DAOS pool"`
+
+	GetACL       PoolGetACLCmd       `command:"get-acl" description:"Get a DAOS pool's Access Control List"`
+	OverwriteACL PoolOverwriteACLCmd `command:"overwrite-acl" description:"Overwrite a DAOS pool's Access Control List"`
+	UpdateACL    PoolUpdateACLCmd    `command:"update-acl" description:"Update entries in a DAOS pool's Access Control List"`
+	DeleteACL    PoolDeleteACLCmd    `command:"delete-acl" description:"Delete an entry from a DAOS pool's Access Control List"`
 }
 
 // PoolCreateCmd is the struct representing the command to create a DAOS pool.
 type PoolCreateCmd struct {
 	logCmd
 	connectedCmd
+	jsonOutputCmd
 	GroupName  string `short:"g" long:"group" description:"DAOS pool to be owned by given group, format name@domain"`
 	UserName   string `short:"u" long:"user" description:"DAOS pool to be owned by given user, format name@domain"`
 	ACLFile    string `short:"a" long:"acl-file" description:"Access Control List file path for DAOS pool"`
@@ -64,22 +77,317 @@ type PoolCreateCmd struct {
 
 // Execute is run when PoolCreateCmd subcommand is activated
 func (c *PoolCreateCmd) Execute(args []string) error {
-	return poolCreate(c.log, c.conns,
+	resp, err := poolCreate(c.ctx, c.log, c.conns,
 		c.ScmSize, c.NVMeSize, c.RankList, c.NumSvcReps,
 		c.GroupName, c.UserName, c.Sys, c.ACLFile)
+
+	if c.jsonOutputEnabled() {
+		if err != nil {
+			return err
+		}
+		return c.outputJSON(os.Stdout, resp)
+	}
+
+	msg := "SUCCEEDED: "
+	if err != nil {
+		msg = errors.WithMessage(err, "FAILED").Error()
+	} else {
+		msg += fmt.Sprintf("UUID: %s, Service replicas: %s",
+			resp.Uuid, resp.SvcReps)
+	}
+
+	c.log.Infof("Pool-create command %s\n", msg)
+
+	return err
 }
 
 // PoolDestroyCmd is the struct representing the command to destroy a DAOS pool.
 type PoolDestroyCmd struct {
 	logCmd
 	connectedCmd
+	jsonOutputCmd
 	Uuid  string `short:"u" long:"uuid" required:"1" description:"UUID of DAOS pool to destroy"`
 	Force bool   `short:"f" long:"force" description:"Force removal of DAOS pool"`
 }
 
 // Execute is run when PoolDestroyCmd subcommand is activated
 func (d *PoolDestroyCmd) Execute(args []string) error {
-	return poolDestroy(d.log, d.conns, d.Uuid, d.Force)
+	msg := "succeeded"
+
+	err := d.conns.PoolDestroy(d.ctx, &client.PoolDestroyReq{Uuid: d.Uuid, Force: d.Force})
+
+	if d.jsonOutputEnabled() {
+		if err != nil {
+			return err
+		}
+		// no response parameters other than success, so echo back
+		// the UUID of the destroyed pool
+		return d.outputJSON(os.Stdout, struct {
+			UUID string `json:"uuid"`
+		}{d.Uuid})
+	}
+
+	if err != nil {
+		msg = errors.WithMessage(err, "failed").Error()
+	}
+
+	d.log.Infof("Pool-destroy command %s\n", msg)
+
+	return err
+}
+
+// PoolListCmd is the struct representing the command to list DAOS pools.
+type PoolListCmd struct {
+	logCmd
+	connectedCmd
+	jsonOutputCmd
+	Sys string `short:"S" long:"sys" default:"daos_server" description:"DAOS system to list pools of"`
+}
+
+// Execute is run when PoolListCmd subcommand is activated
+func (l *PoolListCmd) Execute(args []string) error {
+	resp, err := l.conns.ListPools(l.ctx, &client.ListPoolsReq{Sys: l.Sys})
+	if err != nil {
+		return errors.WithMessage(err, "Pool-list command failed")
+	}
+
+	if l.jsonOutputEnabled() {
+		return l.outputJSON(os.Stdout, resp)
+	}
+
+	if len(resp.Pools) == 0 {
+		l.log.Info("No pools in system\n")
+		return nil
+	}
+	l.log.Infof("Pools:\n%s", formatPoolList(resp.Pools))
+
+	return nil
+}
+
+// PoolQueryCmd is the struct representing the command to query a DAOS pool.
+type PoolQueryCmd struct {
+	logCmd
+	connectedCmd
+	jsonOutputCmd
+	UUID string `short:"u" long:"uuid" required:"1" description:"UUID of DAOS pool to query"`
+}
+
+// Execute is run when PoolQueryCmd subcommand is activated
+func (q *PoolQueryCmd) Execute(args []string) error {
+	resp, err := q.conns.PoolQuery(q.ctx, &client.PoolQueryReq{UUID: q.UUID})
+	if err != nil {
+		return errors.WithMessage(err, "Pool-query command failed")
+	}
+
+	if q.jsonOutputEnabled() {
+		return q.outputJSON(os.Stdout, resp)
+	}
+	q.log.Info(formatPoolQuery(resp))
+
+	return nil
+}
+
+// PoolGetACLCmd represents the command to fetch an Access Control List of a
+// DAOS pool.
+type PoolGetACLCmd struct {
+	logCmd
+	connectedCmd
+	jsonOutputCmd
+	UUID    string `short:"u" long:"uuid" required:"1" description:"UUID of DAOS pool"`
+	OutFile string `short:"o" long:"outfile" description:"Output ACL to file"`
+}
+
+// Execute is run when the PoolGetACLCmd subcommand is activated
+func (g *PoolGetACLCmd) Execute(args []string) error {
+	resp, err := g.conns.PoolGetACL(g.ctx, &client.PoolGetACLReq{UUID: g.UUID})
+	if err != nil {
+		return errors.WithMessage(err, "Pool-get-ACL command failed")
+	}
+
+	if g.OutFile != "" {
+		if err := writeACLFile(g.OutFile, resp.ACL); err != nil {
+			return err
+		}
+		g.log.Infof("Wrote ACL to output file: %s\n", g.OutFile)
+		return nil
+	}
+
+	return g.outputACL(resp)
+}
+
+// PoolOverwriteACLCmd represents the command to overwrite the Access Control
+// List of a DAOS pool.
+type PoolOverwriteACLCmd struct {
+	logCmd
+	connectedCmd
+	jsonOutputCmd
+	UUID    string `short:"u" long:"uuid" required:"1" description:"UUID of DAOS pool"`
+	ACLFile string `short:"a" long:"acl-file" required:"1" description:"Path for new Access Control List file"`
+}
+
+// Execute is run when the PoolOverwriteACLCmd subcommand is activated
+func (o *PoolOverwriteACLCmd) Execute(args []string) error {
+	acl, err := readACLFile(o.ACLFile)
+	if err != nil {
+		return err
+	}
+
+	resp, err := o.conns.PoolOverwriteACL(o.ctx, &client.PoolOverwriteACLReq{
+		UUID: o.UUID,
+		ACL:  acl,
+	})
+	if err != nil {
+		return errors.WithMessage(err, "Pool-overwrite-ACL command failed")
+	}
+
+	return o.outputACL(resp)
+}
+
+// PoolUpdateACLCmd represents the command to add or update entries in the
+// Access Control List of a DAOS pool.
+type PoolUpdateACLCmd struct {
+	logCmd
+	connectedCmd
+	jsonOutputCmd
+	UUID    string `short:"u" long:"uuid" required:"1" description:"UUID of DAOS pool"`
+	ACLFile string `short:"a" long:"acl-file" description:"Path for new Access Control List file"`
+	Entry   string `short:"e" long:"entry" description:"Single Access Control Entry to add or update"`
+}
+
+// Execute is run when the PoolUpdateACLCmd subcommand is activated
+func (u *PoolUpdateACLCmd) Execute(args []string) error {
+	if (u.ACLFile == "" && u.Entry == "") || (u.ACLFile != "" && u.Entry != "") {
+		return errors.New("either ACL file or entry parameter is required")
+	}
+
+	var acl []string
+	if u.ACLFile != "" {
+		aclFileResult, err := readACLFile(u.ACLFile)
+		if err != nil {
+			return err
+		}
+		acl = aclFileResult
+	} else {
+		entryResult, err := validateACEs([]string{u.Entry})
+		if err != nil {
+			return err
+		}
+		acl = entryResult
+	}
+
+	resp, err := u.conns.PoolUpdateACL(u.ctx, &client.PoolUpdateACLReq{
+		UUID: u.UUID,
+		ACL:  acl,
+	})
+	if err != nil {
+		return errors.WithMessage(err, "Pool-update-ACL command failed")
+	}
+
+	return u.outputACL(resp)
+}
+
+// PoolDeleteACLCmd represents the command to delete an entry from the Access
+// Control List of a DAOS pool.
+type PoolDeleteACLCmd struct {
+	logCmd
+	connectedCmd
+	jsonOutputCmd
+	UUID      string `short:"u" long:"uuid" required:"1" description:"UUID of DAOS pool"`
+	Principal string `short:"p" long:"principal" required:"1" description:"Principal whose entry should be removed"`
+}
+
+// Execute is run when the PoolDeleteACLCmd subcommand is activated
+func (d *PoolDeleteACLCmd) Execute(args []string) error {
+	resp, err := d.conns.PoolDeleteACL(d.ctx, &client.PoolDeleteACLReq{
+		UUID:      d.UUID,
+		Principal: d.Principal,
+	})
+	if err != nil {
+		return errors.WithMessage(err, "Pool-delete-ACL command failed")
+	}
+
+	return d.outputACL(resp)
+}
+
+// outputACL prints the ACL in a pool ACL response either as JSON or in the
+// short ACE format accepted by the ACL file parser.
+func (cmd *jsonOutputCmd) outputACL(resp *client.PoolACLResp) error {
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(os.Stdout, resp)
+	}
+	fmt.Print(formatACL(resp.ACL))
+
+	return nil
+}
+
+// formatPoolList returns a table describing the given pools.
+func formatPoolList(pools []*client.PoolDiscovery) string {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+
+	var failed []string
+	fmt.Fprintln(w, "UUID\tSvc Replicas\tSCM Size\tNVMe Size")
+	for _, p := range pools {
+		reps := make([]string, 0, len(p.SvcReplicas))
+		for _, r := range p.SvcReplicas {
+			reps = append(reps, strconv.FormatUint(uint64(r), 10))
+		}
+		scmSize, nvmeSize := "-", "-"
+		if p.Error != "" {
+			failed = append(failed, fmt.Sprintf("%s: %s", p.UUID, p.Error))
+		} else {
+			scmSize = bytesize.New(float64(p.ScmBytes)).String()
+			nvmeSize = bytesize.New(float64(p.NvmeBytes)).String()
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.UUID, strings.Join(reps, ","),
+			scmSize, nvmeSize)
+	}
+	w.Flush()
+
+	if len(failed) != 0 {
+		fmt.Fprintf(&buf, "Failed to query pool sizes:\n  %s\n", strings.Join(failed, "\n  "))
+	}
+
+	return buf.String()
+}
+
+// formatPoolQuery returns a description of the targets, space usage and
+// rebuild status of a pool.
+func formatPoolQuery(resp *client.PoolQueryResp) string {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "Pool %s, ntarget=%d, disabled=%d\n",
+		resp.UUID, resp.TotalTargets, resp.DisabledTargets)
+	fmt.Fprintln(&buf, "Pool space info:")
+	fmt.Fprintf(&buf, "- Target(VOS) count:%d\n", resp.ActiveTargets)
+	for _, tier := range []struct {
+		name  string
+		stats *client.StorageUsageStats
+	}{
+		{"SCM", resp.Scm},
+		{"NVMe", resp.Nvme},
+	} {
+		if tier.stats == nil {
+			continue
+		}
+		fmt.Fprintf(&buf, "- %s:\n", tier.name)
+		fmt.Fprintf(&buf, "  Total size: %s\n", bytesize.New(float64(tier.stats.Total)))
+		fmt.Fprintf(&buf, "  Free: %s, min:%s, max:%s, mean:%s\n",
+			bytesize.New(float64(tier.stats.Free)),
+			bytesize.New(float64(tier.stats.Min)),
+			bytesize.New(float64(tier.stats.Max)),
+			bytesize.New(float64(tier.stats.Mean)))
+	}
+
+	if rb := resp.Rebuild; rb != nil {
+		if rb.Status != 0 {
+			fmt.Fprintf(&buf, "Rebuild failed, rc=%d\n", rb.Status)
+		} else {
+			fmt.Fprintf(&buf, "Rebuild %s, %d objs, %d recs\n", rb.State, rb.Objects, rb.Records)
+		}
+	}
+
+	return buf.String()
 }
 
 // getSize retrieves number of bytes from human readable string representation
@@ -174,33 +482,31 @@ func formatNameGroup(usr string, grp string) (string, string, error) {
 }
 
 // poolCreate with specified parameters.
-func poolCreate(log logging.Logger, conns client.Connect, scmSize string,
+func poolCreate(ctx context.Context, log logging.Logger, conns client.Connect, scmSize string,
 	nvmeSize string, rankList string, numSvcReps uint32, groupName string,
-	userName string, sys string, aclFile string) error {
-
-	msg := "SUCCEEDED: "
+	userName string, sys string, aclFile string) (*client.PoolCreateResp, error) {
 
 	scmBytes, nvmeBytes, err := calcStorage(log, scmSize, nvmeSize)
 	if err != nil {
-		return errors.Wrap(err, "calculating pool storage sizes")
+		return nil, errors.Wrap(err, "calculating pool storage sizes")
 	}
 
 	var acl []string
 	if aclFile != "" {
 		acl, err = readACLFile(aclFile)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
 	if numSvcReps > maxNumSvcReps {
-		return errors.Errorf("max number of service replicas is %d, got %d",
+		return nil, errors.Errorf("max number of service replicas is %d, got %d",
 			maxNumSvcReps, numSvcReps)
 	}
 
 	usr, grp, err := formatNameGroup(userName, groupName)
 	if err != nil {
-		return errors.WithMessage(err, "formatting user/group strings")
+		return nil, errors.WithMessage(err, "formatting user/group strings")
 	}
 
 	req := &client.PoolCreateReq{
@@ -209,31 +515,5 @@ func poolCreate(log logging.Logger, conns client.Connect, scmSize string,
 		Usr: usr, Grp: grp, Acl: acl,
 	}
 
-	resp, err := conns.PoolCreate(req)
-	if err != nil {
-		msg = errors.WithMessage(err, "FAILED").Error()
-	} else {
-		msg += fmt.Sprintf("UUID: %s, Service replicas: %s",
-			resp.Uuid, resp.SvcReps)
-	}
-
-	log.Infof("Pool-create command %s\n", msg)
-
-	return err
-}
-
-// poolDestroy identified by UUID.
-func poolDestroy(log logging.Logger, conns client.Connect, uuid string, force bool) error {
-	msg := "succeeded"
-
-	req := &client.PoolDestroyReq{Uuid: uuid, Force: force}
-
-	err := conns.PoolDestroy(req)
-	if err != nil {
-		msg = errors.WithMessage(err, "failed").Error()
-	}
-
-	log.Infof("Pool-destroy command %s\n", msg)
-
-	return err
+	return conns.PoolCreate(ctx, req)
 }
@@ -25,7 +25,10 @@ package main
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"os/user"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -133,6 +136,23 @@ func TestPoolCommands(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	testDir, err := ioutil.TempDir("", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(testDir)
+
+	testACL := []string{"A::OWNER@:rw", "A:G:GROUP@:r"}
+	testACLFile := filepath.Join(testDir, "acl.txt")
+	if err := ioutil.WriteFile(testACLFile, []byte(formatACL(testACL)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	testBadACLFile := filepath.Join(testDir, "bad_acl.txt")
+	if err := ioutil.WriteFile(testBadACLFile, []byte("A:g:readers@:r\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	testUUID := "031bcaf8-f0f5-42ef-b3c5-ee048676dceb"
+
 	runCmdTests(t, []cmdTest{
 		{
 			"Create pool with missing arguments",
@@ -215,6 +235,12 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Create pool with invalid ACL file",
+			fmt.Sprintf("pool create --scm-size %s --acl-file %s", testSizeStr, testBadACLFile),
+			"ConnectClients",
+			fmt.Errorf(`parsing ACL file: invalid ACE "A:g:readers@:r": unknown flag 'g'`),
+		},
 		{
 			"Destroy pool with force",
 			"pool destroy --uuid 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --force",
@@ -227,6 +253,136 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"List pools",
+			"pool list",
+			strings.Join([]string{
+				"ConnectClients",
+				fmt.Sprintf("ListPools-%+v", &client.ListPoolsReq{
+					Sys: "daos_server",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Query pool",
+			"pool query --uuid 031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+			strings.Join([]string{
+				"ConnectClients",
+				fmt.Sprintf("PoolQuery-%+v", &client.PoolQueryReq{
+					UUID: "031bcaf8-f0f5-42ef-b3c5-ee048676dceb",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Query pool with missing uuid",
+			"pool query",
+			"",
+			errMissingFlag,
+		},
+		{
+			"Get pool ACL",
+			fmt.Sprintf("pool get-acl --uuid %s", testUUID),
+			strings.Join([]string{
+				"ConnectClients",
+				fmt.Sprintf("PoolGetACL-%+v", &client.PoolGetACLReq{
+					UUID: testUUID,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Get pool ACL to output file",
+			fmt.Sprintf("pool get-acl --uuid %s --outfile %s",
+				testUUID, filepath.Join(testDir, "out.txt")),
+			strings.Join([]string{
+				"ConnectClients",
+				fmt.Sprintf("PoolGetACL-%+v", &client.PoolGetACLReq{
+					UUID: testUUID,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Overwrite pool ACL",
+			fmt.Sprintf("pool overwrite-acl --uuid %s --acl-file %s", testUUID, testACLFile),
+			strings.Join([]string{
+				"ConnectClients",
+				fmt.Sprintf("PoolOverwriteACL-%+v", &client.PoolOverwriteACLReq{
+					UUID: testUUID,
+					ACL:  testACL,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Overwrite pool ACL with missing file",
+			fmt.Sprintf("pool overwrite-acl --uuid %s", testUUID),
+			"",
+			errMissingFlag,
+		},
+		{
+			"Update pool ACL from file",
+			fmt.Sprintf("pool update-acl --uuid %s --acl-file %s", testUUID, testACLFile),
+			strings.Join([]string{
+				"ConnectClients",
+				fmt.Sprintf("PoolUpdateACL-%+v", &client.PoolUpdateACLReq{
+					UUID: testUUID,
+					ACL:  testACL,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Update pool ACL with single entry",
+			fmt.Sprintf("pool update-acl --uuid %s --entry A::EVERYONE@:r", testUUID),
+			strings.Join([]string{
+				"ConnectClients",
+				fmt.Sprintf("PoolUpdateACL-%+v", &client.PoolUpdateACLReq{
+					UUID: testUUID,
+					ACL:  []string{"A::EVERYONE@:r"},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Update pool ACL with invalid entry",
+			fmt.Sprintf("pool update-acl --uuid %s --entry A::EVERYONE@", testUUID),
+			"ConnectClients",
+			fmt.Errorf(`invalid ACE "A::EVERYONE@": expected 4 fields separated by ":"`),
+		},
+		{
+			"Update pool ACL with both file and entry",
+			fmt.Sprintf("pool update-acl --uuid %s --acl-file %s --entry A::EVERYONE@:r",
+				testUUID, testACLFile),
+			"ConnectClients",
+			fmt.Errorf("either ACL file or entry parameter is required"),
+		},
+		{
+			"Update pool ACL with neither file nor entry",
+			fmt.Sprintf("pool update-acl --uuid %s", testUUID),
+			"ConnectClients",
+			fmt.Errorf("either ACL file or entry parameter is required"),
+		},
+		{
+			"Delete pool ACL entry",
+			fmt.Sprintf("pool delete-acl --uuid %s --principal u:foo@", testUUID),
+			strings.Join([]string{
+				"ConnectClients",
+				fmt.Sprintf("PoolDeleteACL-%+v", &client.PoolDeleteACLReq{
+					UUID:      testUUID,
+					Principal: "u:foo@",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Delete pool ACL entry with missing principal",
+			fmt.Sprintf("pool delete-acl --uuid %s", testUUID),
+			"",
+			errMissingFlag,
+		},
 		{
 			"Nonexistent subcommand",
 			"pool quack",
@@ -235,3 +391,68 @@ func TestPoolCommands(t *testing.T) {
 		},
 	})
 }
+
+func TestFormatPoolList(t *testing.T) {
+	pools := []*client.PoolDiscovery{
+		{UUID: "uuid0", SvcReplicas: []uint32{0, 1, 2}, ScmBytes: 1 << 30, NvmeBytes: 10 << 30},
+		{UUID: "uuid1", SvcReplicas: []uint32{3}, ScmBytes: 512 << 20},
+		{UUID: "uuid2", SvcReplicas: []uint32{4}, Error: "DAOS returned error code: -1006"},
+	}
+
+	expOut := []string{
+		"UUID   Svc Replicas  SCM Size  NVMe Size",
+		"uuid0  0,1,2         1.00GB    10.00GB",
+		"uuid1  3             512.00MB  0.00B",
+		"uuid2  4             -         -",
+		"Failed to query pool sizes:",
+		"  uuid2: DAOS returned error code: -1006",
+	}
+
+	AssertEqual(t, formatPoolList(pools), strings.Join(expOut, "\n")+"\n",
+		"unexpected output")
+}
+
+func TestFormatPoolQuery(t *testing.T) {
+	for name, tt := range map[string]struct {
+		rebuild *client.PoolRebuildStatus
+		expLast string
+	}{
+		"rebuild busy": {
+			rebuild: &client.PoolRebuildStatus{State: "busy", Objects: 42, Records: 1024},
+			expLast: "Rebuild busy, 42 objs, 1024 recs",
+		},
+		"rebuild failed": {
+			rebuild: &client.PoolRebuildStatus{Status: -1003, State: "done"},
+			expLast: "Rebuild failed, rc=-1003",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp := &client.PoolQueryResp{
+				UUID:            "uuid0",
+				TotalTargets:    8,
+				ActiveTargets:   7,
+				DisabledTargets: 1,
+				Rebuild:         tt.rebuild,
+				Scm: &client.StorageUsageStats{
+					Total: 1 << 30, Free: 1 << 29, Min: 1 << 26, Max: 1 << 27, Mean: 3 << 25,
+				},
+				Nvme: &client.StorageUsageStats{},
+			}
+			expOut := []string{
+				"Pool uuid0, ntarget=8, disabled=1",
+				"Pool space info:",
+				"- Target(VOS) count:7",
+				"- SCM:",
+				"  Total size: 1.00GB",
+				"  Free: 512.00MB, min:64.00MB, max:128.00MB, mean:96.00MB",
+				"- NVMe:",
+				"  Total size: 0.00B",
+				"  Free: 0.00B, min:0.00B, max:0.00B, mean:0.00B",
+				tt.expLast,
+			}
+
+			AssertEqual(t, formatPoolQuery(resp), strings.Join(expOut, "\n")+"\n",
+				"unexpected output")
+		})
+	}
+}
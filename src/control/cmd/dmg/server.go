@@ -0,0 +1,75 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package main
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/client"
+)
+
+// ServerCmd is the struct representing the top-level server subcommand.
+type ServerCmd struct {
+	SetLogMasks ServerSetLogMasksCmd `command:"set-logmasks" alias:"sl" description:"Change log levels of remote servers and their I/O servers at runtime"`
+}
+
+// ServerSetLogMasksCmd is the struct representing the command to change the
+// log levels of connected servers.
+type ServerSetLogMasksCmd struct {
+	logCmd
+	connectedCmd
+	jsonOutputCmd
+	Level     string        `short:"l" long:"level" required:"1" choice:"debug" choice:"info" choice:"error" description:"Log level to set"`
+	Subsystem string        `short:"s" long:"subsystem" description:"Comma separated list of I/O server subsystems to set the level of, e.g. mgmt,rdb (default: all subsystems and daos_server)"`
+	Ranks     string        `short:"r" long:"ranks" description:"Comma separated list of ranks and rank ranges of I/O servers to set the level of, e.g. 0-15 (default: all ranks)"`
+	Duration  time.Duration `short:"d" long:"duration" description:"Time after which previous log levels are restored e.g. 30m (default: keep new levels)"`
+}
+
+// Execute is run when ServerSetLogMasksCmd activates
+func (cmd *ServerSetLogMasksCmd) Execute(args []string) error {
+	ranks, err := parseRankList(cmd.Ranks)
+	if err != nil {
+		return err
+	}
+
+	var subsystems []string
+	if cmd.Subsystem != "" {
+		subsystems = strings.Split(cmd.Subsystem, ",")
+	}
+
+	results := cmd.conns.SetLogMasks(cmd.ctx, &client.SetLogMasksReq{
+		Level:      strings.ToUpper(cmd.Level),
+		Subsystems: subsystems,
+		Ranks:      ranks,
+		Duration:   cmd.Duration,
+	})
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(os.Stdout, results)
+	}
+
+	cmd.log.Infof("Set log masks results:\n%s", results)
+	return nil
+}
@@ -0,0 +1,64 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package main
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestServerCommands(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"Set log masks",
+			"server set-logmasks --level debug",
+			"ConnectClients SetLogMasks-&{Level:DEBUG Subsystems:[] Ranks:[] Duration:0s}",
+			nil,
+		},
+		{
+			"Set log masks for subsystems and ranks with time limit",
+			"server set-logmasks -l info --subsystem mgmt,rdb --ranks 0-2,5 --duration 30m",
+			"ConnectClients SetLogMasks-&{Level:INFO Subsystems:[mgmt rdb] Ranks:[0 1 2 5] Duration:30m0s}",
+			nil,
+		},
+		{
+			"Set log masks with invalid level",
+			"server set-logmasks --level verbose",
+			"",
+			fmt.Errorf("Invalid value `verbose'"),
+		},
+		{
+			"Set log masks without level",
+			"server set-logmasks",
+			"",
+			fmt.Errorf("the required flag `-l, --level' was not specified"),
+		},
+		{
+			"Set log masks with invalid ranks",
+			"server set-logmasks --level debug --ranks 2-1",
+			"ConnectClients",
+			fmt.Errorf("invalid rank list"),
+		},
+	})
+}
@@ -23,9 +23,12 @@
 
 package main
 
+import "os"
+
 // SvcCmd is the struct representing the top-level service subcommand.
 type SvcCmd struct {
-	KillRank KillRankSvcCmd `command:"kill-rank" alias:"kr" description:"Terminate server running as specific rank on a DAOS pool"`
+	KillRank       KillRankSvcCmd       `command:"kill-rank" alias:"kr" description:"Terminate server running as specific rank on a DAOS pool"`
+	QueryInstances QueryInstancesSvcCmd `command:"query-instances" alias:"qi" description:"Query status and restart history of I/O server instances"`
 }
 
 // KillRankSvcCmd is the struct representing the command to kill server
@@ -33,12 +36,37 @@ type SvcCmd struct {
 type KillRankSvcCmd struct {
 	logCmd
 	connectedCmd
+	jsonOutputCmd
 	Rank     uint32 `short:"r" long:"rank" description:"Rank identifying DAOS server" required:"1"`
 	PoolUUID string `short:"p" long:"pool-uuid" description:"Pool uuid that rank relates to" required:"1"`
 }
 
 // Execute is run when KillRankSvcCmd activates
 func (k *KillRankSvcCmd) Execute(args []string) error {
-	k.log.Infof("Kill Rank command results:\n%s", k.conns.KillRank(k.PoolUUID, k.Rank))
+	results := k.conns.KillRank(k.ctx, k.PoolUUID, k.Rank)
+	if k.jsonOutputEnabled() {
+		return k.outputJSON(os.Stdout, results)
+	}
+
+	k.log.Infof("Kill Rank command results:\n%s", results)
+	return nil
+}
+
+// QueryInstancesSvcCmd is the struct representing the command to query the
+// status of I/O server instances managed by connected servers.
+type QueryInstancesSvcCmd struct {
+	logCmd
+	connectedCmd
+	jsonOutputCmd
+}
+
+// Execute is run when QueryInstancesSvcCmd activates
+func (q *QueryInstancesSvcCmd) Execute(args []string) error {
+	results := q.conns.InstanceQuery(q.ctx)
+	if q.jsonOutputEnabled() {
+		return q.outputJSON(os.Stdout, results)
+	}
+
+	q.log.Infof("Instance query results:\n%s", results)
 	return nil
 }
@@ -43,6 +43,18 @@ func TestServiceCommands(t *testing.T) {
 			"ConnectClients KillRank-uuid 031bcaf8-f0f5-42ef-b3c5-ee048676dceb, rank 2",
 			nil,
 		},
+		{
+			"Kill rank with JSON output",
+			"--json service kill-rank --pool-uuid 031bcaf8-f0f5-42ef-b3c5-ee048676dceb --rank 2",
+			"ConnectClients KillRank-uuid 031bcaf8-f0f5-42ef-b3c5-ee048676dceb, rank 2",
+			nil,
+		},
+		{
+			"Query instances",
+			"service query-instances",
+			"ConnectClients InstanceQuery",
+			nil,
+		},
 		{
 			"Nonexistent subcommand",
 			"service quack",
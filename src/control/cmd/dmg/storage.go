@@ -24,11 +24,14 @@
 package main
 
 import (
+	"os"
+
+	"github.com/pkg/errors"
+
 	"github.com/daos-stack/daos/src/control/client"
 	"github.com/daos-stack/daos/src/control/common"
 	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	types "github.com/daos-stack/daos/src/control/common/storage"
-	"github.com/daos-stack/daos/src/control/logging"
 )
 
 // storageCmd is the struct representing the top-level storage subcommand.
@@ -37,6 +40,7 @@ type storageCmd struct {
 	Scan    storageScanCmd    `command:"scan" alias:"s" description:"Scan SCM and NVMe storage attached to remote servers."`
 	Format  storageFormatCmd  `command:"format" alias:"f" description:"Format SCM and NVMe storage attached to remote servers."`
 	Update  storageUpdateCmd  `command:"fwupdate" alias:"u" description:"Update firmware on NVMe storage attached to remote servers."`
+	BurnIn  storageBurnInCmd  `command:"burn-in" alias:"b" description:"Run fio workloads to validate SCM and NVMe storage attached to remote servers."`
 	Query   storageQueryCmd   `command:"query" alias:"q" description:"Query storage commands, including raw NVMe SSD device health stats and internal blobstore health info."`
 }
 
@@ -44,6 +48,7 @@ type storageCmd struct {
 type storagePrepareCmd struct {
 	logCmd
 	connectedCmd
+	jsonOutputCmd
 	types.StoragePrepareCmd
 }
 
@@ -74,8 +79,12 @@ func (cmd *storagePrepareCmd) Execute(args []string) error {
 		sReq = &pb.PrepareScmReq{Reset_: cmd.Reset}
 	}
 
-	cmd.log.Infof("NVMe & SCM preparation:\n%s",
-		cmd.conns.StoragePrepare(&pb.StoragePrepareReq{Nvme: nReq, Scm: sReq}))
+	results := cmd.conns.StoragePrepare(cmd.ctx, &pb.StoragePrepareReq{Nvme: nReq, Scm: sReq})
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(os.Stdout, results)
+	}
+
+	cmd.log.Infof("NVMe & SCM preparation:\n%s", results)
 
 	return nil
 }
@@ -84,19 +93,29 @@ func (cmd *storagePrepareCmd) Execute(args []string) error {
 type storageScanCmd struct {
 	logCmd
 	connectedCmd
+	jsonOutputCmd
 }
 
-// run NVMe and SCM storage and health query on all connected servers
-func storageScan(log logging.Logger, conns client.Connect) {
-	cCtrlrs, cModules, cPmems := conns.StorageScan()
-	log.Infof("NVMe SSD controllers and constituent namespaces:\n%s", cCtrlrs)
-	log.Infof("SCM modules:\n%s", cModules)
-	log.Infof("PMEM device files:\n%s", cPmems)
+// storageScanResults contains the per-server results of a storage scan.
+type storageScanResults struct {
+	Nvme       client.ClientCtrlrMap  `json:"nvme"`
+	ScmModules client.ClientModuleMap `json:"scm_modules"`
+	Pmem       client.ClientPmemMap   `json:"pmem_devices"`
 }
 
 // Execute is run when storageScanCmd activates
+//
+// Runs NVMe and SCM storage and health query on all connected servers.
 func (s *storageScanCmd) Execute(args []string) error {
-	storageScan(s.log, s.conns)
+	cCtrlrs, cModules, cPmems := s.conns.StorageScan(s.ctx)
+	if s.jsonOutputEnabled() {
+		return s.outputJSON(os.Stdout, storageScanResults{cCtrlrs, cModules, cPmems})
+	}
+
+	s.log.Infof("NVMe SSD controllers and constituent namespaces:\n%s", cCtrlrs)
+	s.log.Infof("SCM modules:\n%s", cModules)
+	s.log.Infof("PMEM device files:\n%s", cPmems)
+
 	return nil
 }
 
@@ -104,27 +123,39 @@ func (s *storageScanCmd) Execute(args []string) error {
 type storageFormatCmd struct {
 	logCmd
 	connectedCmd
+	jsonOutputCmd
+	longRunningCmd
 	Force bool `short:"f" long:"force" description:"Perform format without prompting for confirmation"`
 }
 
-// run NVMe and SCM storage format on all connected servers
-func storageFormat(log logging.Logger, conns client.Connect, force bool) {
-	log.Info(
+// storageFormatResults contains the per-server results of a storage format.
+type storageFormatResults struct {
+	Nvme client.ClientCtrlrMap `json:"nvme"`
+	Scm  client.ClientMountMap `json:"scm"`
+}
+
+// Execute is run when storageFormatCmd activates
+//
+// Runs NVMe and SCM storage format on all connected servers.
+func (s *storageFormatCmd) Execute(args []string) error {
+	s.log.Info(
 		"This is a destructive operation and storage devices " +
 			"specified in the server config file will be erased.\n" +
 			"Please be patient as it may take several minutes.\n")
 
-	if force || common.GetConsent(log) {
-		log.Info("")
-		cCtrlrResults, cMountResults := conns.StorageFormat()
-		log.Infof("NVMe storage format results:\n%s", cCtrlrResults)
-		log.Infof("SCM storage format results:\n%s", cMountResults)
+	if !s.Force && !common.GetConsent(s.log) {
+		return nil
 	}
-}
 
-// Execute is run when storageFormatCmd activates
-func (s *storageFormatCmd) Execute(args []string) error {
-	storageFormat(s.log, s.conns, s.Force)
+	s.log.Info("")
+	cCtrlrResults, cMountResults := s.conns.StorageFormat(s.ctx)
+	if s.jsonOutputEnabled() {
+		return s.outputJSON(os.Stdout, storageFormatResults{cCtrlrResults, cMountResults})
+	}
+
+	s.log.Infof("NVMe storage format results:\n%s", cCtrlrResults)
+	s.log.Infof("SCM storage format results:\n%s", cMountResults)
+
 	return nil
 }
 
@@ -132,6 +163,8 @@ func (s *storageFormatCmd) Execute(args []string) error {
 type storageUpdateCmd struct {
 	logCmd
 	connectedCmd
+	jsonOutputCmd
+	longRunningCmd
 	Force        bool   `short:"f" long:"force" description:"Perform update without prompting for confirmation"`
 	NVMeModel    string `short:"m" long:"nvme-model" description:"Only update firmware on NVMe SSDs with this model name/number." required:"1"`
 	NVMeStartRev string `short:"r" long:"nvme-fw-rev" description:"Only update firmware on NVMe SSDs currently running this firmware revision." required:"1"`
@@ -139,131 +172,92 @@ type storageUpdateCmd struct {
 	NVMeFwSlot   int    `short:"s" default:"0" long:"nvme-fw-slot" description:"Update firmware on NVMe SSDs to this firmware register."`
 }
 
-// run NVMe and SCM storage update on all connected servers
-func storageUpdate(log logging.Logger, conns client.Connect, req *pb.StorageUpdateReq, force bool) {
-	log.Info(
+// storageUpdateResults contains the per-server results of a storage update.
+type storageUpdateResults struct {
+	Nvme client.ClientCtrlrMap  `json:"nvme"`
+	Scm  client.ClientModuleMap `json:"scm"`
+}
+
+// Execute is run when storageUpdateCmd activates
+//
+// Runs NVMe and SCM storage update on all connected servers.
+func (u *storageUpdateCmd) Execute(args []string) error {
+	u.log.Info(
 		"This could be a destructive operation and storage devices " +
 			"specified in the server config file will have firmware " +
 			"updated. Please check this is a supported upgrade path " +
 			"and be patient as it may take several minutes.\n")
 
-	if force || common.GetConsent(log) {
-		log.Info("")
-		cCtrlrResults, cModuleResults := conns.StorageUpdate(req)
-		log.Infof("NVMe storage update results:\n%s", cCtrlrResults)
-		log.Infof("SCM storage update results:\n%s", cModuleResults)
+	if !u.Force && !common.GetConsent(u.log) {
+		return nil
 	}
-}
 
-// Execute is run when storageUpdateCmd activates
-func (u *storageUpdateCmd) Execute(args []string) error {
+	u.log.Info("")
 	// only populate nvme fwupdate params for the moment
-	storageUpdate(
-		u.log,
-		u.conns,
+	cCtrlrResults, cModuleResults := u.conns.StorageUpdate(u.ctx,
 		&pb.StorageUpdateReq{
 			Nvme: &pb.UpdateNvmeReq{
 				Model: u.NVMeModel, Startrev: u.NVMeStartRev,
 				Path: u.NVMeFwPath, Slot: int32(u.NVMeFwSlot),
 			},
-		}, u.Force)
+		})
+	if u.jsonOutputEnabled() {
+		return u.outputJSON(os.Stdout, storageUpdateResults{cCtrlrResults, cModuleResults})
+	}
+
+	u.log.Infof("NVMe storage update results:\n%s", cCtrlrResults)
+	u.log.Infof("SCM storage update results:\n%s", cModuleResults)
 
 	return nil
 }
 
-// TODO: implement burn-in subcommand
-
-//func getFioConfig(c *ishell.Context) (configPath string, err error) {
-//	// fetch existing configuration files
-//	paths, err := mgmtClient.FetchFioConfigPaths()
-//	if err != nil {
-//		return
-//	}
-//	// cut prefix to display filenames not full path
-//	configChoices := functional.Map(
-//		paths, func(p string) string { return filepath.Base(p) })
-//	// add custom path option
-//	configChoices = append(configChoices, "custom path")
-//
-//	choiceIdx := c.MultiChoice(
-//		configChoices,
-//		"Select the fio configuration you would like to run on the selected controllers.")
-//
-//	// if custom path selected (last index), process input
-//	if choiceIdx == len(configChoices)-1 {
-//		// disable the '>>>' for cleaner same line input.
-//		c.ShowPrompt(false)
-//		defer c.ShowPrompt(true) // revert after user input.
-//
-//		c.Print("Please enter fio configuration file-path [has file extension .fio]: ")
-//		path := c.ReadLine()
-//
-//		if path == "" {
-//			return "", fmt.Errorf("no filepath provided")
-//		}
-//		if filepath.Ext(path) != ".fio" {
-//			return "", fmt.Errorf("provided filepath does not end in .fio")
-//		}
-//		if !filepath.IsAbs(path) {
-//			return "", fmt.Errorf("provided filepath is not absolute")
-//		}
-//
-//		configPath = path
-//	} else {
-//		configPath = paths[choiceIdx]
-//	}
-//
-//	return
-//}
+// storageBurnInCmd is the struct representing the burn-in storage subcommand.
+type storageBurnInCmd struct {
+	logCmd
+	connectedCmd
+	jsonOutputCmd
+	longRunningCmd
+	Force      bool   `short:"f" long:"force" description:"Perform burn-in without prompting for confirmation"`
+	NVMeConfig string `short:"c" long:"nvme-config" description:"Run this fio job file against NVMe SSDs with the SPDK fio plugin (relative paths are resolved in the fio_plugin directory on each server)."`
+	SCMConfig  string `short:"s" long:"scm-config" description:"Run this fio job file against mounted SCM (relative paths are resolved in the fio_plugin directory on each server)."`
+}
 
-//func nvmeTaskLookup(
-//	c *ishell.Context, ctrlrs []*pb.NvmeController, feature string) error {
-//
-//	switch feature {
-//	case "nvme-fw-update":
-//		params, err := getUpdateParams(c)
-//		if err != nil {
-//			c.Println("Problem reading user inputs: ", err)
-//			return err
-//		}
-//
-//		for _, ctrlr := range ctrlrs {
-//			c.Printf("\nController: %+v\n", ctrlr)
-//			c.Printf(
-//				"\t- Updating firmware on slot %d with image %s.\n",
-//				params.Slot, params.Path)
-//
-//			params.Ctrlr = ctrlr
+// Execute is run when storageBurnInCmd activates
 //
-//			newFwrev, err := mgmtClient.UpdateNvmeCtrlr(params)
-//			if err != nil {
-//				c.Println("\nProblem updating firmware: ", err)
-//				return err
-//			}
-//			c.Printf(
-//				"\nSuccessfully updated firmware from revision %s to %s!\n",
-//				params.Ctrlr.Fwrev, newFwrev)
-//		}
-//	case "nvme-burn-in":
-//		configPath, err := getFioConfig(c)
-//		if err != nil {
-//			c.Println("Problem reading user inputs: ", err)
-//			return err
-//		}
-//
-//		for _, ctrlr := range ctrlrs {
-//			c.Printf("\nController: %+v\n", ctrlr)
-//			c.Printf(
-//				"\t- Running burn-in validation with spdk fio plugin using job file %s.\n\n",
-//				filepath.Base(configPath))
-//			_, err := mgmtClient.BurnInNvme(ctrlr.Id, configPath)
-//			if err != nil {
-//				return err
-//			}
-//		}
-//	default:
-//		c.Printf("Sorry, task '%s' has not been implemented.\n", feature)
-//	}
-//
-//	return nil
-//}
+// Runs NVMe and SCM storage burn-in on all connected servers.
+func (b *storageBurnInCmd) Execute(args []string) error {
+	if b.NVMeConfig == "" && b.SCMConfig == "" {
+		return errors.New("either nvme-config or scm-config option is required")
+	}
+
+	req := new(pb.StorageBurnInReq)
+	if b.NVMeConfig != "" {
+		req.Nvme = &pb.BurninNvmeReq{
+			Fioconfig: &pb.FilePath{Path: b.NVMeConfig},
+		}
+	}
+	if b.SCMConfig != "" {
+		req.Scm = &pb.BurninScmReq{
+			Fioconfig: &pb.FilePath{Path: b.SCMConfig},
+		}
+	}
+
+	b.log.Info(
+		"This is a destructive operation and data on storage devices " +
+			"specified in the server config file will be overwritten.\n" +
+			"Please be patient as it may take several hours.\n")
+
+	if !b.Force && !common.GetConsent(b.log) {
+		return nil
+	}
+
+	b.log.Info("")
+	results := b.conns.StorageBurnIn(b.ctx, req)
+	if b.jsonOutputEnabled() {
+		return b.outputJSON(os.Stdout, results)
+	}
+
+	b.log.Infof("Storage burn-in results:\n%s", results)
+
+	return nil
+}
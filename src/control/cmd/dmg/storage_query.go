@@ -24,9 +24,12 @@
 package main
 
 import (
+	"os"
+
+	"github.com/pkg/errors"
+
 	"github.com/daos-stack/daos/src/control/client"
 	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
-	"github.com/daos-stack/daos/src/control/logging"
 )
 
 // storageQueryCmd is the struct representing the query storage subcommand
@@ -43,17 +46,20 @@ type storageQueryCmd struct {
 type nvmeHealthQueryCmd struct {
 	logCmd
 	connectedCmd
-}
-
-// Query the SPDK NVMe device health stats from all devices on all hosts
-func nvmeHealthQuery(log logging.Logger, conns client.Connect) {
-	cCtrlrs, _, _ := conns.StorageScan()
-	log.Infof("NVMe SSD Device Health Stats:\n%s", cCtrlrs)
+	jsonOutputCmd
 }
 
 // Execute is run when nvmeHealthQueryCmd activates
+//
+// Query the SPDK NVMe device health stats from all devices on all hosts.
 func (h *nvmeHealthQueryCmd) Execute(args []string) error {
-	nvmeHealthQuery(h.log, h.conns)
+	cCtrlrs, _, _ := h.conns.StorageScan(h.ctx)
+	if h.jsonOutputEnabled() {
+		return h.outputJSON(os.Stdout, cCtrlrs)
+	}
+
+	h.log.Infof("NVMe SSD Device Health Stats:\n%s", cCtrlrs)
+
 	return nil
 }
 
@@ -63,28 +69,28 @@ func (h *nvmeHealthQueryCmd) Execute(args []string) error {
 type bsHealthQueryCmd struct {
 	logCmd
 	connectedCmd
+	jsonOutputCmd
 	Devuuid string `short:"u" long:"devuuid" description:"Device/Blobstore UUID to query"`
 	Tgtid   string `short:"t" long:"tgtid" description:"VOS target ID to query"`
 }
 
-// Query the BIO health and error stats of the given device
-func bsHealthQuery(log logging.Logger, conns client.Connect, uuid string, tgtid string) {
-	if uuid != "" && tgtid != "" {
-		log.Infof("Either device UUID OR target ID need to be specified, not both\n")
-		return
-	} else if uuid == "" && tgtid == "" {
-		log.Infof("Device UUID or target ID is required\n")
-		return
+// Execute is run when bsHealthQueryCmd activates
+//
+// Query the BIO health and error stats of the given device.
+func (b *bsHealthQueryCmd) Execute(args []string) error {
+	if b.Devuuid != "" && b.Tgtid != "" {
+		return errors.New("either device UUID OR target ID need to be specified, not both")
+	} else if b.Devuuid == "" && b.Tgtid == "" {
+		return errors.New("device UUID or target ID is required")
 	}
 
-	req := &pb.BioHealthReq{DevUuid: uuid, TgtId: tgtid}
+	results := b.conns.BioHealthQuery(b.ctx, &pb.BioHealthReq{DevUuid: b.Devuuid, TgtId: b.Tgtid})
+	if b.jsonOutputEnabled() {
+		return b.outputJSON(os.Stdout, results)
+	}
 
-	log.Infof("Blobstore Health Data:\n%s\n", conns.BioHealthQuery(req))
-}
+	b.log.Infof("Blobstore Health Data:\n%s\n", results)
 
-// Execute is run when bsHealthQueryCmd activates
-func (b *bsHealthQueryCmd) Execute(args []string) error {
-	bsHealthQuery(b.log, b.conns, b.Devuuid, b.Tgtid)
 	return nil
 }
 
@@ -94,30 +100,43 @@ func (b *bsHealthQueryCmd) Execute(args []string) error {
 type smdQueryCmd struct {
 	logCmd
 	connectedCmd
+	jsonOutputCmd
 	Devices bool `short:"d" long:"devices" description:"List all devices/blobstores stored in per-server metadata table."`
 	Pools   bool `short:"p" long:"pools" descriptsion:"List all VOS pool targets stored in per-server metadata table."`
 }
 
-// Query per-server metadata device table for all connected servers
-func smdQuery(log logging.Logger, conns client.Connect, devices bool, pools bool) {
+// smdQueryResults contains the per-server metadata tables queried.
+type smdQueryResults struct {
+	Devices client.ResultSmdMap `json:"devices,omitempty"`
+}
+
+// Execute is run when ListSmdDevCmd activates
+//
+// Query per-server metadata device table for all connected servers.
+func (s *smdQueryCmd) Execute(args []string) error {
+	var results smdQueryResults
+
 	// default is to print both pools and devices if not specified
+	pools, devices := s.Pools, s.Devices
 	if !pools && !devices {
 		pools = true
 		devices = true
 	}
 	if pools {
 		// TODO implement query pool table
-		log.Infof("SMD Pool List:\n")
-		log.Infof("--pools option not implemented yet\n")
+		s.log.Infof("SMD Pool List:\n")
+		s.log.Infof("--pools option not implemented yet\n")
 	}
 	if devices {
-		req := &pb.SmdDevReq{}
-		log.Infof("SMD Device List:\n%s\n", conns.SmdListDevs(req))
+		results.Devices = s.conns.SmdListDevs(s.ctx, &pb.SmdDevReq{})
+		if !s.jsonOutputEnabled() {
+			s.log.Infof("SMD Device List:\n%s\n", results.Devices)
+		}
+	}
+
+	if s.jsonOutputEnabled() {
+		return s.outputJSON(os.Stdout, results)
 	}
-}
 
-// Execute is run when ListSmdDevCmd activates
-func (s *smdQueryCmd) Execute(args []string) error {
-	smdQuery(s.log, s.conns, s.Devices, s.Pools)
 	return nil
 }
@@ -76,6 +76,35 @@ func TestStorageCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Burn-in with missing arguments",
+			"storage burn-in --force",
+			"ConnectClients",
+			fmt.Errorf("either nvme-config or scm-config option is required"),
+		},
+		{
+			// Likewise here, this should probably result in a failure
+			"Burn-in without force",
+			"storage burn-in --nvme-config job.fio",
+			"ConnectClients",
+			nil,
+		},
+		{
+			"Burn-in with force",
+			"storage burn-in --force --nvme-config job.fio --scm-config /tmp/scm.fio",
+			strings.Join([]string{
+				"ConnectClients",
+				fmt.Sprintf("StorageBurnIn-%s", &pb.StorageBurnInReq{
+					Nvme: &pb.BurninNvmeReq{
+						Fioconfig: &pb.FilePath{Path: "job.fio"},
+					},
+					Scm: &pb.BurninScmReq{
+						Fioconfig: &pb.FilePath{Path: "/tmp/scm.fio"},
+					},
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Scan",
 			"storage scan",
@@ -124,6 +153,54 @@ func TestStorageCommands(t *testing.T) {
 			"ConnectClients StoragePrepare",
 			nil,
 		},
+		{
+			"Scan with JSON output",
+			"--json storage scan",
+			"ConnectClients StorageScan",
+			nil,
+		},
+		{
+			"Format with force and JSON output",
+			"--json storage format --force",
+			"ConnectClients StorageFormat",
+			nil,
+		},
+		{
+			"Query NVMe health",
+			"storage query nvme-health",
+			"ConnectClients StorageScan",
+			nil,
+		},
+		{
+			"Query blobstore health without device or target",
+			"storage query blobstore-health",
+			"ConnectClients",
+			fmt.Errorf("device UUID or target ID is required"),
+		},
+		{
+			"Query blobstore health with device and target",
+			"storage query blobstore-health --devuuid abc --tgtid 1",
+			"ConnectClients",
+			fmt.Errorf("either device UUID OR target ID need to be specified, not both"),
+		},
+		{
+			"Query blobstore health with JSON output",
+			"--json storage query blobstore-health --devuuid abc",
+			strings.Join([]string{
+				"ConnectClients",
+				fmt.Sprintf("BioHealthQuery-%s", &pb.BioHealthReq{DevUuid: "abc"}),
+			}, " "),
+			nil,
+		},
+		{
+			"Query SMD devices",
+			"storage query smd --devices",
+			strings.Join([]string{
+				"ConnectClients",
+				fmt.Sprintf("SmdListDevs-%s", &pb.SmdDevReq{}),
+			}, " "),
+			nil,
+		},
 		{
 			"Nonexistent subcommand",
 			"storage quack",
@@ -0,0 +1,300 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/client"
+)
+
+// SystemCmd is the struct representing the top-level system subcommand.
+type SystemCmd struct {
+	Query       SystemQueryCmd       `command:"query" alias:"q" description:"Query DAOS system membership"`
+	Stop        SystemStopCmd        `command:"stop" alias:"s" description:"Perform controlled shutdown of DAOS system ranks"`
+	Start       SystemStartCmd       `command:"start" alias:"r" description:"Start stopped DAOS system ranks"`
+	LeaderQuery SystemLeaderQueryCmd `command:"leader-query" alias:"l" description:"Query the DAOS system management service leader and replicas"`
+}
+
+// SystemLeaderQueryCmd is the struct representing the command to query the
+// management service leader and replica set.
+type SystemLeaderQueryCmd struct {
+	logCmd
+	connectedCmd
+	jsonOutputCmd
+	System string `short:"S" long:"sys" default:"daos_server" description:"DAOS system to query"`
+}
+
+// Execute is run when SystemLeaderQueryCmd activates
+func (cmd *SystemLeaderQueryCmd) Execute(args []string) error {
+	resp, err := cmd.conns.LeaderQuery(cmd.ctx, &client.LeaderQueryReq{System: cmd.System})
+	if err != nil {
+		return errors.WithMessage(err, "Leader query failed")
+	}
+
+	if cmd.jsonOutputEnabled() {
+		return cmd.outputJSON(os.Stdout, resp)
+	}
+
+	cmd.log.Info(formatLeaderQuery(resp))
+
+	return nil
+}
+
+// formatLeaderQuery returns a description of the management service leader
+// and the health of each replica.
+func formatLeaderQuery(resp *client.LeaderQueryResp) string {
+	var buf bytes.Buffer
+
+	fmt.Fprintf(&buf, "Current Leader: %s\n", resp.Leader)
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "Replica\tStatus")
+	for _, replica := range resp.Replicas {
+		health := "up"
+		for _, down := range resp.DownReplicas {
+			if replica == down {
+				health = "down"
+			}
+		}
+		fmt.Fprintf(w, "%s\t%s\n", replica, health)
+	}
+	w.Flush()
+
+	return buf.String()
+}
+
+// SystemQueryCmd is the struct representing the command to query the
+// membership of the DAOS system.
+type SystemQueryCmd struct {
+	logCmd
+	connectedCmd
+	jsonOutputCmd
+	Ranks   []uint32 `short:"r" long:"rank" description:"Rank of system member to query, may be repeated (default: all members)"`
+	Verbose bool     `short:"v" long:"verbose" description:"Display additional member details and I/O server instance states"`
+}
+
+// Execute is run when SystemQueryCmd activates
+func (cmd *SystemQueryCmd) Execute(args []string) error {
+	resp, err := cmd.conns.SystemQuery(cmd.ctx, &client.SystemQueryReq{Ranks: cmd.Ranks})
+
+	// Instance states are reported by the harness on each server rather
+	// than by the management service, so they are still available when
+	// the system has failed to form.
+	var instances client.ClientInstanceMap
+	if cmd.Verbose {
+		instances = cmd.conns.InstanceQuery(cmd.ctx)
+	}
+
+	if err != nil {
+		if cmd.Verbose && !cmd.jsonOutputEnabled() {
+			cmd.log.Infof("I/O server instances:\n%s", instances)
+		}
+		return errors.WithMessage(err, "System query failed")
+	}
+
+	if cmd.jsonOutputEnabled() {
+		if cmd.Verbose {
+			return cmd.outputJSON(os.Stdout, struct {
+				*client.SystemQueryResp
+				Instances client.ClientInstanceMap `json:"instances"`
+			}{resp, instances})
+		}
+		return cmd.outputJSON(os.Stdout, resp)
+	}
+
+	if len(resp.Members) == 0 {
+		cmd.log.Info("No system members have joined\n")
+	} else {
+		cmd.log.Infof("System members:\n%s", formatSystemMembers(resp.Members, cmd.Verbose))
+	}
+	if cmd.Verbose {
+		cmd.log.Infof("I/O server instances:\n%s", instances)
+	}
+
+	return nil
+}
+
+// formatSystemMembers returns a table describing the given members, with
+// additional columns when verbose is set.
+func formatSystemMembers(members []*client.SystemMember, verbose bool) string {
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+
+	if verbose {
+		fmt.Fprintln(w, "Rank\tAddress\tState\tLast Seen\tHeartbeat\tUUID\tFabric URI")
+	} else {
+		fmt.Fprintln(w, "Rank\tAddress\tState")
+	}
+
+	for _, m := range members {
+		if !verbose {
+			fmt.Fprintf(w, "%d\t%s\t%s\n", m.Rank, m.Addr, m.State)
+			continue
+		}
+
+		lastSeen := "never"
+		if !m.LastSeen.IsZero() {
+			lastSeen = m.LastSeen.Format(time.RFC3339)
+		}
+		heartbeat := "-"
+		if m.HeartbeatLatency != 0 {
+			heartbeat = m.HeartbeatLatency.String()
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			m.Rank, m.Addr, m.State, lastSeen, heartbeat, m.UUID, m.URI)
+	}
+	w.Flush()
+
+	return buf.String()
+}
+
+// parseRankList returns the ranks specified in a rank list string, or nil
+// (all ranks) if the string is empty.
+func parseRankList(rankList string) ([]uint32, error) {
+	if rankList == "" {
+		return nil, nil
+	}
+
+	ranks, err := client.ParseRanks(rankList)
+	if err != nil {
+		return nil, errors.WithMessage(err, "invalid rank list")
+	}
+
+	return ranks, nil
+}
+
+// SystemStopCmd is the struct representing the command to shutdown DAOS
+// system ranks.
+type SystemStopCmd struct {
+	logCmd
+	connectedCmd
+	jsonOutputCmd
+	Ranks string `long:"ranks" description:"Comma separated list of ranks and rank ranges to stop, e.g. 0-15 (default: all ranks)"`
+	Force bool   `long:"force" description:"Kill ranks immediately rather than terminating them gracefully, and without pool service ordering if the management service is unavailable"`
+}
+
+// Execute is run when SystemStopCmd activates
+func (cmd *SystemStopCmd) Execute(args []string) error {
+	ranks, err := parseRankList(cmd.Ranks)
+	if err != nil {
+		return err
+	}
+
+	resp, err := cmd.conns.SystemStop(cmd.ctx, &client.SystemStopReq{Ranks: ranks, Force: cmd.Force})
+	if err != nil {
+		return errors.WithMessage(err, "System stop failed")
+	}
+
+	return cmd.outputRankResults(resp)
+}
+
+// SystemStartCmd is the struct representing the command to start stopped
+// DAOS system ranks.
+type SystemStartCmd struct {
+	logCmd
+	connectedCmd
+	jsonOutputCmd
+	Ranks string `long:"ranks" description:"Comma separated list of ranks and rank ranges to start, e.g. 0-15 (default: all stopped ranks)"`
+}
+
+// Execute is run when SystemStartCmd activates
+func (cmd *SystemStartCmd) Execute(args []string) error {
+	ranks, err := parseRankList(cmd.Ranks)
+	if err != nil {
+		return err
+	}
+
+	resp, err := cmd.conns.SystemStart(cmd.ctx, &client.SystemStartReq{Ranks: ranks})
+	if err != nil {
+		return errors.WithMessage(err, "System start failed")
+	}
+
+	return cmd.outputRankResults(resp)
+}
+
+// outputRankResults displays the results of a system action, returning an
+// error if the action failed on any rank or host.
+func (cmd *jsonOutputCmd) outputRankResults(resp *client.SystemRankResp) error {
+	if cmd.jsonOutputEnabled() {
+		if err := cmd.outputJSON(os.Stdout, resp); err != nil {
+			return err
+		}
+	} else {
+		fmt.Print(formatRankResults(resp))
+	}
+
+	failed := len(resp.HostErrors)
+	for _, r := range resp.Results {
+		if r.Errored {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return errors.Errorf("%d rank or host results indicate failure", failed)
+	}
+
+	return nil
+}
+
+// formatRankResults returns a table describing the per-rank results of a
+// system action followed by any host errors.
+func formatRankResults(resp *client.SystemRankResp) string {
+	var buf bytes.Buffer
+
+	if resp.OrderSkipped != "" {
+		fmt.Fprintf(&buf, "Ordering skipped: %s\n", resp.OrderSkipped)
+	}
+	if len(resp.Results) == 0 {
+		fmt.Fprintln(&buf, "No ranks matched request")
+	} else {
+		w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "Rank\tAddress\tAction\tResult")
+		for _, r := range resp.Results {
+			result := "OK"
+			if r.Errored {
+				result = "FAIL"
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s (%s)\n", r.Rank, r.Address, r.Action, result, r.Msg)
+		}
+		w.Flush()
+	}
+
+	addrs := make([]string, 0, len(resp.HostErrors))
+	for addr := range resp.HostErrors {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	for _, addr := range addrs {
+		fmt.Fprintf(&buf, "%s: %s\n", addr, resp.HostErrors[addr])
+	}
+
+	return buf.String()
+}
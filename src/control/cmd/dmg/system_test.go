@@ -0,0 +1,209 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/client"
+	"github.com/daos-stack/daos/src/control/common"
+)
+
+func TestSystemCommands(t *testing.T) {
+	runCmdTests(t, []cmdTest{
+		{
+			"Query all members",
+			"system query",
+			"ConnectClients SystemQuery-&{Ranks:[]}",
+			nil,
+		},
+		{
+			"Query single rank",
+			"system query --rank 2 --verbose",
+			"ConnectClients SystemQuery-&{Ranks:[2]} InstanceQuery",
+			nil,
+		},
+		{
+			"Query multiple ranks",
+			"system query -r 2 -r 5",
+			"ConnectClients SystemQuery-&{Ranks:[2 5]}",
+			nil,
+		},
+		{
+			"Bad rank",
+			"system query --rank foo",
+			"",
+			fmt.Errorf("invalid argument for flag"),
+		},
+		{
+			"Leader query",
+			"system leader-query",
+			"ConnectClients LeaderQuery-&{System:daos_server}",
+			nil,
+		},
+		{
+			"Leader query other system",
+			"system leader-query --sys foo",
+			"ConnectClients LeaderQuery-&{System:foo}",
+			nil,
+		},
+		{
+			"Stop all ranks",
+			"system stop",
+			"ConnectClients SystemStop-&{Ranks:[] Force:false}",
+			nil,
+		},
+		{
+			"Stop rank range with force",
+			"system stop --ranks 0-2,5 --force",
+			"ConnectClients SystemStop-&{Ranks:[0 1 2 5] Force:true}",
+			nil,
+		},
+		{
+			"Stop bad rank list",
+			"system stop --ranks 3-1",
+			"ConnectClients",
+			fmt.Errorf("invalid rank list"),
+		},
+		{
+			"Start all ranks",
+			"system start",
+			"ConnectClients SystemStart-&{Ranks:[]}",
+			nil,
+		},
+		{
+			"Start ranks",
+			"system start --ranks 1,3",
+			"ConnectClients SystemStart-&{Ranks:[1 3]}",
+			nil,
+		},
+		{
+			"Nonexistent subcommand",
+			"system quack",
+			"",
+			fmt.Errorf("Unknown command"),
+		},
+	})
+}
+
+func TestFormatSystemMembers(t *testing.T) {
+	lastSeen := time.Unix(1500000000, 0).UTC()
+	members := []*client.SystemMember{
+		{Rank: 0, UUID: "uuid0", URI: "uri0", Addr: "10.0.0.1:10001", State: "Joined", LastSeen: lastSeen,
+			HeartbeatLatency: 250 * time.Microsecond},
+		{Rank: 12, UUID: "uuid12", URI: "uri12", Addr: "10.0.0.2:10001", State: "Missing"},
+	}
+
+	for name, tt := range map[string]struct {
+		verbose bool
+		expOut  []string
+	}{
+		"brief": {
+			expOut: []string{
+				"Rank  Address         State",
+				"0     10.0.0.1:10001  Joined",
+				"12    10.0.0.2:10001  Missing",
+			},
+		},
+		"verbose": {
+			verbose: true,
+			expOut: []string{
+				"Rank  Address         State    Last Seen             Heartbeat  UUID    Fabric URI",
+				fmt.Sprintf("0     10.0.0.1:10001  Joined   %-20s  250µs      uuid0   uri0", lastSeen.Format(time.RFC3339)),
+				fmt.Sprintf("12    10.0.0.2:10001  Missing  %-20s  -          uuid12  uri12", "never"),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			out := formatSystemMembers(members, tt.verbose)
+			common.AssertEqual(t, out, strings.Join(tt.expOut, "\n")+"\n", "unexpected output")
+		})
+	}
+}
+
+func TestFormatRankResults(t *testing.T) {
+	for name, tt := range map[string]struct {
+		resp   *client.SystemRankResp
+		expOut []string
+	}{
+		"no results": {
+			resp:   &client.SystemRankResp{},
+			expOut: []string{"No ranks matched request"},
+		},
+		"ordering skipped": {
+			resp: &client.SystemRankResp{
+				OrderSkipped: "query system members: no quorum",
+			},
+			expOut: []string{
+				"Ordering skipped: query system members: no quorum",
+				"No ranks matched request",
+			},
+		},
+		"results and host errors": {
+			resp: &client.SystemRankResp{
+				Results: []*client.RankResult{
+					{Rank: 0, Address: "10.0.0.1:10001", Action: "stop", Msg: "stopped"},
+					{Rank: 1, Address: "10.0.0.2:10001", Action: "stop", Errored: true, Msg: "timed out"},
+				},
+				HostErrors: map[string]string{
+					"10.0.0.4:10001": "connection refused",
+					"10.0.0.3:10001": "not an access point",
+				},
+			},
+			expOut: []string{
+				"Rank  Address         Action  Result",
+				"0     10.0.0.1:10001  stop    OK (stopped)",
+				"1     10.0.0.2:10001  stop    FAIL (timed out)",
+				"10.0.0.3:10001: not an access point",
+				"10.0.0.4:10001: connection refused",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			out := formatRankResults(tt.resp)
+			common.AssertEqual(t, out, strings.Join(tt.expOut, "\n")+"\n", "unexpected output")
+		})
+	}
+}
+
+func TestFormatLeaderQuery(t *testing.T) {
+	resp := &client.LeaderQueryResp{
+		Leader:       "10.0.0.1:10001",
+		Replicas:     []string{"10.0.0.1:10001", "10.0.0.2:10001", "10.0.0.3:10001"},
+		DownReplicas: []string{"10.0.0.2:10001"},
+	}
+	expOut := []string{
+		"Current Leader: 10.0.0.1:10001",
+		"Replica         Status",
+		"10.0.0.1:10001  up",
+		"10.0.0.2:10001  down",
+		"10.0.0.3:10001  up",
+	}
+
+	common.AssertEqual(t, formatLeaderQuery(resp), strings.Join(expOut, "\n")+"\n",
+		"unexpected output")
+}
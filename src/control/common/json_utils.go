@@ -0,0 +1,65 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// MarshalWithError JSON encodes v and, if err is non-nil, adds an "error"
+// member holding the error message to the resulting object.
+//
+// It is intended for the MarshalJSON methods of result types which carry an
+// error, as error values otherwise encode as empty objects. To avoid infinite
+// recursion, v must be of a type without a MarshalJSON method, e.g. a type
+// defined from the result type, and must encode to a JSON object.
+func MarshalWithError(v interface{}, err error) ([]byte, error) {
+	data, mErr := json.Marshal(v)
+	if mErr != nil || err == nil {
+		return data, mErr
+	}
+
+	if len(data) < 2 || data[0] != '{' || data[len(data)-1] != '}' {
+		return nil, errors.Errorf("can't add error to non-object JSON %s", data)
+	}
+
+	msg, mErr := json.Marshal(err.Error())
+	if mErr != nil {
+		return nil, mErr
+	}
+
+	var buf bytes.Buffer
+	buf.Write(data[:len(data)-1])
+	if len(data) > 2 {
+		buf.WriteByte(',')
+	}
+	buf.WriteString(`"error":`)
+	buf.Write(msg)
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,124 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: config.proto
+
+package mgmt
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type ConfigReloadReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfigReloadReq) Reset()         { *m = ConfigReloadReq{} }
+func (m *ConfigReloadReq) String() string { return proto.CompactTextString(m) }
+func (*ConfigReloadReq) ProtoMessage()    {}
+func (*ConfigReloadReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_config_e125445cb7048409, []int{0}
+}
+func (m *ConfigReloadReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigReloadReq.Unmarshal(m, b)
+}
+func (m *ConfigReloadReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigReloadReq.Marshal(b, m, deterministic)
+}
+func (dst *ConfigReloadReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigReloadReq.Merge(dst, src)
+}
+func (m *ConfigReloadReq) XXX_Size() int {
+	return xxx_messageInfo_ConfigReloadReq.Size(m)
+}
+func (m *ConfigReloadReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigReloadReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigReloadReq proto.InternalMessageInfo
+
+// ConfigReloadResp reports the outcome of re-reading the server config
+// file, settings are identified by their YAML keys.
+type ConfigReloadResp struct {
+	Applied              []string `protobuf:"bytes,1,rep,name=applied,proto3" json:"applied,omitempty"`
+	Pending              []string `protobuf:"bytes,2,rep,name=pending,proto3" json:"pending,omitempty"`
+	Failed               []string `protobuf:"bytes,3,rep,name=failed,proto3" json:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfigReloadResp) Reset()         { *m = ConfigReloadResp{} }
+func (m *ConfigReloadResp) String() string { return proto.CompactTextString(m) }
+func (*ConfigReloadResp) ProtoMessage()    {}
+func (*ConfigReloadResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_config_e125445cb7048409, []int{1}
+}
+func (m *ConfigReloadResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfigReloadResp.Unmarshal(m, b)
+}
+func (m *ConfigReloadResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfigReloadResp.Marshal(b, m, deterministic)
+}
+func (dst *ConfigReloadResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfigReloadResp.Merge(dst, src)
+}
+func (m *ConfigReloadResp) XXX_Size() int {
+	return xxx_messageInfo_ConfigReloadResp.Size(m)
+}
+func (m *ConfigReloadResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfigReloadResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfigReloadResp proto.InternalMessageInfo
+
+func (m *ConfigReloadResp) GetApplied() []string {
+	if m != nil {
+		return m.Applied
+	}
+	return nil
+}
+
+func (m *ConfigReloadResp) GetPending() []string {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+func (m *ConfigReloadResp) GetFailed() []string {
+	if m != nil {
+		return m.Failed
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ConfigReloadReq)(nil), "mgmt.ConfigReloadReq")
+	proto.RegisterType((*ConfigReloadResp)(nil), "mgmt.ConfigReloadResp")
+}
+
+func init() { proto.RegisterFile("config.proto", fileDescriptor_config_e125445cb7048409) }
+
+var fileDescriptor_config_e125445cb7048409 = []byte{
+	// 120 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x49, 0xce, 0xcf, 0x4b,
+	0xcb, 0x4c, 0xd7, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0xc9, 0x4d, 0xcf, 0x2d, 0x51, 0x12,
+	0xe4, 0xe2, 0x77, 0x06, 0x8b, 0x06, 0xa5, 0xe6, 0xe4, 0x27, 0xa6, 0x04, 0xa5, 0x16, 0x2a, 0xc5,
+	0x71, 0x09, 0xa0, 0x0a, 0x15, 0x17, 0x08, 0x49, 0x70, 0xb1, 0x27, 0x16, 0x14, 0xe4, 0x64, 0xa6,
+	0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6b, 0x70, 0x06, 0xc1, 0xb8, 0x20, 0x99, 0x82, 0xd4, 0xbc, 0x94,
+	0xcc, 0xbc, 0x74, 0x09, 0x26, 0x88, 0x0c, 0x94, 0x2b, 0x24, 0xc6, 0xc5, 0x96, 0x96, 0x98, 0x99,
+	0x93, 0x9a, 0x22, 0xc1, 0x0c, 0x96, 0x80, 0xf2, 0x92, 0xd8, 0xc0, 0xf6, 0x1b, 0x03, 0x06, 0x00,
+	0xa5, 0xfc, 0xe8, 0x4e, 0x8f, 0x00, 0x00, 0x00,
+}
@@ -49,6 +49,28 @@ type MgmtCtlClient interface {
 	FetchFioConfigPaths(ctx context.Context, in *EmptyReq, opts ...grpc.CallOption) (MgmtCtl_FetchFioConfigPathsClient, error)
 	// List features supported on remote storage server/DAOS system
 	ListFeatures(ctx context.Context, in *EmptyReq, opts ...grpc.CallOption) (MgmtCtl_ListFeaturesClient, error)
+	// Query the status of I/O server instances managed by the server
+	InstanceQuery(ctx context.Context, in *InstanceQueryReq, opts ...grpc.CallOption) (*InstanceQueryResp, error)
+	// Query membership of the DAOS system, only served by access points
+	SystemQuery(ctx context.Context, in *SystemQueryReq, opts ...grpc.CallOption) (*SystemQueryResp, error)
+	// Stop I/O server instances managed by the server
+	SystemStop(ctx context.Context, in *SystemStopReq, opts ...grpc.CallOption) (*SystemStopResp, error)
+	// Start stopped I/O server instances managed by the server
+	SystemStart(ctx context.Context, in *SystemStartReq, opts ...grpc.CallOption) (*SystemStartResp, error)
+	// Report the latest heartbeat of an I/O server instance, only served by access points
+	SystemHeartbeat(ctx context.Context, in *SystemHeartbeatReq, opts ...grpc.CallOption) (*SystemHeartbeatResp, error)
+	// Vote in a system database leader election, only served by access points
+	SystemDbVote(ctx context.Context, in *SystemDbVoteReq, opts ...grpc.CallOption) (*SystemDbVoteResp, error)
+	// Replicate system database log entries, only served by access points
+	SystemDbAppend(ctx context.Context, in *SystemDbAppendReq, opts ...grpc.CallOption) (*SystemDbAppendResp, error)
+	// Submit an update to the system database leader
+	SystemDbSubmit(ctx context.Context, in *SystemDbSubmitReq, opts ...grpc.CallOption) (*SystemDbSubmitResp, error)
+	// List fabric interfaces on the server with their NUMA affinity
+	NetworkScan(ctx context.Context, in *NetworkScanReq, opts ...grpc.CallOption) (*NetworkScanResp, error)
+	// Re-read the server config file and apply changes that are safe at runtime
+	ConfigReload(ctx context.Context, in *ConfigReloadReq, opts ...grpc.CallOption) (*ConfigReloadResp, error)
+	// Change log levels of the server and its I/O server instances at runtime
+	ServerSetLogMasks(ctx context.Context, in *ServerSetLogMasksReq, opts ...grpc.CallOption) (*ServerSetLogMasksResp, error)
 }
 
 type mgmtCtlClient struct {
@@ -237,6 +259,105 @@ func (x *mgmtCtlListFeaturesClient) Recv() (*Feature, error) {
 	return m, nil
 }
 
+func (c *mgmtCtlClient) InstanceQuery(ctx context.Context, in *InstanceQueryReq, opts ...grpc.CallOption) (*InstanceQueryResp, error) {
+	out := new(InstanceQueryResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtCtl/InstanceQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtCtlClient) SystemQuery(ctx context.Context, in *SystemQueryReq, opts ...grpc.CallOption) (*SystemQueryResp, error) {
+	out := new(SystemQueryResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtCtl/SystemQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtCtlClient) SystemStop(ctx context.Context, in *SystemStopReq, opts ...grpc.CallOption) (*SystemStopResp, error) {
+	out := new(SystemStopResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtCtl/SystemStop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtCtlClient) SystemStart(ctx context.Context, in *SystemStartReq, opts ...grpc.CallOption) (*SystemStartResp, error) {
+	out := new(SystemStartResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtCtl/SystemStart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtCtlClient) SystemHeartbeat(ctx context.Context, in *SystemHeartbeatReq, opts ...grpc.CallOption) (*SystemHeartbeatResp, error) {
+	out := new(SystemHeartbeatResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtCtl/SystemHeartbeat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtCtlClient) SystemDbVote(ctx context.Context, in *SystemDbVoteReq, opts ...grpc.CallOption) (*SystemDbVoteResp, error) {
+	out := new(SystemDbVoteResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtCtl/SystemDbVote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtCtlClient) SystemDbAppend(ctx context.Context, in *SystemDbAppendReq, opts ...grpc.CallOption) (*SystemDbAppendResp, error) {
+	out := new(SystemDbAppendResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtCtl/SystemDbAppend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtCtlClient) SystemDbSubmit(ctx context.Context, in *SystemDbSubmitReq, opts ...grpc.CallOption) (*SystemDbSubmitResp, error) {
+	out := new(SystemDbSubmitResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtCtl/SystemDbSubmit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtCtlClient) NetworkScan(ctx context.Context, in *NetworkScanReq, opts ...grpc.CallOption) (*NetworkScanResp, error) {
+	out := new(NetworkScanResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtCtl/NetworkScan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtCtlClient) ConfigReload(ctx context.Context, in *ConfigReloadReq, opts ...grpc.CallOption) (*ConfigReloadResp, error) {
+	out := new(ConfigReloadResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtCtl/ConfigReload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtCtlClient) ServerSetLogMasks(ctx context.Context, in *ServerSetLogMasksReq, opts ...grpc.CallOption) (*ServerSetLogMasksResp, error) {
+	out := new(ServerSetLogMasksResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtCtl/ServerSetLogMasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MgmtCtlServer is the server API for MgmtCtl service.
 type MgmtCtlServer interface {
 	// Prepare nonvolatile storage devices for use with DAOS
@@ -253,6 +374,28 @@ type MgmtCtlServer interface {
 	FetchFioConfigPaths(*EmptyReq, MgmtCtl_FetchFioConfigPathsServer) error
 	// List features supported on remote storage server/DAOS system
 	ListFeatures(*EmptyReq, MgmtCtl_ListFeaturesServer) error
+	// Query the status of I/O server instances managed by the server
+	InstanceQuery(context.Context, *InstanceQueryReq) (*InstanceQueryResp, error)
+	// Query membership of the DAOS system, only served by access points
+	SystemQuery(context.Context, *SystemQueryReq) (*SystemQueryResp, error)
+	// Stop I/O server instances managed by the server
+	SystemStop(context.Context, *SystemStopReq) (*SystemStopResp, error)
+	// Start stopped I/O server instances managed by the server
+	SystemStart(context.Context, *SystemStartReq) (*SystemStartResp, error)
+	// Report the latest heartbeat of an I/O server instance, only served by access points
+	SystemHeartbeat(context.Context, *SystemHeartbeatReq) (*SystemHeartbeatResp, error)
+	// Vote in a system database leader election, only served by access points
+	SystemDbVote(context.Context, *SystemDbVoteReq) (*SystemDbVoteResp, error)
+	// Replicate system database log entries, only served by access points
+	SystemDbAppend(context.Context, *SystemDbAppendReq) (*SystemDbAppendResp, error)
+	// Submit an update to the system database leader
+	SystemDbSubmit(context.Context, *SystemDbSubmitReq) (*SystemDbSubmitResp, error)
+	// List fabric interfaces on the server with their NUMA affinity
+	NetworkScan(context.Context, *NetworkScanReq) (*NetworkScanResp, error)
+	// Re-read the server config file and apply changes that are safe at runtime
+	ConfigReload(context.Context, *ConfigReloadReq) (*ConfigReloadResp, error)
+	// Change log levels of the server and its I/O server instances at runtime
+	ServerSetLogMasks(context.Context, *ServerSetLogMasksReq) (*ServerSetLogMasksResp, error)
 }
 
 func RegisterMgmtCtlServer(s *grpc.Server, srv MgmtCtlServer) {
@@ -400,6 +543,204 @@ func (x *mgmtCtlListFeaturesServer) Send(m *Feature) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _MgmtCtl_InstanceQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstanceQueryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtCtlServer).InstanceQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtCtl/InstanceQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtCtlServer).InstanceQuery(ctx, req.(*InstanceQueryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtCtl_SystemQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemQueryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtCtlServer).SystemQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtCtl/SystemQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtCtlServer).SystemQuery(ctx, req.(*SystemQueryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtCtl_SystemStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemStopReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtCtlServer).SystemStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtCtl/SystemStop",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtCtlServer).SystemStop(ctx, req.(*SystemStopReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtCtl_SystemStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemStartReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtCtlServer).SystemStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtCtl/SystemStart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtCtlServer).SystemStart(ctx, req.(*SystemStartReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtCtl_SystemHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemHeartbeatReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtCtlServer).SystemHeartbeat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtCtl/SystemHeartbeat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtCtlServer).SystemHeartbeat(ctx, req.(*SystemHeartbeatReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtCtl_SystemDbVote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemDbVoteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtCtlServer).SystemDbVote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtCtl/SystemDbVote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtCtlServer).SystemDbVote(ctx, req.(*SystemDbVoteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtCtl_SystemDbAppend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemDbAppendReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtCtlServer).SystemDbAppend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtCtl/SystemDbAppend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtCtlServer).SystemDbAppend(ctx, req.(*SystemDbAppendReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtCtl_SystemDbSubmit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemDbSubmitReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtCtlServer).SystemDbSubmit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtCtl/SystemDbSubmit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtCtlServer).SystemDbSubmit(ctx, req.(*SystemDbSubmitReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtCtl_NetworkScan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkScanReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtCtlServer).NetworkScan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtCtl/NetworkScan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtCtlServer).NetworkScan(ctx, req.(*NetworkScanReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtCtl_ConfigReload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfigReloadReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtCtlServer).ConfigReload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtCtl/ConfigReload",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtCtlServer).ConfigReload(ctx, req.(*ConfigReloadReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtCtl_ServerSetLogMasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerSetLogMasksReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtCtlServer).ServerSetLogMasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtCtl/ServerSetLogMasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtCtlServer).ServerSetLogMasks(ctx, req.(*ServerSetLogMasksReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MgmtCtl_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "mgmt.MgmtCtl",
 	HandlerType: (*MgmtCtlServer)(nil),
@@ -412,6 +753,50 @@ var _MgmtCtl_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StorageScan",
 			Handler:    _MgmtCtl_StorageScan_Handler,
 		},
+		{
+			MethodName: "InstanceQuery",
+			Handler:    _MgmtCtl_InstanceQuery_Handler,
+		},
+		{
+			MethodName: "SystemQuery",
+			Handler:    _MgmtCtl_SystemQuery_Handler,
+		},
+		{
+			MethodName: "SystemStop",
+			Handler:    _MgmtCtl_SystemStop_Handler,
+		},
+		{
+			MethodName: "SystemStart",
+			Handler:    _MgmtCtl_SystemStart_Handler,
+		},
+		{
+			MethodName: "SystemHeartbeat",
+			Handler:    _MgmtCtl_SystemHeartbeat_Handler,
+		},
+		{
+			MethodName: "SystemDbVote",
+			Handler:    _MgmtCtl_SystemDbVote_Handler,
+		},
+		{
+			MethodName: "SystemDbAppend",
+			Handler:    _MgmtCtl_SystemDbAppend_Handler,
+		},
+		{
+			MethodName: "SystemDbSubmit",
+			Handler:    _MgmtCtl_SystemDbSubmit_Handler,
+		},
+		{
+			MethodName: "NetworkScan",
+			Handler:    _MgmtCtl_NetworkScan_Handler,
+		},
+		{
+			MethodName: "ConfigReload",
+			Handler:    _MgmtCtl_ConfigReload_Handler,
+		},
+		{
+			MethodName: "ServerSetLogMasks",
+			Handler:    _MgmtCtl_ServerSetLogMasks_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -443,25 +828,39 @@ var _MgmtCtl_serviceDesc = grpc.ServiceDesc{
 	Metadata: "control.proto",
 }
 
-func init() { proto.RegisterFile("control.proto", fileDescriptor_control_e39ddbc8b4732a95) }
-
-var fileDescriptor_control_e39ddbc8b4732a95 = []byte{
-	// 261 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcd, 0x4a, 0xc3, 0x40,
-	0x14, 0x85, 0x15, 0x45, 0x61, 0x6c, 0xb2, 0x18, 0x7f, 0x2a, 0x59, 0xfa, 0x00, 0xc1, 0x9f, 0x95,
-	0xe0, 0xca, 0xda, 0x01, 0x41, 0xa1, 0x58, 0x7c, 0x80, 0x31, 0xde, 0xa6, 0x81, 0xcc, 0xdc, 0x71,
-	0xe6, 0x76, 0xe1, 0x4b, 0xfa, 0x4c, 0x32, 0xb9, 0x53, 0x68, 0x9a, 0x2c, 0xcf, 0x77, 0xcf, 0xf9,
-	0x36, 0x57, 0x64, 0x15, 0x5a, 0xf2, 0xd8, 0x96, 0xce, 0x23, 0xa1, 0x3c, 0x36, 0xb5, 0xa1, 0x62,
-	0x52, 0xa1, 0x31, 0x68, 0x99, 0x15, 0x59, 0x20, 0xf4, 0xba, 0x86, 0x14, 0xf3, 0x15, 0x68, 0xda,
-	0x78, 0x08, 0x9c, 0xef, 0xff, 0x8e, 0xc4, 0xe9, 0x7b, 0x6d, 0x68, 0x46, 0xad, 0x9c, 0x8b, 0x7c,
-	0xc9, 0xe5, 0x85, 0x07, 0xa7, 0x3d, 0xc8, 0x69, 0x19, 0x8d, 0x65, 0x9f, 0x7e, 0xc0, 0x4f, 0x71,
-	0x3d, 0x7e, 0x08, 0xee, 0xe6, 0x40, 0x3e, 0x89, 0xb3, 0xc4, 0x97, 0x95, 0xb6, 0xf2, 0xa2, 0x57,
-	0x8d, 0x28, 0x0a, 0x2e, 0x47, 0x68, 0xb7, 0x7e, 0x11, 0x59, 0x82, 0x0a, 0xbd, 0xd1, 0x24, 0xaf,
-	0x7a, 0x4d, 0x86, 0xd1, 0x30, 0x1d, 0xe5, 0xd1, 0x71, 0x7b, 0xb8, 0x63, 0xf9, 0x74, 0xdf, 0x9a,
-	0x60, 0xcf, 0xc2, 0x70, 0x68, 0xd9, 0xf2, 0x81, 0xe5, 0x79, 0xe3, 0xed, 0xab, 0xdd, 0xb3, 0x30,
-	0x1c, 0x5a, 0xb6, 0x3c, 0x59, 0x1e, 0xc5, 0xb9, 0x02, 0xaa, 0xd6, 0xaa, 0xc1, 0x19, 0xda, 0x55,
-	0x53, 0x2f, 0x34, 0xad, 0x83, 0xcc, 0x79, 0x33, 0x37, 0x8e, 0x7e, 0xa3, 0x23, 0x65, 0xd5, 0xb4,
-	0x10, 0x0b, 0xdd, 0xf4, 0x4e, 0x4c, 0xde, 0x9a, 0x40, 0x2a, 0xfd, 0x6c, 0xb0, 0xc9, 0xd2, 0x86,
-	0xef, 0x71, 0xf2, 0x75, 0xd2, 0xfd, 0xf5, 0xe1, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x7f, 0xef, 0xdd,
-	0xf6, 0x1b, 0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("control.proto", fileDescriptor_control_26cbd8dfcf18c541) }
+
+var fileDescriptor_control_26cbd8dfcf18c541 = []byte{
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xdd, 0x6e, 0xd3, 0x30,
+	0x14, 0xc7, 0x41, 0x42, 0x20, 0x4c, 0x53, 0x84, 0x37, 0xb6, 0x51, 0xee, 0x78, 0x80, 0x8a, 0x8f,
+	0xab, 0x49, 0x48, 0x88, 0x7d, 0x44, 0x9b, 0xb4, 0x4d, 0x63, 0x11, 0xdc, 0xbb, 0xe9, 0x59, 0x1a,
+	0x2d, 0xb6, 0x83, 0x7d, 0x0a, 0xea, 0x63, 0xf1, 0x86, 0xc8, 0x5f, 0x9d, 0xed, 0xa4, 0x97, 0xfe,
+	0xfd, 0xcf, 0xf9, 0xd9, 0xf2, 0x89, 0x43, 0x8a, 0x5a, 0x0a, 0x54, 0xb2, 0x9b, 0xf7, 0x4a, 0xa2,
+	0xa4, 0xcf, 0x78, 0xc3, 0x71, 0x36, 0xa9, 0x25, 0xe7, 0x52, 0x38, 0x36, 0x2b, 0x34, 0x4a, 0xc5,
+	0x1a, 0xf0, 0xcb, 0xe9, 0x3d, 0x30, 0x5c, 0x2b, 0xd0, 0x21, 0x5e, 0x31, 0x25, 0x40, 0x87, 0xe5,
+	0x44, 0x6f, 0x34, 0x02, 0x0f, 0xa1, 0x00, 0xfc, 0x2b, 0xd5, 0x43, 0x08, 0x6b, 0x29, 0xee, 0xdb,
+	0x26, 0x84, 0x9d, 0x6c, 0x9a, 0x56, 0xf8, 0xe5, 0xe7, 0x7f, 0x2f, 0xc9, 0x8b, 0xeb, 0x86, 0xe3,
+	0x29, 0x76, 0xf4, 0x9c, 0x4c, 0x2b, 0xb7, 0xeb, 0xad, 0x82, 0x9e, 0x29, 0xa0, 0x87, 0x73, 0x73,
+	0xb4, 0x79, 0x4a, 0xef, 0xe0, 0xf7, 0xec, 0x68, 0x3c, 0xd0, 0xfd, 0x87, 0x27, 0xf4, 0x2b, 0x79,
+	0xe5, 0x79, 0x55, 0x33, 0x41, 0xf7, 0x93, 0x52, 0x83, 0x8c, 0xe0, 0xed, 0x08, 0xb5, 0xdd, 0x67,
+	0xa4, 0xf0, 0xb0, 0x94, 0x8a, 0x33, 0xa4, 0x07, 0x49, 0xa5, 0x83, 0xc6, 0x70, 0x38, 0xca, 0x8d,
+	0xe3, 0xe3, 0xd3, 0xc8, 0xf2, 0xb3, 0x5f, 0x32, 0x84, 0xcc, 0xe2, 0xe0, 0xd0, 0x12, 0xf8, 0xc0,
+	0x72, 0xb2, 0x56, 0xe2, 0x52, 0x64, 0x16, 0x07, 0x87, 0x96, 0xc0, 0xbd, 0xe5, 0x98, 0xec, 0x95,
+	0x80, 0xf5, 0xaa, 0x6c, 0xe5, 0xa9, 0x9d, 0xc4, 0x2d, 0xc3, 0x95, 0xa6, 0x53, 0xd7, 0x73, 0xce,
+	0x7b, 0xdc, 0x18, 0x87, 0x5f, 0x97, 0x6d, 0x07, 0xa6, 0xc0, 0xb6, 0x7e, 0x22, 0x93, 0xab, 0x56,
+	0x63, 0xe9, 0x87, 0x3f, 0xe8, 0x29, 0x7c, 0x8f, 0xcb, 0x6d, 0xcb, 0x09, 0x29, 0x2e, 0x85, 0x46,
+	0x26, 0x6a, 0xf8, 0xb1, 0x06, 0xb5, 0x09, 0x67, 0x4e, 0x60, 0x74, 0xe6, 0x8c, 0x6f, 0x27, 0x68,
+	0x3f, 0x28, 0x67, 0x08, 0x13, 0x7c, 0x44, 0xf1, 0x04, 0x63, 0x6a, 0xbb, 0x8f, 0x09, 0x71, 0xb0,
+	0x42, 0xd9, 0xd3, 0xbd, 0xb8, 0xcc, 0x10, 0xd3, 0xbb, 0x3f, 0x84, 0xe9, 0xc6, 0x15, 0x32, 0x85,
+	0x34, 0x2b, 0x63, 0x0a, 0x07, 0x1b, 0x7b, 0x6a, 0xbb, 0x2f, 0xc8, 0x6b, 0x07, 0x2f, 0x80, 0x29,
+	0x5c, 0x00, 0x43, 0x7a, 0x14, 0xd7, 0x6e, 0xb1, 0xb1, 0xbc, 0xdb, 0x91, 0x58, 0xd3, 0x37, 0x32,
+	0x71, 0xc1, 0xd9, 0xe2, 0x97, 0x44, 0xa0, 0xc9, 0x96, 0x8e, 0x19, 0xc7, 0xc1, 0x18, 0xb6, 0x02,
+	0xf3, 0x94, 0x3c, 0xfd, 0xde, 0xf7, 0x20, 0x96, 0xdb, 0xa7, 0x94, 0xd0, 0xf8, 0x29, 0x65, 0x41,
+	0xae, 0xa9, 0xd6, 0x0b, 0xde, 0x62, 0xae, 0x71, 0x74, 0x44, 0x13, 0x82, 0x70, 0xad, 0x37, 0xee,
+	0x97, 0x10, 0xbf, 0xc8, 0x08, 0x45, 0xd7, 0x9a, 0xd0, 0x70, 0x19, 0xee, 0xbb, 0xbd, 0x83, 0x4e,
+	0xb2, 0x65, 0xb8, 0x8c, 0x98, 0x45, 0x97, 0x91, 0x62, 0x2b, 0xb8, 0x21, 0x6f, 0x2a, 0x50, 0x7f,
+	0x40, 0x55, 0x80, 0x57, 0xb2, 0xb9, 0x66, 0xfa, 0x41, 0xd3, 0x99, 0x3f, 0x6f, 0x1e, 0x18, 0xd5,
+	0xfb, 0x9d, 0x99, 0xf1, 0x2d, 0x9e, 0xdb, 0x5f, 0xd7, 0x97, 0xff, 0x03, 0x00, 0x88, 0xe6, 0xa1,
+	0x01, 0x47, 0x05, 0x00, 0x00,
 }
@@ -0,0 +1,277 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: harness.proto
+
+package mgmt
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type InstanceQueryReq struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InstanceQueryReq) Reset()         { *m = InstanceQueryReq{} }
+func (m *InstanceQueryReq) String() string { return proto.CompactTextString(m) }
+func (*InstanceQueryReq) ProtoMessage()    {}
+func (*InstanceQueryReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_harness_afce0add956f654f, []int{0}
+}
+func (m *InstanceQueryReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InstanceQueryReq.Unmarshal(m, b)
+}
+func (m *InstanceQueryReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InstanceQueryReq.Marshal(b, m, deterministic)
+}
+func (dst *InstanceQueryReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstanceQueryReq.Merge(dst, src)
+}
+func (m *InstanceQueryReq) XXX_Size() int {
+	return xxx_messageInfo_InstanceQueryReq.Size(m)
+}
+func (m *InstanceQueryReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstanceQueryReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstanceQueryReq proto.InternalMessageInfo
+
+// InstanceStatus describes the run history of an I/O server instance.
+type InstanceStatus struct {
+	Index                uint32                `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Rank                 uint32                `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Running              bool                  `protobuf:"varint,3,opt,name=running,proto3" json:"running,omitempty"`
+	Restarts             uint32                `protobuf:"varint,4,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	LastExit             string                `protobuf:"bytes,5,opt,name=last_exit,json=lastExit,proto3" json:"last_exit,omitempty"`
+	LastExitTime         int64                 `protobuf:"varint,6,opt,name=last_exit_time,json=lastExitTime,proto3" json:"last_exit_time,omitempty"`
+	State                string                `protobuf:"bytes,7,opt,name=state,proto3" json:"state,omitempty"`
+	Transitions          []*InstanceTransition `protobuf:"bytes,8,rep,name=transitions,proto3" json:"transitions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *InstanceStatus) Reset()         { *m = InstanceStatus{} }
+func (m *InstanceStatus) String() string { return proto.CompactTextString(m) }
+func (*InstanceStatus) ProtoMessage()    {}
+func (*InstanceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_harness_afce0add956f654f, []int{1}
+}
+func (m *InstanceStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InstanceStatus.Unmarshal(m, b)
+}
+func (m *InstanceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InstanceStatus.Marshal(b, m, deterministic)
+}
+func (dst *InstanceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstanceStatus.Merge(dst, src)
+}
+func (m *InstanceStatus) XXX_Size() int {
+	return xxx_messageInfo_InstanceStatus.Size(m)
+}
+func (m *InstanceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstanceStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstanceStatus proto.InternalMessageInfo
+
+func (m *InstanceStatus) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *InstanceStatus) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *InstanceStatus) GetRunning() bool {
+	if m != nil {
+		return m.Running
+	}
+	return false
+}
+
+func (m *InstanceStatus) GetRestarts() uint32 {
+	if m != nil {
+		return m.Restarts
+	}
+	return 0
+}
+
+func (m *InstanceStatus) GetLastExit() string {
+	if m != nil {
+		return m.LastExit
+	}
+	return ""
+}
+
+func (m *InstanceStatus) GetLastExitTime() int64 {
+	if m != nil {
+		return m.LastExitTime
+	}
+	return 0
+}
+
+func (m *InstanceStatus) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *InstanceStatus) GetTransitions() []*InstanceTransition {
+	if m != nil {
+		return m.Transitions
+	}
+	return nil
+}
+
+// InstanceTransition records a change of I/O server instance state.
+type InstanceTransition struct {
+	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Time                 int64    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InstanceTransition) Reset()         { *m = InstanceTransition{} }
+func (m *InstanceTransition) String() string { return proto.CompactTextString(m) }
+func (*InstanceTransition) ProtoMessage()    {}
+func (*InstanceTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_harness_afce0add956f654f, []int{2}
+}
+func (m *InstanceTransition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InstanceTransition.Unmarshal(m, b)
+}
+func (m *InstanceTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InstanceTransition.Marshal(b, m, deterministic)
+}
+func (dst *InstanceTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstanceTransition.Merge(dst, src)
+}
+func (m *InstanceTransition) XXX_Size() int {
+	return xxx_messageInfo_InstanceTransition.Size(m)
+}
+func (m *InstanceTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstanceTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstanceTransition proto.InternalMessageInfo
+
+func (m *InstanceTransition) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *InstanceTransition) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *InstanceTransition) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func (m *InstanceTransition) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type InstanceQueryResp struct {
+	Instances            []*InstanceStatus `protobuf:"bytes,1,rep,name=instances,proto3" json:"instances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *InstanceQueryResp) Reset()         { *m = InstanceQueryResp{} }
+func (m *InstanceQueryResp) String() string { return proto.CompactTextString(m) }
+func (*InstanceQueryResp) ProtoMessage()    {}
+func (*InstanceQueryResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_harness_afce0add956f654f, []int{3}
+}
+func (m *InstanceQueryResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InstanceQueryResp.Unmarshal(m, b)
+}
+func (m *InstanceQueryResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InstanceQueryResp.Marshal(b, m, deterministic)
+}
+func (dst *InstanceQueryResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstanceQueryResp.Merge(dst, src)
+}
+func (m *InstanceQueryResp) XXX_Size() int {
+	return xxx_messageInfo_InstanceQueryResp.Size(m)
+}
+func (m *InstanceQueryResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstanceQueryResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstanceQueryResp proto.InternalMessageInfo
+
+func (m *InstanceQueryResp) GetInstances() []*InstanceStatus {
+	if m != nil {
+		return m.Instances
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*InstanceQueryReq)(nil), "mgmt.InstanceQueryReq")
+	proto.RegisterType((*InstanceStatus)(nil), "mgmt.InstanceStatus")
+	proto.RegisterType((*InstanceTransition)(nil), "mgmt.InstanceTransition")
+	proto.RegisterType((*InstanceQueryResp)(nil), "mgmt.InstanceQueryResp")
+}
+
+func init() { proto.RegisterFile("harness.proto", fileDescriptor_harness_afce0add956f654f) }
+
+var fileDescriptor_harness_afce0add956f654f = []byte{
+	// 304 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x4d, 0x4f, 0xf3, 0x30,
+	0x10, 0x84, 0x95, 0xa4, 0x1f, 0xc9, 0xf6, 0x6d, 0xf5, 0xb2, 0xaa, 0x90, 0x05, 0x97, 0x28, 0xe2,
+	0x90, 0x53, 0x0f, 0xe5, 0xc6, 0x1d, 0x21, 0x8e, 0x98, 0xde, 0x2b, 0x43, 0x4d, 0xb1, 0x20, 0x76,
+	0xf1, 0x6e, 0xa5, 0xf2, 0x03, 0xf8, 0xdf, 0x28, 0xdb, 0xa6, 0x7c, 0xdd, 0x76, 0xc6, 0x8f, 0xa5,
+	0x99, 0x5d, 0x18, 0x3f, 0x9b, 0xe8, 0x2d, 0xd1, 0x6c, 0x13, 0x03, 0x07, 0xec, 0x35, 0xeb, 0x86,
+	0x2b, 0x84, 0xff, 0xb7, 0x9e, 0xd8, 0xf8, 0x47, 0x7b, 0xb7, 0xb5, 0xf1, 0x5d, 0xdb, 0xb7, 0xea,
+	0x23, 0x85, 0x49, 0x67, 0xde, 0xb3, 0xe1, 0x2d, 0xe1, 0x14, 0xfa, 0xce, 0xaf, 0xec, 0x4e, 0x25,
+	0x65, 0x52, 0x8f, 0xf5, 0x5e, 0x20, 0x42, 0x2f, 0x1a, 0xff, 0xa2, 0x52, 0x31, 0x65, 0x46, 0x05,
+	0xc3, 0xb8, 0xf5, 0xde, 0xf9, 0xb5, 0xca, 0xca, 0xa4, 0xce, 0x75, 0x27, 0xf1, 0x0c, 0xf2, 0x68,
+	0x89, 0x4d, 0x64, 0x52, 0x3d, 0xf9, 0x71, 0xd4, 0x78, 0x0e, 0xc5, 0xab, 0x21, 0x5e, 0xda, 0x9d,
+	0x63, 0xd5, 0x2f, 0x93, 0xba, 0xd0, 0x79, 0x6b, 0x5c, 0xef, 0x1c, 0xe3, 0x05, 0x4c, 0x8e, 0x8f,
+	0x4b, 0x76, 0x8d, 0x55, 0x83, 0x32, 0xa9, 0x33, 0xfd, 0xaf, 0x23, 0x16, 0xae, 0xb1, 0x6d, 0x44,
+	0x62, 0xc3, 0x56, 0x0d, 0xe5, 0xfb, 0x5e, 0xe0, 0x15, 0x8c, 0x38, 0x1a, 0x4f, 0x8e, 0x5d, 0xf0,
+	0xa4, 0xf2, 0x32, 0xab, 0x47, 0x73, 0x35, 0x6b, 0xbb, 0xcf, 0xba, 0x8e, 0x8b, 0x23, 0xa0, 0xbf,
+	0xc3, 0xd5, 0x0a, 0xf0, 0x2f, 0xd2, 0x96, 0x7e, 0x8a, 0xa1, 0x91, 0x4d, 0x14, 0x5a, 0x66, 0x9c,
+	0x40, 0xca, 0x41, 0xd6, 0x50, 0xe8, 0x94, 0x43, 0xcb, 0x48, 0xce, 0x4c, 0x72, 0xca, 0x8c, 0xa7,
+	0x30, 0x88, 0xd6, 0x50, 0xf0, 0x52, 0xbe, 0xd0, 0x07, 0x55, 0xdd, 0xc0, 0xc9, 0xaf, 0x0b, 0xd0,
+	0x06, 0xe7, 0x50, 0xb8, 0x83, 0x49, 0x2a, 0x91, 0xd0, 0xd3, 0x9f, 0xa1, 0xf7, 0x87, 0xd1, 0x5f,
+	0xd8, 0xc3, 0x40, 0xee, 0x7a, 0xf9, 0x39, 0x00, 0x87, 0xe1, 0xb5, 0x4a, 0xe8, 0x01, 0x00, 0x00,
+}
@@ -0,0 +1,229 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: logging.proto
+
+package mgmt
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// ServerSetLogMasksReq changes the log level of daos_server and the log
+// masks of its I/O server instances at runtime.
+type ServerSetLogMasksReq struct {
+	Level                string   `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	Subsystems           []string `protobuf:"bytes,2,rep,name=subsystems,proto3" json:"subsystems,omitempty"`
+	Ranks                []uint32 `protobuf:"varint,3,rep,packed,name=ranks,proto3" json:"ranks,omitempty"`
+	Duration             int64    `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ServerSetLogMasksReq) Reset()         { *m = ServerSetLogMasksReq{} }
+func (m *ServerSetLogMasksReq) String() string { return proto.CompactTextString(m) }
+func (*ServerSetLogMasksReq) ProtoMessage()    {}
+func (*ServerSetLogMasksReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_logging_2c258a56b18931e6, []int{0}
+}
+func (m *ServerSetLogMasksReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServerSetLogMasksReq.Unmarshal(m, b)
+}
+func (m *ServerSetLogMasksReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServerSetLogMasksReq.Marshal(b, m, deterministic)
+}
+func (dst *ServerSetLogMasksReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServerSetLogMasksReq.Merge(dst, src)
+}
+func (m *ServerSetLogMasksReq) XXX_Size() int {
+	return xxx_messageInfo_ServerSetLogMasksReq.Size(m)
+}
+func (m *ServerSetLogMasksReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServerSetLogMasksReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServerSetLogMasksReq proto.InternalMessageInfo
+
+func (m *ServerSetLogMasksReq) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *ServerSetLogMasksReq) GetSubsystems() []string {
+	if m != nil {
+		return m.Subsystems
+	}
+	return nil
+}
+
+func (m *ServerSetLogMasksReq) GetRanks() []uint32 {
+	if m != nil {
+		return m.Ranks
+	}
+	return nil
+}
+
+func (m *ServerSetLogMasksReq) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+// InstanceLogMasks reports the log masks set on an I/O server instance.
+type InstanceLogMasks struct {
+	Index                uint32   `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Rank                 uint32   `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	Masks                string   `protobuf:"bytes,3,opt,name=masks,proto3" json:"masks,omitempty"`
+	Error                string   `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InstanceLogMasks) Reset()         { *m = InstanceLogMasks{} }
+func (m *InstanceLogMasks) String() string { return proto.CompactTextString(m) }
+func (*InstanceLogMasks) ProtoMessage()    {}
+func (*InstanceLogMasks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_logging_2c258a56b18931e6, []int{1}
+}
+func (m *InstanceLogMasks) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InstanceLogMasks.Unmarshal(m, b)
+}
+func (m *InstanceLogMasks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InstanceLogMasks.Marshal(b, m, deterministic)
+}
+func (dst *InstanceLogMasks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InstanceLogMasks.Merge(dst, src)
+}
+func (m *InstanceLogMasks) XXX_Size() int {
+	return xxx_messageInfo_InstanceLogMasks.Size(m)
+}
+func (m *InstanceLogMasks) XXX_DiscardUnknown() {
+	xxx_messageInfo_InstanceLogMasks.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InstanceLogMasks proto.InternalMessageInfo
+
+func (m *InstanceLogMasks) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *InstanceLogMasks) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *InstanceLogMasks) GetMasks() string {
+	if m != nil {
+		return m.Masks
+	}
+	return ""
+}
+
+func (m *InstanceLogMasks) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type ServerSetLogMasksResp struct {
+	ControlLevel         string              `protobuf:"bytes,1,opt,name=control_level,json=controlLevel,proto3" json:"control_level,omitempty"`
+	Instances            []*InstanceLogMasks `protobuf:"bytes,2,rep,name=instances,proto3" json:"instances,omitempty"`
+	RevertTime           int64               `protobuf:"varint,3,opt,name=revert_time,json=revertTime,proto3" json:"revert_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ServerSetLogMasksResp) Reset()         { *m = ServerSetLogMasksResp{} }
+func (m *ServerSetLogMasksResp) String() string { return proto.CompactTextString(m) }
+func (*ServerSetLogMasksResp) ProtoMessage()    {}
+func (*ServerSetLogMasksResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_logging_2c258a56b18931e6, []int{2}
+}
+func (m *ServerSetLogMasksResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ServerSetLogMasksResp.Unmarshal(m, b)
+}
+func (m *ServerSetLogMasksResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ServerSetLogMasksResp.Marshal(b, m, deterministic)
+}
+func (dst *ServerSetLogMasksResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServerSetLogMasksResp.Merge(dst, src)
+}
+func (m *ServerSetLogMasksResp) XXX_Size() int {
+	return xxx_messageInfo_ServerSetLogMasksResp.Size(m)
+}
+func (m *ServerSetLogMasksResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServerSetLogMasksResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServerSetLogMasksResp proto.InternalMessageInfo
+
+func (m *ServerSetLogMasksResp) GetControlLevel() string {
+	if m != nil {
+		return m.ControlLevel
+	}
+	return ""
+}
+
+func (m *ServerSetLogMasksResp) GetInstances() []*InstanceLogMasks {
+	if m != nil {
+		return m.Instances
+	}
+	return nil
+}
+
+func (m *ServerSetLogMasksResp) GetRevertTime() int64 {
+	if m != nil {
+		return m.RevertTime
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ServerSetLogMasksReq)(nil), "mgmt.ServerSetLogMasksReq")
+	proto.RegisterType((*InstanceLogMasks)(nil), "mgmt.InstanceLogMasks")
+	proto.RegisterType((*ServerSetLogMasksResp)(nil), "mgmt.ServerSetLogMasksResp")
+}
+
+func init() { proto.RegisterFile("logging.proto", fileDescriptor_logging_2c258a56b18931e6) }
+
+var fileDescriptor_logging_2c258a56b18931e6 = []byte{
+	// 270 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0x41, 0x4e, 0xc3, 0x30,
+	0x10, 0x54, 0xea, 0x82, 0xc8, 0x96, 0x48, 0xc8, 0x2a, 0xc8, 0xe2, 0x00, 0x51, 0xb8, 0xe4, 0x94,
+	0x03, 0xf0, 0x09, 0xa4, 0x72, 0x71, 0xb9, 0x57, 0x69, 0xbb, 0x0a, 0x56, 0x63, 0xbb, 0xac, 0xdd,
+	0x08, 0x2e, 0x7c, 0x82, 0x0f, 0x23, 0xdb, 0x2d, 0x54, 0x88, 0x9b, 0x67, 0xb4, 0xb3, 0x33, 0xe3,
+	0x85, 0xa2, 0xb7, 0x5d, 0xa7, 0x4c, 0xd7, 0x6c, 0xc9, 0x7a, 0xcb, 0xc7, 0xba, 0xd3, 0xbe, 0xfa,
+	0x84, 0xe9, 0x1c, 0x69, 0x40, 0x9a, 0xa3, 0x9f, 0xd9, 0xee, 0xb9, 0x75, 0x1b, 0x27, 0xf1, 0x8d,
+	0x4f, 0xe1, 0xa4, 0xc7, 0x01, 0x7b, 0x91, 0x95, 0x59, 0x9d, 0xcb, 0x04, 0xf8, 0x0d, 0x80, 0xdb,
+	0x2d, 0xdd, 0x87, 0xf3, 0xa8, 0x9d, 0x18, 0x95, 0xac, 0xce, 0xe5, 0x11, 0x13, 0x54, 0xd4, 0x9a,
+	0x8d, 0x13, 0xac, 0x64, 0x75, 0x21, 0x13, 0xe0, 0xd7, 0x70, 0xb6, 0xde, 0x51, 0xeb, 0x95, 0x35,
+	0x62, 0x5c, 0x66, 0x35, 0x93, 0x3f, 0xb8, 0x7a, 0x85, 0x8b, 0x27, 0xe3, 0x7c, 0x6b, 0x56, 0x78,
+	0xb0, 0x0f, 0x5b, 0x94, 0x59, 0xe3, 0x7b, 0xf4, 0x2e, 0x64, 0x02, 0x9c, 0xc3, 0x38, 0xac, 0x13,
+	0xa3, 0x48, 0xc6, 0x77, 0x98, 0xd4, 0x41, 0x22, 0x58, 0x4a, 0xa9, 0x0f, 0x7a, 0x24, 0xb2, 0x14,
+	0xcd, 0x72, 0x99, 0x40, 0xf5, 0x95, 0xc1, 0xe5, 0x3f, 0x55, 0xdd, 0x96, 0xdf, 0x41, 0xb1, 0xb2,
+	0xc6, 0x93, 0xed, 0x17, 0xc7, 0x9d, 0xcf, 0xf7, 0xe4, 0x2c, 0x56, 0x7f, 0x84, 0x5c, 0xed, 0x83,
+	0xa6, 0xe6, 0x93, 0xfb, 0xab, 0x26, 0x7c, 0x61, 0xf3, 0x37, 0xbf, 0xfc, 0x1d, 0xe4, 0xb7, 0x30,
+	0x21, 0x1c, 0x90, 0xfc, 0xc2, 0x2b, 0x8d, 0x31, 0x26, 0x93, 0x90, 0xa8, 0x17, 0xa5, 0x71, 0x79,
+	0x1a, 0x8f, 0xf1, 0xf0, 0x3d, 0x00, 0xcf, 0xb7, 0x68, 0xff, 0x9d, 0x01, 0x00, 0x00,
+}
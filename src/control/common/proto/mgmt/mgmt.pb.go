@@ -46,7 +46,7 @@ func (x JoinResp_State) String() string {
 	return proto.EnumName(JoinResp_State_name, int32(x))
 }
 func (JoinResp_State) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_mgmt_043fae4fd6dd1244, []int{1, 0}
+	return fileDescriptor_mgmt_3065f8175f88add4, []int{1, 0}
 }
 
 type JoinReq struct {
@@ -59,7 +59,9 @@ type JoinReq struct {
 	// Server CaRT context count.
 	Nctxs uint32 `protobuf:"varint,4,opt,name=nctxs,proto3" json:"nctxs,omitempty"`
 	// Server management address.
-	Addr                 string   `protobuf:"bytes,5,opt,name=addr,proto3" json:"addr,omitempty"`
+	Addr string `protobuf:"bytes,5,opt,name=addr,proto3" json:"addr,omitempty"`
+	// Server is an access point hosting a Management Service replica.
+	Replica              bool     `protobuf:"varint,6,opt,name=replica,proto3" json:"replica,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -69,7 +71,7 @@ func (m *JoinReq) Reset()         { *m = JoinReq{} }
 func (m *JoinReq) String() string { return proto.CompactTextString(m) }
 func (*JoinReq) ProtoMessage()    {}
 func (*JoinReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mgmt_043fae4fd6dd1244, []int{0}
+	return fileDescriptor_mgmt_3065f8175f88add4, []int{0}
 }
 func (m *JoinReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_JoinReq.Unmarshal(m, b)
@@ -124,6 +126,13 @@ func (m *JoinReq) GetAddr() string {
 	return ""
 }
 
+func (m *JoinReq) GetReplica() bool {
+	if m != nil {
+		return m.Replica
+	}
+	return false
+}
+
 type JoinResp struct {
 	// DAOS error code
 	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
@@ -139,7 +148,7 @@ func (m *JoinResp) Reset()         { *m = JoinResp{} }
 func (m *JoinResp) String() string { return proto.CompactTextString(m) }
 func (*JoinResp) ProtoMessage()    {}
 func (*JoinResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mgmt_043fae4fd6dd1244, []int{1}
+	return fileDescriptor_mgmt_3065f8175f88add4, []int{1}
 }
 func (m *JoinResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_JoinResp.Unmarshal(m, b)
@@ -192,7 +201,7 @@ func (m *GetAttachInfoReq) Reset()         { *m = GetAttachInfoReq{} }
 func (m *GetAttachInfoReq) String() string { return proto.CompactTextString(m) }
 func (*GetAttachInfoReq) ProtoMessage()    {}
 func (*GetAttachInfoReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mgmt_043fae4fd6dd1244, []int{2}
+	return fileDescriptor_mgmt_3065f8175f88add4, []int{2}
 }
 func (m *GetAttachInfoReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAttachInfoReq.Unmarshal(m, b)
@@ -233,7 +242,7 @@ func (m *GetAttachInfoResp) Reset()         { *m = GetAttachInfoResp{} }
 func (m *GetAttachInfoResp) String() string { return proto.CompactTextString(m) }
 func (*GetAttachInfoResp) ProtoMessage()    {}
 func (*GetAttachInfoResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mgmt_043fae4fd6dd1244, []int{3}
+	return fileDescriptor_mgmt_3065f8175f88add4, []int{3}
 }
 func (m *GetAttachInfoResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAttachInfoResp.Unmarshal(m, b)
@@ -280,7 +289,7 @@ func (m *GetAttachInfoResp_Psr) Reset()         { *m = GetAttachInfoResp_Psr{} }
 func (m *GetAttachInfoResp_Psr) String() string { return proto.CompactTextString(m) }
 func (*GetAttachInfoResp_Psr) ProtoMessage()    {}
 func (*GetAttachInfoResp_Psr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mgmt_043fae4fd6dd1244, []int{3, 0}
+	return fileDescriptor_mgmt_3065f8175f88add4, []int{3, 0}
 }
 func (m *GetAttachInfoResp_Psr) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetAttachInfoResp_Psr.Unmarshal(m, b)
@@ -314,12 +323,110 @@ func (m *GetAttachInfoResp_Psr) GetUri() string {
 	return ""
 }
 
+type LeaderQueryReq struct {
+	// System name.
+	System               string   `protobuf:"bytes,1,opt,name=system,proto3" json:"system,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaderQueryReq) Reset()         { *m = LeaderQueryReq{} }
+func (m *LeaderQueryReq) String() string { return proto.CompactTextString(m) }
+func (*LeaderQueryReq) ProtoMessage()    {}
+func (*LeaderQueryReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_mgmt_3065f8175f88add4, []int{4}
+}
+func (m *LeaderQueryReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaderQueryReq.Unmarshal(m, b)
+}
+func (m *LeaderQueryReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaderQueryReq.Marshal(b, m, deterministic)
+}
+func (dst *LeaderQueryReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaderQueryReq.Merge(dst, src)
+}
+func (m *LeaderQueryReq) XXX_Size() int {
+	return xxx_messageInfo_LeaderQueryReq.Size(m)
+}
+func (m *LeaderQueryReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaderQueryReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaderQueryReq proto.InternalMessageInfo
+
+func (m *LeaderQueryReq) GetSystem() string {
+	if m != nil {
+		return m.System
+	}
+	return ""
+}
+
+type LeaderQueryResp struct {
+	// Control-plane address of the current Management Service leader.
+	CurrentLeader string `protobuf:"bytes,1,opt,name=current_leader,json=currentLeader,proto3" json:"current_leader,omitempty"`
+	// Control-plane addresses of the Management Service replicas.
+	Replicas []string `protobuf:"bytes,2,rep,name=replicas,proto3" json:"replicas,omitempty"`
+	// Control-plane addresses of the replicas which could not be reached.
+	DownReplicas         []string `protobuf:"bytes,3,rep,name=down_replicas,json=downReplicas,proto3" json:"down_replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LeaderQueryResp) Reset()         { *m = LeaderQueryResp{} }
+func (m *LeaderQueryResp) String() string { return proto.CompactTextString(m) }
+func (*LeaderQueryResp) ProtoMessage()    {}
+func (*LeaderQueryResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_mgmt_3065f8175f88add4, []int{5}
+}
+func (m *LeaderQueryResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LeaderQueryResp.Unmarshal(m, b)
+}
+func (m *LeaderQueryResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LeaderQueryResp.Marshal(b, m, deterministic)
+}
+func (dst *LeaderQueryResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaderQueryResp.Merge(dst, src)
+}
+func (m *LeaderQueryResp) XXX_Size() int {
+	return xxx_messageInfo_LeaderQueryResp.Size(m)
+}
+func (m *LeaderQueryResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaderQueryResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LeaderQueryResp proto.InternalMessageInfo
+
+func (m *LeaderQueryResp) GetCurrentLeader() string {
+	if m != nil {
+		return m.CurrentLeader
+	}
+	return ""
+}
+
+func (m *LeaderQueryResp) GetReplicas() []string {
+	if m != nil {
+		return m.Replicas
+	}
+	return nil
+}
+
+func (m *LeaderQueryResp) GetDownReplicas() []string {
+	if m != nil {
+		return m.DownReplicas
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*JoinReq)(nil), "mgmt.JoinReq")
 	proto.RegisterType((*JoinResp)(nil), "mgmt.JoinResp")
 	proto.RegisterType((*GetAttachInfoReq)(nil), "mgmt.GetAttachInfoReq")
 	proto.RegisterType((*GetAttachInfoResp)(nil), "mgmt.GetAttachInfoResp")
 	proto.RegisterType((*GetAttachInfoResp_Psr)(nil), "mgmt.GetAttachInfoResp.Psr")
+	proto.RegisterType((*LeaderQueryReq)(nil), "mgmt.LeaderQueryReq")
+	proto.RegisterType((*LeaderQueryResp)(nil), "mgmt.LeaderQueryResp")
 	proto.RegisterEnum("mgmt.JoinResp_State", JoinResp_State_name, JoinResp_State_value)
 }
 
@@ -349,6 +456,12 @@ type MgmtSvcClient interface {
 	SmdListDevs(ctx context.Context, in *SmdDevReq, opts ...grpc.CallOption) (*SmdDevResp, error)
 	// Kill a given rank associated with a given pool
 	KillRank(ctx context.Context, in *DaosRank, opts ...grpc.CallOption) (*DaosResp, error)
+	// List all pools in a DAOS system: basic info: UUIDs, service ranks
+	ListPools(ctx context.Context, in *ListPoolsReq, opts ...grpc.CallOption) (*ListPoolsResp, error)
+	// Query a DAOS pool's space usage, target counts and rebuild state
+	PoolQuery(ctx context.Context, in *PoolQueryReq, opts ...grpc.CallOption) (*PoolQueryResp, error)
+	// Query the current Management Service leader and replica set
+	LeaderQuery(ctx context.Context, in *LeaderQueryReq, opts ...grpc.CallOption) (*LeaderQueryResp, error)
 }
 
 type mgmtSvcClient struct {
@@ -422,6 +535,33 @@ func (c *mgmtSvcClient) KillRank(ctx context.Context, in *DaosRank, opts ...grpc
 	return out, nil
 }
 
+func (c *mgmtSvcClient) ListPools(ctx context.Context, in *ListPoolsReq, opts ...grpc.CallOption) (*ListPoolsResp, error) {
+	out := new(ListPoolsResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/ListPools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolQuery(ctx context.Context, in *PoolQueryReq, opts ...grpc.CallOption) (*PoolQueryResp, error) {
+	out := new(PoolQueryResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) LeaderQuery(ctx context.Context, in *LeaderQueryReq, opts ...grpc.CallOption) (*LeaderQueryResp, error) {
+	out := new(LeaderQueryResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/LeaderQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MgmtSvcServer is the server API for MgmtSvc service.
 type MgmtSvcServer interface {
 	// Join the server described by JoinReq to the system.
@@ -438,6 +578,12 @@ type MgmtSvcServer interface {
 	SmdListDevs(context.Context, *SmdDevReq) (*SmdDevResp, error)
 	// Kill a given rank associated with a given pool
 	KillRank(context.Context, *DaosRank) (*DaosResp, error)
+	// List all pools in a DAOS system: basic info: UUIDs, service ranks
+	ListPools(context.Context, *ListPoolsReq) (*ListPoolsResp, error)
+	// Query a DAOS pool's space usage, target counts and rebuild state
+	PoolQuery(context.Context, *PoolQueryReq) (*PoolQueryResp, error)
+	// Query the current Management Service leader and replica set
+	LeaderQuery(context.Context, *LeaderQueryReq) (*LeaderQueryResp, error)
 }
 
 func RegisterMgmtSvcServer(s *grpc.Server, srv MgmtSvcServer) {
@@ -570,6 +716,60 @@ func _MgmtSvc_KillRank_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_ListPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPoolsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).ListPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/ListPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).ListPools(ctx, req.(*ListPoolsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolQueryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/PoolQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolQuery(ctx, req.(*PoolQueryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_LeaderQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LeaderQueryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).LeaderQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/LeaderQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).LeaderQuery(ctx, req.(*LeaderQueryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MgmtSvc_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "mgmt.MgmtSvc",
 	HandlerType: (*MgmtSvcServer)(nil),
@@ -602,42 +802,62 @@ var _MgmtSvc_serviceDesc = grpc.ServiceDesc{
 			MethodName: "KillRank",
 			Handler:    _MgmtSvc_KillRank_Handler,
 		},
+		{
+			MethodName: "ListPools",
+			Handler:    _MgmtSvc_ListPools_Handler,
+		},
+		{
+			MethodName: "PoolQuery",
+			Handler:    _MgmtSvc_PoolQuery_Handler,
+		},
+		{
+			MethodName: "LeaderQuery",
+			Handler:    _MgmtSvc_LeaderQuery_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mgmt.proto",
 }
 
-func init() { proto.RegisterFile("mgmt.proto", fileDescriptor_mgmt_043fae4fd6dd1244) }
-
-var fileDescriptor_mgmt_043fae4fd6dd1244 = []byte{
-	// 454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0xaf, 0xd2, 0x40,
-	0x14, 0xa5, 0xb4, 0x85, 0xf7, 0x2e, 0x01, 0xeb, 0x05, 0x9f, 0x4d, 0xdd, 0x90, 0xc6, 0x44, 0xa2,
-	0x06, 0x13, 0x5c, 0x19, 0xdd, 0xf8, 0x24, 0xd1, 0xe7, 0x27, 0x16, 0x5d, 0x9b, 0x4a, 0x47, 0x68,
-	0x6c, 0x19, 0x98, 0x99, 0x36, 0x92, 0xf8, 0x07, 0xdc, 0xf8, 0x9b, 0xcd, 0x9d, 0xa9, 0x08, 0x16,
-	0xdd, 0x9d, 0x7b, 0xe6, 0x9c, 0xb9, 0x73, 0x0e, 0x14, 0x20, 0x5f, 0xe6, 0x6a, 0xbc, 0x11, 0x5c,
-	0x71, 0x74, 0x08, 0x07, 0xb0, 0xe1, 0x3c, 0x33, 0x4c, 0x70, 0x2e, 0x45, 0x59, 0xc1, 0xbe, 0x54,
-	0x5c, 0xc4, 0x4b, 0xf6, 0x69, 0x5b, 0x30, 0xb1, 0x33, 0x64, 0x98, 0x43, 0xfb, 0x25, 0x4f, 0xd7,
-	0x11, 0xdb, 0x22, 0x82, 0x53, 0x14, 0x69, 0xe2, 0x5b, 0x43, 0x6b, 0x74, 0x1e, 0x69, 0x4c, 0x9c,
-	0x88, 0xd7, 0x5f, 0xfd, 0xe6, 0xd0, 0x1a, 0x75, 0x23, 0x8d, 0xd1, 0x03, 0xbb, 0x10, 0xa9, 0x6f,
-	0x6b, 0x19, 0x41, 0x1c, 0x80, 0xbb, 0x5e, 0xa8, 0x6f, 0xd2, 0x77, 0xb4, 0xcc, 0x0c, 0xe4, 0x8d,
-	0x93, 0x44, 0xf8, 0xae, 0xb9, 0x8f, 0x70, 0xf8, 0x1d, 0xce, 0xcc, 0x3a, 0xb9, 0xc1, 0x0b, 0x68,
-	0x49, 0x15, 0xab, 0x42, 0xea, 0x8d, 0x6e, 0x54, 0x4d, 0x27, 0x77, 0xde, 0x05, 0x97, 0x4e, 0x99,
-	0xde, 0xda, 0x9b, 0x0c, 0xc6, 0x3a, 0xf4, 0xef, 0xab, 0xc6, 0x73, 0x3a, 0x8b, 0x8c, 0x24, 0xf4,
-	0xc1, 0xd5, 0x33, 0xb6, 0xa0, 0x79, 0xf5, 0xd6, 0x6b, 0x60, 0x1b, 0xec, 0x77, 0x1f, 0x3f, 0x78,
-	0x56, 0x78, 0x1b, 0xbc, 0xe7, 0x4c, 0x3d, 0x55, 0x2a, 0x5e, 0xac, 0xae, 0xd6, 0x5f, 0x38, 0xa5,
-	0xf6, 0xc0, 0x96, 0x3b, 0x59, 0x85, 0x26, 0x18, 0xfe, 0xb0, 0xe0, 0xfa, 0x5f, 0xb2, 0xff, 0xbc,
-	0xf6, 0x01, 0x38, 0x1b, 0x29, 0xa4, 0xdf, 0x1c, 0xda, 0xa3, 0xce, 0xe4, 0x96, 0x79, 0x58, 0xcd,
-	0x3e, 0x9e, 0x49, 0x11, 0x69, 0x61, 0x70, 0x0f, 0xec, 0x99, 0x14, 0xfb, 0x94, 0x56, 0xbd, 0xd9,
-	0xe6, 0xbe, 0xd9, 0xc9, 0x4f, 0x1b, 0xda, 0x6f, 0x96, 0xb9, 0x9a, 0x97, 0x0b, 0xbc, 0x03, 0x0e,
-	0x05, 0xc6, 0xee, 0x61, 0xf8, 0x6d, 0xd0, 0x3b, 0xee, 0x22, 0x6c, 0xe0, 0x23, 0x80, 0x19, 0xe7,
-	0xd9, 0x33, 0xc1, 0xa8, 0x85, 0xbe, 0x39, 0xff, 0xc3, 0x90, 0x69, 0x50, 0x27, 0xb5, 0xf5, 0x09,
-	0x74, 0x88, 0x9b, 0x32, 0xa9, 0x04, 0xdf, 0xe1, 0x81, 0xac, 0xa2, 0xc8, 0x7c, 0xe3, 0x04, 0xab,
-	0xdd, 0x97, 0xd0, 0x3d, 0x4a, 0x8e, 0x17, 0x27, 0xeb, 0xd8, 0x06, 0x37, 0xff, 0x51, 0x53, 0xd8,
-	0xc0, 0xc7, 0xd0, 0xbb, 0x4c, 0xf9, 0x0b, 0x16, 0x67, 0x6a, 0xf5, 0x9e, 0xfe, 0xa8, 0x88, 0x46,
-	0xbc, 0x67, 0xe9, 0x82, 0x7e, 0x8d, 0xd3, 0xe6, 0x09, 0x74, 0xe6, 0x79, 0xf2, 0x3a, 0x95, 0x6a,
-	0xca, 0x4a, 0x89, 0xd7, 0x8c, 0x6a, 0x9e, 0x27, 0x53, 0x56, 0x92, 0xcd, 0x3b, 0x26, 0xb4, 0xe7,
-	0x3e, 0x9c, 0xbd, 0x4a, 0xb3, 0x2c, 0xa2, 0x1f, 0xa0, 0xea, 0x72, 0x1a, 0x73, 0x49, 0x73, 0x70,
-	0x38, 0x6b, 0xf5, 0xe7, 0x96, 0xfe, 0x6c, 0x1e, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x85,
-	0x35, 0x15, 0x76, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("mgmt.proto", fileDescriptor_mgmt_3065f8175f88add4) }
+
+var fileDescriptor_mgmt_3065f8175f88add4 = []byte{
+	// 581 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x5f, 0x8f, 0x93, 0x4e,
+	0x14, 0x5d, 0x0a, 0xfd, 0x77, 0xf7, 0xd7, 0x2e, 0xbf, 0xd9, 0x3f, 0x12, 0x7c, 0x69, 0x50, 0x63,
+	0xa3, 0xa6, 0x26, 0x35, 0x31, 0x31, 0xfa, 0xe2, 0xda, 0x44, 0x57, 0x57, 0x5d, 0xa9, 0x3e, 0x6f,
+	0xb0, 0x8c, 0x5d, 0x22, 0x30, 0x74, 0x66, 0xa8, 0x36, 0xf1, 0xdd, 0xf8, 0x9d, 0xfc, 0x70, 0xe6,
+	0xce, 0x00, 0x82, 0xad, 0xbe, 0xdd, 0x73, 0xe6, 0x9c, 0xc9, 0x99, 0x7b, 0x08, 0x00, 0xc9, 0x32,
+	0x91, 0x93, 0x8c, 0x33, 0xc9, 0x88, 0x85, 0xb3, 0x0b, 0x19, 0x63, 0xb1, 0x66, 0xdc, 0xbe, 0xe0,
+	0xeb, 0x62, 0x3c, 0x14, 0x92, 0xf1, 0x60, 0x49, 0x2f, 0x57, 0x39, 0xe5, 0x1b, 0x4d, 0x7a, 0xdf,
+	0x0d, 0xe8, 0xbe, 0x64, 0x51, 0xea, 0xd3, 0x15, 0x21, 0x60, 0xe5, 0x79, 0x14, 0x3a, 0xc6, 0xc8,
+	0x18, 0xf7, 0x7d, 0x35, 0x23, 0xc7, 0x83, 0xf4, 0xb3, 0xd3, 0x1a, 0x19, 0xe3, 0x81, 0xaf, 0x66,
+	0x62, 0x83, 0x99, 0xf3, 0xc8, 0x31, 0x95, 0x0c, 0x47, 0x72, 0x04, 0xed, 0x74, 0x21, 0xbf, 0x0a,
+	0xc7, 0x52, 0x32, 0x0d, 0xd0, 0x1b, 0x84, 0x21, 0x77, 0xda, 0xfa, 0x3e, 0x9c, 0x89, 0x03, 0x5d,
+	0x4e, 0xb3, 0x38, 0x5a, 0x04, 0x4e, 0x67, 0x64, 0x8c, 0x7b, 0x7e, 0x09, 0xbd, 0x6f, 0xd0, 0xd3,
+	0x41, 0x44, 0x46, 0x4e, 0xa0, 0x23, 0x64, 0x20, 0x73, 0xa1, 0xb2, 0xb4, 0xfd, 0x02, 0xed, 0x4c,
+	0x73, 0x07, 0xda, 0x78, 0x4a, 0x55, 0x9e, 0xe1, 0xf4, 0x68, 0xa2, 0xf6, 0x51, 0x5e, 0x35, 0x99,
+	0xe3, 0x99, 0xaf, 0x25, 0x9e, 0x03, 0x6d, 0x85, 0x49, 0x07, 0x5a, 0x67, 0x6f, 0xec, 0x3d, 0xd2,
+	0x05, 0xf3, 0xed, 0x87, 0xf7, 0xb6, 0xe1, 0xdd, 0x04, 0xfb, 0x39, 0x95, 0x4f, 0xa5, 0x0c, 0x16,
+	0x57, 0x67, 0xe9, 0x27, 0x86, 0xfb, 0xb0, 0xc1, 0x14, 0x1b, 0x51, 0xac, 0x03, 0x47, 0xef, 0x87,
+	0x01, 0xff, 0xff, 0x21, 0xfb, 0x47, 0xda, 0xfb, 0x60, 0x65, 0x82, 0x0b, 0xa7, 0x35, 0x32, 0xc7,
+	0xfb, 0xd3, 0xeb, 0x3a, 0xd8, 0x96, 0x7d, 0x72, 0x21, 0xb8, 0xaf, 0x84, 0xee, 0x5d, 0x30, 0x2f,
+	0x04, 0xaf, 0x5e, 0x69, 0x6c, 0xef, 0xbc, 0x55, 0xed, 0xdc, 0x1b, 0xc3, 0xf0, 0x9c, 0x06, 0x21,
+	0xe5, 0xef, 0xb0, 0x4e, 0xcc, 0x8b, 0x39, 0x36, 0x42, 0xd2, 0xa4, 0x88, 0x5c, 0x20, 0x6f, 0x03,
+	0x07, 0x0d, 0xa5, 0xc8, 0xc8, 0x2d, 0x18, 0x2e, 0x72, 0xce, 0x69, 0x2a, 0x2f, 0x63, 0x75, 0x54,
+	0x58, 0x06, 0x05, 0xab, 0xf5, 0xc4, 0x85, 0x5e, 0x51, 0x8f, 0x7e, 0x45, 0xdf, 0xaf, 0x30, 0xb9,
+	0x01, 0x83, 0x90, 0x7d, 0x49, 0x2f, 0x2b, 0x81, 0xa9, 0x04, 0xff, 0x21, 0xe9, 0x17, 0xdc, 0xf4,
+	0xa7, 0x05, 0xdd, 0xd7, 0xcb, 0x44, 0xce, 0xd7, 0x0b, 0x72, 0x1b, 0x2c, 0x6c, 0x85, 0x0c, 0xea,
+	0x0d, 0xad, 0xdc, 0x61, 0xb3, 0x30, 0x6f, 0x8f, 0x3c, 0x02, 0xb8, 0x60, 0x2c, 0x7e, 0xc6, 0x29,
+	0x56, 0x75, 0xa8, 0xcf, 0x7f, 0x33, 0x68, 0x3a, 0xda, 0x26, 0x95, 0xf5, 0x09, 0xec, 0x23, 0x37,
+	0xa3, 0x42, 0x72, 0xb6, 0x21, 0x35, 0x59, 0x41, 0xa1, 0xf9, 0x78, 0x07, 0xab, 0xdc, 0xa7, 0x30,
+	0x68, 0xd4, 0x43, 0x4e, 0x76, 0x76, 0xb6, 0x72, 0xaf, 0xfd, 0xa5, 0x4b, 0x6f, 0x8f, 0x3c, 0x86,
+	0xe1, 0x69, 0xc4, 0x5e, 0xd0, 0x20, 0x96, 0x57, 0x6a, 0xdf, 0x84, 0x68, 0x71, 0xc5, 0xe2, 0x05,
+	0x87, 0x5b, 0x9c, 0x32, 0x4f, 0x61, 0x7f, 0x9e, 0x84, 0xe7, 0x91, 0x90, 0x33, 0xba, 0x16, 0xe4,
+	0x40, 0xab, 0xe6, 0x49, 0x38, 0xa3, 0x6b, 0xb4, 0xd9, 0x4d, 0x42, 0x79, 0xee, 0x41, 0xef, 0x55,
+	0x14, 0xc7, 0x3e, 0x7e, 0x25, 0xc5, 0x2e, 0x67, 0x01, 0x13, 0x88, 0xdd, 0x3a, 0xd6, 0xea, 0x87,
+	0xd0, 0xc7, 0xeb, 0xf1, 0xed, 0xa2, 0x4c, 0x56, 0x11, 0xb5, 0x64, 0x35, 0xae, 0xf4, 0x21, 0x6c,
+	0xbc, 0xa8, 0x22, 0x6a, 0xbe, 0x1a, 0x57, 0x16, 0x52, 0xfb, 0xf6, 0xca, 0x42, 0x9a, 0x1f, 0xae,
+	0x7b, 0xbc, 0x83, 0x45, 0xf7, 0xc7, 0x8e, 0xfa, 0x49, 0x3d, 0xf8, 0x35, 0x00, 0x72, 0x3b, 0xfa,
+	0x0c, 0xe4, 0x04, 0x00, 0x00,
 }
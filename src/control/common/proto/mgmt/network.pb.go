@@ -0,0 +1,192 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: network.proto
+
+package mgmt
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type NetworkScanReq struct {
+	Provider             string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NetworkScanReq) Reset()         { *m = NetworkScanReq{} }
+func (m *NetworkScanReq) String() string { return proto.CompactTextString(m) }
+func (*NetworkScanReq) ProtoMessage()    {}
+func (*NetworkScanReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_network_626528acc60607ce, []int{0}
+}
+func (m *NetworkScanReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkScanReq.Unmarshal(m, b)
+}
+func (m *NetworkScanReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkScanReq.Marshal(b, m, deterministic)
+}
+func (dst *NetworkScanReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkScanReq.Merge(dst, src)
+}
+func (m *NetworkScanReq) XXX_Size() int {
+	return xxx_messageInfo_NetworkScanReq.Size(m)
+}
+func (m *NetworkScanReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkScanReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkScanReq proto.InternalMessageInfo
+
+func (m *NetworkScanReq) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+// FabricInterface describes a network interface that may be used as a
+// fabric_iface and its affinity to NUMA nodes and CPUs.
+type FabricInterface struct {
+	Provider             string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Device               string   `protobuf:"bytes,2,opt,name=device,proto3" json:"device,omitempty"`
+	Numanode             uint32   `protobuf:"varint,3,opt,name=numanode,proto3" json:"numanode,omitempty"`
+	Cpuset               string   `protobuf:"bytes,4,opt,name=cpuset,proto3" json:"cpuset,omitempty"`
+	Nodeset              string   `protobuf:"bytes,5,opt,name=nodeset,proto3" json:"nodeset,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FabricInterface) Reset()         { *m = FabricInterface{} }
+func (m *FabricInterface) String() string { return proto.CompactTextString(m) }
+func (*FabricInterface) ProtoMessage()    {}
+func (*FabricInterface) Descriptor() ([]byte, []int) {
+	return fileDescriptor_network_626528acc60607ce, []int{1}
+}
+func (m *FabricInterface) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FabricInterface.Unmarshal(m, b)
+}
+func (m *FabricInterface) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FabricInterface.Marshal(b, m, deterministic)
+}
+func (dst *FabricInterface) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FabricInterface.Merge(dst, src)
+}
+func (m *FabricInterface) XXX_Size() int {
+	return xxx_messageInfo_FabricInterface.Size(m)
+}
+func (m *FabricInterface) XXX_DiscardUnknown() {
+	xxx_messageInfo_FabricInterface.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FabricInterface proto.InternalMessageInfo
+
+func (m *FabricInterface) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *FabricInterface) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+func (m *FabricInterface) GetNumanode() uint32 {
+	if m != nil {
+		return m.Numanode
+	}
+	return 0
+}
+
+func (m *FabricInterface) GetCpuset() string {
+	if m != nil {
+		return m.Cpuset
+	}
+	return ""
+}
+
+func (m *FabricInterface) GetNodeset() string {
+	if m != nil {
+		return m.Nodeset
+	}
+	return ""
+}
+
+type NetworkScanResp struct {
+	Interfaces           []*FabricInterface `protobuf:"bytes,1,rep,name=interfaces,proto3" json:"interfaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *NetworkScanResp) Reset()         { *m = NetworkScanResp{} }
+func (m *NetworkScanResp) String() string { return proto.CompactTextString(m) }
+func (*NetworkScanResp) ProtoMessage()    {}
+func (*NetworkScanResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_network_626528acc60607ce, []int{2}
+}
+func (m *NetworkScanResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NetworkScanResp.Unmarshal(m, b)
+}
+func (m *NetworkScanResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NetworkScanResp.Marshal(b, m, deterministic)
+}
+func (dst *NetworkScanResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkScanResp.Merge(dst, src)
+}
+func (m *NetworkScanResp) XXX_Size() int {
+	return xxx_messageInfo_NetworkScanResp.Size(m)
+}
+func (m *NetworkScanResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkScanResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkScanResp proto.InternalMessageInfo
+
+func (m *NetworkScanResp) GetInterfaces() []*FabricInterface {
+	if m != nil {
+		return m.Interfaces
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*NetworkScanReq)(nil), "mgmt.NetworkScanReq")
+	proto.RegisterType((*FabricInterface)(nil), "mgmt.FabricInterface")
+	proto.RegisterType((*NetworkScanResp)(nil), "mgmt.NetworkScanResp")
+}
+
+func init() { proto.RegisterFile("network.proto", fileDescriptor_network_626528acc60607ce) }
+
+var fileDescriptor_network_626528acc60607ce = []byte{
+	// 199 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xcd, 0x4b, 0x2d, 0x29,
+	0xcf, 0x2f, 0xca, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0xc9, 0x4d, 0xcf, 0x2d, 0x51,
+	0xd2, 0xe1, 0xe2, 0xf3, 0x83, 0x08, 0x07, 0x27, 0x27, 0xe6, 0x05, 0xa5, 0x16, 0x0a, 0x49, 0x71,
+	0x71, 0x14, 0x14, 0xe5, 0x97, 0x65, 0xa6, 0xa4, 0x16, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x06,
+	0xc1, 0xf9, 0x4a, 0x93, 0x19, 0xb9, 0xf8, 0xdd, 0x12, 0x93, 0x8a, 0x32, 0x93, 0x3d, 0xf3, 0x4a,
+	0x52, 0x8b, 0xd2, 0x12, 0x93, 0x53, 0xf1, 0xa9, 0x17, 0x12, 0xe3, 0x62, 0x4b, 0x49, 0x2d, 0xcb,
+	0x4c, 0x4e, 0x95, 0x60, 0x02, 0xcb, 0x40, 0x79, 0x20, 0x3d, 0x79, 0xa5, 0xb9, 0x89, 0x79, 0xf9,
+	0x29, 0xa9, 0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0xbc, 0x41, 0x70, 0x3e, 0x48, 0x4f, 0x72, 0x41, 0x69,
+	0x71, 0x6a, 0x89, 0x04, 0x0b, 0x44, 0x0f, 0x84, 0x27, 0x24, 0xc1, 0xc5, 0x0e, 0x92, 0x07, 0x49,
+	0xb0, 0x82, 0x25, 0x60, 0x5c, 0x25, 0x0f, 0x2e, 0x7e, 0x14, 0x3f, 0x14, 0x17, 0x08, 0x99, 0x72,
+	0x71, 0x65, 0xc2, 0x5c, 0x58, 0x2c, 0xc1, 0xa8, 0xc0, 0xac, 0xc1, 0x6d, 0x24, 0xaa, 0x07, 0xf2,
+	0xb1, 0x1e, 0x9a, 0xfb, 0x83, 0x90, 0x14, 0x26, 0xb1, 0x81, 0x83, 0xc6, 0x18, 0x30, 0x00, 0x9b,
+	0xed, 0x0e, 0x89, 0x2b, 0x01, 0x00, 0x00,
+}
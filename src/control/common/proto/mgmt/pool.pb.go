@@ -18,6 +18,32 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type PoolRebuildStatus_State int32
+
+const (
+	PoolRebuildStatus_IDLE PoolRebuildStatus_State = 0
+	PoolRebuildStatus_DONE PoolRebuildStatus_State = 1
+	PoolRebuildStatus_BUSY PoolRebuildStatus_State = 2
+)
+
+var PoolRebuildStatus_State_name = map[int32]string{
+	0: "IDLE",
+	1: "DONE",
+	2: "BUSY",
+}
+var PoolRebuildStatus_State_value = map[string]int32{
+	"IDLE": 0,
+	"DONE": 1,
+	"BUSY": 2,
+}
+
+func (x PoolRebuildStatus_State) String() string {
+	return proto.EnumName(PoolRebuildStatus_State_name, int32(x))
+}
+func (PoolRebuildStatus_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_pool_1a415d1c7c1eebf6, []int{8, 0}
+}
+
 // PoolCreateReq supplies new pool parameters.
 type PoolCreateReq struct {
 	Scmbytes             uint64   `protobuf:"varint,1,opt,name=scmbytes,proto3" json:"scmbytes,omitempty"`
@@ -37,7 +63,7 @@ func (m *PoolCreateReq) Reset()         { *m = PoolCreateReq{} }
 func (m *PoolCreateReq) String() string { return proto.CompactTextString(m) }
 func (*PoolCreateReq) ProtoMessage()    {}
 func (*PoolCreateReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pool_e89282fdb5ae9f98, []int{0}
+	return fileDescriptor_pool_1a415d1c7c1eebf6, []int{0}
 }
 func (m *PoolCreateReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PoolCreateReq.Unmarshal(m, b)
@@ -127,7 +153,7 @@ func (m *PoolCreateResp) Reset()         { *m = PoolCreateResp{} }
 func (m *PoolCreateResp) String() string { return proto.CompactTextString(m) }
 func (*PoolCreateResp) ProtoMessage()    {}
 func (*PoolCreateResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pool_e89282fdb5ae9f98, []int{1}
+	return fileDescriptor_pool_1a415d1c7c1eebf6, []int{1}
 }
 func (m *PoolCreateResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PoolCreateResp.Unmarshal(m, b)
@@ -182,7 +208,7 @@ func (m *PoolDestroyReq) Reset()         { *m = PoolDestroyReq{} }
 func (m *PoolDestroyReq) String() string { return proto.CompactTextString(m) }
 func (*PoolDestroyReq) ProtoMessage()    {}
 func (*PoolDestroyReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pool_e89282fdb5ae9f98, []int{2}
+	return fileDescriptor_pool_1a415d1c7c1eebf6, []int{2}
 }
 func (m *PoolDestroyReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PoolDestroyReq.Unmarshal(m, b)
@@ -235,7 +261,7 @@ func (m *PoolDestroyResp) Reset()         { *m = PoolDestroyResp{} }
 func (m *PoolDestroyResp) String() string { return proto.CompactTextString(m) }
 func (*PoolDestroyResp) ProtoMessage()    {}
 func (*PoolDestroyResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pool_e89282fdb5ae9f98, []int{3}
+	return fileDescriptor_pool_1a415d1c7c1eebf6, []int{3}
 }
 func (m *PoolDestroyResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PoolDestroyResp.Unmarshal(m, b)
@@ -262,33 +288,485 @@ func (m *PoolDestroyResp) GetStatus() int32 {
 	return 0
 }
 
+// ListPoolsReq represents a request to list pools on a given DAOS system.
+type ListPoolsReq struct {
+	Sys                  string   `protobuf:"bytes,1,opt,name=sys,proto3" json:"sys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPoolsReq) Reset()         { *m = ListPoolsReq{} }
+func (m *ListPoolsReq) String() string { return proto.CompactTextString(m) }
+func (*ListPoolsReq) ProtoMessage()    {}
+func (*ListPoolsReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pool_1a415d1c7c1eebf6, []int{4}
+}
+func (m *ListPoolsReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPoolsReq.Unmarshal(m, b)
+}
+func (m *ListPoolsReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPoolsReq.Marshal(b, m, deterministic)
+}
+func (dst *ListPoolsReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPoolsReq.Merge(dst, src)
+}
+func (m *ListPoolsReq) XXX_Size() int {
+	return xxx_messageInfo_ListPoolsReq.Size(m)
+}
+func (m *ListPoolsReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPoolsReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPoolsReq proto.InternalMessageInfo
+
+func (m *ListPoolsReq) GetSys() string {
+	if m != nil {
+		return m.Sys
+	}
+	return ""
+}
+
+// ListPoolsResp returns the list of pools in the system.
+type ListPoolsResp struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Pools                []*ListPoolsResp_Pool `protobuf:"bytes,2,rep,name=pools,proto3" json:"pools,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListPoolsResp) Reset()         { *m = ListPoolsResp{} }
+func (m *ListPoolsResp) String() string { return proto.CompactTextString(m) }
+func (*ListPoolsResp) ProtoMessage()    {}
+func (*ListPoolsResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pool_1a415d1c7c1eebf6, []int{5}
+}
+func (m *ListPoolsResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPoolsResp.Unmarshal(m, b)
+}
+func (m *ListPoolsResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPoolsResp.Marshal(b, m, deterministic)
+}
+func (dst *ListPoolsResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPoolsResp.Merge(dst, src)
+}
+func (m *ListPoolsResp) XXX_Size() int {
+	return xxx_messageInfo_ListPoolsResp.Size(m)
+}
+func (m *ListPoolsResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPoolsResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPoolsResp proto.InternalMessageInfo
+
+func (m *ListPoolsResp) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ListPoolsResp) GetPools() []*ListPoolsResp_Pool {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+type ListPoolsResp_Pool struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Svcreps              []uint32 `protobuf:"varint,2,rep,packed,name=svcreps,proto3" json:"svcreps,omitempty"`
+	Scmbytes             uint64   `protobuf:"varint,3,opt,name=scmbytes,proto3" json:"scmbytes,omitempty"`
+	Nvmebytes            uint64   `protobuf:"varint,4,opt,name=nvmebytes,proto3" json:"nvmebytes,omitempty"`
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPoolsResp_Pool) Reset()         { *m = ListPoolsResp_Pool{} }
+func (m *ListPoolsResp_Pool) String() string { return proto.CompactTextString(m) }
+func (*ListPoolsResp_Pool) ProtoMessage()    {}
+func (*ListPoolsResp_Pool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pool_1a415d1c7c1eebf6, []int{5, 0}
+}
+func (m *ListPoolsResp_Pool) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPoolsResp_Pool.Unmarshal(m, b)
+}
+func (m *ListPoolsResp_Pool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPoolsResp_Pool.Marshal(b, m, deterministic)
+}
+func (dst *ListPoolsResp_Pool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPoolsResp_Pool.Merge(dst, src)
+}
+func (m *ListPoolsResp_Pool) XXX_Size() int {
+	return xxx_messageInfo_ListPoolsResp_Pool.Size(m)
+}
+func (m *ListPoolsResp_Pool) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPoolsResp_Pool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPoolsResp_Pool proto.InternalMessageInfo
+
+func (m *ListPoolsResp_Pool) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *ListPoolsResp_Pool) GetSvcreps() []uint32 {
+	if m != nil {
+		return m.Svcreps
+	}
+	return nil
+}
+
+func (m *ListPoolsResp_Pool) GetScmbytes() uint64 {
+	if m != nil {
+		return m.Scmbytes
+	}
+	return 0
+}
+
+func (m *ListPoolsResp_Pool) GetNvmebytes() uint64 {
+	if m != nil {
+		return m.Nvmebytes
+	}
+	return 0
+}
+
+func (m *ListPoolsResp_Pool) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// PoolQueryReq represents a pool query request.
+type PoolQueryReq struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PoolQueryReq) Reset()         { *m = PoolQueryReq{} }
+func (m *PoolQueryReq) String() string { return proto.CompactTextString(m) }
+func (*PoolQueryReq) ProtoMessage()    {}
+func (*PoolQueryReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pool_1a415d1c7c1eebf6, []int{6}
+}
+func (m *PoolQueryReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolQueryReq.Unmarshal(m, b)
+}
+func (m *PoolQueryReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolQueryReq.Marshal(b, m, deterministic)
+}
+func (dst *PoolQueryReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolQueryReq.Merge(dst, src)
+}
+func (m *PoolQueryReq) XXX_Size() int {
+	return xxx_messageInfo_PoolQueryReq.Size(m)
+}
+func (m *PoolQueryReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolQueryReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolQueryReq proto.InternalMessageInfo
+
+func (m *PoolQueryReq) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+// StorageUsageStats represents usage statistics for a storage tier.
+type StorageUsageStats struct {
+	Total                uint64   `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Free                 uint64   `protobuf:"varint,2,opt,name=free,proto3" json:"free,omitempty"`
+	Min                  uint64   `protobuf:"varint,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  uint64   `protobuf:"varint,4,opt,name=max,proto3" json:"max,omitempty"`
+	Mean                 uint64   `protobuf:"varint,5,opt,name=mean,proto3" json:"mean,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageUsageStats) Reset()         { *m = StorageUsageStats{} }
+func (m *StorageUsageStats) String() string { return proto.CompactTextString(m) }
+func (*StorageUsageStats) ProtoMessage()    {}
+func (*StorageUsageStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pool_1a415d1c7c1eebf6, []int{7}
+}
+func (m *StorageUsageStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageUsageStats.Unmarshal(m, b)
+}
+func (m *StorageUsageStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageUsageStats.Marshal(b, m, deterministic)
+}
+func (dst *StorageUsageStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageUsageStats.Merge(dst, src)
+}
+func (m *StorageUsageStats) XXX_Size() int {
+	return xxx_messageInfo_StorageUsageStats.Size(m)
+}
+func (m *StorageUsageStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageUsageStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageUsageStats proto.InternalMessageInfo
+
+func (m *StorageUsageStats) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *StorageUsageStats) GetFree() uint64 {
+	if m != nil {
+		return m.Free
+	}
+	return 0
+}
+
+func (m *StorageUsageStats) GetMin() uint64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *StorageUsageStats) GetMax() uint64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *StorageUsageStats) GetMean() uint64 {
+	if m != nil {
+		return m.Mean
+	}
+	return 0
+}
+
+// PoolRebuildStatus represents a pool's rebuild status.
+type PoolRebuildStatus struct {
+	Status               int32                   `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                PoolRebuildStatus_State `protobuf:"varint,2,opt,name=state,proto3,enum=mgmt.PoolRebuildStatus_State" json:"state,omitempty"`
+	Objects              uint64                  `protobuf:"varint,3,opt,name=objects,proto3" json:"objects,omitempty"`
+	Records              uint64                  `protobuf:"varint,4,opt,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *PoolRebuildStatus) Reset()         { *m = PoolRebuildStatus{} }
+func (m *PoolRebuildStatus) String() string { return proto.CompactTextString(m) }
+func (*PoolRebuildStatus) ProtoMessage()    {}
+func (*PoolRebuildStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pool_1a415d1c7c1eebf6, []int{8}
+}
+func (m *PoolRebuildStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolRebuildStatus.Unmarshal(m, b)
+}
+func (m *PoolRebuildStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolRebuildStatus.Marshal(b, m, deterministic)
+}
+func (dst *PoolRebuildStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolRebuildStatus.Merge(dst, src)
+}
+func (m *PoolRebuildStatus) XXX_Size() int {
+	return xxx_messageInfo_PoolRebuildStatus.Size(m)
+}
+func (m *PoolRebuildStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolRebuildStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolRebuildStatus proto.InternalMessageInfo
+
+func (m *PoolRebuildStatus) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *PoolRebuildStatus) GetState() PoolRebuildStatus_State {
+	if m != nil {
+		return m.State
+	}
+	return PoolRebuildStatus_IDLE
+}
+
+func (m *PoolRebuildStatus) GetObjects() uint64 {
+	if m != nil {
+		return m.Objects
+	}
+	return 0
+}
+
+func (m *PoolRebuildStatus) GetRecords() uint64 {
+	if m != nil {
+		return m.Records
+	}
+	return 0
+}
+
+// PoolQueryResp represents a pool query response.
+type PoolQueryResp struct {
+	Status               int32              `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Uuid                 string             `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Totaltargets         uint32             `protobuf:"varint,3,opt,name=totaltargets,proto3" json:"totaltargets,omitempty"`
+	Activetargets        uint32             `protobuf:"varint,4,opt,name=activetargets,proto3" json:"activetargets,omitempty"`
+	Disabledtargets      uint32             `protobuf:"varint,5,opt,name=disabledtargets,proto3" json:"disabledtargets,omitempty"`
+	Rebuild              *PoolRebuildStatus `protobuf:"bytes,6,opt,name=rebuild,proto3" json:"rebuild,omitempty"`
+	Scm                  *StorageUsageStats `protobuf:"bytes,7,opt,name=scm,proto3" json:"scm,omitempty"`
+	Nvme                 *StorageUsageStats `protobuf:"bytes,8,opt,name=nvme,proto3" json:"nvme,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *PoolQueryResp) Reset()         { *m = PoolQueryResp{} }
+func (m *PoolQueryResp) String() string { return proto.CompactTextString(m) }
+func (*PoolQueryResp) ProtoMessage()    {}
+func (*PoolQueryResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pool_1a415d1c7c1eebf6, []int{9}
+}
+func (m *PoolQueryResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PoolQueryResp.Unmarshal(m, b)
+}
+func (m *PoolQueryResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PoolQueryResp.Marshal(b, m, deterministic)
+}
+func (dst *PoolQueryResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolQueryResp.Merge(dst, src)
+}
+func (m *PoolQueryResp) XXX_Size() int {
+	return xxx_messageInfo_PoolQueryResp.Size(m)
+}
+func (m *PoolQueryResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolQueryResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolQueryResp proto.InternalMessageInfo
+
+func (m *PoolQueryResp) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *PoolQueryResp) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *PoolQueryResp) GetTotaltargets() uint32 {
+	if m != nil {
+		return m.Totaltargets
+	}
+	return 0
+}
+
+func (m *PoolQueryResp) GetActivetargets() uint32 {
+	if m != nil {
+		return m.Activetargets
+	}
+	return 0
+}
+
+func (m *PoolQueryResp) GetDisabledtargets() uint32 {
+	if m != nil {
+		return m.Disabledtargets
+	}
+	return 0
+}
+
+func (m *PoolQueryResp) GetRebuild() *PoolRebuildStatus {
+	if m != nil {
+		return m.Rebuild
+	}
+	return nil
+}
+
+func (m *PoolQueryResp) GetScm() *StorageUsageStats {
+	if m != nil {
+		return m.Scm
+	}
+	return nil
+}
+
+func (m *PoolQueryResp) GetNvme() *StorageUsageStats {
+	if m != nil {
+		return m.Nvme
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PoolCreateReq)(nil), "mgmt.PoolCreateReq")
 	proto.RegisterType((*PoolCreateResp)(nil), "mgmt.PoolCreateResp")
 	proto.RegisterType((*PoolDestroyReq)(nil), "mgmt.PoolDestroyReq")
 	proto.RegisterType((*PoolDestroyResp)(nil), "mgmt.PoolDestroyResp")
-}
-
-func init() { proto.RegisterFile("pool.proto", fileDescriptor_pool_e89282fdb5ae9f98) }
-
-var fileDescriptor_pool_e89282fdb5ae9f98 = []byte{
-	// 275 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcd, 0x4e, 0xeb, 0x30,
-	0x10, 0x85, 0xe5, 0xe6, 0xa7, 0xe9, 0x48, 0xbd, 0x17, 0x8d, 0x2a, 0x64, 0x21, 0x84, 0xa2, 0xac,
-	0xc2, 0x86, 0x0d, 0x8f, 0x00, 0x4b, 0x16, 0xc8, 0x0b, 0xf6, 0x69, 0x6a, 0xaa, 0x8a, 0x24, 0x36,
-	0x1e, 0xbb, 0x52, 0x1e, 0x94, 0xf7, 0x41, 0xb6, 0x1b, 0x12, 0x16, 0xac, 0x32, 0xe7, 0x1b, 0xcd,
-	0xcc, 0x39, 0x31, 0x80, 0x56, 0xaa, 0x7b, 0xd0, 0x46, 0x59, 0x85, 0x69, 0x7f, 0xec, 0x6d, 0xf5,
-	0xc5, 0x60, 0xfb, 0xaa, 0x54, 0xf7, 0x64, 0x64, 0x63, 0xa5, 0x90, 0x9f, 0x78, 0x03, 0x05, 0xb5,
-	0xfd, 0x7e, 0xb4, 0x92, 0x38, 0x2b, 0x59, 0x9d, 0x8a, 0x1f, 0x8d, 0xb7, 0xb0, 0x19, 0xce, 0xbd,
-	0x8c, 0xcd, 0x55, 0x68, 0xce, 0x00, 0x77, 0x90, 0x99, 0x66, 0xf8, 0x20, 0x9e, 0x94, 0xac, 0xde,
-	0x88, 0x28, 0xf0, 0x0e, 0x60, 0x70, 0x3d, 0x9d, 0x5b, 0x23, 0x35, 0xf1, 0xb4, 0x64, 0xf5, 0x56,
-	0x2c, 0x08, 0x22, 0xa4, 0x8e, 0xa4, 0xe1, 0x59, 0x18, 0x0a, 0xb5, 0xbf, 0xe3, 0xbf, 0x47, 0xa3,
-	0x9c, 0xe6, 0x79, 0x68, 0xcc, 0x00, 0xaf, 0x20, 0xa1, 0x91, 0xf8, 0x3a, 0x70, 0x5f, 0x7a, 0xd2,
-	0xb4, 0x1d, 0x2f, 0xca, 0xc4, 0x93, 0xa6, 0xed, 0xaa, 0x37, 0xf8, 0xb7, 0x8c, 0x45, 0x1a, 0xaf,
-	0x21, 0x27, 0xdb, 0x58, 0x17, 0x53, 0x65, 0xe2, 0xa2, 0xc2, 0x7d, 0x77, 0x3a, 0x84, 0x38, 0xfe,
-	0xbe, 0x3b, 0x1d, 0x90, 0xc3, 0x7a, 0x32, 0x1c, 0xb3, 0x4c, 0xb2, 0x7a, 0x89, 0x7b, 0x9f, 0x25,
-	0x59, 0xa3, 0x46, 0xff, 0xbf, 0xa6, 0x79, 0xb6, 0x98, 0xbf, 0x38, 0x5c, 0xcd, 0x0e, 0x77, 0x90,
-	0xbd, 0x2b, 0xd3, 0xca, 0xb0, 0xaf, 0x10, 0x51, 0x54, 0xf7, 0xf0, 0xff, 0xd7, 0xb6, 0xbf, 0x6d,
-	0xee, 0xf3, 0xf0, 0x6a, 0x8f, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe1, 0x68, 0xb8, 0xf6, 0xc3,
-	0x01, 0x00, 0x00,
+	proto.RegisterType((*ListPoolsReq)(nil), "mgmt.ListPoolsReq")
+	proto.RegisterType((*ListPoolsResp)(nil), "mgmt.ListPoolsResp")
+	proto.RegisterType((*ListPoolsResp_Pool)(nil), "mgmt.ListPoolsResp.Pool")
+	proto.RegisterType((*PoolQueryReq)(nil), "mgmt.PoolQueryReq")
+	proto.RegisterType((*StorageUsageStats)(nil), "mgmt.StorageUsageStats")
+	proto.RegisterType((*PoolRebuildStatus)(nil), "mgmt.PoolRebuildStatus")
+	proto.RegisterType((*PoolQueryResp)(nil), "mgmt.PoolQueryResp")
+	proto.RegisterEnum("mgmt.PoolRebuildStatus_State", PoolRebuildStatus_State_name, PoolRebuildStatus_State_value)
+}
+
+func init() { proto.RegisterFile("pool.proto", fileDescriptor_pool_1a415d1c7c1eebf6) }
+
+var fileDescriptor_pool_1a415d1c7c1eebf6 = []byte{
+	// 598 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xdd, 0x6e, 0xd3, 0x4c,
+	0x10, 0xfd, 0x1c, 0xdb, 0x6d, 0x3a, 0xad, 0xdb, 0x74, 0x55, 0x7d, 0xac, 0x2a, 0x40, 0xd1, 0x0a,
+	0xa4, 0x54, 0x48, 0x96, 0x68, 0xdf, 0x00, 0xda, 0x0b, 0xa4, 0x8a, 0x9f, 0x8d, 0x8a, 0xc4, 0xe5,
+	0xc6, 0xde, 0x46, 0x06, 0x3b, 0x1b, 0x76, 0xd7, 0x15, 0xb9, 0xe7, 0xa9, 0xe0, 0x39, 0xb8, 0xe5,
+	0x59, 0xd0, 0xec, 0xda, 0x89, 0x43, 0x9b, 0x48, 0x5c, 0x65, 0x66, 0xf6, 0xcc, 0xcc, 0x99, 0xa3,
+	0x13, 0x03, 0xcc, 0x95, 0x2a, 0xd3, 0xb9, 0x56, 0x56, 0x91, 0xa8, 0x9a, 0x56, 0x96, 0xfd, 0x0a,
+	0x20, 0x79, 0xaf, 0x54, 0xf9, 0x5a, 0x4b, 0x61, 0x25, 0x97, 0x5f, 0xc9, 0x29, 0xf4, 0x4d, 0x56,
+	0x4d, 0x16, 0x56, 0x1a, 0x1a, 0x0c, 0x83, 0x51, 0xc4, 0x97, 0x39, 0x79, 0x0c, 0x7b, 0xb3, 0xbb,
+	0x4a, 0xfa, 0xc7, 0x9e, 0x7b, 0x5c, 0x15, 0xc8, 0x09, 0xc4, 0x5a, 0xcc, 0xbe, 0x18, 0x1a, 0x0e,
+	0x83, 0xd1, 0x1e, 0xf7, 0x09, 0x79, 0x0a, 0x30, 0xab, 0x2b, 0x73, 0x97, 0x69, 0x39, 0x37, 0x34,
+	0x1a, 0x06, 0xa3, 0x84, 0x77, 0x2a, 0x84, 0x40, 0x54, 0x1b, 0xa9, 0x69, 0xec, 0x9a, 0x5c, 0x8c,
+	0x7b, 0xf0, 0x77, 0xaa, 0x55, 0x3d, 0xa7, 0x3b, 0xee, 0x61, 0x55, 0x20, 0x03, 0x08, 0xcd, 0xc2,
+	0xd0, 0x5d, 0x57, 0xc7, 0x10, 0x2b, 0x22, 0x2b, 0x69, 0x7f, 0x18, 0x62, 0x45, 0x64, 0x25, 0xfb,
+	0x08, 0x87, 0xdd, 0xb3, 0xcc, 0x9c, 0xfc, 0x0f, 0x3b, 0xc6, 0x0a, 0x5b, 0xfb, 0xab, 0x62, 0xde,
+	0x64, 0x6e, 0x7f, 0x5d, 0xe4, 0xb4, 0xd7, 0xec, 0xaf, 0x8b, 0x9c, 0x50, 0xd8, 0x6d, 0x09, 0xfb,
+	0x5b, 0xda, 0x94, 0x5d, 0xfb, 0xb9, 0x97, 0xd2, 0x58, 0xad, 0x16, 0xa8, 0x57, 0xdb, 0x1f, 0x74,
+	0xfa, 0x1b, 0x86, 0xbd, 0x15, 0xc3, 0x13, 0x88, 0x6f, 0x95, 0xce, 0xa4, 0x9b, 0xd7, 0xe7, 0x3e,
+	0x61, 0x67, 0x70, 0xb4, 0x36, 0x6d, 0x33, 0x4d, 0x36, 0x84, 0x83, 0xeb, 0xc2, 0x58, 0x84, 0x1b,
+	0x5c, 0xdb, 0xac, 0x08, 0x96, 0x2b, 0xd8, 0xef, 0x00, 0x92, 0x0e, 0x64, 0xcb, 0xc9, 0x29, 0xc4,
+	0x68, 0x04, 0x24, 0x18, 0x8e, 0xf6, 0xcf, 0x69, 0x8a, 0x56, 0x48, 0xd7, 0x7a, 0x53, 0x8c, 0xb8,
+	0x87, 0x9d, 0x7e, 0x0f, 0x20, 0xc2, 0xfc, 0xc1, 0x5b, 0x3b, 0x5a, 0xe1, 0xb8, 0x64, 0xa9, 0xd5,
+	0x9a, 0x93, 0xc2, 0x6d, 0x4e, 0x8a, 0x1e, 0x70, 0x92, 0xd4, 0x5a, 0xb5, 0xa6, 0xf0, 0x09, 0x63,
+	0x70, 0x80, 0x2c, 0x3e, 0xd4, 0x52, 0x6f, 0x52, 0x9e, 0xd5, 0x70, 0x3c, 0xb6, 0x4a, 0x8b, 0xa9,
+	0xbc, 0x31, 0x62, 0x2a, 0xc7, 0x56, 0x58, 0x37, 0xce, 0x2a, 0x2b, 0xca, 0xc6, 0xcf, 0x3e, 0xc1,
+	0xf6, 0x5b, 0x2d, 0x65, 0xe3, 0x63, 0x17, 0xa3, 0xaa, 0x55, 0x31, 0x6b, 0xd8, 0x62, 0xe8, 0x2a,
+	0xe2, 0x5b, 0x43, 0x11, 0x43, 0xec, 0xab, 0xa4, 0x98, 0x39, 0x6e, 0x11, 0x77, 0x31, 0xfb, 0x19,
+	0xc0, 0xb1, 0x53, 0x4c, 0x4e, 0xea, 0xa2, 0xcc, 0xc7, 0x5e, 0xe7, 0x4d, 0xfa, 0x5f, 0x40, 0x8c,
+	0x91, 0x5f, 0x7d, 0x78, 0xfe, 0xc4, 0xeb, 0x7f, 0xaf, 0x3f, 0xc5, 0x1f, 0xc9, 0x3d, 0x16, 0x75,
+	0x56, 0x93, 0xcf, 0x32, 0xb3, 0xad, 0x98, 0x6d, 0x8a, 0x2f, 0x5a, 0x66, 0x4a, 0xe7, 0xad, 0x92,
+	0x6d, 0xca, 0x9e, 0x43, 0xec, 0x66, 0x90, 0x3e, 0x44, 0x6f, 0x2e, 0xaf, 0xaf, 0x06, 0xff, 0x61,
+	0x74, 0xf9, 0xee, 0xed, 0xd5, 0x20, 0xc0, 0xe8, 0xd5, 0xcd, 0xf8, 0xd3, 0xa0, 0xc7, 0x7e, 0xf4,
+	0x20, 0xe9, 0x28, 0xfb, 0x8f, 0x7f, 0x16, 0x06, 0x07, 0x4e, 0x50, 0x2b, 0xf4, 0x54, 0x36, 0xec,
+	0x12, 0xbe, 0x56, 0x23, 0xcf, 0x20, 0x11, 0x99, 0x2d, 0xee, 0x64, 0x0b, 0xf2, 0xdf, 0x81, 0xf5,
+	0x22, 0x19, 0xc1, 0x51, 0x5e, 0x18, 0x31, 0x29, 0x65, 0xde, 0xe2, 0x62, 0x87, 0xfb, 0xbb, 0x4c,
+	0x5e, 0xe2, 0xc9, 0x4e, 0x2a, 0xf7, 0x79, 0xd8, 0x3f, 0x7f, 0xb4, 0x41, 0x43, 0xde, 0xe2, 0xc8,
+	0x19, 0x84, 0x26, 0xab, 0xe8, 0x6e, 0x17, 0x7e, 0xcf, 0x2a, 0x1c, 0x31, 0xe4, 0x05, 0x44, 0xe8,
+	0x45, 0xda, 0xdf, 0x8e, 0x75, 0xa0, 0xc9, 0x8e, 0xfb, 0x9c, 0x5e, 0xfc, 0x19, 0x00, 0x8f, 0xe7,
+	0x32, 0x69, 0x5c, 0x05, 0x00, 0x00,
 }
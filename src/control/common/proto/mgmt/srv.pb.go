@@ -31,7 +31,7 @@ func (m *DaosRank) Reset()         { *m = DaosRank{} }
 func (m *DaosRank) String() string { return proto.CompactTextString(m) }
 func (*DaosRank) ProtoMessage()    {}
 func (*DaosRank) Descriptor() ([]byte, []int) {
-	return fileDescriptor_srv_4a95c7b2f5cb01fb, []int{0}
+	return fileDescriptor_srv_17a51948b3bc6b01, []int{0}
 }
 func (m *DaosRank) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DaosRank.Unmarshal(m, b)
@@ -76,7 +76,7 @@ func (m *DaosResp) Reset()         { *m = DaosResp{} }
 func (m *DaosResp) String() string { return proto.CompactTextString(m) }
 func (*DaosResp) ProtoMessage()    {}
 func (*DaosResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_srv_4a95c7b2f5cb01fb, []int{1}
+	return fileDescriptor_srv_17a51948b3bc6b01, []int{1}
 }
 func (m *DaosResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DaosResp.Unmarshal(m, b)
@@ -114,7 +114,7 @@ func (m *SetRankReq) Reset()         { *m = SetRankReq{} }
 func (m *SetRankReq) String() string { return proto.CompactTextString(m) }
 func (*SetRankReq) ProtoMessage()    {}
 func (*SetRankReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_srv_4a95c7b2f5cb01fb, []int{2}
+	return fileDescriptor_srv_17a51948b3bc6b01, []int{2}
 }
 func (m *SetRankReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SetRankReq.Unmarshal(m, b)
@@ -141,6 +141,44 @@ func (m *SetRankReq) GetRank() uint32 {
 	return 0
 }
 
+type SetLogMasksReq struct {
+	Masks                string   `protobuf:"bytes,1,opt,name=masks,proto3" json:"masks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLogMasksReq) Reset()         { *m = SetLogMasksReq{} }
+func (m *SetLogMasksReq) String() string { return proto.CompactTextString(m) }
+func (*SetLogMasksReq) ProtoMessage()    {}
+func (*SetLogMasksReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_srv_17a51948b3bc6b01, []int{3}
+}
+func (m *SetLogMasksReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLogMasksReq.Unmarshal(m, b)
+}
+func (m *SetLogMasksReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLogMasksReq.Marshal(b, m, deterministic)
+}
+func (dst *SetLogMasksReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogMasksReq.Merge(dst, src)
+}
+func (m *SetLogMasksReq) XXX_Size() int {
+	return xxx_messageInfo_SetLogMasksReq.Size(m)
+}
+func (m *SetLogMasksReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogMasksReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogMasksReq proto.InternalMessageInfo
+
+func (m *SetLogMasksReq) GetMasks() string {
+	if m != nil {
+		return m.Masks
+	}
+	return ""
+}
+
 type CreateMsReq struct {
 	Bootstrap bool `protobuf:"varint,1,opt,name=bootstrap,proto3" json:"bootstrap,omitempty"`
 	// Server UUID of this MS replica.
@@ -156,7 +194,7 @@ func (m *CreateMsReq) Reset()         { *m = CreateMsReq{} }
 func (m *CreateMsReq) String() string { return proto.CompactTextString(m) }
 func (*CreateMsReq) ProtoMessage()    {}
 func (*CreateMsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_srv_4a95c7b2f5cb01fb, []int{3}
+	return fileDescriptor_srv_17a51948b3bc6b01, []int{4}
 }
 func (m *CreateMsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateMsReq.Unmarshal(m, b)
@@ -201,23 +239,26 @@ func init() {
 	proto.RegisterType((*DaosRank)(nil), "mgmt.DaosRank")
 	proto.RegisterType((*DaosResp)(nil), "mgmt.DaosResp")
 	proto.RegisterType((*SetRankReq)(nil), "mgmt.SetRankReq")
+	proto.RegisterType((*SetLogMasksReq)(nil), "mgmt.SetLogMasksReq")
 	proto.RegisterType((*CreateMsReq)(nil), "mgmt.CreateMsReq")
 }
 
-func init() { proto.RegisterFile("srv.proto", fileDescriptor_srv_4a95c7b2f5cb01fb) }
-
-var fileDescriptor_srv_4a95c7b2f5cb01fb = []byte{
-	// 187 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x8f, 0x31, 0x0f, 0xc2, 0x20,
-	0x10, 0x85, 0x43, 0xad, 0x4d, 0x39, 0xe3, 0xc2, 0x60, 0x9a, 0xe8, 0xd0, 0x30, 0x75, 0x72, 0x71,
-	0x74, 0xd4, 0xd5, 0x85, 0xc6, 0xd9, 0xd0, 0x40, 0x4c, 0x53, 0x5b, 0x10, 0x0e, 0x7f, 0xbf, 0x01,
-	0x1b, 0x75, 0x7b, 0x7c, 0xb9, 0xf7, 0xe5, 0x01, 0xd4, 0xbb, 0xd7, 0xde, 0x3a, 0x83, 0x86, 0xe5,
-	0xe3, 0x7d, 0x44, 0x7e, 0x84, 0xf2, 0x2c, 0x8d, 0x17, 0x72, 0x1a, 0xd8, 0x16, 0xa8, 0x35, 0xe6,
-	0x71, 0x0b, 0xa1, 0x57, 0x15, 0xa9, 0x49, 0x43, 0x45, 0x19, 0xc1, 0x35, 0xf4, 0x8a, 0x31, 0xc8,
-	0x9d, 0x9c, 0x86, 0x2a, 0xab, 0x49, 0xb3, 0x16, 0x29, 0x73, 0x3e, 0x97, 0xb5, 0xb7, 0x6c, 0x03,
-	0x85, 0x47, 0x89, 0xc1, 0xa7, 0xe6, 0x52, 0xcc, 0x2f, 0x5e, 0x03, 0xb4, 0x1a, 0xa3, 0x5f, 0xe8,
-	0xe7, 0xd7, 0x42, 0xfe, 0x2c, 0x2d, 0xac, 0x4e, 0x4e, 0x4b, 0xd4, 0x17, 0x1f, 0x4f, 0x76, 0x40,
-	0x3b, 0x63, 0xd0, 0xa3, 0x93, 0x36, 0xdd, 0x95, 0xe2, 0x07, 0xa2, 0x20, 0xcd, 0xcb, 0xd2, 0xbc,
-	0x94, 0x23, 0x93, 0x4a, 0xb9, 0x6a, 0xf1, 0x61, 0x31, 0x77, 0x45, 0xfa, 0xe4, 0xe1, 0x1d, 0x00,
-	0x00, 0xff, 0xff, 0x26, 0x65, 0x14, 0x6d, 0xf1, 0x00, 0x00, 0x00,
+func init() { proto.RegisterFile("srv.proto", fileDescriptor_srv_17a51948b3bc6b01) }
+
+var fileDescriptor_srv_17a51948b3bc6b01 = []byte{
+	// 210 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xbf, 0x4b, 0x04, 0x31,
+	0x10, 0x85, 0xd9, 0xf3, 0xee, 0xd8, 0x8c, 0x68, 0x11, 0x44, 0x16, 0xb4, 0x58, 0x52, 0xc8, 0x55,
+	0x36, 0x96, 0x96, 0x5a, 0x7a, 0x4d, 0x16, 0x6b, 0x99, 0x23, 0xe1, 0x38, 0xd6, 0xbd, 0x89, 0x99,
+	0x89, 0x7f, 0xbf, 0x64, 0x76, 0x51, 0xbb, 0x37, 0x1f, 0x6f, 0xbe, 0xfc, 0x00, 0xc3, 0xf9, 0xfb,
+	0x31, 0x65, 0x12, 0xb2, 0xeb, 0xe9, 0x38, 0x89, 0x7b, 0x86, 0xf6, 0x15, 0x89, 0x3d, 0x9e, 0x47,
+	0x7b, 0x07, 0x26, 0x11, 0x7d, 0x7e, 0x94, 0x72, 0x0a, 0x5d, 0xd3, 0x37, 0x3b, 0xe3, 0xdb, 0x0a,
+	0xde, 0xcb, 0x29, 0x58, 0x0b, 0xeb, 0x8c, 0xe7, 0xb1, 0x5b, 0xf5, 0xcd, 0xee, 0xca, 0x6b, 0x76,
+	0x6e, 0x59, 0x8e, 0x9c, 0xec, 0x2d, 0x6c, 0x59, 0x50, 0x0a, 0xeb, 0xe6, 0xc6, 0x2f, 0x93, 0xeb,
+	0x01, 0x86, 0x28, 0xd5, 0xef, 0xe3, 0xd7, 0xaf, 0xa5, 0xf9, 0x67, 0x79, 0x80, 0xeb, 0x21, 0xca,
+	0x1b, 0x1d, 0xf7, 0xc8, 0x23, 0xd7, 0xd6, 0x0d, 0x6c, 0xa6, 0x9a, 0x97, 0x4b, 0xcc, 0x83, 0x1b,
+	0xe0, 0xf2, 0x25, 0x47, 0x94, 0xb8, 0xd7, 0xd2, 0x3d, 0x98, 0x03, 0x91, 0xb0, 0x64, 0x4c, 0x5a,
+	0x6c, 0xfd, 0x1f, 0xa8, 0x07, 0xe9, 0x33, 0x56, 0x6a, 0xd0, 0x5c, 0x19, 0x86, 0x90, 0xbb, 0x8b,
+	0x99, 0xd5, 0x7c, 0xd8, 0xea, 0x67, 0x3c, 0xfd, 0x0c, 0x00, 0x1c, 0x24, 0x71, 0xb3, 0x19, 0x01,
+	0x00, 0x00,
 }
@@ -30,7 +30,7 @@ func (m *StoragePrepareReq) Reset()         { *m = StoragePrepareReq{} }
 func (m *StoragePrepareReq) String() string { return proto.CompactTextString(m) }
 func (*StoragePrepareReq) ProtoMessage()    {}
 func (*StoragePrepareReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_a5934919a5b6df32, []int{0}
+	return fileDescriptor_storage_4ef910f2492d726a, []int{0}
 }
 func (m *StoragePrepareReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StoragePrepareReq.Unmarshal(m, b)
@@ -76,7 +76,7 @@ func (m *StoragePrepareResp) Reset()         { *m = StoragePrepareResp{} }
 func (m *StoragePrepareResp) String() string { return proto.CompactTextString(m) }
 func (*StoragePrepareResp) ProtoMessage()    {}
 func (*StoragePrepareResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_a5934919a5b6df32, []int{1}
+	return fileDescriptor_storage_4ef910f2492d726a, []int{1}
 }
 func (m *StoragePrepareResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StoragePrepareResp.Unmarshal(m, b)
@@ -122,7 +122,7 @@ func (m *StorageScanReq) Reset()         { *m = StorageScanReq{} }
 func (m *StorageScanReq) String() string { return proto.CompactTextString(m) }
 func (*StorageScanReq) ProtoMessage()    {}
 func (*StorageScanReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_a5934919a5b6df32, []int{2}
+	return fileDescriptor_storage_4ef910f2492d726a, []int{2}
 }
 func (m *StorageScanReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageScanReq.Unmarshal(m, b)
@@ -168,7 +168,7 @@ func (m *StorageScanResp) Reset()         { *m = StorageScanResp{} }
 func (m *StorageScanResp) String() string { return proto.CompactTextString(m) }
 func (*StorageScanResp) ProtoMessage()    {}
 func (*StorageScanResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_a5934919a5b6df32, []int{3}
+	return fileDescriptor_storage_4ef910f2492d726a, []int{3}
 }
 func (m *StorageScanResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageScanResp.Unmarshal(m, b)
@@ -214,7 +214,7 @@ func (m *StorageFormatReq) Reset()         { *m = StorageFormatReq{} }
 func (m *StorageFormatReq) String() string { return proto.CompactTextString(m) }
 func (*StorageFormatReq) ProtoMessage()    {}
 func (*StorageFormatReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_a5934919a5b6df32, []int{4}
+	return fileDescriptor_storage_4ef910f2492d726a, []int{4}
 }
 func (m *StorageFormatReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageFormatReq.Unmarshal(m, b)
@@ -260,7 +260,7 @@ func (m *StorageFormatResp) Reset()         { *m = StorageFormatResp{} }
 func (m *StorageFormatResp) String() string { return proto.CompactTextString(m) }
 func (*StorageFormatResp) ProtoMessage()    {}
 func (*StorageFormatResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_a5934919a5b6df32, []int{5}
+	return fileDescriptor_storage_4ef910f2492d726a, []int{5}
 }
 func (m *StorageFormatResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageFormatResp.Unmarshal(m, b)
@@ -306,7 +306,7 @@ func (m *StorageUpdateReq) Reset()         { *m = StorageUpdateReq{} }
 func (m *StorageUpdateReq) String() string { return proto.CompactTextString(m) }
 func (*StorageUpdateReq) ProtoMessage()    {}
 func (*StorageUpdateReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_a5934919a5b6df32, []int{6}
+	return fileDescriptor_storage_4ef910f2492d726a, []int{6}
 }
 func (m *StorageUpdateReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageUpdateReq.Unmarshal(m, b)
@@ -352,7 +352,7 @@ func (m *StorageUpdateResp) Reset()         { *m = StorageUpdateResp{} }
 func (m *StorageUpdateResp) String() string { return proto.CompactTextString(m) }
 func (*StorageUpdateResp) ProtoMessage()    {}
 func (*StorageUpdateResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_a5934919a5b6df32, []int{7}
+	return fileDescriptor_storage_4ef910f2492d726a, []int{7}
 }
 func (m *StorageUpdateResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageUpdateResp.Unmarshal(m, b)
@@ -398,7 +398,7 @@ func (m *StorageBurnInReq) Reset()         { *m = StorageBurnInReq{} }
 func (m *StorageBurnInReq) String() string { return proto.CompactTextString(m) }
 func (*StorageBurnInReq) ProtoMessage()    {}
 func (*StorageBurnInReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_a5934919a5b6df32, []int{8}
+	return fileDescriptor_storage_4ef910f2492d726a, []int{8}
 }
 func (m *StorageBurnInReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageBurnInReq.Unmarshal(m, b)
@@ -432,9 +432,223 @@ func (m *StorageBurnInReq) GetScm() *BurninScmReq {
 	return nil
 }
 
+// BurnInProgress is a periodic status line reported by fio during burn-in.
+type BurnInProgress struct {
+	Device               string   `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Status               string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BurnInProgress) Reset()         { *m = BurnInProgress{} }
+func (m *BurnInProgress) String() string { return proto.CompactTextString(m) }
+func (*BurnInProgress) ProtoMessage()    {}
+func (*BurnInProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_4ef910f2492d726a, []int{9}
+}
+func (m *BurnInProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BurnInProgress.Unmarshal(m, b)
+}
+func (m *BurnInProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BurnInProgress.Marshal(b, m, deterministic)
+}
+func (dst *BurnInProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnInProgress.Merge(dst, src)
+}
+func (m *BurnInProgress) XXX_Size() int {
+	return xxx_messageInfo_BurnInProgress.Size(m)
+}
+func (m *BurnInProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnInProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnInProgress proto.InternalMessageInfo
+
+func (m *BurnInProgress) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+func (m *BurnInProgress) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+// LatencyPercentile is the completion latency at a given percentile.
+type LatencyPercentile struct {
+	Percentile           float64  `protobuf:"fixed64,1,opt,name=percentile,proto3" json:"percentile,omitempty"`
+	Nsec                 uint64   `protobuf:"varint,2,opt,name=nsec,proto3" json:"nsec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LatencyPercentile) Reset()         { *m = LatencyPercentile{} }
+func (m *LatencyPercentile) String() string { return proto.CompactTextString(m) }
+func (*LatencyPercentile) ProtoMessage()    {}
+func (*LatencyPercentile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_4ef910f2492d726a, []int{10}
+}
+func (m *LatencyPercentile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LatencyPercentile.Unmarshal(m, b)
+}
+func (m *LatencyPercentile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LatencyPercentile.Marshal(b, m, deterministic)
+}
+func (dst *LatencyPercentile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LatencyPercentile.Merge(dst, src)
+}
+func (m *LatencyPercentile) XXX_Size() int {
+	return xxx_messageInfo_LatencyPercentile.Size(m)
+}
+func (m *LatencyPercentile) XXX_DiscardUnknown() {
+	xxx_messageInfo_LatencyPercentile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LatencyPercentile proto.InternalMessageInfo
+
+func (m *LatencyPercentile) GetPercentile() float64 {
+	if m != nil {
+		return m.Percentile
+	}
+	return 0
+}
+
+func (m *LatencyPercentile) GetNsec() uint64 {
+	if m != nil {
+		return m.Nsec
+	}
+	return 0
+}
+
+// BurnInStats are the aggregated fio job statistics for one I/O direction.
+type BurnInStats struct {
+	Iops                 float64              `protobuf:"fixed64,1,opt,name=iops,proto3" json:"iops,omitempty"`
+	Bw                   uint64               `protobuf:"varint,2,opt,name=bw,proto3" json:"bw,omitempty"`
+	Latency              []*LatencyPercentile `protobuf:"bytes,3,rep,name=latency,proto3" json:"latency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BurnInStats) Reset()         { *m = BurnInStats{} }
+func (m *BurnInStats) String() string { return proto.CompactTextString(m) }
+func (*BurnInStats) ProtoMessage()    {}
+func (*BurnInStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_4ef910f2492d726a, []int{11}
+}
+func (m *BurnInStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BurnInStats.Unmarshal(m, b)
+}
+func (m *BurnInStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BurnInStats.Marshal(b, m, deterministic)
+}
+func (dst *BurnInStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnInStats.Merge(dst, src)
+}
+func (m *BurnInStats) XXX_Size() int {
+	return xxx_messageInfo_BurnInStats.Size(m)
+}
+func (m *BurnInStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnInStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnInStats proto.InternalMessageInfo
+
+func (m *BurnInStats) GetIops() float64 {
+	if m != nil {
+		return m.Iops
+	}
+	return 0
+}
+
+func (m *BurnInStats) GetBw() uint64 {
+	if m != nil {
+		return m.Bw
+	}
+	return 0
+}
+
+func (m *BurnInStats) GetLatency() []*LatencyPercentile {
+	if m != nil {
+		return m.Latency
+	}
+	return nil
+}
+
+// BurnInResult contains the parsed fio results for a single device.
+type BurnInResult struct {
+	Device               string       `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Read                 *BurnInStats `protobuf:"bytes,2,opt,name=read,proto3" json:"read,omitempty"`
+	Write                *BurnInStats `protobuf:"bytes,3,opt,name=write,proto3" json:"write,omitempty"`
+	Errors               uint64       `protobuf:"varint,4,opt,name=errors,proto3" json:"errors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *BurnInResult) Reset()         { *m = BurnInResult{} }
+func (m *BurnInResult) String() string { return proto.CompactTextString(m) }
+func (*BurnInResult) ProtoMessage()    {}
+func (*BurnInResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_storage_4ef910f2492d726a, []int{12}
+}
+func (m *BurnInResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BurnInResult.Unmarshal(m, b)
+}
+func (m *BurnInResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BurnInResult.Marshal(b, m, deterministic)
+}
+func (dst *BurnInResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnInResult.Merge(dst, src)
+}
+func (m *BurnInResult) XXX_Size() int {
+	return xxx_messageInfo_BurnInResult.Size(m)
+}
+func (m *BurnInResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnInResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnInResult proto.InternalMessageInfo
+
+func (m *BurnInResult) GetDevice() string {
+	if m != nil {
+		return m.Device
+	}
+	return ""
+}
+
+func (m *BurnInResult) GetRead() *BurnInStats {
+	if m != nil {
+		return m.Read
+	}
+	return nil
+}
+
+func (m *BurnInResult) GetWrite() *BurnInStats {
+	if m != nil {
+		return m.Write
+	}
+	return nil
+}
+
+func (m *BurnInResult) GetErrors() uint64 {
+	if m != nil {
+		return m.Errors
+	}
+	return 0
+}
+
 type StorageBurnInResp struct {
 	Crets                []*NvmeControllerResult `protobuf:"bytes,1,rep,name=crets,proto3" json:"crets,omitempty"`
 	Mrets                []*ScmMountResult       `protobuf:"bytes,2,rep,name=mrets,proto3" json:"mrets,omitempty"`
+	Progress             *BurnInProgress         `protobuf:"bytes,3,opt,name=progress,proto3" json:"progress,omitempty"`
+	Results              []*BurnInResult         `protobuf:"bytes,4,rep,name=results,proto3" json:"results,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -444,7 +658,7 @@ func (m *StorageBurnInResp) Reset()         { *m = StorageBurnInResp{} }
 func (m *StorageBurnInResp) String() string { return proto.CompactTextString(m) }
 func (*StorageBurnInResp) ProtoMessage()    {}
 func (*StorageBurnInResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_a5934919a5b6df32, []int{9}
+	return fileDescriptor_storage_4ef910f2492d726a, []int{13}
 }
 func (m *StorageBurnInResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StorageBurnInResp.Unmarshal(m, b)
@@ -478,6 +692,20 @@ func (m *StorageBurnInResp) GetMrets() []*ScmMountResult {
 	return nil
 }
 
+func (m *StorageBurnInResp) GetProgress() *BurnInProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+func (m *StorageBurnInResp) GetResults() []*BurnInResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StoragePrepareReq)(nil), "mgmt.StoragePrepareReq")
 	proto.RegisterType((*StoragePrepareResp)(nil), "mgmt.StoragePrepareResp")
@@ -488,34 +716,51 @@ func init() {
 	proto.RegisterType((*StorageUpdateReq)(nil), "mgmt.StorageUpdateReq")
 	proto.RegisterType((*StorageUpdateResp)(nil), "mgmt.StorageUpdateResp")
 	proto.RegisterType((*StorageBurnInReq)(nil), "mgmt.StorageBurnInReq")
+	proto.RegisterType((*BurnInProgress)(nil), "mgmt.BurnInProgress")
+	proto.RegisterType((*LatencyPercentile)(nil), "mgmt.LatencyPercentile")
+	proto.RegisterType((*BurnInStats)(nil), "mgmt.BurnInStats")
+	proto.RegisterType((*BurnInResult)(nil), "mgmt.BurnInResult")
 	proto.RegisterType((*StorageBurnInResp)(nil), "mgmt.StorageBurnInResp")
 }
 
-func init() { proto.RegisterFile("storage.proto", fileDescriptor_storage_a5934919a5b6df32) }
-
-var fileDescriptor_storage_a5934919a5b6df32 = []byte{
-	// 365 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x5d, 0x4b, 0xf3, 0x30,
-	0x18, 0x86, 0xd9, 0xd7, 0x7b, 0x90, 0xf1, 0xea, 0x1a, 0x37, 0x18, 0x3b, 0x1a, 0xd3, 0xcd, 0xa9,
-	0x30, 0x64, 0xfe, 0x03, 0x05, 0xc1, 0x03, 0x45, 0x3a, 0x3c, 0x12, 0x94, 0xd8, 0x85, 0x21, 0x34,
-	0x1f, 0x4b, 0xd2, 0xfd, 0x7e, 0xc9, 0x57, 0x4d, 0xea, 0xca, 0x40, 0xf0, 0x34, 0xcf, 0xfd, 0xdc,
-	0x57, 0xae, 0x06, 0x0a, 0xfe, 0x4b, 0xc5, 0x04, 0xda, 0xe0, 0x05, 0x17, 0x4c, 0x31, 0xd8, 0x26,
-	0x1b, 0xa2, 0x46, 0xd0, 0x1d, 0xbe, 0xd3, 0x1d, 0x71, 0x93, 0x51, 0xe2, 0xcf, 0x64, 0x46, 0xec,
-	0xd1, 0x64, 0x0d, 0x92, 0x95, 0x3d, 0x7c, 0x16, 0x98, 0x23, 0x81, 0x53, 0xbc, 0x85, 0x73, 0xd0,
-	0xd6, 0x5b, 0xc3, 0xc6, 0xb8, 0x31, 0xef, 0x2e, 0xfb, 0x0b, 0x5d, 0xb8, 0x70, 0xf3, 0xa7, 0x1d,
-	0xd1, 0x99, 0xd4, 0x24, 0xe0, 0x14, 0xb4, 0x64, 0x46, 0x86, 0x4d, 0x13, 0x3c, 0x89, 0x82, 0xab,
-	0x8c, 0xe8, 0x9c, 0x9e, 0x4f, 0x36, 0x00, 0x56, 0x29, 0x92, 0xc3, 0x8b, 0x08, 0x33, 0xd8, 0x83,
-	0x91, 0xdc, 0x71, 0x66, 0x21, 0xa7, 0xff, 0x93, 0x23, 0xb9, 0x05, 0xbd, 0x82, 0x23, 0x07, 0x5a,
-	0x65, 0x88, 0x6a, 0x97, 0x69, 0x04, 0x49, 0xec, 0xaa, 0x1e, 0xc6, 0x22, 0x93, 0x10, 0xd0, 0xfb,
-	0x4e, 0x85, 0x16, 0x6f, 0xe0, 0x38, 0x2a, 0x97, 0x1c, 0xce, 0xa2, 0x76, 0x58, 0x6d, 0x2f, 0xef,
-	0x7f, 0x1a, 0xd6, 0x27, 0x95, 0x7a, 0x7f, 0x79, 0x04, 0x7a, 0xae, 0xff, 0x9e, 0x09, 0x82, 0x94,
-	0xbe, 0xfe, 0x79, 0x04, 0x70, 0x5f, 0xd8, 0x8e, 0x63, 0x81, 0xb3, 0x90, 0x00, 0xc3, 0x5c, 0xa8,
-	0xb0, 0x2d, 0x9f, 0xdb, 0x23, 0x24, 0x87, 0xd7, 0xa0, 0x93, 0x09, 0xac, 0xe4, 0xb0, 0x31, 0x6e,
-	0xcd, 0xbb, 0xcb, 0x91, 0x5d, 0xd6, 0xf5, 0x77, 0x8c, 0x2a, 0xc1, 0xf2, 0x1c, 0x8b, 0x14, 0xcb,
-	0x22, 0x57, 0xa9, 0x0d, 0xc2, 0x4b, 0xd0, 0x21, 0x66, 0xa3, 0x69, 0x36, 0xfa, 0x5e, 0x88, 0x3c,
-	0xb2, 0x82, 0x2a, 0x9f, 0x35, 0x91, 0xc0, 0xea, 0x85, 0xaf, 0x91, 0xc2, 0xb5, 0x56, 0x76, 0x7c,
-	0xd8, 0xca, 0xe6, 0x42, 0x2b, 0x51, 0x5a, 0x79, 0xc4, 0xaf, 0xac, 0xae, 0x62, 0xab, 0x41, 0x60,
-	0xb5, 0x2e, 0x72, 0x5c, 0xa7, 0x75, 0x5b, 0x08, 0xfa, 0x40, 0x6b, 0xb5, 0xf4, 0xf8, 0x93, 0x1e,
-	0xd6, 0xb2, 0xb9, 0xfd, 0x8f, 0xe5, 0x11, 0x7f, 0xfd, 0x58, 0x1f, 0xff, 0xcc, 0x5f, 0xe1, 0xe6,
-	0x2b, 0x00, 0x00, 0xff, 0xff, 0xb0, 0xd2, 0xdf, 0xd4, 0x53, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("storage.proto", fileDescriptor_storage_4ef910f2492d726a) }
+
+var fileDescriptor_storage_4ef910f2492d726a = []byte{
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xeb, 0x6a, 0x13, 0x41,
+	0x14, 0x66, 0xb3, 0xdb, 0xd6, 0x9e, 0x68, 0x6c, 0x8e, 0xa9, 0x2e, 0xf9, 0x21, 0x61, 0x35, 0x6d,
+	0xbc, 0x10, 0x6a, 0x7d, 0x01, 0x51, 0x50, 0x04, 0x95, 0x30, 0xc1, 0x5f, 0x82, 0xb2, 0xd9, 0x1d,
+	0x42, 0x60, 0x2f, 0xd3, 0x99, 0x49, 0x82, 0x2f, 0xe1, 0xbb, 0xf9, 0x46, 0x32, 0x97, 0xdd, 0xce,
+	0xa4, 0x86, 0x82, 0xe0, 0xbf, 0xcc, 0x39, 0xdf, 0xf9, 0x2e, 0x67, 0x66, 0x03, 0xf7, 0x84, 0xac,
+	0x79, 0xba, 0xa4, 0x53, 0xc6, 0x6b, 0x59, 0x63, 0x54, 0x2e, 0x4b, 0x39, 0x44, 0x5b, 0xfc, 0x51,
+	0x6d, 0x4a, 0xdb, 0x19, 0xf6, 0x9b, 0x9a, 0xc8, 0x4a, 0x53, 0x4a, 0x72, 0xe8, 0xcf, 0x4d, 0x71,
+	0xc6, 0x29, 0x4b, 0x39, 0x25, 0xf4, 0x0a, 0x27, 0x10, 0xa9, 0xa9, 0x38, 0x18, 0x05, 0x93, 0xee,
+	0xe5, 0x60, 0xaa, 0x08, 0xa7, 0xb6, 0xff, 0x65, 0x53, 0x2a, 0x0c, 0xd1, 0x08, 0x1c, 0x43, 0x28,
+	0xb2, 0x32, 0xee, 0x68, 0xe0, 0x03, 0x0f, 0x38, 0xcf, 0x4a, 0x85, 0x53, 0xfd, 0x64, 0x09, 0xb8,
+	0xab, 0x22, 0x18, 0x3e, 0xf3, 0x64, 0x4e, 0xff, 0x22, 0x23, 0x98, 0xd5, 0x39, 0x73, 0x75, 0x06,
+	0x37, 0x75, 0x04, 0x33, 0x42, 0xdf, 0xa0, 0x67, 0x85, 0xe6, 0x59, 0x5a, 0xa9, 0x2c, 0x63, 0x4f,
+	0xa4, 0x6f, 0x46, 0x55, 0xd3, 0x0f, 0x92, 0xb8, 0x02, 0x27, 0xd7, 0x28, 0x37, 0xc5, 0x77, 0xb8,
+	0xef, 0x91, 0x0b, 0x86, 0x67, 0x1e, 0x3b, 0xee, 0xb2, 0xb7, 0xfe, 0x9f, 0xb8, 0xf4, 0xfd, 0x1d,
+	0xfa, 0xc6, 0x7c, 0x0a, 0x27, 0x96, 0xff, 0x7d, 0xcd, 0xcb, 0x54, 0x2a, 0xfb, 0xe7, 0x9e, 0x80,
+	0xdd, 0xb0, 0x69, 0xfb, 0x01, 0x9e, 0xba, 0x0a, 0xe8, 0xe2, 0xdc, 0x08, 0x57, 0xed, 0x75, 0x37,
+	0x12, 0x82, 0xe1, 0x05, 0x1c, 0x64, 0x9c, 0x4a, 0x11, 0x07, 0xa3, 0x70, 0xd2, 0xbd, 0x1c, 0x9a,
+	0x61, 0x45, 0xff, 0xae, 0xae, 0x24, 0xaf, 0x8b, 0x82, 0x72, 0x42, 0xc5, 0xba, 0x90, 0xc4, 0x00,
+	0xf1, 0x39, 0x1c, 0x94, 0x7a, 0xa2, 0x33, 0x0a, 0xaf, 0x2f, 0x64, 0x9e, 0x95, 0x9f, 0xeb, 0x75,
+	0x25, 0x1b, 0xac, 0x86, 0x38, 0xa9, 0xbe, 0xb2, 0x3c, 0x95, 0x74, 0x6f, 0x2a, 0xd3, 0xbe, 0x3d,
+	0x95, 0xc1, 0xb9, 0xa9, 0x78, 0x9b, 0xaa, 0x91, 0xf8, 0xa7, 0x54, 0x2f, 0xfc, 0x54, 0xa7, 0x4e,
+	0xaa, 0x7c, 0x5d, 0xd0, 0x7d, 0xb1, 0xde, 0xae, 0x79, 0xf5, 0xb1, 0xda, 0x1b, 0x4b, 0xb5, 0x57,
+	0xd5, 0xed, 0xb1, 0x0c, 0xce, 0x8d, 0xf5, 0x06, 0x7a, 0x86, 0x7b, 0xc6, 0xeb, 0x25, 0xa7, 0x42,
+	0xe0, 0x43, 0x38, 0xcc, 0xe9, 0x66, 0x95, 0x19, 0x89, 0x63, 0x62, 0x4f, 0xaa, 0x2e, 0x64, 0x2a,
+	0xd7, 0x42, 0x53, 0x1e, 0x13, 0x7b, 0x4a, 0x3e, 0x40, 0xff, 0x53, 0x2a, 0x69, 0x95, 0xfd, 0x9c,
+	0x51, 0x9e, 0xd1, 0x4a, 0xae, 0x0a, 0x8a, 0x8f, 0x01, 0x58, 0x7b, 0xd2, 0x44, 0x01, 0x71, 0x2a,
+	0x88, 0x10, 0x55, 0x82, 0x66, 0x9a, 0x2a, 0x22, 0xfa, 0x77, 0x92, 0x43, 0xd7, 0x58, 0x99, 0xcb,
+	0x54, 0x0a, 0x05, 0x59, 0xd5, 0x4c, 0xd8, 0x61, 0xfd, 0x1b, 0x7b, 0xd0, 0x59, 0x6c, 0xed, 0x50,
+	0x67, 0xb1, 0xc5, 0x57, 0x70, 0x54, 0x18, 0xed, 0x38, 0xd4, 0xfb, 0x7c, 0x64, 0x72, 0xde, 0x30,
+	0x44, 0x1a, 0x5c, 0xf2, 0x2b, 0x80, 0xbb, 0xcd, 0x36, 0xd5, 0xae, 0xf7, 0xe6, 0x1d, 0x43, 0xc4,
+	0x69, 0x9a, 0xfb, 0xdf, 0x93, 0x63, 0x90, 0xe8, 0x36, 0x9e, 0xc3, 0xc1, 0x96, 0xaf, 0x24, 0x8d,
+	0xc3, 0x7d, 0x38, 0xd3, 0x57, 0x3a, 0x94, 0xf3, 0x9a, 0x8b, 0x38, 0xd2, 0xfe, 0xed, 0x29, 0xf9,
+	0x1d, 0xb4, 0x2f, 0xab, 0xf5, 0xf5, 0x9f, 0xbf, 0x17, 0xbc, 0x80, 0x3b, 0xcc, 0xde, 0xb7, 0xf5,
+	0x3d, 0x70, 0x7d, 0x37, 0x6f, 0x81, 0xb4, 0x28, 0x7c, 0x09, 0x47, 0x5c, 0x53, 0x28, 0xfb, 0xa1,
+	0xff, 0xa2, 0x9a, 0x55, 0x92, 0x06, 0xb2, 0x38, 0xd4, 0x7f, 0xfc, 0xaf, 0xff, 0x0c, 0x00, 0x09,
+	0x19, 0x49, 0x1b, 0x36, 0x06, 0x00, 0x00,
 }
@@ -36,7 +36,7 @@ func (m *NvmeController) Reset()         { *m = NvmeController{} }
 func (m *NvmeController) String() string { return proto.CompactTextString(m) }
 func (*NvmeController) ProtoMessage()    {}
 func (*NvmeController) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_nvme_28ffae7e534c51fd, []int{0}
+	return fileDescriptor_storage_nvme_fefe8123b8e0ad88, []int{0}
 }
 func (m *NvmeController) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NvmeController.Unmarshal(m, b)
@@ -118,7 +118,7 @@ func (m *NvmeController_Namespace) Reset()         { *m = NvmeController_Namespa
 func (m *NvmeController_Namespace) String() string { return proto.CompactTextString(m) }
 func (*NvmeController_Namespace) ProtoMessage()    {}
 func (*NvmeController_Namespace) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_nvme_28ffae7e534c51fd, []int{0, 0}
+	return fileDescriptor_storage_nvme_fefe8123b8e0ad88, []int{0, 0}
 }
 func (m *NvmeController_Namespace) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NvmeController_Namespace.Unmarshal(m, b)
@@ -177,7 +177,7 @@ func (m *NvmeController_Health) Reset()         { *m = NvmeController_Health{} }
 func (m *NvmeController_Health) String() string { return proto.CompactTextString(m) }
 func (*NvmeController_Health) ProtoMessage()    {}
 func (*NvmeController_Health) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_nvme_28ffae7e534c51fd, []int{0, 1}
+	return fileDescriptor_storage_nvme_fefe8123b8e0ad88, []int{0, 1}
 }
 func (m *NvmeController_Health) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NvmeController_Health.Unmarshal(m, b)
@@ -308,7 +308,7 @@ func (m *NvmeControllerResult) Reset()         { *m = NvmeControllerResult{} }
 func (m *NvmeControllerResult) String() string { return proto.CompactTextString(m) }
 func (*NvmeControllerResult) ProtoMessage()    {}
 func (*NvmeControllerResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_nvme_28ffae7e534c51fd, []int{1}
+	return fileDescriptor_storage_nvme_fefe8123b8e0ad88, []int{1}
 }
 func (m *NvmeControllerResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NvmeControllerResult.Unmarshal(m, b)
@@ -356,7 +356,7 @@ func (m *PrepareNvmeReq) Reset()         { *m = PrepareNvmeReq{} }
 func (m *PrepareNvmeReq) String() string { return proto.CompactTextString(m) }
 func (*PrepareNvmeReq) ProtoMessage()    {}
 func (*PrepareNvmeReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_nvme_28ffae7e534c51fd, []int{2}
+	return fileDescriptor_storage_nvme_fefe8123b8e0ad88, []int{2}
 }
 func (m *PrepareNvmeReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PrepareNvmeReq.Unmarshal(m, b)
@@ -415,7 +415,7 @@ func (m *PrepareNvmeResp) Reset()         { *m = PrepareNvmeResp{} }
 func (m *PrepareNvmeResp) String() string { return proto.CompactTextString(m) }
 func (*PrepareNvmeResp) ProtoMessage()    {}
 func (*PrepareNvmeResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_nvme_28ffae7e534c51fd, []int{3}
+	return fileDescriptor_storage_nvme_fefe8123b8e0ad88, []int{3}
 }
 func (m *PrepareNvmeResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PrepareNvmeResp.Unmarshal(m, b)
@@ -452,7 +452,7 @@ func (m *ScanNvmeReq) Reset()         { *m = ScanNvmeReq{} }
 func (m *ScanNvmeReq) String() string { return proto.CompactTextString(m) }
 func (*ScanNvmeReq) ProtoMessage()    {}
 func (*ScanNvmeReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_nvme_28ffae7e534c51fd, []int{4}
+	return fileDescriptor_storage_nvme_fefe8123b8e0ad88, []int{4}
 }
 func (m *ScanNvmeReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ScanNvmeReq.Unmarshal(m, b)
@@ -484,7 +484,7 @@ func (m *ScanNvmeResp) Reset()         { *m = ScanNvmeResp{} }
 func (m *ScanNvmeResp) String() string { return proto.CompactTextString(m) }
 func (*ScanNvmeResp) ProtoMessage()    {}
 func (*ScanNvmeResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_nvme_28ffae7e534c51fd, []int{5}
+	return fileDescriptor_storage_nvme_fefe8123b8e0ad88, []int{5}
 }
 func (m *ScanNvmeResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ScanNvmeResp.Unmarshal(m, b)
@@ -528,7 +528,7 @@ func (m *FormatNvmeReq) Reset()         { *m = FormatNvmeReq{} }
 func (m *FormatNvmeReq) String() string { return proto.CompactTextString(m) }
 func (*FormatNvmeReq) ProtoMessage()    {}
 func (*FormatNvmeReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_nvme_28ffae7e534c51fd, []int{6}
+	return fileDescriptor_storage_nvme_fefe8123b8e0ad88, []int{6}
 }
 func (m *FormatNvmeReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FormatNvmeReq.Unmarshal(m, b)
@@ -562,7 +562,7 @@ func (m *UpdateNvmeReq) Reset()         { *m = UpdateNvmeReq{} }
 func (m *UpdateNvmeReq) String() string { return proto.CompactTextString(m) }
 func (*UpdateNvmeReq) ProtoMessage()    {}
 func (*UpdateNvmeReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_nvme_28ffae7e534c51fd, []int{7}
+	return fileDescriptor_storage_nvme_fefe8123b8e0ad88, []int{7}
 }
 func (m *UpdateNvmeReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateNvmeReq.Unmarshal(m, b)
@@ -610,7 +610,6 @@ func (m *UpdateNvmeReq) GetSlot() int32 {
 	return 0
 }
 
-// TODO: provide facility to supply FIO config in params
 type BurninNvmeReq struct {
 	Fioconfig            *FilePath `protobuf:"bytes,1,opt,name=fioconfig,proto3" json:"fioconfig,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
@@ -622,7 +621,7 @@ func (m *BurninNvmeReq) Reset()         { *m = BurninNvmeReq{} }
 func (m *BurninNvmeReq) String() string { return proto.CompactTextString(m) }
 func (*BurninNvmeReq) ProtoMessage()    {}
 func (*BurninNvmeReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_nvme_28ffae7e534c51fd, []int{8}
+	return fileDescriptor_storage_nvme_fefe8123b8e0ad88, []int{8}
 }
 func (m *BurninNvmeReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BurninNvmeReq.Unmarshal(m, b)
@@ -663,51 +662,51 @@ func init() {
 	proto.RegisterType((*BurninNvmeReq)(nil), "mgmt.BurninNvmeReq")
 }
 
-func init() { proto.RegisterFile("storage_nvme.proto", fileDescriptor_storage_nvme_28ffae7e534c51fd) }
-
-var fileDescriptor_storage_nvme_28ffae7e534c51fd = []byte{
-	// 683 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6a, 0xdb, 0x4a,
-	0x14, 0x46, 0x89, 0xed, 0xd8, 0xc7, 0x3f, 0x81, 0xb9, 0xe1, 0x32, 0xf8, 0x5e, 0x82, 0xf1, 0xca,
-	0x85, 0xe0, 0x45, 0xba, 0xe8, 0xa6, 0xe9, 0xa2, 0x85, 0xd0, 0x55, 0x08, 0x13, 0xba, 0xea, 0xa2,
-	0x4c, 0xa4, 0x63, 0x69, 0xe8, 0x48, 0xa3, 0xce, 0x8c, 0x6c, 0xfc, 0x04, 0xdd, 0xf4, 0xa9, 0xfa,
-	0x64, 0xe5, 0x8c, 0x64, 0x59, 0x2e, 0x81, 0xd2, 0x95, 0xe6, 0xfb, 0xce, 0xef, 0x7c, 0x3a, 0x73,
-	0x80, 0x39, 0x6f, 0xac, 0x4c, 0xf1, 0x4b, 0xb1, 0xcd, 0x71, 0x5d, 0x5a, 0xe3, 0x0d, 0xeb, 0xe5,
-	0x69, 0xee, 0xe7, 0x93, 0xd8, 0xe4, 0xb9, 0x29, 0x6a, 0x6e, 0xf9, 0x7d, 0x00, 0xb3, 0x87, 0x6d,
-	0x8e, 0x1f, 0x4c, 0xe1, 0xad, 0xd1, 0x1a, 0x2d, 0xbb, 0x82, 0x7e, 0x6e, 0x12, 0xd4, 0x3c, 0x5a,
-	0x44, 0xab, 0x91, 0xa8, 0x01, 0xfb, 0x17, 0x06, 0x0e, 0xad, 0x92, 0x9a, 0x9f, 0x05, 0xba, 0x41,
-	0x8c, 0xc3, 0x45, 0x19, 0x2b, 0x99, 0x24, 0x96, 0x9f, 0x07, 0xc3, 0x01, 0x52, 0x9e, 0xcd, 0xce,
-	0xe2, 0x96, 0xf7, 0xea, 0x3c, 0x01, 0xb0, 0x39, 0x0c, 0x9d, 0x89, 0xbf, 0xa2, 0x57, 0x09, 0xef,
-	0x2f, 0xa2, 0x55, 0x5f, 0xb4, 0x98, 0xbd, 0x03, 0x28, 0x64, 0x8e, 0xae, 0x94, 0x31, 0x3a, 0x3e,
-	0x58, 0x9c, 0xaf, 0xc6, 0xb7, 0xd7, 0x6b, 0xea, 0x7a, 0x7d, 0xda, 0xe3, 0xfa, 0xe1, 0xe0, 0x26,
-	0x3a, 0x11, 0xec, 0x0e, 0xc6, 0x19, 0x4a, 0xed, 0x33, 0xe7, 0xa5, 0x77, 0xfc, 0x22, 0x24, 0xf8,
-	0xef, 0xc5, 0x04, 0x1f, 0x83, 0x9f, 0xe8, 0xfa, 0xcf, 0xdf, 0xc0, 0xa8, 0xcd, 0xcb, 0x66, 0x70,
-	0xa6, 0x92, 0x20, 0x41, 0x5f, 0x9c, 0xa9, 0x84, 0xfa, 0x8e, 0x65, 0x29, 0x63, 0xe5, 0xf7, 0x41,
-	0x81, 0xbe, 0x68, 0xf1, 0xfc, 0xe7, 0x39, 0x0c, 0xea, 0x84, 0x8c, 0x41, 0xcf, 0x63, 0x5e, 0x86,
-	0xc0, 0xa9, 0x08, 0x67, 0x0a, 0xa5, 0xef, 0x4e, 0xda, 0x22, 0x84, 0x4e, 0x45, 0x8b, 0x0f, 0xb6,
-	0xd8, 0x2a, 0x1f, 0xf4, 0x6b, 0x6c, 0x84, 0x43, 0x49, 0x6f, 0xf5, 0x73, 0xe5, 0xf6, 0x41, 0xc3,
-	0x9e, 0x68, 0x31, 0x5b, 0xc0, 0xb8, 0x34, 0x3b, 0xb4, 0xf1, 0x3e, 0xd6, 0xe8, 0x82, 0x92, 0x3d,
-	0xd1, 0xa5, 0xd8, 0x12, 0x26, 0x01, 0x9a, 0x22, 0x33, 0x95, 0x25, 0x39, 0xc9, 0xe5, 0x84, 0x63,
-	0x2b, 0xb8, 0xac, 0x0a, 0x27, 0x37, 0xe8, 0xb2, 0xca, 0x27, 0x66, 0x57, 0x90, 0x68, 0xe4, 0xf6,
-	0x3b, 0x4d, 0xf5, 0x72, 0x4c, 0x94, 0x44, 0x6b, 0x8d, 0x75, 0x7c, 0x58, 0xd7, 0xeb, 0x50, 0xec,
-	0x7f, 0x18, 0x85, 0x93, 0x36, 0xa9, 0xe3, 0xa3, 0x60, 0x3f, 0x12, 0x14, 0x7f, 0xb8, 0xb3, 0x2a,
-	0x52, 0x0e, 0x8b, 0x68, 0x35, 0x14, 0x5d, 0x8a, 0x5d, 0x03, 0xc8, 0xad, 0x54, 0xda, 0x95, 0xd2,
-	0x22, 0x1f, 0x07, 0x87, 0x0e, 0x43, 0x19, 0x2c, 0x6a, 0x25, 0x9f, 0x95, 0xa6, 0x7f, 0x30, 0xa9,
-	0x33, 0x74, 0x28, 0xd2, 0xcb, 0xa2, 0x4c, 0x4c, 0xa1, 0xf7, 0x7c, 0x1a, 0xcc, 0x2d, 0xa6, 0xe8,
-	0xad, 0xd1, 0xd2, 0x2b, 0x8d, 0x39, 0xe6, 0x7c, 0x56, 0x47, 0x77, 0xa8, 0xe5, 0x67, 0xb8, 0x3a,
-	0x9d, 0x11, 0x81, 0xae, 0xd2, 0xbe, 0x3b, 0xe0, 0xd1, 0xe9, 0x80, 0xbf, 0x82, 0x3e, 0x0d, 0x0e,
-	0x86, 0x9f, 0x3a, 0xbe, 0xfd, 0xa7, 0x1e, 0x34, 0x81, 0xae, 0x34, 0x85, 0xc3, 0x27, 0x32, 0x89,
-	0xda, 0x63, 0xf9, 0x23, 0x82, 0xd9, 0xa3, 0x45, 0xba, 0x08, 0x15, 0x11, 0xf8, 0x2d, 0xfc, 0x9f,
-	0x58, 0xed, 0x32, 0xe5, 0x51, 0x2b, 0xe7, 0x9b, 0xe4, 0x27, 0x1c, 0x75, 0x5d, 0xd8, 0xac, 0x4a,
-	0xb1, 0x94, 0x29, 0xba, 0x66, 0xee, 0xba, 0x14, 0xa9, 0xe6, 0xa5, 0x4d, 0xd1, 0x57, 0x0e, 0x0f,
-	0x2f, 0xb0, 0xc3, 0xd0, 0x23, 0xb4, 0xe8, 0xd0, 0x87, 0x01, 0x1a, 0x8a, 0x1a, 0x2c, 0xdf, 0xc2,
-	0xe5, 0x49, 0x37, 0xae, 0x3c, 0x5e, 0x26, 0xfa, 0xe3, 0x65, 0xa6, 0x30, 0x7e, 0x8a, 0x65, 0xd1,
-	0x5c, 0x64, 0x99, 0xc2, 0xe4, 0x08, 0x5d, 0xc9, 0x6e, 0x60, 0x40, 0x63, 0x6a, 0x1d, 0x8f, 0xc2,
-	0x03, 0xbc, 0x7a, 0xe9, 0x01, 0x8a, 0xc6, 0xe7, 0x6f, 0x44, 0xbc, 0x84, 0xe9, 0xbd, 0xb1, 0xb9,
-	0xf4, 0x87, 0xca, 0x0a, 0xa6, 0x9f, 0xca, 0x44, 0xfa, 0x56, 0xd3, 0x97, 0x57, 0x17, 0xad, 0x1c,
-	0x2f, 0xad, 0xa7, 0x5d, 0x54, 0x2f, 0xaf, 0x16, 0xd3, 0x7b, 0x2d, 0xa5, 0xcf, 0x1a, 0xe5, 0xc2,
-	0x99, 0x38, 0xa7, 0x4d, 0x2d, 0x59, 0x5f, 0x84, 0xf3, 0xf2, 0x0e, 0xa6, 0xef, 0x2b, 0x1a, 0xd4,
-	0x43, 0xa9, 0x1b, 0x18, 0x6d, 0x94, 0x89, 0x4d, 0xb1, 0x51, 0x69, 0xa3, 0xd9, 0xac, 0xee, 0xfd,
-	0x5e, 0x69, 0x7c, 0x94, 0x3e, 0x13, 0x47, 0x87, 0xe7, 0x41, 0xd8, 0xb6, 0xaf, 0x7f, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0xf7, 0x40, 0xaa, 0x8c, 0x97, 0x05, 0x00, 0x00,
+func init() { proto.RegisterFile("storage_nvme.proto", fileDescriptor_storage_nvme_fefe8123b8e0ad88) }
+
+var fileDescriptor_storage_nvme_fefe8123b8e0ad88 = []byte{
+	// 678 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6a, 0xdb, 0x40,
+	0x10, 0x46, 0xf1, 0x4f, 0xec, 0xf1, 0x4f, 0x60, 0x1b, 0xca, 0xe2, 0x96, 0x60, 0x7c, 0x72, 0x21,
+	0xf8, 0x90, 0x1e, 0x7a, 0x69, 0x7a, 0x68, 0x21, 0xf4, 0x14, 0xc2, 0x86, 0x9e, 0x7a, 0x28, 0x1b,
+	0x69, 0x2c, 0x2d, 0x5d, 0x69, 0xd5, 0xdd, 0x95, 0x8d, 0x9f, 0xa0, 0x97, 0x3e, 0x55, 0x9f, 0xac,
+	0xcc, 0xca, 0x96, 0xe5, 0x12, 0x28, 0x3d, 0x69, 0xbf, 0x6f, 0x7e, 0xf7, 0xd3, 0xec, 0x00, 0x73,
+	0xde, 0x58, 0x99, 0xe2, 0xb7, 0x62, 0x93, 0xe3, 0xaa, 0xb4, 0xc6, 0x1b, 0xd6, 0xcd, 0xd3, 0xdc,
+	0xcf, 0xc6, 0xb1, 0xc9, 0x73, 0x53, 0xd4, 0xdc, 0xe2, 0x67, 0x1f, 0xa6, 0xf7, 0x9b, 0x1c, 0x3f,
+	0x99, 0xc2, 0x5b, 0xa3, 0x35, 0x5a, 0x76, 0x09, 0xbd, 0xdc, 0x24, 0xa8, 0x79, 0x34, 0x8f, 0x96,
+	0x43, 0x51, 0x03, 0xf6, 0x12, 0xfa, 0x0e, 0xad, 0x92, 0x9a, 0x9f, 0x05, 0x7a, 0x8f, 0x18, 0x87,
+	0xf3, 0x32, 0x56, 0x32, 0x49, 0x2c, 0xef, 0x04, 0xc3, 0x01, 0x52, 0x9e, 0xf5, 0xd6, 0xe2, 0x86,
+	0x77, 0xeb, 0x3c, 0x01, 0xb0, 0x19, 0x0c, 0x9c, 0x89, 0xbf, 0xa3, 0x57, 0x09, 0xef, 0xcd, 0xa3,
+	0x65, 0x4f, 0x34, 0x98, 0x7d, 0x00, 0x28, 0x64, 0x8e, 0xae, 0x94, 0x31, 0x3a, 0xde, 0x9f, 0x77,
+	0x96, 0xa3, 0x9b, 0xab, 0x15, 0x75, 0xbd, 0x3a, 0xed, 0x71, 0x75, 0x7f, 0x70, 0x13, 0xad, 0x08,
+	0x76, 0x0b, 0xa3, 0x0c, 0xa5, 0xf6, 0x99, 0xf3, 0xd2, 0x3b, 0x7e, 0x1e, 0x12, 0xbc, 0x7a, 0x36,
+	0xc1, 0xe7, 0xe0, 0x27, 0xda, 0xfe, 0xb3, 0x77, 0x30, 0x6c, 0xf2, 0xb2, 0x29, 0x9c, 0xa9, 0x24,
+	0x48, 0xd0, 0x13, 0x67, 0x2a, 0xa1, 0xbe, 0x63, 0x59, 0xca, 0x58, 0xf9, 0x5d, 0x50, 0xa0, 0x27,
+	0x1a, 0x3c, 0xfb, 0xdd, 0x81, 0x7e, 0x9d, 0x90, 0x31, 0xe8, 0x7a, 0xcc, 0xcb, 0x10, 0x38, 0x11,
+	0xe1, 0x4c, 0xa1, 0xf4, 0xdd, 0x4a, 0x5b, 0x84, 0xd0, 0x89, 0x68, 0xf0, 0xc1, 0x16, 0x5b, 0xe5,
+	0x79, 0xe7, 0x68, 0x23, 0x1c, 0x4a, 0x7a, 0xab, 0x9f, 0x2a, 0xb7, 0x0b, 0x1a, 0x76, 0x45, 0x83,
+	0xd9, 0x1c, 0x46, 0xa5, 0xd9, 0xa2, 0x8d, 0x77, 0xb1, 0x46, 0x17, 0x94, 0xec, 0x8a, 0x36, 0xc5,
+	0x16, 0x30, 0x0e, 0xd0, 0x14, 0x99, 0xa9, 0x2c, 0xc9, 0x49, 0x2e, 0x27, 0x1c, 0x5b, 0xc2, 0x45,
+	0x55, 0x38, 0xb9, 0x46, 0x97, 0x55, 0x3e, 0x31, 0xdb, 0x82, 0x44, 0x23, 0xb7, 0xbf, 0x69, 0xaa,
+	0x97, 0x63, 0xa2, 0x24, 0x5a, 0x6b, 0xac, 0xe3, 0x83, 0xba, 0x5e, 0x8b, 0x62, 0xaf, 0x61, 0x18,
+	0x4e, 0xda, 0xa4, 0x8e, 0x0f, 0x83, 0xfd, 0x48, 0x50, 0xfc, 0xe1, 0xce, 0xaa, 0x48, 0x39, 0xcc,
+	0xa3, 0xe5, 0x40, 0xb4, 0x29, 0x76, 0x05, 0x20, 0x37, 0x52, 0x69, 0x57, 0x4a, 0x8b, 0x7c, 0x14,
+	0x1c, 0x5a, 0x0c, 0x65, 0xb0, 0xa8, 0x95, 0x7c, 0x52, 0x9a, 0xfe, 0xc1, 0xb8, 0xce, 0xd0, 0xa2,
+	0x48, 0x2f, 0x8b, 0x32, 0x31, 0x85, 0xde, 0xf1, 0x49, 0x30, 0x37, 0x98, 0xa2, 0x37, 0x46, 0x4b,
+	0xaf, 0x34, 0xe6, 0x98, 0xf3, 0x69, 0x1d, 0xdd, 0xa2, 0x16, 0x5f, 0xe1, 0xf2, 0x74, 0x46, 0x04,
+	0xba, 0x4a, 0xfb, 0xf6, 0x80, 0x47, 0xa7, 0x03, 0xfe, 0x06, 0x7a, 0x34, 0x38, 0x18, 0x7e, 0xea,
+	0xe8, 0xe6, 0x45, 0x3d, 0x68, 0x02, 0x5d, 0x69, 0x0a, 0x87, 0x8f, 0x64, 0x12, 0xb5, 0xc7, 0xe2,
+	0x57, 0x04, 0xd3, 0x07, 0x8b, 0x74, 0x11, 0x2a, 0x22, 0xf0, 0x47, 0xf8, 0x3f, 0xb1, 0xda, 0x66,
+	0xca, 0xa3, 0x56, 0xce, 0xef, 0x93, 0x9f, 0x70, 0xd4, 0x75, 0x61, 0xb3, 0x2a, 0xc5, 0x52, 0xa6,
+	0xe8, 0xf6, 0x73, 0xd7, 0xa6, 0x48, 0x35, 0x2f, 0x6d, 0x8a, 0xbe, 0x72, 0x78, 0x78, 0x81, 0x2d,
+	0x86, 0x1e, 0xa1, 0x45, 0x87, 0x3e, 0x0c, 0xd0, 0x40, 0xd4, 0x60, 0xf1, 0x1e, 0x2e, 0x4e, 0xba,
+	0x71, 0xe5, 0xf1, 0x32, 0xd1, 0x3f, 0x2f, 0x33, 0x81, 0xd1, 0x63, 0x2c, 0x8b, 0xfd, 0x45, 0x16,
+	0x29, 0x8c, 0x8f, 0xd0, 0x95, 0xec, 0x1a, 0xfa, 0x34, 0xa6, 0xd6, 0xf1, 0x28, 0x3c, 0xc0, 0xcb,
+	0xe7, 0x1e, 0xa0, 0xd8, 0xfb, 0xfc, 0x8f, 0x88, 0x17, 0x30, 0xb9, 0x33, 0x36, 0x97, 0xfe, 0x50,
+	0x59, 0xc1, 0xe4, 0x4b, 0x99, 0x48, 0xdf, 0x68, 0xfa, 0xfc, 0xea, 0xa2, 0x95, 0xe3, 0xa5, 0xf5,
+	0xb4, 0x8b, 0xea, 0xe5, 0xd5, 0x60, 0x7a, 0xaf, 0xa5, 0xf4, 0xd9, 0x5e, 0xb9, 0x70, 0x26, 0xce,
+	0x69, 0x53, 0x4b, 0xd6, 0x13, 0xe1, 0xbc, 0xb8, 0x85, 0xc9, 0xc7, 0x8a, 0x06, 0xf5, 0x50, 0xea,
+	0x1a, 0x86, 0x6b, 0x65, 0x62, 0x53, 0xac, 0x55, 0xba, 0xd7, 0x6c, 0x5a, 0xf7, 0x7e, 0xa7, 0x34,
+	0x3e, 0x48, 0x9f, 0x89, 0xa3, 0xc3, 0x53, 0x3f, 0x6c, 0xdb, 0xb7, 0x7f, 0x06, 0x00, 0xf7, 0x40,
+	0xaa, 0x8c, 0x97, 0x05, 0x00, 0x00,
 }
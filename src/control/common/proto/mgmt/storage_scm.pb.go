@@ -36,7 +36,7 @@ func (m *ScmModule) Reset()         { *m = ScmModule{} }
 func (m *ScmModule) String() string { return proto.CompactTextString(m) }
 func (*ScmModule) ProtoMessage()    {}
 func (*ScmModule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_scm_fd1c75f9ee18a67b, []int{0}
+	return fileDescriptor_storage_scm_3898f9018ad33913, []int{0}
 }
 func (m *ScmModule) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ScmModule.Unmarshal(m, b)
@@ -91,7 +91,7 @@ func (m *ScmModule_Location) Reset()         { *m = ScmModule_Location{} }
 func (m *ScmModule_Location) String() string { return proto.CompactTextString(m) }
 func (*ScmModule_Location) ProtoMessage()    {}
 func (*ScmModule_Location) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_scm_fd1c75f9ee18a67b, []int{0, 0}
+	return fileDescriptor_storage_scm_3898f9018ad33913, []int{0, 0}
 }
 func (m *ScmModule_Location) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ScmModule_Location.Unmarshal(m, b)
@@ -154,7 +154,7 @@ func (m *PmemDevice) Reset()         { *m = PmemDevice{} }
 func (m *PmemDevice) String() string { return proto.CompactTextString(m) }
 func (*PmemDevice) ProtoMessage()    {}
 func (*PmemDevice) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_scm_fd1c75f9ee18a67b, []int{1}
+	return fileDescriptor_storage_scm_3898f9018ad33913, []int{1}
 }
 func (m *PmemDevice) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PmemDevice.Unmarshal(m, b)
@@ -216,7 +216,7 @@ func (m *ScmMount) Reset()         { *m = ScmMount{} }
 func (m *ScmMount) String() string { return proto.CompactTextString(m) }
 func (*ScmMount) ProtoMessage()    {}
 func (*ScmMount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_scm_fd1c75f9ee18a67b, []int{2}
+	return fileDescriptor_storage_scm_3898f9018ad33913, []int{2}
 }
 func (m *ScmMount) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ScmMount.Unmarshal(m, b)
@@ -272,7 +272,7 @@ func (m *ScmModuleResult) Reset()         { *m = ScmModuleResult{} }
 func (m *ScmModuleResult) String() string { return proto.CompactTextString(m) }
 func (*ScmModuleResult) ProtoMessage()    {}
 func (*ScmModuleResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_scm_fd1c75f9ee18a67b, []int{3}
+	return fileDescriptor_storage_scm_3898f9018ad33913, []int{3}
 }
 func (m *ScmModuleResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ScmModuleResult.Unmarshal(m, b)
@@ -319,7 +319,7 @@ func (m *ScmMountResult) Reset()         { *m = ScmMountResult{} }
 func (m *ScmMountResult) String() string { return proto.CompactTextString(m) }
 func (*ScmMountResult) ProtoMessage()    {}
 func (*ScmMountResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_scm_fd1c75f9ee18a67b, []int{4}
+	return fileDescriptor_storage_scm_3898f9018ad33913, []int{4}
 }
 func (m *ScmMountResult) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ScmMountResult.Unmarshal(m, b)
@@ -364,7 +364,7 @@ func (m *PrepareScmReq) Reset()         { *m = PrepareScmReq{} }
 func (m *PrepareScmReq) String() string { return proto.CompactTextString(m) }
 func (*PrepareScmReq) ProtoMessage()    {}
 func (*PrepareScmReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_scm_fd1c75f9ee18a67b, []int{5}
+	return fileDescriptor_storage_scm_3898f9018ad33913, []int{5}
 }
 func (m *PrepareScmReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PrepareScmReq.Unmarshal(m, b)
@@ -403,7 +403,7 @@ func (m *PrepareScmResp) Reset()         { *m = PrepareScmResp{} }
 func (m *PrepareScmResp) String() string { return proto.CompactTextString(m) }
 func (*PrepareScmResp) ProtoMessage()    {}
 func (*PrepareScmResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_scm_fd1c75f9ee18a67b, []int{6}
+	return fileDescriptor_storage_scm_3898f9018ad33913, []int{6}
 }
 func (m *PrepareScmResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PrepareScmResp.Unmarshal(m, b)
@@ -447,7 +447,7 @@ func (m *ScanScmReq) Reset()         { *m = ScanScmReq{} }
 func (m *ScanScmReq) String() string { return proto.CompactTextString(m) }
 func (*ScanScmReq) ProtoMessage()    {}
 func (*ScanScmReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_scm_fd1c75f9ee18a67b, []int{7}
+	return fileDescriptor_storage_scm_3898f9018ad33913, []int{7}
 }
 func (m *ScanScmReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ScanScmReq.Unmarshal(m, b)
@@ -480,7 +480,7 @@ func (m *ScanScmResp) Reset()         { *m = ScanScmResp{} }
 func (m *ScanScmResp) String() string { return proto.CompactTextString(m) }
 func (*ScanScmResp) ProtoMessage()    {}
 func (*ScanScmResp) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_scm_fd1c75f9ee18a67b, []int{8}
+	return fileDescriptor_storage_scm_3898f9018ad33913, []int{8}
 }
 func (m *ScanScmResp) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ScanScmResp.Unmarshal(m, b)
@@ -531,7 +531,7 @@ func (m *FormatScmReq) Reset()         { *m = FormatScmReq{} }
 func (m *FormatScmReq) String() string { return proto.CompactTextString(m) }
 func (*FormatScmReq) ProtoMessage()    {}
 func (*FormatScmReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_scm_fd1c75f9ee18a67b, []int{9}
+	return fileDescriptor_storage_scm_3898f9018ad33913, []int{9}
 }
 func (m *FormatScmReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_FormatScmReq.Unmarshal(m, b)
@@ -561,7 +561,7 @@ func (m *UpdateScmReq) Reset()         { *m = UpdateScmReq{} }
 func (m *UpdateScmReq) String() string { return proto.CompactTextString(m) }
 func (*UpdateScmReq) ProtoMessage()    {}
 func (*UpdateScmReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_scm_fd1c75f9ee18a67b, []int{10}
+	return fileDescriptor_storage_scm_3898f9018ad33913, []int{10}
 }
 func (m *UpdateScmReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_UpdateScmReq.Unmarshal(m, b)
@@ -582,16 +582,17 @@ func (m *UpdateScmReq) XXX_DiscardUnknown() {
 var xxx_messageInfo_UpdateScmReq proto.InternalMessageInfo
 
 type BurninScmReq struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Fioconfig            *FilePath `protobuf:"bytes,1,opt,name=fioconfig,proto3" json:"fioconfig,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
 func (m *BurninScmReq) Reset()         { *m = BurninScmReq{} }
 func (m *BurninScmReq) String() string { return proto.CompactTextString(m) }
 func (*BurninScmReq) ProtoMessage()    {}
 func (*BurninScmReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_storage_scm_fd1c75f9ee18a67b, []int{11}
+	return fileDescriptor_storage_scm_3898f9018ad33913, []int{11}
 }
 func (m *BurninScmReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BurninScmReq.Unmarshal(m, b)
@@ -611,6 +612,13 @@ func (m *BurninScmReq) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_BurninScmReq proto.InternalMessageInfo
 
+func (m *BurninScmReq) GetFioconfig() *FilePath {
+	if m != nil {
+		return m.Fioconfig
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ScmModule)(nil), "mgmt.ScmModule")
 	proto.RegisterType((*ScmModule_Location)(nil), "mgmt.ScmModule.Location")
@@ -627,38 +635,40 @@ func init() {
 	proto.RegisterType((*BurninScmReq)(nil), "mgmt.BurninScmReq")
 }
 
-func init() { proto.RegisterFile("storage_scm.proto", fileDescriptor_storage_scm_fd1c75f9ee18a67b) }
-
-var fileDescriptor_storage_scm_fd1c75f9ee18a67b = []byte{
-	// 471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcd, 0x6e, 0x13, 0x31,
-	0x10, 0x96, 0xbb, 0x49, 0x9b, 0x4c, 0x7e, 0x5a, 0x0c, 0x42, 0xab, 0x1c, 0x50, 0xb4, 0x02, 0x94,
-	0x72, 0xc8, 0x21, 0xbc, 0x01, 0x42, 0x9c, 0x40, 0xaa, 0x1c, 0x21, 0x8e, 0xc8, 0xf5, 0x8e, 0x9a,
-	0xa5, 0xeb, 0x1f, 0x6c, 0x6f, 0xa1, 0xcf, 0xc0, 0xb3, 0xf2, 0x0e, 0xc8, 0x5e, 0xef, 0x26, 0x02,
-	0x89, 0xa6, 0x37, 0x7f, 0x33, 0xb3, 0xf3, 0xfd, 0x8c, 0x16, 0x9e, 0x38, 0xaf, 0x2d, 0xbf, 0xc1,
-	0xaf, 0x4e, 0xc8, 0xb5, 0xb1, 0xda, 0x6b, 0x3a, 0x90, 0x37, 0xd2, 0x2f, 0xa6, 0x42, 0x4b, 0xa9,
-	0x55, 0x5b, 0x2b, 0x7e, 0x13, 0x18, 0x6f, 0x85, 0xfc, 0xa4, 0xcb, 0xa6, 0x46, 0xfa, 0x02, 0xc0,
-	0xec, 0xee, 0x5d, 0x25, 0x78, 0x5d, 0x95, 0x39, 0x59, 0x92, 0xd5, 0x8c, 0x1d, 0x54, 0xe8, 0x02,
-	0x46, 0x82, 0x1b, 0x2e, 0x2a, 0x7f, 0x9f, 0x9f, 0x2c, 0xc9, 0x6a, 0xc0, 0x7a, 0x4c, 0xdf, 0x40,
-	0x56, 0x6b, 0x91, 0x67, 0x4b, 0xb2, 0x9a, 0x6c, 0xf2, 0x75, 0xe0, 0x5a, 0xf7, 0x9b, 0xd7, 0x1f,
-	0xb5, 0xe0, 0xbe, 0xd2, 0x8a, 0x85, 0xa1, 0xc5, 0x4f, 0x18, 0x75, 0x05, 0x9a, 0xc3, 0x99, 0xd8,
-	0x71, 0xa5, 0xb0, 0x4e, 0x84, 0x1d, 0x0c, 0x6a, 0xd2, 0xd3, 0x68, 0x17, 0xf9, 0x66, 0xec, 0xa0,
-	0x12, 0xd4, 0x48, 0x94, 0xc2, 0xdb, 0xda, 0x46, 0xda, 0x19, 0xeb, 0x31, 0x7d, 0x0e, 0xa7, 0x4e,
-	0x8b, 0x5b, 0xf4, 0xf9, 0x20, 0x76, 0x12, 0x2a, 0xbe, 0x01, 0x5c, 0x49, 0x94, 0xef, 0xf1, 0xae,
-	0x12, 0x48, 0x29, 0x0c, 0x9a, 0x26, 0x39, 0x1d, 0xb3, 0xf8, 0x0e, 0x5b, 0xaf, 0x6b, 0x2d, 0x6e,
-	0x4b, 0xbc, 0x8b, 0x9c, 0x63, 0xd6, 0x63, 0x7a, 0x01, 0x59, 0x28, 0x67, 0xb1, 0x1c, 0x9e, 0x61,
-	0x5a, 0x35, 0x92, 0x2b, 0x5d, 0x62, 0x62, 0xea, 0x71, 0xf1, 0x03, 0x46, 0x31, 0x80, 0x46, 0xf9,
-	0xa8, 0x55, 0x79, 0xa3, 0x2b, 0xe5, 0x13, 0x5b, 0x8f, 0xe9, 0x25, 0x9c, 0xc9, 0x98, 0x52, 0x30,
-	0x99, 0xad, 0x26, 0x9b, 0xf3, 0xbf, 0xd2, 0x63, 0x5d, 0x9f, 0xbe, 0x84, 0x81, 0x91, 0x28, 0x53,
-	0xca, 0x17, 0xed, 0xdc, 0xde, 0x10, 0x8b, 0xdd, 0x62, 0x07, 0xe7, 0xfb, 0x6f, 0xd1, 0x35, 0xb5,
-	0xef, 0xae, 0x43, 0x8e, 0xb8, 0x0e, 0xbd, 0x84, 0xa1, 0xf3, 0xdc, 0x63, 0xb4, 0x3f, 0xd9, 0x3c,
-	0x6d, 0xa7, 0x19, 0x3a, 0xa3, 0x95, 0xc3, 0x6d, 0x68, 0xb1, 0x76, 0xa2, 0xf8, 0x02, 0xf3, 0xce,
-	0x62, 0x22, 0xfa, 0xbf, 0xd1, 0xa3, 0x17, 0xbf, 0x82, 0xd9, 0x95, 0x45, 0xc3, 0x2d, 0x6e, 0x85,
-	0x64, 0xf8, 0x9d, 0x3e, 0x83, 0xa1, 0x45, 0x87, 0xed, 0xd2, 0x11, 0x6b, 0x41, 0x21, 0x60, 0x7e,
-	0x38, 0xe6, 0x0c, 0x7d, 0x0d, 0xc3, 0x90, 0x81, 0xcb, 0x49, 0x8c, 0xf2, 0xdf, 0x88, 0xda, 0xf6,
-	0x63, 0xb4, 0x4c, 0x01, 0xb6, 0x82, 0xab, 0x56, 0x48, 0xf1, 0x8b, 0xc0, 0xa4, 0x87, 0xce, 0x1c,
-	0x5e, 0x8f, 0x3c, 0x70, 0xbd, 0x5e, 0xdb, 0xc9, 0x91, 0xda, 0xb2, 0x07, 0xb5, 0xcd, 0x61, 0xfa,
-	0x41, 0x5b, 0xc9, 0x7d, 0x52, 0x37, 0x87, 0xe9, 0x67, 0x53, 0x72, 0x8f, 0x7b, 0xfc, 0xae, 0xb1,
-	0xaa, 0x4a, 0xea, 0xaf, 0x4f, 0xe3, 0x6f, 0xff, 0xf6, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x64,
-	0x25, 0x6c, 0x9f, 0x1f, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("storage_scm.proto", fileDescriptor_storage_scm_3898f9018ad33913) }
+
+var fileDescriptor_storage_scm_3898f9018ad33913 = []byte{
+	// 497 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x95, 0xeb, 0xa4, 0x4d, 0x26, 0x1f, 0x2d, 0x0b, 0x42, 0x56, 0x0e, 0x28, 0xb2, 0x00, 0xa5,
+	0x08, 0xe5, 0x10, 0xae, 0x9c, 0x10, 0xea, 0x09, 0xa4, 0x68, 0x23, 0xc4, 0x11, 0x6d, 0xd7, 0xd3,
+	0x64, 0xa9, 0xf7, 0x03, 0xef, 0xba, 0xd0, 0xdf, 0xc0, 0x6f, 0xe5, 0x3f, 0xa0, 0x5d, 0xaf, 0x9d,
+	0x08, 0x21, 0x1a, 0x6e, 0xfb, 0x66, 0x5e, 0xe6, 0xbd, 0x79, 0x13, 0x19, 0x1e, 0x59, 0xa7, 0x2b,
+	0xb6, 0xc5, 0x2f, 0x96, 0xcb, 0xa5, 0xa9, 0xb4, 0xd3, 0xa4, 0x27, 0xb7, 0xd2, 0xcd, 0xc6, 0x5c,
+	0x4b, 0xa9, 0x55, 0x53, 0xcb, 0x7f, 0x25, 0x30, 0xdc, 0x70, 0xf9, 0x51, 0x17, 0x75, 0x89, 0xe4,
+	0x19, 0x80, 0xd9, 0xdd, 0x5b, 0xc1, 0x59, 0x29, 0x8a, 0x2c, 0x99, 0x27, 0x8b, 0x09, 0x3d, 0xa8,
+	0x90, 0x19, 0x0c, 0x38, 0x33, 0x8c, 0x0b, 0x77, 0x9f, 0x9d, 0xcc, 0x93, 0x45, 0x8f, 0x76, 0x98,
+	0xbc, 0x82, 0xb4, 0xd4, 0x3c, 0x4b, 0xe7, 0xc9, 0x62, 0xb4, 0xca, 0x96, 0x5e, 0x6b, 0xd9, 0x4d,
+	0x5e, 0x7e, 0xd0, 0x9c, 0x39, 0xa1, 0x15, 0xf5, 0xa4, 0xd9, 0x0f, 0x18, 0xb4, 0x05, 0x92, 0xc1,
+	0x19, 0xdf, 0x31, 0xa5, 0xb0, 0x8c, 0x82, 0x2d, 0xf4, 0x6e, 0xe2, 0xd3, 0x68, 0x1b, 0xf4, 0x26,
+	0xf4, 0xa0, 0xe2, 0xdd, 0x48, 0x94, 0xdc, 0x55, 0x65, 0x15, 0x64, 0x27, 0xb4, 0xc3, 0xe4, 0x29,
+	0x9c, 0x5a, 0xcd, 0x6f, 0xd1, 0x65, 0xbd, 0xd0, 0x89, 0x28, 0xff, 0x0a, 0xb0, 0x96, 0x28, 0xdf,
+	0xe3, 0x9d, 0xe0, 0x48, 0x08, 0xf4, 0xea, 0x3a, 0x6e, 0x3a, 0xa4, 0xe1, 0xed, 0xa7, 0x5e, 0x97,
+	0x9a, 0xdf, 0x16, 0x78, 0x17, 0x34, 0x87, 0xb4, 0xc3, 0xe4, 0x02, 0x52, 0x5f, 0x4e, 0x43, 0xd9,
+	0x3f, 0x3d, 0x5b, 0xd5, 0x92, 0x29, 0x5d, 0x60, 0x54, 0xea, 0x70, 0xfe, 0x1d, 0x06, 0x21, 0x80,
+	0x5a, 0xb9, 0xe0, 0x55, 0x39, 0xa3, 0x85, 0x72, 0x51, 0xad, 0xc3, 0xe4, 0x12, 0xce, 0x64, 0x48,
+	0xc9, 0x2f, 0x99, 0x2e, 0x46, 0xab, 0xf3, 0x3f, 0xd2, 0xa3, 0x6d, 0x9f, 0x3c, 0x87, 0x9e, 0x91,
+	0x28, 0x63, 0xca, 0x17, 0x0d, 0x6f, 0xbf, 0x10, 0x0d, 0xdd, 0x7c, 0x07, 0xe7, 0xfb, 0xdf, 0xa2,
+	0xad, 0x4b, 0xd7, 0x5e, 0x27, 0x39, 0xe2, 0x3a, 0xe4, 0x12, 0xfa, 0xd6, 0x31, 0x87, 0x61, 0xfd,
+	0xd1, 0xea, 0x71, 0xc3, 0xa6, 0x68, 0x8d, 0x56, 0x16, 0x37, 0xbe, 0x45, 0x1b, 0x46, 0xfe, 0x19,
+	0xa6, 0xed, 0x8a, 0x51, 0xe8, 0xdf, 0x8b, 0x1e, 0x3d, 0xf8, 0x05, 0x4c, 0xd6, 0x15, 0x1a, 0x56,
+	0xe1, 0x86, 0x4b, 0x8a, 0xdf, 0xc8, 0x13, 0xe8, 0x57, 0x68, 0xb1, 0x19, 0x3a, 0xa0, 0x0d, 0xc8,
+	0x39, 0x4c, 0x0f, 0x69, 0xd6, 0x90, 0x97, 0xd0, 0xf7, 0x19, 0xd8, 0x2c, 0x99, 0xa7, 0x7f, 0x8d,
+	0xa8, 0x69, 0xff, 0x8f, 0x97, 0x31, 0xc0, 0x86, 0x33, 0xd5, 0x18, 0xc9, 0x7f, 0x26, 0x30, 0xea,
+	0xa0, 0x35, 0x87, 0xd7, 0x4b, 0x1e, 0xb8, 0x5e, 0xe7, 0xed, 0xe4, 0x48, 0x6f, 0xe9, 0x83, 0xde,
+	0xa6, 0x30, 0xbe, 0xd2, 0x95, 0x64, 0x2e, 0xba, 0x9b, 0xc2, 0xf8, 0x93, 0x29, 0x98, 0x8b, 0xb1,
+	0xe5, 0x6f, 0x61, 0xfc, 0xae, 0xae, 0x94, 0x88, 0xee, 0xc9, 0x6b, 0x18, 0xde, 0x08, 0xcd, 0xb5,
+	0xba, 0x11, 0xdb, 0xf8, 0x6f, 0x98, 0x36, 0xe3, 0xaf, 0x44, 0x89, 0x6b, 0xe6, 0x76, 0x74, 0x4f,
+	0xb8, 0x3e, 0x0d, 0x1f, 0x89, 0x37, 0xbf, 0x07, 0x00, 0x30, 0xdf, 0x91, 0xba, 0x4d, 0x04, 0x00,
+	0x00,
 }
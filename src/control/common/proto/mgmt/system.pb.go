@@ -0,0 +1,968 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: system.proto
+
+package mgmt
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// SystemMember refers to a data-plane instance that is a member of a DAOS
+// system running on a host with the specified control-plane address.
+type SystemMember struct {
+	Rank                 uint32   `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Uuid                 string   `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Uri                  string   `protobuf:"bytes,3,opt,name=uri,proto3" json:"uri,omitempty"`
+	Addr                 string   `protobuf:"bytes,4,opt,name=addr,proto3" json:"addr,omitempty"`
+	State                string   `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	LastSeen             int64    `protobuf:"varint,6,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	HeartbeatLatency     int64    `protobuf:"varint,7,opt,name=heartbeat_latency,json=heartbeatLatency,proto3" json:"heartbeat_latency,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemMember) Reset()         { *m = SystemMember{} }
+func (m *SystemMember) String() string { return proto.CompactTextString(m) }
+func (*SystemMember) ProtoMessage()    {}
+func (*SystemMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_system_cf094448f254b801, []int{0}
+}
+func (m *SystemMember) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemMember.Unmarshal(m, b)
+}
+func (m *SystemMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemMember.Marshal(b, m, deterministic)
+}
+func (dst *SystemMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemMember.Merge(dst, src)
+}
+func (m *SystemMember) XXX_Size() int {
+	return xxx_messageInfo_SystemMember.Size(m)
+}
+func (m *SystemMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemMember proto.InternalMessageInfo
+
+func (m *SystemMember) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *SystemMember) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *SystemMember) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+func (m *SystemMember) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
+}
+
+func (m *SystemMember) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *SystemMember) GetLastSeen() int64 {
+	if m != nil {
+		return m.LastSeen
+	}
+	return 0
+}
+
+func (m *SystemMember) GetHeartbeatLatency() int64 {
+	if m != nil {
+		return m.HeartbeatLatency
+	}
+	return 0
+}
+
+type SystemQueryReq struct {
+	Ranks                []uint32 `protobuf:"varint,1,rep,packed,name=ranks,proto3" json:"ranks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemQueryReq) Reset()         { *m = SystemQueryReq{} }
+func (m *SystemQueryReq) String() string { return proto.CompactTextString(m) }
+func (*SystemQueryReq) ProtoMessage()    {}
+func (*SystemQueryReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_system_cf094448f254b801, []int{1}
+}
+func (m *SystemQueryReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemQueryReq.Unmarshal(m, b)
+}
+func (m *SystemQueryReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemQueryReq.Marshal(b, m, deterministic)
+}
+func (dst *SystemQueryReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemQueryReq.Merge(dst, src)
+}
+func (m *SystemQueryReq) XXX_Size() int {
+	return xxx_messageInfo_SystemQueryReq.Size(m)
+}
+func (m *SystemQueryReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemQueryReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemQueryReq proto.InternalMessageInfo
+
+func (m *SystemQueryReq) GetRanks() []uint32 {
+	if m != nil {
+		return m.Ranks
+	}
+	return nil
+}
+
+type SystemQueryResp struct {
+	Members              []*SystemMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SystemQueryResp) Reset()         { *m = SystemQueryResp{} }
+func (m *SystemQueryResp) String() string { return proto.CompactTextString(m) }
+func (*SystemQueryResp) ProtoMessage()    {}
+func (*SystemQueryResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_system_cf094448f254b801, []int{2}
+}
+func (m *SystemQueryResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemQueryResp.Unmarshal(m, b)
+}
+func (m *SystemQueryResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemQueryResp.Marshal(b, m, deterministic)
+}
+func (dst *SystemQueryResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemQueryResp.Merge(dst, src)
+}
+func (m *SystemQueryResp) XXX_Size() int {
+	return xxx_messageInfo_SystemQueryResp.Size(m)
+}
+func (m *SystemQueryResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemQueryResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemQueryResp proto.InternalMessageInfo
+
+func (m *SystemQueryResp) GetMembers() []*SystemMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type SystemStopReq struct {
+	Ranks                []uint32 `protobuf:"varint,1,rep,packed,name=ranks,proto3" json:"ranks,omitempty"`
+	Force                bool     `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	Replicas             bool     `protobuf:"varint,3,opt,name=replicas,proto3" json:"replicas,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemStopReq) Reset()         { *m = SystemStopReq{} }
+func (m *SystemStopReq) String() string { return proto.CompactTextString(m) }
+func (*SystemStopReq) ProtoMessage()    {}
+func (*SystemStopReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_system_cf094448f254b801, []int{3}
+}
+func (m *SystemStopReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemStopReq.Unmarshal(m, b)
+}
+func (m *SystemStopReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemStopReq.Marshal(b, m, deterministic)
+}
+func (dst *SystemStopReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemStopReq.Merge(dst, src)
+}
+func (m *SystemStopReq) XXX_Size() int {
+	return xxx_messageInfo_SystemStopReq.Size(m)
+}
+func (m *SystemStopReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemStopReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemStopReq proto.InternalMessageInfo
+
+func (m *SystemStopReq) GetRanks() []uint32 {
+	if m != nil {
+		return m.Ranks
+	}
+	return nil
+}
+
+func (m *SystemStopReq) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func (m *SystemStopReq) GetReplicas() bool {
+	if m != nil {
+		return m.Replicas
+	}
+	return false
+}
+
+// RankResult describes the outcome of an action performed on a rank.
+type RankResult struct {
+	Rank                 uint32   `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Errored              bool     `protobuf:"varint,3,opt,name=errored,proto3" json:"errored,omitempty"`
+	Msg                  string   `protobuf:"bytes,4,opt,name=msg,proto3" json:"msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RankResult) Reset()         { *m = RankResult{} }
+func (m *RankResult) String() string { return proto.CompactTextString(m) }
+func (*RankResult) ProtoMessage()    {}
+func (*RankResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_system_cf094448f254b801, []int{4}
+}
+func (m *RankResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RankResult.Unmarshal(m, b)
+}
+func (m *RankResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RankResult.Marshal(b, m, deterministic)
+}
+func (dst *RankResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RankResult.Merge(dst, src)
+}
+func (m *RankResult) XXX_Size() int {
+	return xxx_messageInfo_RankResult.Size(m)
+}
+func (m *RankResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_RankResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RankResult proto.InternalMessageInfo
+
+func (m *RankResult) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *RankResult) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *RankResult) GetErrored() bool {
+	if m != nil {
+		return m.Errored
+	}
+	return false
+}
+
+func (m *RankResult) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+type SystemStopResp struct {
+	Results              []*RankResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SystemStopResp) Reset()         { *m = SystemStopResp{} }
+func (m *SystemStopResp) String() string { return proto.CompactTextString(m) }
+func (*SystemStopResp) ProtoMessage()    {}
+func (*SystemStopResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_system_cf094448f254b801, []int{5}
+}
+func (m *SystemStopResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemStopResp.Unmarshal(m, b)
+}
+func (m *SystemStopResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemStopResp.Marshal(b, m, deterministic)
+}
+func (dst *SystemStopResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemStopResp.Merge(dst, src)
+}
+func (m *SystemStopResp) XXX_Size() int {
+	return xxx_messageInfo_SystemStopResp.Size(m)
+}
+func (m *SystemStopResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemStopResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemStopResp proto.InternalMessageInfo
+
+func (m *SystemStopResp) GetResults() []*RankResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type SystemStartReq struct {
+	Ranks                []uint32 `protobuf:"varint,1,rep,packed,name=ranks,proto3" json:"ranks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemStartReq) Reset()         { *m = SystemStartReq{} }
+func (m *SystemStartReq) String() string { return proto.CompactTextString(m) }
+func (*SystemStartReq) ProtoMessage()    {}
+func (*SystemStartReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_system_cf094448f254b801, []int{6}
+}
+func (m *SystemStartReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemStartReq.Unmarshal(m, b)
+}
+func (m *SystemStartReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemStartReq.Marshal(b, m, deterministic)
+}
+func (dst *SystemStartReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemStartReq.Merge(dst, src)
+}
+func (m *SystemStartReq) XXX_Size() int {
+	return xxx_messageInfo_SystemStartReq.Size(m)
+}
+func (m *SystemStartReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemStartReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemStartReq proto.InternalMessageInfo
+
+func (m *SystemStartReq) GetRanks() []uint32 {
+	if m != nil {
+		return m.Ranks
+	}
+	return nil
+}
+
+type SystemStartResp struct {
+	Results              []*RankResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *SystemStartResp) Reset()         { *m = SystemStartResp{} }
+func (m *SystemStartResp) String() string { return proto.CompactTextString(m) }
+func (*SystemStartResp) ProtoMessage()    {}
+func (*SystemStartResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_system_cf094448f254b801, []int{7}
+}
+func (m *SystemStartResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemStartResp.Unmarshal(m, b)
+}
+func (m *SystemStartResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemStartResp.Marshal(b, m, deterministic)
+}
+func (dst *SystemStartResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemStartResp.Merge(dst, src)
+}
+func (m *SystemStartResp) XXX_Size() int {
+	return xxx_messageInfo_SystemStartResp.Size(m)
+}
+func (m *SystemStartResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemStartResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemStartResp proto.InternalMessageInfo
+
+func (m *SystemStartResp) GetResults() []*RankResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// SystemHeartbeatReq reports the outcome of the latest liveness check of an
+// I/O server instance by its control plane.
+type SystemHeartbeatReq struct {
+	Rank                 uint32   `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Uuid                 string   `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Responsive           bool     `protobuf:"varint,3,opt,name=responsive,proto3" json:"responsive,omitempty"`
+	Latency              int64    `protobuf:"varint,4,opt,name=latency,proto3" json:"latency,omitempty"`
+	Missed               uint32   `protobuf:"varint,5,opt,name=missed,proto3" json:"missed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemHeartbeatReq) Reset()         { *m = SystemHeartbeatReq{} }
+func (m *SystemHeartbeatReq) String() string { return proto.CompactTextString(m) }
+func (*SystemHeartbeatReq) ProtoMessage()    {}
+func (*SystemHeartbeatReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_system_cf094448f254b801, []int{8}
+}
+func (m *SystemHeartbeatReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemHeartbeatReq.Unmarshal(m, b)
+}
+func (m *SystemHeartbeatReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemHeartbeatReq.Marshal(b, m, deterministic)
+}
+func (dst *SystemHeartbeatReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemHeartbeatReq.Merge(dst, src)
+}
+func (m *SystemHeartbeatReq) XXX_Size() int {
+	return xxx_messageInfo_SystemHeartbeatReq.Size(m)
+}
+func (m *SystemHeartbeatReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemHeartbeatReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemHeartbeatReq proto.InternalMessageInfo
+
+func (m *SystemHeartbeatReq) GetRank() uint32 {
+	if m != nil {
+		return m.Rank
+	}
+	return 0
+}
+
+func (m *SystemHeartbeatReq) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *SystemHeartbeatReq) GetResponsive() bool {
+	if m != nil {
+		return m.Responsive
+	}
+	return false
+}
+
+func (m *SystemHeartbeatReq) GetLatency() int64 {
+	if m != nil {
+		return m.Latency
+	}
+	return 0
+}
+
+func (m *SystemHeartbeatReq) GetMissed() uint32 {
+	if m != nil {
+		return m.Missed
+	}
+	return 0
+}
+
+type SystemHeartbeatResp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemHeartbeatResp) Reset()         { *m = SystemHeartbeatResp{} }
+func (m *SystemHeartbeatResp) String() string { return proto.CompactTextString(m) }
+func (*SystemHeartbeatResp) ProtoMessage()    {}
+func (*SystemHeartbeatResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_system_cf094448f254b801, []int{9}
+}
+func (m *SystemHeartbeatResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemHeartbeatResp.Unmarshal(m, b)
+}
+func (m *SystemHeartbeatResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemHeartbeatResp.Marshal(b, m, deterministic)
+}
+func (dst *SystemHeartbeatResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemHeartbeatResp.Merge(dst, src)
+}
+func (m *SystemHeartbeatResp) XXX_Size() int {
+	return xxx_messageInfo_SystemHeartbeatResp.Size(m)
+}
+func (m *SystemHeartbeatResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemHeartbeatResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemHeartbeatResp proto.InternalMessageInfo
+
+// SystemDbEntry is an entry in the replicated system database log.
+type SystemDbEntry struct {
+	Term                 uint64   `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	Index                uint64   `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Op                   string   `protobuf:"bytes,3,opt,name=op,proto3" json:"op,omitempty"`
+	Data                 []byte   `protobuf:"bytes,4,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemDbEntry) Reset()         { *m = SystemDbEntry{} }
+func (m *SystemDbEntry) String() string { return proto.CompactTextString(m) }
+func (*SystemDbEntry) ProtoMessage()    {}
+func (*SystemDbEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_system_cf094448f254b801, []int{10}
+}
+func (m *SystemDbEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemDbEntry.Unmarshal(m, b)
+}
+func (m *SystemDbEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemDbEntry.Marshal(b, m, deterministic)
+}
+func (dst *SystemDbEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemDbEntry.Merge(dst, src)
+}
+func (m *SystemDbEntry) XXX_Size() int {
+	return xxx_messageInfo_SystemDbEntry.Size(m)
+}
+func (m *SystemDbEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemDbEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemDbEntry proto.InternalMessageInfo
+
+func (m *SystemDbEntry) GetTerm() uint64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *SystemDbEntry) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *SystemDbEntry) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *SystemDbEntry) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+// SystemDbVoteReq solicits a vote for a candidate system database leader.
+type SystemDbVoteReq struct {
+	Term                 uint64   `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	Candidate            string   `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	LastLogIndex         uint64   `protobuf:"varint,3,opt,name=last_log_index,json=lastLogIndex,proto3" json:"last_log_index,omitempty"`
+	LastLogTerm          uint64   `protobuf:"varint,4,opt,name=last_log_term,json=lastLogTerm,proto3" json:"last_log_term,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemDbVoteReq) Reset()         { *m = SystemDbVoteReq{} }
+func (m *SystemDbVoteReq) String() string { return proto.CompactTextString(m) }
+func (*SystemDbVoteReq) ProtoMessage()    {}
+func (*SystemDbVoteReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_system_cf094448f254b801, []int{11}
+}
+func (m *SystemDbVoteReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemDbVoteReq.Unmarshal(m, b)
+}
+func (m *SystemDbVoteReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemDbVoteReq.Marshal(b, m, deterministic)
+}
+func (dst *SystemDbVoteReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemDbVoteReq.Merge(dst, src)
+}
+func (m *SystemDbVoteReq) XXX_Size() int {
+	return xxx_messageInfo_SystemDbVoteReq.Size(m)
+}
+func (m *SystemDbVoteReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemDbVoteReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemDbVoteReq proto.InternalMessageInfo
+
+func (m *SystemDbVoteReq) GetTerm() uint64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *SystemDbVoteReq) GetCandidate() string {
+	if m != nil {
+		return m.Candidate
+	}
+	return ""
+}
+
+func (m *SystemDbVoteReq) GetLastLogIndex() uint64 {
+	if m != nil {
+		return m.LastLogIndex
+	}
+	return 0
+}
+
+func (m *SystemDbVoteReq) GetLastLogTerm() uint64 {
+	if m != nil {
+		return m.LastLogTerm
+	}
+	return 0
+}
+
+type SystemDbVoteResp struct {
+	Term                 uint64   `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	Granted              bool     `protobuf:"varint,2,opt,name=granted,proto3" json:"granted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemDbVoteResp) Reset()         { *m = SystemDbVoteResp{} }
+func (m *SystemDbVoteResp) String() string { return proto.CompactTextString(m) }
+func (*SystemDbVoteResp) ProtoMessage()    {}
+func (*SystemDbVoteResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_system_cf094448f254b801, []int{12}
+}
+func (m *SystemDbVoteResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemDbVoteResp.Unmarshal(m, b)
+}
+func (m *SystemDbVoteResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemDbVoteResp.Marshal(b, m, deterministic)
+}
+func (dst *SystemDbVoteResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemDbVoteResp.Merge(dst, src)
+}
+func (m *SystemDbVoteResp) XXX_Size() int {
+	return xxx_messageInfo_SystemDbVoteResp.Size(m)
+}
+func (m *SystemDbVoteResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemDbVoteResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemDbVoteResp proto.InternalMessageInfo
+
+func (m *SystemDbVoteResp) GetTerm() uint64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *SystemDbVoteResp) GetGranted() bool {
+	if m != nil {
+		return m.Granted
+	}
+	return false
+}
+
+// SystemDbAppendReq replicates system database log entries from the leader,
+// or acts as a heartbeat if there are no entries.
+type SystemDbAppendReq struct {
+	Term                 uint64           `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	Leader               string           `protobuf:"bytes,2,opt,name=leader,proto3" json:"leader,omitempty"`
+	PrevLogIndex         uint64           `protobuf:"varint,3,opt,name=prev_log_index,json=prevLogIndex,proto3" json:"prev_log_index,omitempty"`
+	PrevLogTerm          uint64           `protobuf:"varint,4,opt,name=prev_log_term,json=prevLogTerm,proto3" json:"prev_log_term,omitempty"`
+	Entries              []*SystemDbEntry `protobuf:"bytes,5,rep,name=entries,proto3" json:"entries,omitempty"`
+	LeaderCommit         uint64           `protobuf:"varint,6,opt,name=leader_commit,json=leaderCommit,proto3" json:"leader_commit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SystemDbAppendReq) Reset()         { *m = SystemDbAppendReq{} }
+func (m *SystemDbAppendReq) String() string { return proto.CompactTextString(m) }
+func (*SystemDbAppendReq) ProtoMessage()    {}
+func (*SystemDbAppendReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_system_cf094448f254b801, []int{13}
+}
+func (m *SystemDbAppendReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemDbAppendReq.Unmarshal(m, b)
+}
+func (m *SystemDbAppendReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemDbAppendReq.Marshal(b, m, deterministic)
+}
+func (dst *SystemDbAppendReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemDbAppendReq.Merge(dst, src)
+}
+func (m *SystemDbAppendReq) XXX_Size() int {
+	return xxx_messageInfo_SystemDbAppendReq.Size(m)
+}
+func (m *SystemDbAppendReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemDbAppendReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemDbAppendReq proto.InternalMessageInfo
+
+func (m *SystemDbAppendReq) GetTerm() uint64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *SystemDbAppendReq) GetLeader() string {
+	if m != nil {
+		return m.Leader
+	}
+	return ""
+}
+
+func (m *SystemDbAppendReq) GetPrevLogIndex() uint64 {
+	if m != nil {
+		return m.PrevLogIndex
+	}
+	return 0
+}
+
+func (m *SystemDbAppendReq) GetPrevLogTerm() uint64 {
+	if m != nil {
+		return m.PrevLogTerm
+	}
+	return 0
+}
+
+func (m *SystemDbAppendReq) GetEntries() []*SystemDbEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *SystemDbAppendReq) GetLeaderCommit() uint64 {
+	if m != nil {
+		return m.LeaderCommit
+	}
+	return 0
+}
+
+type SystemDbAppendResp struct {
+	Term                 uint64   `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	Success              bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	LastIndex            uint64   `protobuf:"varint,3,opt,name=last_index,json=lastIndex,proto3" json:"last_index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemDbAppendResp) Reset()         { *m = SystemDbAppendResp{} }
+func (m *SystemDbAppendResp) String() string { return proto.CompactTextString(m) }
+func (*SystemDbAppendResp) ProtoMessage()    {}
+func (*SystemDbAppendResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_system_cf094448f254b801, []int{14}
+}
+func (m *SystemDbAppendResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemDbAppendResp.Unmarshal(m, b)
+}
+func (m *SystemDbAppendResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemDbAppendResp.Marshal(b, m, deterministic)
+}
+func (dst *SystemDbAppendResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemDbAppendResp.Merge(dst, src)
+}
+func (m *SystemDbAppendResp) XXX_Size() int {
+	return xxx_messageInfo_SystemDbAppendResp.Size(m)
+}
+func (m *SystemDbAppendResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemDbAppendResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemDbAppendResp proto.InternalMessageInfo
+
+func (m *SystemDbAppendResp) GetTerm() uint64 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *SystemDbAppendResp) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *SystemDbAppendResp) GetLastIndex() uint64 {
+	if m != nil {
+		return m.LastIndex
+	}
+	return 0
+}
+
+// SystemDbSubmitReq forwards a system database update to the leader.
+type SystemDbSubmitReq struct {
+	Op                   string   `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemDbSubmitReq) Reset()         { *m = SystemDbSubmitReq{} }
+func (m *SystemDbSubmitReq) String() string { return proto.CompactTextString(m) }
+func (*SystemDbSubmitReq) ProtoMessage()    {}
+func (*SystemDbSubmitReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_system_cf094448f254b801, []int{15}
+}
+func (m *SystemDbSubmitReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemDbSubmitReq.Unmarshal(m, b)
+}
+func (m *SystemDbSubmitReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemDbSubmitReq.Marshal(b, m, deterministic)
+}
+func (dst *SystemDbSubmitReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemDbSubmitReq.Merge(dst, src)
+}
+func (m *SystemDbSubmitReq) XXX_Size() int {
+	return xxx_messageInfo_SystemDbSubmitReq.Size(m)
+}
+func (m *SystemDbSubmitReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemDbSubmitReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemDbSubmitReq proto.InternalMessageInfo
+
+func (m *SystemDbSubmitReq) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *SystemDbSubmitReq) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type SystemDbSubmitResp struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SystemDbSubmitResp) Reset()         { *m = SystemDbSubmitResp{} }
+func (m *SystemDbSubmitResp) String() string { return proto.CompactTextString(m) }
+func (*SystemDbSubmitResp) ProtoMessage()    {}
+func (*SystemDbSubmitResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_system_cf094448f254b801, []int{16}
+}
+func (m *SystemDbSubmitResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SystemDbSubmitResp.Unmarshal(m, b)
+}
+func (m *SystemDbSubmitResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SystemDbSubmitResp.Marshal(b, m, deterministic)
+}
+func (dst *SystemDbSubmitResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemDbSubmitResp.Merge(dst, src)
+}
+func (m *SystemDbSubmitResp) XXX_Size() int {
+	return xxx_messageInfo_SystemDbSubmitResp.Size(m)
+}
+func (m *SystemDbSubmitResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemDbSubmitResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemDbSubmitResp proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SystemMember)(nil), "mgmt.SystemMember")
+	proto.RegisterType((*SystemQueryReq)(nil), "mgmt.SystemQueryReq")
+	proto.RegisterType((*SystemQueryResp)(nil), "mgmt.SystemQueryResp")
+	proto.RegisterType((*SystemStopReq)(nil), "mgmt.SystemStopReq")
+	proto.RegisterType((*RankResult)(nil), "mgmt.RankResult")
+	proto.RegisterType((*SystemStopResp)(nil), "mgmt.SystemStopResp")
+	proto.RegisterType((*SystemStartReq)(nil), "mgmt.SystemStartReq")
+	proto.RegisterType((*SystemStartResp)(nil), "mgmt.SystemStartResp")
+	proto.RegisterType((*SystemHeartbeatReq)(nil), "mgmt.SystemHeartbeatReq")
+	proto.RegisterType((*SystemHeartbeatResp)(nil), "mgmt.SystemHeartbeatResp")
+	proto.RegisterType((*SystemDbEntry)(nil), "mgmt.SystemDbEntry")
+	proto.RegisterType((*SystemDbVoteReq)(nil), "mgmt.SystemDbVoteReq")
+	proto.RegisterType((*SystemDbVoteResp)(nil), "mgmt.SystemDbVoteResp")
+	proto.RegisterType((*SystemDbAppendReq)(nil), "mgmt.SystemDbAppendReq")
+	proto.RegisterType((*SystemDbAppendResp)(nil), "mgmt.SystemDbAppendResp")
+	proto.RegisterType((*SystemDbSubmitReq)(nil), "mgmt.SystemDbSubmitReq")
+	proto.RegisterType((*SystemDbSubmitResp)(nil), "mgmt.SystemDbSubmitResp")
+}
+
+func init() { proto.RegisterFile("system.proto", fileDescriptor_system_cf094448f254b801) }
+
+var fileDescriptor_system_cf094448f254b801 = []byte{
+	// 681 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xdd, 0x6e, 0x13, 0x3d,
+	0x10, 0xd5, 0x26, 0x69, 0x92, 0x4e, 0x93, 0x34, 0x75, 0xfb, 0x55, 0xab, 0xef, 0xfb, 0x40, 0xd1,
+	0x82, 0x50, 0xc4, 0x4f, 0x2f, 0xe0, 0x82, 0x1b, 0x10, 0x20, 0x8a, 0x04, 0x52, 0xb9, 0xc0, 0x41,
+	0x70, 0x85, 0x22, 0x67, 0x77, 0x08, 0xab, 0x66, 0x7f, 0xb0, 0xbd, 0x15, 0x79, 0x08, 0x78, 0x22,
+	0x5e, 0x85, 0x77, 0x41, 0x1e, 0xdb, 0xc9, 0xb6, 0x69, 0x24, 0xb8, 0x9b, 0x39, 0x39, 0xde, 0x39,
+	0xe7, 0x78, 0xdc, 0x42, 0x4f, 0x2d, 0x95, 0xc6, 0xec, 0xa4, 0x94, 0x85, 0x2e, 0x58, 0x2b, 0x9b,
+	0x67, 0x3a, 0xfa, 0x19, 0x40, 0x6f, 0x42, 0xf0, 0x5b, 0xcc, 0x66, 0x28, 0x19, 0x83, 0x96, 0x14,
+	0xf9, 0x79, 0x18, 0x8c, 0x82, 0x71, 0x9f, 0x53, 0x6d, 0xb0, 0xaa, 0x4a, 0x93, 0xb0, 0x31, 0x0a,
+	0xc6, 0xbb, 0x9c, 0x6a, 0x36, 0x84, 0x66, 0x25, 0xd3, 0xb0, 0x49, 0x90, 0x29, 0x0d, 0x4b, 0x24,
+	0x89, 0x0c, 0x5b, 0x96, 0x65, 0x6a, 0x76, 0x04, 0x3b, 0x4a, 0x0b, 0x8d, 0xe1, 0x0e, 0x81, 0xb6,
+	0x61, 0xff, 0xc1, 0xee, 0x42, 0x28, 0x3d, 0x55, 0x88, 0x79, 0xd8, 0x1e, 0x05, 0xe3, 0x26, 0xef,
+	0x1a, 0x60, 0x82, 0x98, 0xb3, 0x7b, 0x70, 0xf0, 0x05, 0x85, 0xd4, 0x33, 0x14, 0x7a, 0xba, 0x10,
+	0x1a, 0xf3, 0x78, 0x19, 0x76, 0x88, 0x34, 0x5c, 0xfd, 0x70, 0x66, 0xf1, 0xe8, 0x0e, 0x0c, 0xac,
+	0xfa, 0x77, 0x15, 0xca, 0x25, 0xc7, 0xaf, 0x66, 0xa2, 0xd1, 0xac, 0xc2, 0x60, 0xd4, 0x1c, 0xf7,
+	0xb9, 0x6d, 0xa2, 0x67, 0xb0, 0x7f, 0x89, 0xa7, 0x4a, 0x76, 0x1f, 0x3a, 0x19, 0x59, 0xb6, 0xd4,
+	0xbd, 0x87, 0xec, 0xc4, 0x24, 0x72, 0x52, 0x4f, 0x83, 0x7b, 0x4a, 0xf4, 0x11, 0xfa, 0xf6, 0x87,
+	0x89, 0x2e, 0xca, 0xad, 0x73, 0x0c, 0xfa, 0xb9, 0x90, 0x31, 0x52, 0x54, 0x5d, 0x6e, 0x1b, 0xf6,
+	0x2f, 0x74, 0x25, 0x96, 0x8b, 0x34, 0x16, 0x8a, 0x02, 0xeb, 0xf2, 0x55, 0x1f, 0x25, 0x00, 0x5c,
+	0xe4, 0xe7, 0x1c, 0x55, 0xb5, 0xd0, 0xd7, 0xa6, 0x7f, 0x0c, 0x6d, 0x11, 0xeb, 0xb4, 0xc8, 0x5d,
+	0xfe, 0xae, 0x63, 0x21, 0x74, 0x50, 0xca, 0x42, 0x62, 0xe2, 0x3e, 0xea, 0x5b, 0x73, 0x37, 0x99,
+	0x9a, 0xbb, 0x8b, 0x30, 0x65, 0xf4, 0x04, 0x06, 0x75, 0xf9, 0xaa, 0x64, 0x77, 0xa1, 0x23, 0x69,
+	0xa6, 0xb7, 0x3f, 0xb4, 0xf6, 0xd7, 0x62, 0xb8, 0x27, 0xac, 0x53, 0x9e, 0x68, 0x21, 0xf5, 0xf6,
+	0x94, 0x9f, 0xc2, 0xfe, 0x25, 0xde, 0x5f, 0x8e, 0xf9, 0x11, 0x00, 0xb3, 0xe7, 0x5f, 0xfb, 0x7b,
+	0x36, 0xb3, 0xfe, 0x74, 0x23, 0x6f, 0x02, 0x48, 0x54, 0x65, 0x91, 0xab, 0xf4, 0x02, 0x5d, 0x24,
+	0x35, 0xc4, 0xe4, 0xe5, 0xd7, 0xa9, 0x45, 0xeb, 0xe4, 0x5b, 0x93, 0x70, 0x96, 0x2a, 0x85, 0x09,
+	0xad, 0x69, 0x9f, 0xbb, 0x2e, 0xfa, 0x07, 0x0e, 0x37, 0xf4, 0xa8, 0x32, 0xfa, 0xe4, 0x77, 0xe1,
+	0x74, 0xf6, 0x2a, 0xd7, 0x72, 0x69, 0xd4, 0x68, 0x94, 0x19, 0x29, 0x6c, 0x71, 0xaa, 0x4d, 0x42,
+	0x69, 0x9e, 0xe0, 0x37, 0x92, 0xd8, 0xe2, 0xb6, 0x61, 0x03, 0x68, 0x14, 0xa5, 0x7b, 0x34, 0x8d,
+	0xa2, 0x34, 0x27, 0x13, 0xa1, 0x05, 0x09, 0xea, 0x71, 0xaa, 0xa3, 0xef, 0x81, 0x8f, 0xf1, 0x74,
+	0xf6, 0xa1, 0xd0, 0xe8, 0x32, 0xd8, 0x98, 0xf0, 0x3f, 0xec, 0xc6, 0x22, 0x4f, 0xd2, 0xc4, 0xbc,
+	0x2f, 0x1b, 0xc4, 0x1a, 0x60, 0xb7, 0x61, 0x40, 0x6f, 0x6c, 0x51, 0xcc, 0xa7, 0x56, 0x48, 0x93,
+	0xce, 0xf6, 0x0c, 0x7a, 0x56, 0xcc, 0xdf, 0x90, 0x9e, 0x08, 0xfa, 0x2b, 0x16, 0x0d, 0x68, 0x11,
+	0x69, 0xcf, 0x91, 0xde, 0xa3, 0xcc, 0xa2, 0xe7, 0x30, 0xbc, 0x2c, 0x47, 0x95, 0xd7, 0xea, 0x09,
+	0xa1, 0x33, 0x97, 0x22, 0xd7, 0x98, 0xb8, 0xed, 0xf7, 0x6d, 0xf4, 0x2b, 0x80, 0x03, 0xff, 0x89,
+	0x17, 0x65, 0x89, 0x79, 0xb2, 0xcd, 0xd3, 0x31, 0xb4, 0x17, 0x28, 0x12, 0x94, 0x7e, 0xd7, 0x6d,
+	0x67, 0xdc, 0x94, 0x12, 0x2f, 0x36, 0xdd, 0x18, 0xb4, 0xee, 0x66, 0xc5, 0xaa, 0xbb, 0x71, 0x24,
+	0xe3, 0x86, 0x3d, 0x80, 0x0e, 0xe6, 0x5a, 0xa6, 0xa8, 0xc2, 0x1d, 0x5a, 0xc8, 0xc3, 0xfa, 0xb3,
+	0x77, 0x37, 0xca, 0x3d, 0x87, 0xdd, 0x82, 0xbe, 0x95, 0x30, 0x8d, 0x8b, 0x2c, 0x4b, 0x75, 0xd8,
+	0x76, 0x29, 0x12, 0xf8, 0x92, 0xb0, 0x48, 0x00, 0xbb, 0x6a, 0x6f, 0x7b, 0x46, 0xaa, 0x8a, 0x63,
+	0x54, 0xca, 0x67, 0xe4, 0x5a, 0x76, 0x03, 0x80, 0x6e, 0xa2, 0xee, 0x8e, 0xfe, 0x4a, 0x92, 0xb5,
+	0xe8, 0xf1, 0x3a, 0xc1, 0x49, 0x35, 0xcb, 0x52, 0x7a, 0x19, 0x76, 0x9b, 0x82, 0x8d, 0x6d, 0x6a,
+	0xd4, 0xb6, 0xe9, 0x08, 0xd8, 0xd5, 0x83, 0xaa, 0x9c, 0xb5, 0xe9, 0x7f, 0xc0, 0xa3, 0xdf, 0x03,
+	0x00, 0xed, 0x35, 0x71, 0x10, 0x13, 0x06, 0x00, 0x00,
+}
@@ -27,6 +27,7 @@ import (
 	"bytes"
 	"fmt"
 
+	"github.com/daos-stack/daos/src/control/common"
 	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 )
 
@@ -165,9 +166,15 @@ func (ncr NvmeControllerResults) String() string {
 // CtrlrResults contains controllers and/or results of operations on controllers
 // and an error signifying a problem in making the request.
 type CtrlrResults struct {
-	Ctrlrs    NvmeControllers
-	Responses NvmeControllerResults
-	Err       error
+	Ctrlrs    NvmeControllers       `json:"ctrlrs,omitempty"`
+	Responses NvmeControllerResults `json:"results,omitempty"`
+	Err       error                 `json:"-"`
+}
+
+// MarshalJSON encodes the results with any error rendered as a string.
+func (cr CtrlrResults) MarshalJSON() ([]byte, error) {
+	type toJSON CtrlrResults
+	return common.MarshalWithError(toJSON(cr), cr.Err)
 }
 
 func (cr CtrlrResults) String() string {
@@ -200,8 +207,14 @@ func (pds PmemDevices) String() string {
 
 // PmemResults contains PMEM device file details created on SCM regions.
 type PmemResults struct {
-	Devices PmemDevices
-	Err     error
+	Devices PmemDevices `json:"devices,omitempty"`
+	Err     error       `json:"-"`
+}
+
+// MarshalJSON encodes the results with any error rendered as a string.
+func (pr PmemResults) MarshalJSON() ([]byte, error) {
+	type toJSON PmemResults
+	return common.MarshalWithError(toJSON(pr), pr.Err)
 }
 
 // ScmMountResults is an alias for protobuf ScmMountResult message slice
@@ -255,9 +268,15 @@ func (smr ScmMountResults) String() string {
 // MountResults contains modules and/or results of operations on mounted SCM
 // regions and an error signifying a problem in making the request.
 type MountResults struct {
-	Mounts    ScmMounts
-	Responses ScmMountResults
-	Err       error
+	Mounts    ScmMounts       `json:"mounts,omitempty"`
+	Responses ScmMountResults `json:"results,omitempty"`
+	Err       error           `json:"-"`
+}
+
+// MarshalJSON encodes the results with any error rendered as a string.
+func (mr MountResults) MarshalJSON() ([]byte, error) {
+	type toJSON MountResults
+	return common.MarshalWithError(toJSON(mr), mr.Err)
 }
 
 func (mr MountResults) String() string {
@@ -322,9 +341,15 @@ func (smr ScmModuleResults) String() string {
 // ModuleResults contains scm modules and/or results of operations on modules
 // and an error signifying a problem in making the request.
 type ModuleResults struct {
-	Modules   ScmModules
-	Responses ScmModuleResults
-	Err       error
+	Modules   ScmModules       `json:"modules,omitempty"`
+	Responses ScmModuleResults `json:"results,omitempty"`
+	Err       error            `json:"-"`
+}
+
+// MarshalJSON encodes the results with any error rendered as a string.
+func (mr ModuleResults) MarshalJSON() ([]byte, error) {
+	type toJSON ModuleResults
+	return common.MarshalWithError(toJSON(mr), mr.Err)
 }
 
 func (mr ModuleResults) String() string {
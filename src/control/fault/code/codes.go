@@ -41,4 +41,12 @@ const (
 
 	// security fault codes
 	SecurityUnknown Code = iota + 200
+
+	// system fault codes
+	SystemUnknown Code = iota + 300
+	SystemJoinRankChanged
+	SystemJoinRankTaken
+	SystemJoinExcluded
+	SystemJoinRejected
+	SystemJoinTimeout
 )
@@ -0,0 +1,84 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+// +build linux,amd64
+//
+
+package netdetect
+
+import (
+	"math/bits"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// GetFabricDeviceNames returns the names of the network interfaces that are
+// candidates for use as a fabric interface, i.e. those that are up and are
+// not loopback devices.
+func GetFabricDeviceNames() ([]string, error) {
+	networkInterfaces, err := net.Interfaces()
+	if err != nil {
+		return nil, errors.Wrap(err, "detecting network interfaces")
+	}
+
+	return filterFabricInterfaces(networkInterfaces), nil
+}
+
+func filterFabricInterfaces(ifaces []net.Interface) []string {
+	netNames := make([]string, 0, len(ifaces))
+	for _, i := range ifaces {
+		if i.Flags&net.FlagUp == 0 || i.Flags&net.FlagLoopback != 0 {
+			continue
+		}
+		netNames = append(netNames, i.Name)
+	}
+
+	return netNames
+}
+
+// NUMANodeFromNodeSet returns the lowest NUMA node set in the string
+// representation of an hwloc nodeset bitmap, as reported in
+// DeviceAffinity.NodeSet.
+//
+// hwloc formats bitmaps as comma separated 32-bit hexadecimal words, most
+// significant word first, e.g. "0x00000002" or "0x00000001,0x00000000".
+func NUMANodeFromNodeSet(nodeset string) (uint, error) {
+	words := strings.Split(nodeset, ",")
+	for i := len(words) - 1; i >= 0; i-- {
+		word := strings.TrimPrefix(strings.TrimSpace(words[i]), "0x")
+		val, err := strconv.ParseUint(word, 16, 32)
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid nodeset %q", nodeset)
+		}
+		if val == 0 {
+			continue
+		}
+
+		offset := uint(len(words)-1-i) * 32
+		return offset + uint(bits.TrailingZeros32(uint32(val))), nil
+	}
+
+	return 0, errors.Errorf("no NUMA node in nodeset %q", nodeset)
+}
@@ -0,0 +1,72 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package netdetect
+
+import (
+	"net"
+	"testing"
+
+	. "github.com/daos-stack/daos/src/control/common"
+)
+
+func TestFilterFabricInterfaces(t *testing.T) {
+	ifaces := []net.Interface{
+		{Name: "lo", Flags: net.FlagUp | net.FlagLoopback},
+		{Name: "eth0", Flags: net.FlagUp | net.FlagBroadcast},
+		{Name: "eth1", Flags: net.FlagBroadcast},
+		{Name: "ib0", Flags: net.FlagUp},
+	}
+
+	AssertEqual(t, filterFabricInterfaces(ifaces), []string{"eth0", "ib0"},
+		"unexpected fabric interfaces")
+}
+
+func TestNUMANodeFromNodeSet(t *testing.T) {
+	for name, tt := range map[string]struct {
+		nodeset string
+		expNode uint
+		expErr  string
+	}{
+		"node 0":          {nodeset: "0x00000001", expNode: 0},
+		"node 1":          {nodeset: "0x00000002", expNode: 1},
+		"multiple nodes":  {nodeset: "0x0000000c", expNode: 2},
+		"second word":     {nodeset: "0x00000001,0x00000000", expNode: 32},
+		"lowest of words": {nodeset: "0x00000001,0x00000100", expNode: 8},
+		"empty bitmap":    {nodeset: "0x00000000", expErr: `no NUMA node in nodeset "0x00000000"`},
+		"invalid bitmap":  {nodeset: "0xzz", expErr: `invalid nodeset "0xzz": strconv.ParseUint: parsing "zz": invalid syntax`},
+		"empty string":    {nodeset: "", expErr: `invalid nodeset "": strconv.ParseUint: parsing "": invalid syntax`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			node, err := NUMANodeFromNodeSet(tt.nodeset)
+			if tt.expErr != "" {
+				ExpectError(t, err, tt.expErr, name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEqual(t, node, tt.expNode, "unexpected NUMA node")
+		})
+	}
+}
@@ -0,0 +1,170 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+// +build linux,amd64
+//
+
+package netdetect
+
+/*
+#cgo CFLAGS: -I${SRCDIR}/../../include
+#cgo LDFLAGS: -lhwloc -lfabric
+#include <stdlib.h>
+#include <string.h>
+#include <hwloc.h>
+#include <rdma/fabric.h>
+
+static int
+provider_getinfo(const char *prov_name, struct fi_info **info)
+{
+	struct fi_info	*hints;
+	int		 rc;
+
+	hints = fi_allocinfo();
+	if (hints == NULL)
+		return -FI_ENOMEM;
+
+	hints->fabric_attr->prov_name = strdup(prov_name);
+	if (hints->fabric_attr->prov_name == NULL) {
+		fi_freeinfo(hints);
+		return -FI_ENOMEM;
+	}
+
+	rc = fi_getinfo(FI_VERSION(1, 5), NULL, NULL, 0, hints, info);
+	fi_freeinfo(hints);
+	return rc;
+}
+
+static int
+is_network_osdev(hwloc_obj_t obj)
+{
+	return obj->attr->osdev.type == HWLOC_OBJ_OSDEV_NETWORK;
+}
+*/
+import "C"
+
+import (
+	"strings"
+	"unsafe"
+
+	"github.com/pkg/errors"
+)
+
+// providerPrefix is prepended to libfabric provider names in the server
+// configuration.
+const providerPrefix = "ofi+"
+
+// GetProviderDeviceNames returns the names of the network devices on which
+// libfabric reports the given provider to be available. The provider is
+// given in the form used by the server configuration, e.g. "ofi+sockets".
+//
+// Some providers (e.g. verbs) name their domains after the fabric device
+// ("mlx5_0") rather than the network interface ("ib0"), so network devices
+// sharing a parent in the hwloc topology with a domain are also returned.
+func GetProviderDeviceNames(provider string) ([]string, error) {
+	domains, err := getProviderDomains(strings.TrimPrefix(provider, providerPrefix))
+	if err != nil {
+		return nil, err
+	}
+	if len(domains) == 0 {
+		return nil, nil
+	}
+
+	return getNetworkDevicesForDomains(domains)
+}
+
+// getProviderDomains returns the set of domain names libfabric reports for
+// the given provider.
+func getProviderDomains(provider string) (map[string]struct{}, error) {
+	var info *C.struct_fi_info
+
+	cProvider := C.CString(provider)
+	defer C.free(unsafe.Pointer(cProvider))
+
+	domains := make(map[string]struct{})
+
+	rc := C.provider_getinfo(cProvider, &info)
+	switch {
+	case rc == -C.FI_ENODATA:
+		return domains, nil
+	case rc != 0:
+		return nil, errors.Errorf("fi_getinfo for provider %q: %s",
+			provider, C.GoString(C.fi_strerror(-rc)))
+	}
+	defer C.fi_freeinfo(info)
+
+	for fi := info; fi != nil; fi = fi.next {
+		if fi.domain_attr == nil || fi.domain_attr.name == nil {
+			continue
+		}
+		domains[C.GoString(fi.domain_attr.name)] = struct{}{}
+	}
+
+	return domains, nil
+}
+
+// getNetworkDevicesForDomains returns the names of the network OS devices in
+// the hwloc topology that are either named in domains or share a parent
+// device with an OS device named in domains.
+func getNetworkDevicesForDomains(domains map[string]struct{}) ([]string, error) {
+	topology, err := initLib()
+	if err != nil {
+		return nil, errors.New("unable to initialize hwloc library")
+	}
+	defer cleanUp(topology)
+
+	depth := C.hwloc_get_type_depth(topology, C.HWLOC_OBJ_OS_DEVICE)
+	if depth != C.HWLOC_TYPE_DEPTH_OS_DEVICE {
+		return nil,
+			errors.New("hwloc_get_type_depth returned invalid value")
+	}
+
+	numObj := C.hwloc_get_nbobjs_by_depth(topology, C.uint(depth))
+
+	parents := make(map[C.hwloc_obj_t]struct{})
+	for i := C.uint(0); i < numObj; i++ {
+		node := C.hwloc_get_obj_by_depth(topology, C.uint(depth), i)
+		if node == nil {
+			continue
+		}
+		if _, found := domains[C.GoString(node.name)]; found {
+			parents[node.parent] = struct{}{}
+		}
+	}
+
+	var names []string
+	for i := C.uint(0); i < numObj; i++ {
+		node := C.hwloc_get_obj_by_depth(topology, C.uint(depth), i)
+		if node == nil || C.is_network_osdev(node) == 0 {
+			continue
+		}
+
+		name := C.GoString(node.name)
+		_, isDomain := domains[name]
+		_, sharesParent := parents[node.parent]
+		if isDomain || sharesParent {
+			names = append(names, name)
+		}
+	}
+
+	return names, nil
+}
@@ -0,0 +1,108 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package logging
+
+import (
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// LogFile is an io.Writer which appends to a log file that can be switched
+// to a new location while loggers are writing to it.
+type LogFile struct {
+	sync.Mutex
+	path string
+	file *os.File
+}
+
+func openLogFile(path string) (*os.File, error) {
+	return os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0664)
+}
+
+// OpenLogFile opens the log file at the given path for appending, creating
+// it if necessary.
+func OpenLogFile(path string) (*LogFile, error) {
+	f, err := openLogFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LogFile{path: path, file: f}, nil
+}
+
+// Path returns the location of the log file.
+func (lf *LogFile) Path() string {
+	lf.Lock()
+	defer lf.Unlock()
+
+	return lf.path
+}
+
+// Write appends p to the log file.
+func (lf *LogFile) Write(p []byte) (int, error) {
+	lf.Lock()
+	defer lf.Unlock()
+
+	if lf.file == nil {
+		return 0, errors.New("log file is closed")
+	}
+	return lf.file.Write(p)
+}
+
+// Reopen switches output to the log file at the given path, which may be
+// the current one, e.g. after it has been rotated. The current file is
+// only closed once the new one has been opened successfully.
+func (lf *LogFile) Reopen(path string) error {
+	f, err := openLogFile(path)
+	if err != nil {
+		return err
+	}
+
+	lf.Lock()
+	defer lf.Unlock()
+
+	if lf.file != nil {
+		lf.file.Close()
+	}
+	lf.path = path
+	lf.file = f
+
+	return nil
+}
+
+// Close closes the log file.
+func (lf *LogFile) Close() error {
+	lf.Lock()
+	defer lf.Unlock()
+
+	if lf.file == nil {
+		return nil
+	}
+	err := lf.file.Close()
+	lf.file = nil
+
+	return err
+}
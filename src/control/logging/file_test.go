@@ -0,0 +1,82 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package logging_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestLogFileReopen(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	firstPath := filepath.Join(tmpDir, "first.log")
+	secondPath := filepath.Join(tmpDir, "second.log")
+
+	lf, err := logging.OpenLogFile(firstPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lf.Close()
+
+	log := logging.NewCombinedLogger("test", lf)
+	log.Info("before reopen")
+
+	if err := lf.Reopen(filepath.Join(tmpDir, "missing", "third.log")); err == nil {
+		t.Fatal("expected reopen in missing directory to fail")
+	}
+	if lf.Path() != firstPath {
+		t.Fatalf("expected path %s after failed reopen, got %s", firstPath, lf.Path())
+	}
+
+	if err := lf.Reopen(secondPath); err != nil {
+		t.Fatal(err)
+	}
+	log.Info("after reopen")
+
+	for path, want := range map[string]string{
+		firstPath:  "before reopen",
+		secondPath: "after reopen",
+	} {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.Contains(string(content), want) {
+			t.Fatalf("expected %q in %s, got %q", want, path, content)
+		}
+		if strings.Count(string(content), "reopen") != 1 {
+			t.Fatalf("expected a single message in %s, got %q", path, content)
+		}
+	}
+}
@@ -0,0 +1,323 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package acl
+
+import (
+	"bufio"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxPrincipalLength is the longest principal name accepted by DAOS.
+	MaxPrincipalLength = 255
+
+	// CommentPrefix marks lines in an ACL file that are to be ignored.
+	CommentPrefix = "#"
+
+	aceFieldSep  = ":"
+	aceNumFields = 4
+
+	principalOwner      = "OWNER@"
+	principalOwnerGroup = "GROUP@"
+	principalEveryone   = "EVERYONE@"
+)
+
+// Characters used to represent access types, flags and permissions in the
+// short ACE string format, in the order they are written.
+var (
+	entryTypeChars = []struct {
+		ch  byte
+		val EntryType
+	}{
+		{'A', EntryType_ALLOW},
+		{'U', EntryType_AUDIT},
+		{'L', EntryType_ALARM},
+	}
+	flagChars = []struct {
+		ch  byte
+		val Flags
+	}{
+		{'G', Flags_GROUP},
+		{'S', Flags_ACCESS_SUCCESS},
+		{'F', Flags_ACCESS_FAILURE},
+		{'P', Flags_POOL_INHERIT},
+	}
+	permChars = []struct {
+		ch  byte
+		val Permissions
+	}{
+		{'r', Permissions_READ},
+		{'w', Permissions_WRITE},
+	}
+)
+
+// PrincipalType is the category of principal an ACE applies to. The values
+// are in canonical ACL order.
+type PrincipalType int
+
+const (
+	// PrincipalOwner is the owner of the object.
+	PrincipalOwner PrincipalType = iota
+	// PrincipalUser is a named user.
+	PrincipalUser
+	// PrincipalOwnerGroup is the owning group of the object.
+	PrincipalOwnerGroup
+	// PrincipalGroup is a named group.
+	PrincipalGroup
+	// PrincipalEveryone is anyone not matched by another entry.
+	PrincipalEveryone
+)
+
+// AccessControlEntry is a single entry in an Access Control List, in the form
+// TYPE:FLAGS:PRINCIPAL:PERMS.
+type AccessControlEntry struct {
+	Types       []EntryType
+	Flags       Flags
+	Principal   string
+	Permissions Permissions
+}
+
+// PrincipalType returns the category of principal the entry applies to.
+func (ace *AccessControlEntry) PrincipalType() PrincipalType {
+	switch ace.Principal {
+	case principalOwner:
+		return PrincipalOwner
+	case principalOwnerGroup:
+		return PrincipalOwnerGroup
+	case principalEveryone:
+		return PrincipalEveryone
+	}
+
+	if ace.Flags&Flags_GROUP != 0 {
+		return PrincipalGroup
+	}
+	return PrincipalUser
+}
+
+func (ace *AccessControlEntry) hasType(entryType EntryType) bool {
+	for _, t := range ace.Types {
+		if t == entryType {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the entry in the short ACE string format.
+func (ace *AccessControlEntry) String() string {
+	var buf strings.Builder
+
+	for _, tc := range entryTypeChars {
+		if ace.hasType(tc.val) {
+			buf.WriteByte(tc.ch)
+		}
+	}
+	buf.WriteString(aceFieldSep)
+	for _, fc := range flagChars {
+		if ace.Flags&fc.val != 0 {
+			buf.WriteByte(fc.ch)
+		}
+	}
+	buf.WriteString(aceFieldSep + ace.Principal + aceFieldSep)
+	for _, pc := range permChars {
+		if ace.Permissions&pc.val != 0 {
+			buf.WriteByte(pc.ch)
+		}
+	}
+
+	return buf.String()
+}
+
+// Validate checks that the entry is self-consistent and would be accepted by
+// DAOS.
+func (ace *AccessControlEntry) Validate() error {
+	if len(ace.Types) == 0 {
+		return errors.New("no access type")
+	}
+
+	if err := validatePrincipalName(ace.Principal); err != nil {
+		return err
+	}
+
+	pType := ace.PrincipalType()
+	isGroup := pType == PrincipalOwnerGroup || pType == PrincipalGroup
+	if isGroup != (ace.Flags&Flags_GROUP != 0) {
+		return errors.New("group flag (G) must be set for group principals only")
+	}
+
+	isAlert := ace.hasType(EntryType_AUDIT) || ace.hasType(EntryType_ALARM)
+	hasAlertFlags := ace.Flags&(Flags_ACCESS_SUCCESS|Flags_ACCESS_FAILURE) != 0
+	if isAlert != hasAlertFlags {
+		return errors.New("success (S) and failure (F) flags must be set for audit and alarm entries only")
+	}
+
+	return nil
+}
+
+// validatePrincipalName checks that a principal is of the form name@[domain].
+func validatePrincipalName(principal string) error {
+	if len(principal) > MaxPrincipalLength {
+		return errors.Errorf("principal longer than %d characters", MaxPrincipalLength)
+	}
+
+	if strings.Count(principal, "@") != 1 || strings.HasPrefix(principal, "@") {
+		return errors.Errorf("invalid principal %q", principal)
+	}
+
+	return nil
+}
+
+// ParseACE parses a single ACE string of the form TYPE:FLAGS:PRINCIPAL:PERMS
+// and validates the result.
+func ParseACE(str string) (*AccessControlEntry, error) {
+	fields := strings.Split(str, aceFieldSep)
+	if len(fields) != aceNumFields {
+		return nil, errors.Errorf("invalid ACE %q: expected %d fields separated by %q",
+			str, aceNumFields, aceFieldSep)
+	}
+
+	ace := &AccessControlEntry{Principal: fields[2]}
+	for _, ch := range []byte(fields[0]) {
+		found := false
+		for _, tc := range entryTypeChars {
+			if ch != tc.ch {
+				continue
+			}
+			if !ace.hasType(tc.val) {
+				ace.Types = append(ace.Types, tc.val)
+			}
+			found = true
+		}
+		if !found {
+			return nil, errors.Errorf("invalid ACE %q: unknown access type %q", str, ch)
+		}
+	}
+	// keep types in the same order they are written out
+	sort.Slice(ace.Types, func(i, j int) bool { return ace.Types[i] < ace.Types[j] })
+
+	for _, ch := range []byte(fields[1]) {
+		found := false
+		for _, fc := range flagChars {
+			if ch == fc.ch {
+				ace.Flags |= fc.val
+				found = true
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("invalid ACE %q: unknown flag %q", str, ch)
+		}
+	}
+
+	for _, ch := range []byte(fields[3]) {
+		found := false
+		for _, pc := range permChars {
+			if ch == pc.ch {
+				ace.Permissions |= pc.val
+				found = true
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("invalid ACE %q: unknown permission %q", str, ch)
+		}
+	}
+
+	if err := ace.Validate(); err != nil {
+		return nil, errors.WithMessagef(err, "invalid ACE %q", str)
+	}
+
+	return ace, nil
+}
+
+// AccessControlList is an ordered list of Access Control Entries.
+type AccessControlList []*AccessControlEntry
+
+// Sort puts the entries into canonical order: owner, users, owner group,
+// groups, everyone. Entries of the same principal type keep their order.
+func (acl AccessControlList) Sort() {
+	sort.SliceStable(acl, func(i, j int) bool {
+		return acl[i].PrincipalType() < acl[j].PrincipalType()
+	})
+}
+
+// Strings returns the entries in the short ACE string format.
+func (acl AccessControlList) Strings() []string {
+	strs := make([]string, 0, len(acl))
+	for _, ace := range acl {
+		strs = append(strs, ace.String())
+	}
+
+	return strs
+}
+
+// ParseACEStrings parses a list of ACE strings into an AccessControlList in
+// canonical order, rejecting malformed entries and duplicate principals.
+func ParseACEStrings(aceStrs []string) (AccessControlList, error) {
+	type principalKey struct {
+		pType PrincipalType
+		name  string
+	}
+	seen := make(map[principalKey]bool)
+
+	acl := make(AccessControlList, 0, len(aceStrs))
+	for _, str := range aceStrs {
+		ace, err := ParseACE(str)
+		if err != nil {
+			return nil, err
+		}
+
+		key := principalKey{ace.PrincipalType(), ace.Principal}
+		if seen[key] {
+			return nil, errors.Errorf("duplicate entry for principal %q", ace.Principal)
+		}
+		seen[key] = true
+
+		acl = append(acl, ace)
+	}
+	acl.Sort()
+
+	return acl, nil
+}
+
+// ParseACL reads ACE strings from the reader, one per line, and parses them
+// into an AccessControlList. Blank lines and lines beginning with '#' are
+// ignored.
+func ParseACL(reader io.Reader) (AccessControlList, error) {
+	aceStrs := make([]string, 0)
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" && !strings.HasPrefix(line, CommentPrefix) {
+			aceStrs = append(aceStrs, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, errors.WithMessage(err, "reading ACL")
+	}
+
+	return ParseACEStrings(aceStrs)
+}
@@ -0,0 +1,233 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package acl
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/daos-stack/daos/src/control/common"
+)
+
+func TestParseACE(t *testing.T) {
+	for name, tt := range map[string]struct {
+		aceStr   string
+		expACE   *AccessControlEntry
+		expPType PrincipalType
+		expStr   string
+		expErr   string
+	}{
+		"owner": {
+			aceStr: "A::OWNER@:rw",
+			expACE: &AccessControlEntry{
+				Types:       []EntryType{EntryType_ALLOW},
+				Principal:   "OWNER@",
+				Permissions: Permissions_READ | Permissions_WRITE,
+			},
+			expPType: PrincipalOwner,
+		},
+		"owner group": {
+			aceStr: "A:G:GROUP@:r",
+			expACE: &AccessControlEntry{
+				Types:       []EntryType{EntryType_ALLOW},
+				Flags:       Flags_GROUP,
+				Principal:   "GROUP@",
+				Permissions: Permissions_READ,
+			},
+			expPType: PrincipalOwnerGroup,
+		},
+		"named group with audit": {
+			aceStr: "UA:FG:readers@:r",
+			expACE: &AccessControlEntry{
+				Types:       []EntryType{EntryType_ALLOW, EntryType_AUDIT},
+				Flags:       Flags_GROUP | Flags_ACCESS_FAILURE,
+				Principal:   "readers@",
+				Permissions: Permissions_READ,
+			},
+			expPType: PrincipalGroup,
+			expStr:   "AU:GF:readers@:r",
+		},
+		"named user with domain": {
+			aceStr: "L:S:user@domain:",
+			expACE: &AccessControlEntry{
+				Types:     []EntryType{EntryType_ALARM},
+				Flags:     Flags_ACCESS_SUCCESS,
+				Principal: "user@domain",
+			},
+			expPType: PrincipalUser,
+		},
+		"everyone": {
+			aceStr: "A:P:EVERYONE@:w",
+			expACE: &AccessControlEntry{
+				Types:       []EntryType{EntryType_ALLOW},
+				Flags:       Flags_POOL_INHERIT,
+				Principal:   "EVERYONE@",
+				Permissions: Permissions_WRITE,
+			},
+			expPType: PrincipalEveryone,
+		},
+		"too few fields": {
+			aceStr: "A::OWNER@",
+			expErr: `invalid ACE "A::OWNER@": expected 4 fields separated by ":"`,
+		},
+		"unknown type": {
+			aceStr: "X::OWNER@:rw",
+			expErr: `invalid ACE "X::OWNER@:rw": unknown access type 'X'`,
+		},
+		"lowercase flag": {
+			aceStr: "A:g:readers@:r",
+			expErr: `invalid ACE "A:g:readers@:r": unknown flag 'g'`,
+		},
+		"unknown permission": {
+			aceStr: "A::OWNER@:rx",
+			expErr: `invalid ACE "A::OWNER@:rx": unknown permission 'x'`,
+		},
+		"no type": {
+			aceStr: ":G:GROUP@:r",
+			expErr: `invalid ACE ":G:GROUP@:r": no access type`,
+		},
+		"principal without domain separator": {
+			aceStr: "A::user:r",
+			expErr: `invalid ACE "A::user:r": invalid principal "user"`,
+		},
+		"principal without name": {
+			aceStr: "A::@domain:r",
+			expErr: `invalid ACE "A::@domain:r": invalid principal "@domain"`,
+		},
+		"principal too long": {
+			aceStr: "A::" + strings.Repeat("a", MaxPrincipalLength) + "@:r",
+			expErr: `invalid ACE "A::` + strings.Repeat("a", MaxPrincipalLength) +
+				`@:r": principal longer than 255 characters`,
+		},
+		"group principal without group flag": {
+			aceStr: "A::GROUP@:r",
+			expErr: `invalid ACE "A::GROUP@:r": group flag (G) must be set for group principals only`,
+		},
+		"user principal with group flag": {
+			aceStr: "A:G:OWNER@:r",
+			expErr: `invalid ACE "A:G:OWNER@:r": group flag (G) must be set for group principals only`,
+		},
+		"audit without alert flag": {
+			aceStr: "U::OWNER@:r",
+			expErr: `invalid ACE "U::OWNER@:r": success (S) and failure (F) flags must be set for audit and alarm entries only`,
+		},
+		"allow with alert flag": {
+			aceStr: "A:S:OWNER@:r",
+			expErr: `invalid ACE "A:S:OWNER@:r": success (S) and failure (F) flags must be set for audit and alarm entries only`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ace, err := ParseACE(tt.aceStr)
+			if tt.expErr != "" {
+				ExpectError(t, err, tt.expErr, name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			AssertEqual(t, ace, tt.expACE, "parsed ACE")
+			AssertEqual(t, ace.PrincipalType(), tt.expPType, "principal type")
+
+			expStr := tt.expStr
+			if expStr == "" {
+				expStr = tt.aceStr
+			}
+			AssertEqual(t, ace.String(), expStr, "formatted ACE")
+		})
+	}
+}
+
+func TestParseACEStrings(t *testing.T) {
+	for name, tt := range map[string]struct {
+		aceStrs []string
+		expStrs []string
+		expErr  string
+	}{
+		"empty": {
+			aceStrs: []string{},
+			expStrs: []string{},
+		},
+		"canonical order": {
+			aceStrs: []string{
+				"A::EVERYONE@:r",
+				"A:G:readers@:r",
+				"A:G:GROUP@:r",
+				"A::user2@:rw",
+				"A::OWNER@:rw",
+				"A::user1@:r",
+			},
+			expStrs: []string{
+				"A::OWNER@:rw",
+				"A::user2@:rw",
+				"A::user1@:r",
+				"A:G:GROUP@:r",
+				"A:G:readers@:r",
+				"A::EVERYONE@:r",
+			},
+		},
+		"invalid entry": {
+			aceStrs: []string{"A::OWNER@:rw", "A::OWNER@"},
+			expErr:  `invalid ACE "A::OWNER@": expected 4 fields separated by ":"`,
+		},
+		"duplicate principal": {
+			aceStrs: []string{"A::user1@:rw", "U:F:user1@:r"},
+			expErr:  `duplicate entry for principal "user1@"`,
+		},
+		"user and group with same name": {
+			aceStrs: []string{"A::daos@:rw", "A:G:daos@:r"},
+			expStrs: []string{"A::daos@:rw", "A:G:daos@:r"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			acl, err := ParseACEStrings(tt.aceStrs)
+			if tt.expErr != "" {
+				ExpectError(t, err, tt.expErr, name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			AssertEqual(t, acl.Strings(), tt.expStrs, "ACE strings")
+		})
+	}
+}
+
+func TestParseACL(t *testing.T) {
+	input := strings.Join([]string{
+		"# Entries:",
+		"  A:G:GROUP@:r  ",
+		"",
+		"A::OWNER@:rw",
+		"   # indented comment",
+	}, "\n")
+
+	acl, err := ParseACL(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	AssertEqual(t, acl.Strings(), []string{"A::OWNER@:rw", "A:G:GROUP@:r"}, "ACE strings")
+}
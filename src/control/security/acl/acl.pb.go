@@ -55,7 +55,7 @@ func (x RequestStatus) String() string {
 	return proto.EnumName(RequestStatus_name, int32(x))
 }
 func (RequestStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_acl_a67c5b5daa5f79f1, []int{0}
+	return fileDescriptor_acl_6c9ab7309e401168, []int{0}
 }
 
 // Bits representing access permissions
@@ -82,7 +82,7 @@ func (x Permissions) String() string {
 	return proto.EnumName(Permissions_name, int32(x))
 }
 func (Permissions) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_acl_a67c5b5daa5f79f1, []int{1}
+	return fileDescriptor_acl_6c9ab7309e401168, []int{1}
 }
 
 // A given user/group may have multiple different types of entries
@@ -109,7 +109,7 @@ func (x EntryType) String() string {
 	return proto.EnumName(EntryType_name, int32(x))
 }
 func (EntryType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_acl_a67c5b5daa5f79f1, []int{2}
+	return fileDescriptor_acl_6c9ab7309e401168, []int{2}
 }
 
 // Bits representing flags on a given ACL entry
@@ -142,7 +142,7 @@ func (x Flags) String() string {
 	return proto.EnumName(Flags_name, int32(x))
 }
 func (Flags) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_acl_a67c5b5daa5f79f1, []int{3}
+	return fileDescriptor_acl_6c9ab7309e401168, []int{3}
 }
 
 type Response struct {
@@ -157,7 +157,7 @@ func (m *Response) Reset()         { *m = Response{} }
 func (m *Response) String() string { return proto.CompactTextString(m) }
 func (*Response) ProtoMessage()    {}
 func (*Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acl_a67c5b5daa5f79f1, []int{0}
+	return fileDescriptor_acl_6c9ab7309e401168, []int{0}
 }
 func (m *Response) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Response.Unmarshal(m, b)
@@ -206,7 +206,7 @@ func (m *Entry) Reset()         { *m = Entry{} }
 func (m *Entry) String() string { return proto.CompactTextString(m) }
 func (*Entry) ProtoMessage()    {}
 func (*Entry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acl_a67c5b5daa5f79f1, []int{1}
+	return fileDescriptor_acl_6c9ab7309e401168, []int{1}
 }
 func (m *Entry) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Entry.Unmarshal(m, b)
@@ -267,7 +267,7 @@ func (m *EntryPermissions) Reset()         { *m = EntryPermissions{} }
 func (m *EntryPermissions) String() string { return proto.CompactTextString(m) }
 func (*EntryPermissions) ProtoMessage()    {}
 func (*EntryPermissions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_acl_a67c5b5daa5f79f1, []int{2}
+	return fileDescriptor_acl_6c9ab7309e401168, []int{2}
 }
 func (m *EntryPermissions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_EntryPermissions.Unmarshal(m, b)
@@ -301,10 +301,196 @@ func (m *EntryPermissions) GetPermissionBits() uint64 {
 	return 0
 }
 
+// GetACLReq requests the Access Control List of a pool.
+type GetACLReq struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetACLReq) Reset()         { *m = GetACLReq{} }
+func (m *GetACLReq) String() string { return proto.CompactTextString(m) }
+func (*GetACLReq) ProtoMessage()    {}
+func (*GetACLReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acl_6c9ab7309e401168, []int{3}
+}
+func (m *GetACLReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetACLReq.Unmarshal(m, b)
+}
+func (m *GetACLReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetACLReq.Marshal(b, m, deterministic)
+}
+func (dst *GetACLReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetACLReq.Merge(dst, src)
+}
+func (m *GetACLReq) XXX_Size() int {
+	return xxx_messageInfo_GetACLReq.Size(m)
+}
+func (m *GetACLReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetACLReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetACLReq proto.InternalMessageInfo
+
+func (m *GetACLReq) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+// ACLResp returns the resulting Access Control List of a pool.
+type ACLResp struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Acl                  []string `protobuf:"bytes,2,rep,name=acl,proto3" json:"acl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ACLResp) Reset()         { *m = ACLResp{} }
+func (m *ACLResp) String() string { return proto.CompactTextString(m) }
+func (*ACLResp) ProtoMessage()    {}
+func (*ACLResp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acl_6c9ab7309e401168, []int{4}
+}
+func (m *ACLResp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ACLResp.Unmarshal(m, b)
+}
+func (m *ACLResp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ACLResp.Marshal(b, m, deterministic)
+}
+func (dst *ACLResp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ACLResp.Merge(dst, src)
+}
+func (m *ACLResp) XXX_Size() int {
+	return xxx_messageInfo_ACLResp.Size(m)
+}
+func (m *ACLResp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ACLResp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ACLResp proto.InternalMessageInfo
+
+func (m *ACLResp) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *ACLResp) GetAcl() []string {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
+// ModifyACLReq supplies Access Control Entries to overwrite or update the
+// Access Control List of a pool with.
+type ModifyACLReq struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Acl                  []string `protobuf:"bytes,2,rep,name=acl,proto3" json:"acl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifyACLReq) Reset()         { *m = ModifyACLReq{} }
+func (m *ModifyACLReq) String() string { return proto.CompactTextString(m) }
+func (*ModifyACLReq) ProtoMessage()    {}
+func (*ModifyACLReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acl_6c9ab7309e401168, []int{5}
+}
+func (m *ModifyACLReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyACLReq.Unmarshal(m, b)
+}
+func (m *ModifyACLReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyACLReq.Marshal(b, m, deterministic)
+}
+func (dst *ModifyACLReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyACLReq.Merge(dst, src)
+}
+func (m *ModifyACLReq) XXX_Size() int {
+	return xxx_messageInfo_ModifyACLReq.Size(m)
+}
+func (m *ModifyACLReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyACLReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyACLReq proto.InternalMessageInfo
+
+func (m *ModifyACLReq) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *ModifyACLReq) GetAcl() []string {
+	if m != nil {
+		return m.Acl
+	}
+	return nil
+}
+
+// DeleteACLReq identifies the Access Control Entry to remove from the
+// Access Control List of a pool.
+type DeleteACLReq struct {
+	Uuid                 string   `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Principal            string   `protobuf:"bytes,2,opt,name=principal,proto3" json:"principal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteACLReq) Reset()         { *m = DeleteACLReq{} }
+func (m *DeleteACLReq) String() string { return proto.CompactTextString(m) }
+func (*DeleteACLReq) ProtoMessage()    {}
+func (*DeleteACLReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acl_6c9ab7309e401168, []int{6}
+}
+func (m *DeleteACLReq) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteACLReq.Unmarshal(m, b)
+}
+func (m *DeleteACLReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteACLReq.Marshal(b, m, deterministic)
+}
+func (dst *DeleteACLReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteACLReq.Merge(dst, src)
+}
+func (m *DeleteACLReq) XXX_Size() int {
+	return xxx_messageInfo_DeleteACLReq.Size(m)
+}
+func (m *DeleteACLReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteACLReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteACLReq proto.InternalMessageInfo
+
+func (m *DeleteACLReq) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *DeleteACLReq) GetPrincipal() string {
+	if m != nil {
+		return m.Principal
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Response)(nil), "acl.Response")
 	proto.RegisterType((*Entry)(nil), "acl.Entry")
 	proto.RegisterType((*EntryPermissions)(nil), "acl.EntryPermissions")
+	proto.RegisterType((*GetACLReq)(nil), "acl.GetACLReq")
+	proto.RegisterType((*ACLResp)(nil), "acl.ACLResp")
+	proto.RegisterType((*ModifyACLReq)(nil), "acl.ModifyACLReq")
+	proto.RegisterType((*DeleteACLReq)(nil), "acl.DeleteACLReq")
 	proto.RegisterEnum("acl.RequestStatus", RequestStatus_name, RequestStatus_value)
 	proto.RegisterEnum("acl.Permissions", Permissions_name, Permissions_value)
 	proto.RegisterEnum("acl.EntryType", EntryType_name, EntryType_value)
@@ -329,6 +515,14 @@ type AccessControlClient interface {
 	GetPermissions(ctx context.Context, in *Entry, opts ...grpc.CallOption) (*Response, error)
 	// Remove the given ACE completely from the ACL
 	DestroyAclEntry(ctx context.Context, in *Entry, opts ...grpc.CallOption) (*Response, error)
+	// Fetch the Access Control List for a DAOS pool
+	PoolGetACL(ctx context.Context, in *GetACLReq, opts ...grpc.CallOption) (*ACLResp, error)
+	// Overwrite the Access Control List for a DAOS pool with a new one
+	PoolOverwriteACL(ctx context.Context, in *ModifyACLReq, opts ...grpc.CallOption) (*ACLResp, error)
+	// Update the existing Access Control List for a DAOS pool with new entries
+	PoolUpdateACL(ctx context.Context, in *ModifyACLReq, opts ...grpc.CallOption) (*ACLResp, error)
+	// Delete an entry from a DAOS pool's Access Control List
+	PoolDeleteACL(ctx context.Context, in *DeleteACLReq, opts ...grpc.CallOption) (*ACLResp, error)
 }
 
 type accessControlClient struct {
@@ -366,6 +560,42 @@ func (c *accessControlClient) DestroyAclEntry(ctx context.Context, in *Entry, op
 	return out, nil
 }
 
+func (c *accessControlClient) PoolGetACL(ctx context.Context, in *GetACLReq, opts ...grpc.CallOption) (*ACLResp, error) {
+	out := new(ACLResp)
+	err := c.cc.Invoke(ctx, "/acl.AccessControl/PoolGetACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessControlClient) PoolOverwriteACL(ctx context.Context, in *ModifyACLReq, opts ...grpc.CallOption) (*ACLResp, error) {
+	out := new(ACLResp)
+	err := c.cc.Invoke(ctx, "/acl.AccessControl/PoolOverwriteACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessControlClient) PoolUpdateACL(ctx context.Context, in *ModifyACLReq, opts ...grpc.CallOption) (*ACLResp, error) {
+	out := new(ACLResp)
+	err := c.cc.Invoke(ctx, "/acl.AccessControl/PoolUpdateACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accessControlClient) PoolDeleteACL(ctx context.Context, in *DeleteACLReq, opts ...grpc.CallOption) (*ACLResp, error) {
+	out := new(ACLResp)
+	err := c.cc.Invoke(ctx, "/acl.AccessControl/PoolDeleteACL", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AccessControlServer is the server API for AccessControl service.
 type AccessControlServer interface {
 	// Set the permissions on a given ACE or create it if it doesn't exist
@@ -374,6 +604,14 @@ type AccessControlServer interface {
 	GetPermissions(context.Context, *Entry) (*Response, error)
 	// Remove the given ACE completely from the ACL
 	DestroyAclEntry(context.Context, *Entry) (*Response, error)
+	// Fetch the Access Control List for a DAOS pool
+	PoolGetACL(context.Context, *GetACLReq) (*ACLResp, error)
+	// Overwrite the Access Control List for a DAOS pool with a new one
+	PoolOverwriteACL(context.Context, *ModifyACLReq) (*ACLResp, error)
+	// Update the existing Access Control List for a DAOS pool with new entries
+	PoolUpdateACL(context.Context, *ModifyACLReq) (*ACLResp, error)
+	// Delete an entry from a DAOS pool's Access Control List
+	PoolDeleteACL(context.Context, *DeleteACLReq) (*ACLResp, error)
 }
 
 func RegisterAccessControlServer(s *grpc.Server, srv AccessControlServer) {
@@ -434,6 +672,78 @@ func _AccessControl_DestroyAclEntry_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AccessControl_PoolGetACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetACLReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlServer).PoolGetACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acl.AccessControl/PoolGetACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlServer).PoolGetACL(ctx, req.(*GetACLReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessControl_PoolOverwriteACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyACLReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlServer).PoolOverwriteACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acl.AccessControl/PoolOverwriteACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlServer).PoolOverwriteACL(ctx, req.(*ModifyACLReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessControl_PoolUpdateACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyACLReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlServer).PoolUpdateACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acl.AccessControl/PoolUpdateACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlServer).PoolUpdateACL(ctx, req.(*ModifyACLReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccessControl_PoolDeleteACL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteACLReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccessControlServer).PoolDeleteACL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/acl.AccessControl/PoolDeleteACL",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccessControlServer).PoolDeleteACL(ctx, req.(*DeleteACLReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AccessControl_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "acl.AccessControl",
 	HandlerType: (*AccessControlServer)(nil),
@@ -450,45 +760,70 @@ var _AccessControl_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DestroyAclEntry",
 			Handler:    _AccessControl_DestroyAclEntry_Handler,
 		},
+		{
+			MethodName: "PoolGetACL",
+			Handler:    _AccessControl_PoolGetACL_Handler,
+		},
+		{
+			MethodName: "PoolOverwriteACL",
+			Handler:    _AccessControl_PoolOverwriteACL_Handler,
+		},
+		{
+			MethodName: "PoolUpdateACL",
+			Handler:    _AccessControl_PoolUpdateACL_Handler,
+		},
+		{
+			MethodName: "PoolDeleteACL",
+			Handler:    _AccessControl_PoolDeleteACL_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "acl.proto",
 }
 
-func init() { proto.RegisterFile("acl.proto", fileDescriptor_acl_a67c5b5daa5f79f1) }
-
-var fileDescriptor_acl_a67c5b5daa5f79f1 = []byte{
-	// 509 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x5f, 0xaf, 0xd2, 0x3e,
-	0x18, 0xc7, 0x19, 0x07, 0xf8, 0xc1, 0xb3, 0xc3, 0xe8, 0xaf, 0xf1, 0x18, 0x72, 0xa2, 0x86, 0xec,
-	0x46, 0x42, 0x94, 0x44, 0xbc, 0xd0, 0xdb, 0xca, 0x0a, 0x36, 0xee, 0x6c, 0xa4, 0x63, 0x92, 0x98,
-	0x98, 0x85, 0x83, 0xd5, 0x2c, 0x41, 0x86, 0xb4, 0x5c, 0xf0, 0x8e, 0x7c, 0x07, 0xbe, 0x38, 0xff,
-	0x66, 0xdd, 0x60, 0x43, 0x8d, 0x5c, 0xd1, 0xe7, 0xf3, 0xe9, 0xfa, 0xdd, 0xd3, 0x67, 0xd0, 0x5a,
-	0xae, 0xd6, 0xc3, 0xed, 0x2e, 0x51, 0x09, 0xbe, 0x58, 0xae, 0xd6, 0x76, 0x02, 0x4d, 0x2e, 0xe4,
-	0x36, 0xd9, 0x48, 0x81, 0x07, 0xd0, 0x90, 0x6a, 0xa9, 0xf6, 0xb2, 0x6b, 0xf4, 0x8c, 0xbe, 0x35,
-	0xc2, 0xc3, 0x54, 0xe6, 0xe2, 0xd3, 0x5e, 0x48, 0x15, 0x68, 0xc2, 0x73, 0x03, 0x3f, 0x03, 0x73,
-	0x2b, 0x76, 0x1f, 0x63, 0x29, 0xe3, 0x64, 0x23, 0xbb, 0xd5, 0x9e, 0xd1, 0x37, 0x47, 0x57, 0x7a,
-	0x03, 0xdd, 0xa8, 0xdd, 0x61, 0x56, 0x40, 0x5e, 0x36, 0xed, 0x3d, 0xd4, 0xb5, 0x80, 0x6d, 0xa8,
-	0xa9, 0xc3, 0x56, 0xe4, 0x67, 0x59, 0xc5, 0xd6, 0xf9, 0x61, 0x2b, 0xb8, 0x66, 0xf8, 0x0e, 0xd4,
-	0xdf, 0xaf, 0x97, 0x1f, 0xb2, 0xe7, 0xb7, 0x79, 0xb6, 0xc0, 0x77, 0xa1, 0x21, 0x36, 0x2a, 0x56,
-	0x87, 0xee, 0x45, 0xcf, 0xe8, 0xb7, 0x78, 0xbe, 0xc2, 0xd7, 0xd0, 0x8c, 0xdf, 0xe5, 0xa4, 0xa6,
-	0xc9, 0x69, 0x6d, 0xbf, 0x05, 0xf4, 0x7b, 0x2e, 0xdc, 0x83, 0xba, 0x48, 0x6b, 0x3a, 0x82, 0x39,
-	0x82, 0x22, 0x02, 0xcf, 0x00, 0x7e, 0x08, 0x9d, 0x22, 0x7b, 0x74, 0x1b, 0xab, 0x2c, 0x49, 0x8d,
-	0x5b, 0x45, 0xf9, 0x45, 0xac, 0xe4, 0xe0, 0x8b, 0x01, 0xed, 0xb3, 0x46, 0x61, 0x13, 0xfe, 0x0b,
-	0xc2, 0xf1, 0x98, 0x06, 0x01, 0xaa, 0xe0, 0x2e, 0x98, 0x94, 0xf3, 0x28, 0xf4, 0x5e, 0x79, 0xfe,
-	0xc2, 0x43, 0x3f, 0x8f, 0x3f, 0x03, 0xdf, 0x83, 0x4e, 0x4a, 0x66, 0x94, 0xdf, 0x44, 0x0e, 0xf5,
-	0x18, 0x75, 0xd0, 0x8f, 0x82, 0x3e, 0x80, 0xff, 0x53, 0xca, 0xbc, 0xd7, 0xc4, 0x65, 0x8e, 0xb6,
-	0x02, 0xf4, 0xbd, 0xe0, 0x36, 0x5c, 0x9d, 0x71, 0xce, 0xbc, 0x31, 0x9b, 0x11, 0x17, 0x7d, 0x2b,
-	0x9c, 0xfb, 0x80, 0xca, 0x4e, 0x18, 0x32, 0x07, 0x7d, 0x3d, 0xe1, 0xc1, 0x13, 0x30, 0xcb, 0x3d,
-	0x69, 0x43, 0xcb, 0xf3, 0x23, 0x72, 0x0c, 0xde, 0x84, 0x1a, 0xa7, 0xc4, 0x41, 0x06, 0x6e, 0x41,
-	0x7d, 0xc1, 0xd9, 0x9c, 0xa2, 0xea, 0xe0, 0x11, 0xb4, 0x4e, 0x17, 0x95, 0xd6, 0x89, 0xeb, 0xfa,
-	0x0b, 0x54, 0xd1, 0x7f, 0x43, 0x87, 0xcd, 0x33, 0x9b, 0xb8, 0x84, 0xdf, 0xa0, 0xea, 0xe0, 0x0d,
-	0xd4, 0x27, 0xfa, 0xda, 0x2e, 0xa1, 0xe9, 0xf9, 0xd1, 0xc4, 0x25, 0xd3, 0x20, 0x93, 0xa7, 0xdc,
-	0x0f, 0x67, 0xc8, 0xc0, 0x18, 0xac, 0xec, 0xc0, 0xe8, 0xd8, 0xb1, 0x6a, 0xa9, 0x36, 0x21, 0xcc,
-	0x0d, 0x39, 0x45, 0x35, 0x8c, 0xe0, 0x72, 0xe6, 0xfb, 0x6e, 0xc4, 0xbc, 0x97, 0x94, 0xb3, 0x39,
-	0x6a, 0x8e, 0x3e, 0x1b, 0xd0, 0x26, 0xab, 0x95, 0x90, 0x72, 0x9c, 0x6c, 0xd4, 0x2e, 0x59, 0xe3,
-	0xe7, 0x60, 0x05, 0x42, 0x95, 0xdf, 0xe8, 0xef, 0x43, 0x79, 0xdd, 0xce, 0x87, 0x3b, 0x9b, 0x7d,
-	0xbb, 0x82, 0x1f, 0x83, 0x35, 0x3d, 0xdf, 0x59, 0x1a, 0x88, 0x3f, 0xf5, 0x21, 0x74, 0x1c, 0x21,
-	0xd5, 0x2e, 0x39, 0x90, 0xd5, 0x3a, 0x9b, 0xe8, 0x7f, 0xf9, 0xb7, 0x0d, 0xfd, 0xd1, 0x3d, 0xfd,
-	0x15, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x2e, 0x6c, 0xf0, 0x81, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("acl.proto", fileDescriptor_acl_6c9ab7309e401168) }
+
+var fileDescriptor_acl_6c9ab7309e401168 = []byte{
+	// 652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x5f, 0x6f, 0xd3, 0x3e,
+	0x14, 0x6d, 0xba, 0x76, 0x6b, 0x6e, 0xff, 0xcc, 0xb3, 0x7e, 0xfb, 0xa9, 0x9a, 0x06, 0x54, 0x79,
+	0xa1, 0xaa, 0xc6, 0x24, 0x3a, 0x10, 0x3c, 0x12, 0x9a, 0xac, 0x44, 0x64, 0x49, 0xe5, 0x34, 0x4c,
+	0x42, 0x42, 0x51, 0xd7, 0x7a, 0x28, 0x52, 0x68, 0xb2, 0xd8, 0x05, 0xf5, 0x93, 0xf1, 0xc9, 0x78,
+	0xe2, 0xaf, 0xe2, 0x64, 0x49, 0xba, 0x8d, 0x49, 0xe4, 0xc9, 0xf7, 0x9e, 0x73, 0xec, 0x6b, 0xdf,
+	0x7b, 0x02, 0xf2, 0x6c, 0x1e, 0x1c, 0x47, 0x71, 0xc8, 0x43, 0xbc, 0x35, 0x9b, 0x07, 0x4a, 0x08,
+	0x0d, 0x42, 0x59, 0x14, 0x2e, 0x19, 0xc5, 0x03, 0xd8, 0x66, 0x7c, 0xc6, 0x57, 0xac, 0x2b, 0xf5,
+	0xa4, 0x7e, 0x67, 0x88, 0x8f, 0x13, 0x32, 0xa1, 0x57, 0x2b, 0xca, 0xb8, 0x23, 0x10, 0x92, 0x31,
+	0xf0, 0x0b, 0x68, 0x46, 0x34, 0xfe, 0xe4, 0x33, 0xe6, 0x87, 0x4b, 0xd6, 0xad, 0xf6, 0xa4, 0x7e,
+	0x73, 0xb8, 0x2f, 0x04, 0xfa, 0x92, 0xc7, 0xeb, 0x49, 0x01, 0x92, 0x32, 0x53, 0x59, 0x41, 0x5d,
+	0x10, 0xb0, 0x02, 0x35, 0xbe, 0x8e, 0x68, 0x76, 0x56, 0xa7, 0x90, 0x4e, 0xd7, 0x11, 0x25, 0x02,
+	0xc3, 0xff, 0x41, 0xfd, 0x32, 0x98, 0x7d, 0x4c, 0xf7, 0x6f, 0x93, 0x34, 0xc0, 0xff, 0xc3, 0x36,
+	0x5d, 0x72, 0x9f, 0xaf, 0xbb, 0x5b, 0x3d, 0xa9, 0x2f, 0x93, 0x2c, 0xc2, 0x07, 0xd0, 0xf0, 0x17,
+	0x19, 0x52, 0x13, 0x48, 0x1e, 0x2b, 0x1f, 0x00, 0xdd, 0xac, 0x0b, 0xf7, 0xa0, 0x4e, 0x93, 0x9c,
+	0x28, 0xa1, 0x39, 0x84, 0xa2, 0x04, 0x92, 0x02, 0xf8, 0x31, 0xec, 0x16, 0xb5, 0x7b, 0x17, 0x3e,
+	0x4f, 0x2b, 0xa9, 0x91, 0x4e, 0x91, 0x7e, 0xed, 0x73, 0xa6, 0x3c, 0x02, 0x79, 0x4c, 0xb9, 0x3a,
+	0x32, 0x09, 0xbd, 0xc2, 0x18, 0x6a, 0xab, 0x95, 0xbf, 0x10, 0xdb, 0xca, 0x44, 0xac, 0x95, 0x13,
+	0xd8, 0x11, 0x28, 0x8b, 0x92, 0xf2, 0x4b, 0xcf, 0x5c, 0xcf, 0x9f, 0x14, 0x41, 0xd2, 0x91, 0x6e,
+	0xb5, 0xb7, 0xd5, 0x97, 0x89, 0x68, 0xce, 0x33, 0x68, 0x9d, 0x85, 0x0b, 0xff, 0x72, 0xfd, 0xf7,
+	0x8d, 0xef, 0x50, 0xbd, 0x82, 0x96, 0x46, 0x03, 0xca, 0xe9, 0x3d, 0xaa, 0x43, 0x90, 0xa3, 0xd8,
+	0x5f, 0xce, 0xfd, 0x68, 0x16, 0x88, 0x2b, 0xc9, 0xa4, 0x48, 0x0c, 0xbe, 0x4a, 0xd0, 0xde, 0x68,
+	0x3b, 0x6e, 0xc2, 0x8e, 0xe3, 0x8e, 0x46, 0xba, 0xe3, 0xa0, 0x0a, 0xee, 0x42, 0x53, 0x27, 0xc4,
+	0x73, 0xad, 0xb7, 0x96, 0x7d, 0x6e, 0xa1, 0xdf, 0xd7, 0x9f, 0x84, 0x0f, 0x61, 0x37, 0x41, 0x26,
+	0x3a, 0x39, 0xf3, 0x34, 0xdd, 0x32, 0x74, 0x0d, 0xfd, 0x2a, 0xd0, 0x87, 0xb0, 0x97, 0xa0, 0x86,
+	0xf5, 0x4e, 0x35, 0x0d, 0x4d, 0xb0, 0x1c, 0xf4, 0xb3, 0xc0, 0x15, 0xd8, 0xdf, 0xc0, 0x89, 0x61,
+	0x8d, 0x8c, 0x89, 0x6a, 0xa2, 0x1f, 0x05, 0xe7, 0x01, 0xa0, 0x32, 0xc7, 0x75, 0x0d, 0x0d, 0x7d,
+	0xcf, 0xe1, 0xc1, 0x53, 0x68, 0x96, 0x3b, 0xdc, 0x06, 0xd9, 0xb2, 0x3d, 0xf5, 0xba, 0xf0, 0x06,
+	0xd4, 0x88, 0xae, 0x6a, 0x48, 0xc2, 0x32, 0xd4, 0xcf, 0x89, 0x31, 0xd5, 0x51, 0x75, 0x70, 0x04,
+	0x72, 0x3e, 0x76, 0x49, 0x5e, 0x35, 0x4d, 0xfb, 0x1c, 0x55, 0xc4, 0xd2, 0xd5, 0x8c, 0x69, 0xca,
+	0x56, 0x4d, 0x95, 0x9c, 0xa1, 0xea, 0xe0, 0x3d, 0xd4, 0x4f, 0xc5, 0x10, 0xb6, 0xa0, 0x61, 0xd9,
+	0xde, 0xa9, 0xa9, 0x8e, 0x9d, 0x94, 0x3c, 0x26, 0xb6, 0x3b, 0x41, 0x12, 0xc6, 0xd0, 0x49, 0x0f,
+	0xf4, 0xae, 0x5f, 0xac, 0x5a, 0xca, 0x9d, 0xaa, 0x86, 0xe9, 0x12, 0x1d, 0xd5, 0x30, 0x82, 0xd6,
+	0xc4, 0xb6, 0x4d, 0xcf, 0xb0, 0xde, 0xe8, 0xc4, 0x98, 0xa2, 0xc6, 0xf0, 0x5b, 0x15, 0xda, 0xea,
+	0x7c, 0x4e, 0x19, 0x1b, 0x85, 0x4b, 0x1e, 0x87, 0x01, 0x7e, 0x09, 0x1d, 0x87, 0xf2, 0xf2, 0x8d,
+	0xee, 0xb6, 0xd8, 0x41, 0x3b, 0xb3, 0x6a, 0xea, 0x64, 0xa5, 0x82, 0x9f, 0x40, 0x67, 0xbc, 0xa9,
+	0x2c, 0x8d, 0xf7, 0x6d, 0xfa, 0x31, 0xec, 0x6a, 0x94, 0xf1, 0x38, 0x5c, 0xab, 0xf3, 0x20, 0xf5,
+	0xe7, 0xbd, 0xfc, 0x23, 0x80, 0x49, 0x18, 0x06, 0xe9, 0xcc, 0xe3, 0xd4, 0xbc, 0xb9, 0x01, 0x0e,
+	0x5a, 0x22, 0xce, 0xe6, 0x5d, 0xa9, 0xe0, 0xe7, 0x80, 0x12, 0xb6, 0xfd, 0x99, 0xc6, 0x5f, 0x62,
+	0x5f, 0x0c, 0x26, 0xde, 0x13, 0x9c, 0xf2, 0x78, 0xdf, 0x92, 0x0d, 0xa1, 0x9d, 0xc8, 0xdc, 0x68,
+	0x31, 0xfb, 0x57, 0x4d, 0x6e, 0x80, 0x4c, 0x53, 0x36, 0xc4, 0x4d, 0xcd, 0xc5, 0xb6, 0xf8, 0x1f,
+	0x9e, 0xfc, 0x19, 0x00, 0xc9, 0x96, 0x05, 0x4e, 0x1c, 0x05, 0x00, 0x00,
 }
@@ -27,6 +27,7 @@ import (
 	"crypto"
 	"crypto/tls"
 	"crypto/x509"
+	"sync"
 
 	"github.com/pkg/errors"
 )
@@ -42,6 +43,10 @@ const (
 	defaultInsecure      = false
 )
 
+// certDataLock protects loaded certificate data which may be replaced by
+// UpdateCertificates while it is in use by transport credentials.
+var certDataLock sync.RWMutex
+
 //TransportConfig contains all the information on whether or not to use
 //certificates and their location if their use is specified.
 type TransportConfig struct {
@@ -134,6 +139,63 @@ func (cfg *TransportConfig) ReloadCertData() error {
 	return cfg.PreLoadCertData()
 }
 
+//UpdateCertificates switches the config to the certificate locations in
+//newCfg and loads the certificate data from them. Transport credentials
+//created from the config pick up the new data on subsequent handshakes. On
+//failure the config is left unchanged.
+func (cfg *TransportConfig) UpdateCertificates(newCfg *CertificateConfig) error {
+	if cfg == nil || newCfg == nil {
+		return errors.New("nil TransportConfig")
+	}
+
+	var certificate *tls.Certificate
+	var certPool *x509.CertPool
+	if !cfg.AllowInsecure {
+		var err error
+		certificate, certPool, err = loadCertWithCustomCA(newCfg.CARootPath, newCfg.CertificatePath, newCfg.PrivateKeyPath)
+		if err != nil {
+			return err
+		}
+		certificate.Leaf, err = x509.ParseCertificate(certificate.Certificate[0])
+		if err != nil {
+			return err
+		}
+	}
+
+	certDataLock.Lock()
+	defer certDataLock.Unlock()
+
+	cfg.ServerName = newCfg.ServerName
+	cfg.ClientCertDir = newCfg.ClientCertDir
+	cfg.CARootPath = newCfg.CARootPath
+	cfg.CertificatePath = newCfg.CertificatePath
+	cfg.PrivateKeyPath = newCfg.PrivateKeyPath
+	cfg.tlsKeypair = certificate
+	cfg.caPool = certPool
+
+	return nil
+}
+
+//CommonName returns the common name of the certificate loaded into the
+//TransportConfig, which identifies the component it was issued to.
+func (cfg *TransportConfig) CommonName() (string, error) {
+	if cfg.AllowInsecure == true {
+		return "", nil
+	}
+	// If we don't have our keys loaded attempt to load them.
+	if cfg.tlsKeypair == nil || cfg.caPool == nil {
+		err := cfg.ReloadCertData()
+		if err != nil {
+			return "", err
+		}
+	}
+
+	certDataLock.RLock()
+	defer certDataLock.RUnlock()
+
+	return cfg.tlsKeypair.Leaf.Subject.CommonName, nil
+}
+
 //PrivateKey returns the private key stored in the certificates loaded into the TransportConfig
 func (cfg *TransportConfig) PrivateKey() (crypto.PrivateKey, error) {
 	if cfg.AllowInsecure == true {
@@ -176,6 +176,53 @@ func TestReloadCertData(t *testing.T) {
 	}
 }
 
+func TestUpdateCertificates(t *testing.T) {
+	serverTC := ServerTC()
+	agentTC := AgentTC()
+	badTC := BadTC()
+
+	SetupTCFilePerms(t, serverTC)
+	SetupTCFilePerms(t, agentTC)
+	SetupTCFilePerms(t, badTC)
+
+	testTC := ServerTC()
+	if err := testTC.PreLoadCertData(); err != nil {
+		t.Fatal(err)
+	}
+	beforeCert := testTC.tlsKeypair.Certificate[0]
+
+	// bad certificates leave the config untouched
+	if err := testTC.UpdateCertificates(&badTC.CertificateConfig); err == nil {
+		t.Fatal("Expected an error but got nil")
+	}
+	if testTC.CertificatePath != serverTC.CertificatePath {
+		t.Fatalf("certificate path changed to %s after failed update", testTC.CertificatePath)
+	}
+	if bytes.Compare(beforeCert, testTC.tlsKeypair.Certificate[0]) != 0 {
+		t.Fatal("cert changed after failed update")
+	}
+
+	if err := testTC.UpdateCertificates(&agentTC.CertificateConfig); err != nil {
+		t.Fatal(err)
+	}
+	if testTC.CertificatePath != agentTC.CertificatePath {
+		t.Fatalf("expected certificate path %s, got %s", agentTC.CertificatePath, testTC.CertificatePath)
+	}
+	if bytes.Compare(beforeCert, testTC.tlsKeypair.Certificate[0]) == 0 {
+		t.Fatal("cert before and after update is the same")
+	}
+
+	// insecure configs only record the new locations
+	insecureTC := InsecureTC()
+	if err := insecureTC.UpdateCertificates(&badTC.CertificateConfig); err != nil {
+		t.Fatal(err)
+	}
+	ValidateInsecure(t, insecureTC, nil)
+	if insecureTC.CertificatePath != badTC.CertificatePath {
+		t.Fatalf("expected certificate path %s, got %s", badTC.CertificatePath, insecureTC.CertificatePath)
+	}
+}
+
 func ValidateInsecurePrivateKey(t *testing.T, key crypto.PrivateKey, err error) {
 	if err != nil {
 		t.Fatalf("Unable to Load PrivateKey from TransportConfig: %s", err)
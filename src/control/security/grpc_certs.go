@@ -43,10 +43,21 @@ func GetServerTransportCredentials(cfg *TransportConfig) (credentials.TransportC
 		}
 	}
 
+	// Certificate data is looked up per connection so that certificates
+	// updated while the server is running are used for new clients.
 	tlsConfig := tls.Config{
-		ClientAuth:   tls.RequireAndVerifyClientCert,
-		Certificates: []tls.Certificate{*cfg.tlsKeypair},
-		ClientCAs:    cfg.caPool,
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			certDataLock.RLock()
+			defer certDataLock.RUnlock()
+
+			return &tls.Config{
+				ClientAuth:   tls.RequireAndVerifyClientCert,
+				Certificates: []tls.Certificate{*cfg.tlsKeypair},
+				ClientCAs:    cfg.caPool,
+				NextProtos:   []string{"h2"},
+			}, nil
+		},
 	}
 	creds := credentials.NewTLS(&tlsConfig)
 	return creds, nil
@@ -64,6 +75,9 @@ func GetClientTransportCredentials(cfg *TransportConfig) (credentials.TransportC
 		}
 	}
 
+	certDataLock.RLock()
+	defer certDataLock.RUnlock()
+
 	tlsConfig := tls.Config{
 		ServerName:   cfg.ServerName,
 		Certificates: []tls.Certificate{*cfg.tlsKeypair},
@@ -24,11 +24,14 @@
 package server
 
 import (
+	"fmt"
 	"hash/fnv"
 	"io/ioutil"
+	"math"
 	"os"
 	"path/filepath"
 	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 	yaml "gopkg.in/yaml.v2"
@@ -45,7 +48,9 @@ const (
 	msgConfigNoProvider      = "provider not specified in config"
 	msgConfigNoPath          = "no config path set"
 	msgConfigNoServers       = "no servers specified in config"
-	msgConfigBadAccessPoints = "only a single access point is currently supported"
+	msgConfigBadAccessPoints = "an odd number of access points is required"
+	msgConfigDupAccessPoint  = "access point %q specified more than once"
+	msgConfigSharedResource  = "I/O servers %d and %d share the same %s %q"
 )
 
 // Configuration describes options for DAOS control plane.
@@ -63,6 +68,15 @@ type Configuration struct {
 	ControlLogJSON  bool                      `yaml:"control_log_json,omitempty"`
 	UserName        string                    `yaml:"user_name"`
 	GroupName       string                    `yaml:"group_name"`
+	RestartPolicy   RestartPolicy             `yaml:"restart_policy"`
+
+	RecreateSuperblocks SuperblockRecreation `yaml:"recreate_superblocks"`
+	DeviceCheck         DeviceCheckPolicy    `yaml:"device_check"`
+	JoinTimeout         time.Duration        `yaml:"join_timeout,omitempty"`
+	ShutdownTimeout     time.Duration        `yaml:"shutdown_timeout,omitempty"`
+	HeartbeatInterval   time.Duration        `yaml:"heartbeat_interval,omitempty"`
+	HeartbeatMisses     int                  `yaml:"heartbeat_misses,omitempty"`
+	HeartbeatRestart    bool                 `yaml:"heartbeat_restart,omitempty"`
 
 	// duplicated in ioserver.Config
 	SystemName string                `yaml:"name"`
@@ -70,6 +84,7 @@ type Configuration struct {
 	Fabric     ioserver.FabricConfig `yaml:",inline"`
 	Modules    string
 	Attach     string
+	PMIxCompat bool `yaml:"pmix_compat,omitempty"`
 
 	AccessPoints []string `yaml:"access_points"`
 
@@ -80,6 +95,9 @@ type Configuration struct {
 
 	Path string   // path to config file
 	ext  External // interface to os utilities
+	// Writer for control_log_file, if in use, so that the file can
+	// be switched when the configuration is reloaded.
+	controlLogWriter *logging.LogFile
 	// Shared memory segment ID to enable SPDK multiprocess mode,
 	// SPDK application processes can then access the same shared
 	// memory and therefore NVMe controllers.
@@ -99,8 +117,8 @@ func (c *Configuration) WithSystemName(name string) *Configuration {
 // WithSocketDir sets the default socket directory.
 func (c *Configuration) WithSocketDir(sockDir string) *Configuration {
 	c.SocketDir = sockDir
-	for _, srv := range c.Servers {
-		srv.WithSocketDir(sockDir)
+	for i, srv := range c.Servers {
+		srv.WithSocketDir(c.instanceSocketDir(i))
 	}
 	return c
 }
@@ -108,8 +126,8 @@ func (c *Configuration) WithSocketDir(sockDir string) *Configuration {
 // WithNvmeShmID sets the common shmID used for SPDK multiprocess mode.
 func (c *Configuration) WithNvmeShmID(id int) *Configuration {
 	c.NvmeShmID = id
-	for _, srv := range c.Servers {
-		srv.WithShmID(id)
+	for i, srv := range c.Servers {
+		srv.WithShmID(c.instanceShmID(i))
 	}
 	return c
 }
@@ -142,24 +160,73 @@ func (c *Configuration) WithFabricProvider(provider string) *Configuration {
 	return c
 }
 
+// instanceSocketDir returns the dRPC socket directory for the I/O server
+// at the given index. Each instance needs its own pair of socket files, so
+// when more than one I/O server is configured each is given a subdirectory
+// of the top-level socket directory.
+func (c *Configuration) instanceSocketDir(idx int) string {
+	if len(c.Servers) < 2 {
+		return c.SocketDir
+	}
+	return filepath.Join(c.SocketDir, strconv.Itoa(idx))
+}
+
+// instanceShmID returns the SPDK shared memory segment ID for the I/O server
+// at the given index. The first instance shares the ID used by the control
+// plane and subsequent instances are offset from it. An ID of zero (unset)
+// is left unchanged.
+func (c *Configuration) instanceShmID(idx int) int {
+	if c.NvmeShmID == 0 {
+		return 0
+	}
+
+	id := c.NvmeShmID + idx
+	if id > math.MaxInt32 {
+		id -= math.MaxInt32
+	}
+	return id
+}
+
+// WithPMIxCompat enables the deprecated PMIx compatibility mode, in which
+// I/O servers launched by a PMIx launcher take their ranks from it.
+func (c *Configuration) WithPMIxCompat(compat bool) *Configuration {
+	c.PMIxCompat = compat
+	for _, srv := range c.Servers {
+		srv.WithPMIxCompat(compat)
+	}
+	return c
+}
+
+// WithShutdownTimeout sets how long I/O servers are given to exit after
+// SIGTERM before they are killed.
+func (c *Configuration) WithShutdownTimeout(timeout time.Duration) *Configuration {
+	c.ShutdownTimeout = timeout
+	for _, srv := range c.Servers {
+		srv.WithShutdownTimeout(timeout)
+	}
+	return c
+}
+
 // NB: In order to ease maintenance, the set of chained config functions
 // which modify nested ioserver configurations should be kept above this
 // one as a reference for which things should be set/updated in the next
 // function.
-func (c *Configuration) updateServerConfig(srvCfg *ioserver.Config) {
+func (c *Configuration) updateServerConfig(srvCfg *ioserver.Config, idx int) {
 	srvCfg.Fabric.Update(c.Fabric)
 	srvCfg.SystemName = c.SystemName
-	srvCfg.WithShmID(c.NvmeShmID)
-	srvCfg.SocketDir = c.SocketDir
+	srvCfg.WithShmID(c.instanceShmID(idx))
+	srvCfg.SocketDir = c.instanceSocketDir(idx)
 	srvCfg.Modules = c.Modules
 	srvCfg.AttachInfoPath = c.Attach // TODO: Is this correct?
+	srvCfg.PMIxCompat = c.PMIxCompat
+	srvCfg.ShutdownTimeout = c.ShutdownTimeout
 }
 
 // WithServers sets the list of IOServer configurations.
 func (c *Configuration) WithServers(srvList ...*ioserver.Config) *Configuration {
 	c.Servers = srvList
-	for _, srvCfg := range c.Servers {
-		c.updateServerConfig(srvCfg)
+	for i, srvCfg := range c.Servers {
+		c.updateServerConfig(srvCfg, i)
 	}
 	return c
 }
@@ -242,6 +309,12 @@ func (c *Configuration) WithControlLogFile(filePath string) *Configuration {
 	return c
 }
 
+// WithControlLogWriter sets the writer used for the control log file.
+func (c *Configuration) WithControlLogWriter(lf *logging.LogFile) *Configuration {
+	c.controlLogWriter = lf
+	return c
+}
+
 // WithControlLogJSON enables or disables JSON output.
 func (c *Configuration) WithControlLogJSON(enabled bool) *Configuration {
 	c.ControlLogJSON = enabled
@@ -260,6 +333,53 @@ func (c *Configuration) WithGroupName(name string) *Configuration {
 	return c
 }
 
+// WithRestartPolicy sets the policy used to restart failed I/O servers.
+func (c *Configuration) WithRestartPolicy(policy RestartPolicy) *Configuration {
+	c.RestartPolicy = policy
+	return c
+}
+
+// WithRecreateSuperblocks sets the policy used to recreate unreadable
+// I/O server superblocks.
+func (c *Configuration) WithRecreateSuperblocks(recreate SuperblockRecreation) *Configuration {
+	c.RecreateSuperblocks = recreate
+	return c
+}
+
+// WithDeviceCheck sets how I/O servers handle storage devices which don't
+// match those recorded in their superblocks.
+func (c *Configuration) WithDeviceCheck(policy DeviceCheckPolicy) *Configuration {
+	c.DeviceCheck = policy
+	return c
+}
+
+// WithJoinTimeout sets the deadline for I/O servers to join the system.
+func (c *Configuration) WithJoinTimeout(timeout time.Duration) *Configuration {
+	c.JoinTimeout = timeout
+	return c
+}
+
+// WithHeartbeatInterval sets how often I/O servers are checked for liveness,
+// zero disables heartbeats.
+func (c *Configuration) WithHeartbeatInterval(interval time.Duration) *Configuration {
+	c.HeartbeatInterval = interval
+	return c
+}
+
+// WithHeartbeatMisses sets the number of consecutive heartbeats an I/O
+// server may miss before it is considered unresponsive.
+func (c *Configuration) WithHeartbeatMisses(misses int) *Configuration {
+	c.HeartbeatMisses = misses
+	return c
+}
+
+// WithHeartbeatRestart sets whether unresponsive I/O servers are killed so
+// that they can be restarted.
+func (c *Configuration) WithHeartbeatRestart(restart bool) *Configuration {
+	c.HeartbeatRestart = restart
+	return c
+}
+
 // parse decodes YAML representation of configuration
 func (c *Configuration) parse(data []byte) error {
 	return yaml.Unmarshal(data, c)
@@ -269,17 +389,22 @@ func (c *Configuration) parse(data []byte) error {
 // populated with defaults.
 func newDefaultConfiguration(ext External) *Configuration {
 	return &Configuration{
-		SystemName:      "daos_server",
-		SocketDir:       "/var/run/daos_server",
-		AccessPoints:    []string{"localhost"},
-		ControlPort:     10000,
-		TransportConfig: security.DefaultServerTransportConfig(),
-		Hyperthreads:    false,
-		NrHugepages:     1024,
-		Path:            "etc/daos_server.yml",
-		NvmeShmID:       0,
-		ControlLogMask:  ControlLogLevel(logging.LogLevelInfo),
-		ext:             ext,
+		SystemName:        "daos_server",
+		SocketDir:         "/var/run/daos_server",
+		AccessPoints:      []string{"localhost"},
+		ControlPort:       10000,
+		TransportConfig:   security.DefaultServerTransportConfig(),
+		Hyperthreads:      false,
+		NrHugepages:       1024,
+		Path:              "etc/daos_server.yml",
+		NvmeShmID:         0,
+		ControlLogMask:    ControlLogLevel(logging.LogLevelInfo),
+		RestartPolicy:     DefaultRestartPolicy(),
+		DeviceCheck:       DeviceCheckStrict,
+		ShutdownTimeout:   ioserver.DefaultShutdownTimeout,
+		HeartbeatInterval: defaultHeartbeatInterval,
+		HeartbeatMisses:   defaultHeartbeatMisses,
+		ext:               ext,
 	}
 }
 
@@ -306,8 +431,8 @@ func (c *Configuration) Load() error {
 	}
 
 	// propagate top-level settings to server configs
-	for _, srvCfg := range c.Servers {
-		c.updateServerConfig(srvCfg)
+	for i, srvCfg := range c.Servers {
+		c.updateServerConfig(srvCfg, i)
 	}
 
 	return c.Validate()
@@ -389,9 +514,8 @@ func (c *Configuration) Validate() (err error) {
 		return errors.New(msgConfigNoProvider)
 	}
 
-	// only single access point valid for now
-	if len(c.AccessPoints) != 1 {
-		return errors.New(msgConfigBadAccessPoints)
+	if err := c.validateAccessPoints(); err != nil {
+		return err
 	}
 
 	if len(c.Servers) == 0 {
@@ -405,5 +529,97 @@ func (c *Configuration) Validate() (err error) {
 		}
 	}
 
+	if err := c.RestartPolicy.Validate(); err != nil {
+		return err
+	}
+
+	if err := c.DeviceCheck.Validate(); err != nil {
+		return err
+	}
+
+	if c.JoinTimeout < 0 {
+		return errors.New("join_timeout must not be negative")
+	}
+
+	if c.ShutdownTimeout <= 0 {
+		return errors.New("shutdown_timeout must be positive")
+	}
+
+	if c.HeartbeatInterval < 0 {
+		return errors.New("heartbeat_interval must not be negative")
+	}
+
+	if c.HeartbeatInterval > 0 && c.HeartbeatMisses <= 0 {
+		return errors.New("heartbeat_misses must be positive")
+	}
+
+	return c.validateServerResources()
+}
+
+// validateAccessPoints verifies that the management service will be
+// replicated on an odd number of distinct access points, so that a majority
+// of replicas can always be established.
+func (c *Configuration) validateAccessPoints() error {
+	if len(c.AccessPoints)%2 == 0 {
+		return errors.New(msgConfigBadAccessPoints)
+	}
+
+	seen := make(map[string]bool)
+	for _, ap := range c.AccessPoints {
+		addr := withDefaultPort(ap)
+		if seen[addr] {
+			return errors.Errorf(msgConfigDupAccessPoint, ap)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+// validateServerResources verifies that resources which can't be shared
+// between multiple I/O server instances on the same node are unique.
+func (c *Configuration) validateServerResources() error {
+	type resource struct {
+		name  string
+		value func(*ioserver.Config) []string
+	}
+	resources := []resource{
+		{"scm_mount", func(s *ioserver.Config) []string {
+			return []string{s.Storage.SCM.MountPoint}
+		}},
+		{"log_file", func(s *ioserver.Config) []string {
+			return []string{s.LogFile}
+		}},
+		{"socket_dir", func(s *ioserver.Config) []string {
+			return []string{s.SocketDir}
+		}},
+		{"bdev_list entry", func(s *ioserver.Config) []string {
+			return s.Storage.Bdev.DeviceList
+		}},
+		{"fabric_iface:fabric_iface_port", func(s *ioserver.Config) []string {
+			if s.Fabric.InterfacePort == 0 {
+				return nil // port chosen automatically
+			}
+			return []string{fmt.Sprintf("%s:%d", s.Fabric.Interface,
+				s.Fabric.InterfacePort)}
+		}},
+	}
+
+	for _, res := range resources {
+		seen := make(map[string]int)
+		for i, srv := range c.Servers {
+			for _, val := range res.value(srv) {
+				if val == "" {
+					continue
+				}
+				if prev, exists := seen[val]; exists {
+					return errors.Errorf(msgConfigSharedResource,
+						prev, i, res.name, val)
+				}
+				seen[val] = i
+			}
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,315 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// certificateKeys are the YAML keys of the TLS certificate locations, which
+// are applied together.
+var certificateKeys = map[string]bool{
+	"transport_config.server_name":     true,
+	"transport_config.client_cert_dir": true,
+	"transport_config.ca_cert":         true,
+	"transport_config.cert":            true,
+	"transport_config.key":             true,
+}
+
+var serverLogMaskKey = regexp.MustCompile(`^servers\[(\d+)\]\.log_mask$`)
+
+// reloadResult reports the changed settings found by a config reload.
+type reloadResult struct {
+	applied []string
+	pending []string
+	failed  []string
+}
+
+// configReloader applies changes made to the server config file to the
+// running server.
+//
+// Changes are detected by comparing the file against its own contents
+// rather than against the running configuration, so that settings given
+// on the command line are not reported as changed. Settings which can be
+// changed at runtime are compared with the file as last applied, all others
+// with the file as it was when the server started so that they are reported
+// as pending until the server is restarted.
+type configReloader struct {
+	sync.Mutex
+	log     *logging.LeveledLogger
+	running *Configuration
+	harness *IOServerHarness
+	startup *Configuration // config file contents at startup
+	last    *Configuration // config file contents as last applied
+}
+
+// newConfigReloader returns a configReloader for the running configuration,
+// recording the current contents of the config file.
+func newConfigReloader(log *logging.LeveledLogger, cfg *Configuration, harness *IOServerHarness) (*configReloader, error) {
+	startup, err := cfg.reread()
+	if err != nil {
+		return nil, err
+	}
+
+	return &configReloader{
+		log:     log,
+		running: cfg,
+		harness: harness,
+		startup: startup,
+		last:    startup,
+	}, nil
+}
+
+// reread loads and validates a fresh copy of the configuration from the
+// file it was loaded from.
+func (c *Configuration) reread() (*Configuration, error) {
+	if c.Path == "" {
+		return nil, errors.New(msgConfigNoPath)
+	}
+
+	newCfg := newDefaultConfiguration(c.ext)
+	newCfg.Path = c.Path
+	if err := newCfg.Load(); err != nil {
+		return nil, errors.WithMessagef(err, "load %s", c.Path)
+	}
+
+	return newCfg, nil
+}
+
+// reload re-reads the config file, applies changed settings which are safe
+// to change at runtime and reports the others as pending a restart. The
+// running configuration is left unchanged if the file is invalid.
+func (r *configReloader) reload() (*reloadResult, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	newCfg, err := r.running.reread()
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(reloadResult)
+	for _, key := range changedKeys(r.startup, newCfg) {
+		if r.needsRestart(key, newCfg) {
+			result.pending = append(result.pending, key)
+		}
+	}
+
+	var certsUpdated bool
+	var certErr error
+	for _, key := range changedKeys(r.last, newCfg) {
+		if r.needsRestart(key, newCfg) {
+			continue
+		}
+
+		if certificateKeys[key] {
+			// all certificate locations are switched at once
+			if !certsUpdated {
+				certErr = r.running.TransportConfig.UpdateCertificates(&newCfg.TransportConfig.CertificateConfig)
+				certsUpdated = true
+			}
+			err = certErr
+		} else {
+			err = r.apply(key, newCfg)
+		}
+
+		if err != nil {
+			result.failed = append(result.failed, fmt.Sprintf("%s: %s", key, err))
+			continue
+		}
+		result.applied = append(result.applied, key)
+	}
+
+	// Failed settings are retried on the next reload.
+	if len(result.failed) == 0 {
+		r.last = newCfg
+	}
+
+	sort.Strings(result.applied)
+	sort.Strings(result.pending)
+	sort.Strings(result.failed)
+	r.logResult(result)
+
+	return result, nil
+}
+
+// needsRestart returns true if the setting identified by the YAML key can't
+// be changed to its value in newCfg without restarting daos_server.
+func (r *configReloader) needsRestart(key string, newCfg *Configuration) bool {
+	switch {
+	case key == "control_log_mask", certificateKeys[key], serverLogMaskKey.MatchString(key):
+		return false
+	case key == "control_log_file":
+		// switching between a log file and stdout requires new loggers
+		return r.running.controlLogWriter == nil || newCfg.ControlLogFile == ""
+	default:
+		return true
+	}
+}
+
+// apply changes the running server to use the value in newCfg of the
+// setting identified by the YAML key.
+func (r *configReloader) apply(key string, newCfg *Configuration) error {
+	switch key {
+	case "control_log_mask":
+		r.running.ControlLogMask = newCfg.ControlLogMask
+		r.log.SetLevel(logging.LogLevel(newCfg.ControlLogMask))
+	case "control_log_file":
+		if err := r.running.controlLogWriter.Reopen(newCfg.ControlLogFile); err != nil {
+			return err
+		}
+		r.running.ControlLogFile = newCfg.ControlLogFile
+	default:
+		match := serverLogMaskKey.FindStringSubmatch(key)
+		if match == nil {
+			return errors.Errorf("%s can't be changed at runtime", key)
+		}
+		idx, err := strconv.Atoi(match[1])
+		if err != nil {
+			return err
+		}
+		srv, err := r.harness.GetInstance(idx)
+		if err != nil {
+			return err
+		}
+		return srv.SetLogMask(newCfg.Servers[idx].LogMask)
+	}
+
+	return nil
+}
+
+func (r *configReloader) logResult(result *reloadResult) {
+	if len(result.applied)+len(result.pending)+len(result.failed) == 0 {
+		r.log.Infof("config reloaded from %s: no changes", r.running.Path)
+		return
+	}
+	if len(result.applied) > 0 {
+		r.log.Infof("config reloaded from %s, applied: %s", r.running.Path,
+			strings.Join(result.applied, ", "))
+	}
+	if len(result.pending) > 0 {
+		r.log.Infof("config reloaded from %s, pending restart: %s", r.running.Path,
+			strings.Join(result.pending, ", "))
+	}
+	for _, failed := range result.failed {
+		r.log.Errorf("config reloaded from %s, failed to apply %s", r.running.Path, failed)
+	}
+}
+
+// changedKeys returns the YAML keys of the settings which differ between
+// two configurations.
+func changedKeys(a, b *Configuration) []string {
+	var keys []string
+	diffValues("", reflect.ValueOf(a).Elem(), reflect.ValueOf(b).Elem(), &keys)
+	sort.Strings(keys)
+
+	return keys
+}
+
+// yamlKey returns the key a struct field is serialized with and whether it
+// is inlined, or an empty key if the field isn't serialized.
+func yamlKey(field reflect.StructField) (string, bool) {
+	if field.PkgPath != "" {
+		return "", false // unexported
+	}
+
+	tag := strings.Split(field.Tag.Get("yaml"), ",")
+	if tag[0] == "-" {
+		return "", false
+	}
+	for _, opt := range tag[1:] {
+		if opt == "inline" {
+			return "", true
+		}
+	}
+	if tag[0] != "" {
+		return tag[0], false
+	}
+
+	return strings.ToLower(field.Name), false
+}
+
+// diffValues appends the keys of serialized fields which differ between the
+// structs a and b, descending into nested structs and lists of structs.
+func diffValues(prefix string, a, b reflect.Value, keys *[]string) {
+	for i := 0; i < a.NumField(); i++ {
+		key, inline := yamlKey(a.Type().Field(i))
+		fa, fb := a.Field(i), b.Field(i)
+
+		if inline {
+			diffValues(prefix, fa, fb, keys)
+			continue
+		}
+		if key == "" || reflect.DeepEqual(fa.Interface(), fb.Interface()) {
+			continue
+		}
+		key = prefix + key
+
+		found := len(*keys)
+		switch {
+		case isStruct(fa.Type()):
+			if fa.Kind() == reflect.Ptr && (fa.IsNil() || fb.IsNil()) {
+				break
+			}
+			diffValues(key+".", reflect.Indirect(fa), reflect.Indirect(fb), keys)
+		case fa.Kind() == reflect.Slice && isStruct(fa.Type().Elem()) && fa.Len() == fb.Len():
+			for j := 0; j < fa.Len(); j++ {
+				ea, eb := fa.Index(j), fb.Index(j)
+				if reflect.DeepEqual(ea.Interface(), eb.Interface()) {
+					continue
+				}
+				elemKey := fmt.Sprintf("%s[%d]", key, j)
+				if ea.Kind() == reflect.Ptr && (ea.IsNil() || eb.IsNil()) {
+					*keys = append(*keys, elemKey)
+					continue
+				}
+				diffValues(elemKey+".", reflect.Indirect(ea), reflect.Indirect(eb), keys)
+			}
+		}
+
+		// report the field itself if no nested setting accounts for
+		// the difference
+		if len(*keys) == found {
+			*keys = append(*keys, key)
+		}
+	}
+}
+
+// isStruct returns true for struct types and pointers to them.
+func isStruct(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Kind() == reflect.Struct
+}
@@ -0,0 +1,201 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	. "github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/ioserver"
+)
+
+func TestConfigReload(t *testing.T) {
+	for name, tc := range map[string]struct {
+		change     func(c *Configuration)
+		logWriter  bool
+		expApplied []string
+		expPending []string
+		expErr     string
+		check      func(t *testing.T, log *logging.LeveledLogger, running *Configuration)
+	}{
+		"no changes": {},
+		"log masks": {
+			change: func(c *Configuration) {
+				c.ControlLogMask = ControlLogLevelError
+				c.Servers[0].LogMask = "DEBUG"
+			},
+			expApplied: []string{"control_log_mask", "servers[0].log_mask"},
+			check: func(t *testing.T, log *logging.LeveledLogger, running *Configuration) {
+				AssertEqual(t, log.Level(), logging.LogLevelError, "control log level")
+				AssertEqual(t, running.Servers[0].LogMask, "DEBUG", "I/O server log mask")
+			},
+		},
+		"restart required": {
+			change: func(c *Configuration) {
+				c.NrHugepages = 8192
+				c.Servers[0].Fabric.Interface = "ib0"
+			},
+			expPending: []string{"nr_hugepages", "servers[0].fabric_iface"},
+			check: func(t *testing.T, log *logging.LeveledLogger, running *Configuration) {
+				AssertEqual(t, running.NrHugepages, 4096, "hugepages changed")
+				AssertEqual(t, running.Servers[0].Fabric.Interface, "eth0", "fabric interface changed")
+			},
+		},
+		"control log file without writer": {
+			change: func(c *Configuration) {
+				c.ControlLogFile = "/tmp/daos_control_new.log"
+			},
+			expPending: []string{"control_log_file"},
+		},
+		"control log file with writer": {
+			change: func(c *Configuration) {
+				c.ControlLogFile = "new.log"
+			},
+			logWriter:  true,
+			expApplied: []string{"control_log_file"},
+			check: func(t *testing.T, log *logging.LeveledLogger, running *Configuration) {
+				AssertEqual(t, filepath.Base(running.controlLogWriter.Path()), "new.log", "log file path")
+			},
+		},
+		"certificate locations": {
+			change: func(c *Configuration) {
+				c.TransportConfig.CertificatePath = "/new/server.crt"
+				c.TransportConfig.PrivateKeyPath = "/new/server.key"
+			},
+			expApplied: []string{"transport_config.cert", "transport_config.key"},
+			check: func(t *testing.T, log *logging.LeveledLogger, running *Configuration) {
+				AssertEqual(t, running.TransportConfig.CertificatePath, "/new/server.crt", "certificate path")
+			},
+		},
+		"allow insecure": {
+			change: func(c *Configuration) {
+				c.TransportConfig.AllowInsecure = false
+			},
+			expPending: []string{"transport_config.allow_insecure"},
+		},
+		"server added": {
+			change: func(c *Configuration) {
+				srvCfg := *c.Servers[0]
+				srvCfg.Storage.SCM.MountPoint = "/mnt/daos1"
+				srvCfg.Storage.Bdev.DeviceList = []string{"0000:82:00.0"}
+				srvCfg.Fabric.InterfacePort = 32416
+				srvCfg.LogFile = "/tmp/server1.log"
+				c.Servers = append(c.Servers, &srvCfg)
+			},
+			expPending: []string{"servers"},
+		},
+		"invalid config": {
+			change: func(c *Configuration) {
+				c.Fabric.Provider = ""
+			},
+			expErr: msgConfigNoProvider,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			testDir, err := ioutil.TempDir("", strings.Replace(t.Name(), "/", "-", -1))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(testDir)
+			cfgPath := filepath.Join(testDir, "daos_server.yml")
+
+			// write a config file which doesn't need certificates
+			initial := defaultMockConfig(t)
+			initial.TransportConfig.AllowInsecure = true
+			initial.Path = cfgPath
+			if err := initial.SaveToFile(cfgPath); err != nil {
+				t.Fatal(err)
+			}
+
+			running := mockConfigFromFile(t, defaultMockExt(), cfgPath)
+			if tc.logWriter {
+				lf, err := logging.OpenLogFile(filepath.Join(testDir, "daos_control.log"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer lf.Close()
+				running.WithControlLogWriter(lf)
+			}
+
+			harness := NewIOServerHarness(defaultMockExt(), log)
+			for _, srvCfg := range running.Servers {
+				srv := NewIOServerInstance(harness.ext, log, nil, nil, ioserver.NewRunner(log, srvCfg))
+				if err := harness.AddInstance(srv); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			reloader, err := newConfigReloader(log, running, harness)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.change != nil {
+				changed := mockConfigFromFile(t, defaultMockExt(), cfgPath)
+				tc.change(changed)
+				if tc.logWriter {
+					changed.ControlLogFile = filepath.Join(testDir, changed.ControlLogFile)
+				}
+				if err := changed.SaveToFile(cfgPath); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			result, err := reloader.reload()
+			if tc.expErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expErr) {
+					t.Fatalf("expected error containing %q, got %v", tc.expErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			AssertEqual(t, result.applied, tc.expApplied, "applied settings")
+			AssertEqual(t, result.pending, tc.expPending, "pending settings")
+			AssertEqual(t, len(result.failed), 0, "failed settings")
+			if tc.check != nil {
+				tc.check(t, log, running)
+			}
+
+			// applied settings are only reported once, pending ones
+			// until restart
+			result, err = reloader.reload()
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEqual(t, len(result.applied), 0, "applied settings on second reload")
+			AssertEqual(t, result.pending, tc.expPending, "pending settings on second reload")
+		})
+	}
+}
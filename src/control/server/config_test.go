@@ -26,11 +26,13 @@ package server
 import (
 	"fmt"
 	"io/ioutil"
+	"math"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -207,6 +209,20 @@ func TestConstructedConfig(t *testing.T) {
 		WithFaultCb("./.daos/fd_callback").
 		WithFaultPath("/vcdu0/rack1/hostname").
 		WithHyperthreads(true).
+		WithRecreateSuperblocks(SuperblockRecreation{Enabled: true}).
+		WithDeviceCheck(DeviceCheckWarn).
+		WithJoinTimeout(10 * time.Minute).
+		WithShutdownTimeout(time.Minute).
+		WithHeartbeatInterval(10 * time.Second).
+		WithHeartbeatMisses(5).
+		WithHeartbeatRestart(true).
+		WithPMIxCompat(true).
+		WithRestartPolicy(RestartPolicy{
+			MaxRetries:      5,
+			BackoffBase:     2 * time.Second,
+			BackoffMax:      2 * time.Minute,
+			CrashLoopWindow: 10 * time.Minute,
+		}).
 		WithServers(
 			ioserver.NewConfig().
 				WithRank(0).
@@ -236,7 +252,7 @@ func TestConstructedConfig(t *testing.T) {
 				WithBdevDeviceCount(1).
 				WithBdevFileSize(16).
 				WithFabricInterface("qib0").
-				WithFabricInterfacePort(20000).
+				WithFabricInterfacePort(20001).
 				WithEnvVars("CRT_TIMEOUT=100").
 				WithLogFile("/tmp/daos_server2.log").
 				WithLogMask("WARN"),
@@ -271,18 +287,129 @@ func TestConfigValidation(t *testing.T) {
 			},
 			"",
 		},
-		"multiple access points": {
+		"even number of access points": {
 			func(c *Configuration) *Configuration {
 				return c.WithAccessPoints("1.2.3.4:1234", "5.6.7.8:5678")
 			},
 			msgBadConfig + relConfExamplesPath + ": " + msgConfigBadAccessPoints,
 		},
+		"three access points": {
+			func(c *Configuration) *Configuration {
+				return c.WithAccessPoints("1.2.3.4:1234", "5.6.7.8:5678", "1.2.3.5")
+			},
+			"",
+		},
+		"five access points": {
+			func(c *Configuration) *Configuration {
+				return c.WithAccessPoints("1.2.3.4", "1.2.3.5", "1.2.3.6",
+					"1.2.3.7", "1.2.3.8")
+			},
+			"",
+		},
+		"duplicate access points": {
+			func(c *Configuration) *Configuration {
+				return c.WithAccessPoints("1.2.3.4", "1.2.3.5", "1.2.3.4:10000")
+			},
+			msgBadConfig + relConfExamplesPath + ": " +
+				fmt.Sprintf(msgConfigDupAccessPoint, "1.2.3.4:10000"),
+		},
 		"no access points": {
 			func(c *Configuration) *Configuration {
 				return c.WithAccessPoints()
 			},
 			msgBadConfig + relConfExamplesPath + ": " + msgConfigBadAccessPoints,
 		},
+		"shared scm mount": {
+			func(c *Configuration) *Configuration {
+				c.Servers[1].WithScmMountPoint("/mnt/daos/1")
+				return c
+			},
+			msgBadConfig + relConfExamplesPath + ": " +
+				fmt.Sprintf(msgConfigSharedResource, 0, 1, "scm_mount", "/mnt/daos/1"),
+		},
+		"shared log file": {
+			func(c *Configuration) *Configuration {
+				c.Servers[1].WithLogFile("/tmp/daos_server1.log")
+				return c
+			},
+			msgBadConfig + relConfExamplesPath + ": " +
+				fmt.Sprintf(msgConfigSharedResource, 0, 1, "log_file", "/tmp/daos_server1.log"),
+		},
+		"shared bdev": {
+			func(c *Configuration) *Configuration {
+				c.Servers[1].WithBdevDeviceList("/dev/sdc", "0000:81:00.0")
+				return c
+			},
+			msgBadConfig + relConfExamplesPath + ": " +
+				fmt.Sprintf(msgConfigSharedResource, 0, 1, "bdev_list entry", "0000:81:00.0"),
+		},
+		"shared fabric port": {
+			func(c *Configuration) *Configuration {
+				c.Servers[1].WithFabricInterfacePort(20000)
+				return c
+			},
+			msgBadConfig + relConfExamplesPath + ": " +
+				fmt.Sprintf(msgConfigSharedResource, 0, 1, "fabric_iface:fabric_iface_port", "qib0:20000"),
+		},
+		"automatic fabric ports": {
+			func(c *Configuration) *Configuration {
+				c.Servers[0].WithFabricInterfacePort(0)
+				c.Servers[1].WithFabricInterfacePort(0)
+				return c
+			},
+			"",
+		},
+		"restarts disabled": {
+			func(c *Configuration) *Configuration {
+				return c.WithRestartPolicy(RestartPolicy{})
+			},
+			"",
+		},
+		"bad restart backoff": {
+			func(c *Configuration) *Configuration {
+				return c.WithRestartPolicy(RestartPolicy{
+					MaxRetries:  1,
+					BackoffBase: time.Minute,
+					BackoffMax:  time.Second,
+				})
+			},
+			msgBadConfig + relConfExamplesPath + ": " +
+				"restart_policy: backoff_max must not be less than backoff_base",
+		},
+		"bad device check": {
+			func(c *Configuration) *Configuration {
+				return c.WithDeviceCheck("sometimes")
+			},
+			msgBadConfig + relConfExamplesPath + ": " +
+				`device_check: unknown policy "sometimes" (expected strict, warn or off)`,
+		},
+		"zero shutdown timeout": {
+			func(c *Configuration) *Configuration {
+				return c.WithShutdownTimeout(0)
+			},
+			msgBadConfig + relConfExamplesPath + ": " +
+				"shutdown_timeout must be positive",
+		},
+		"heartbeats disabled": {
+			func(c *Configuration) *Configuration {
+				return c.WithHeartbeatInterval(0).WithHeartbeatMisses(0)
+			},
+			"",
+		},
+		"negative heartbeat interval": {
+			func(c *Configuration) *Configuration {
+				return c.WithHeartbeatInterval(-time.Second)
+			},
+			msgBadConfig + relConfExamplesPath + ": " +
+				"heartbeat_interval must not be negative",
+		},
+		"zero heartbeat misses": {
+			func(c *Configuration) *Configuration {
+				return c.WithHeartbeatMisses(0)
+			},
+			msgBadConfig + relConfExamplesPath + ": " +
+				"heartbeat_misses must be positive",
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			testDir, err := ioutil.TempDir("", strings.Replace(t.Name(), "/", "-", -1))
@@ -311,3 +438,58 @@ func TestConfigValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigPerInstanceResources(t *testing.T) {
+	for name, tt := range map[string]struct {
+		servers    int
+		shmID      int
+		expSockets []string
+		expShmIDs  []int
+	}{
+		"single server": {
+			servers:    1,
+			shmID:      42,
+			expSockets: []string{"/tmp/sockets"},
+			expShmIDs:  []int{42},
+		},
+		"multiple servers": {
+			servers:    3,
+			shmID:      42,
+			expSockets: []string{"/tmp/sockets/0", "/tmp/sockets/1", "/tmp/sockets/2"},
+			expShmIDs:  []int{42, 43, 44},
+		},
+		"unset shm id": {
+			servers:    2,
+			expSockets: []string{"/tmp/sockets/0", "/tmp/sockets/1"},
+			expShmIDs:  []int{0, 0},
+		},
+		"shm id wraps": {
+			servers:    2,
+			shmID:      math.MaxInt32,
+			expSockets: []string{"/tmp/sockets/0", "/tmp/sockets/1"},
+			expShmIDs:  []int{math.MaxInt32, 1},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var srvCfgs []*ioserver.Config
+			for i := 0; i < tt.servers; i++ {
+				srvCfgs = append(srvCfgs, ioserver.NewConfig())
+			}
+
+			config := emptyMockConfig(t).
+				WithSocketDir("/tmp/sockets").
+				WithNvmeShmID(tt.shmID).
+				WithServers(srvCfgs...)
+
+			var sockets []string
+			var shmIDs []int
+			for _, srv := range config.Servers {
+				sockets = append(sockets, srv.SocketDir)
+				shmIDs = append(shmIDs, srv.Storage.Bdev.ShmID)
+			}
+
+			AssertEqual(t, sockets, tt.expSockets, "unexpected socket dirs")
+			AssertEqual(t, shmIDs, tt.expShmIDs, "unexpected shm ids")
+		})
+	}
+}
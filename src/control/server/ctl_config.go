@@ -0,0 +1,51 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+// ConfigReload re-reads the server config file, applies changed settings
+// which are safe to change at runtime and reports those which need a
+// restart to take effect.
+func (c *ControlService) ConfigReload(ctx context.Context, req *pb.ConfigReloadReq) (*pb.ConfigReloadResp, error) {
+	if c.reloader == nil {
+		return nil, errors.New("config reload not available")
+	}
+
+	result, err := c.reloader.reload()
+	if err != nil {
+		return nil, errors.WithMessage(err, "config reload")
+	}
+
+	return &pb.ConfigReloadResp{
+		Applied: result.applied,
+		Pending: result.pending,
+		Failed:  result.failed,
+	}, nil
+}
@@ -0,0 +1,70 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"golang.org/x/net/context"
+
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/server/ioserver"
+)
+
+// InstanceQuery returns the run status of each I/O server instance managed
+// by the harness, including restart history and recent state transitions.
+func (c *ControlService) InstanceQuery(ctx context.Context, req *pb.InstanceQueryReq) (*pb.InstanceQueryResp, error) {
+	resp := &pb.InstanceQueryResp{}
+
+	for _, instance := range c.harness.Instances() {
+		rank, err := instance.GetRank()
+		if err != nil {
+			rank = ioserver.NilRank
+		}
+
+		rs := instance.restartStatus()
+		state, transitions := instance.getState()
+		status := &pb.InstanceStatus{
+			Index:    uint32(instance.Index),
+			Rank:     uint32(rank),
+			Running:  rs.running,
+			Restarts: uint32(rs.restarts),
+			State:    state.String(),
+		}
+		if rs.lastExit != nil {
+			status.LastExit = rs.lastExit.Error()
+			status.LastExitTime = rs.lastExitTime.Unix()
+		}
+		for _, t := range transitions {
+			status.Transitions = append(status.Transitions, &pb.InstanceTransition{
+				From:   t.from.String(),
+				To:     t.to.String(),
+				Time:   t.time.UnixNano(),
+				Reason: t.reason,
+			})
+		}
+
+		resp.Instances = append(resp.Instances, status)
+	}
+
+	return resp, nil
+}
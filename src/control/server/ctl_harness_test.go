@@ -0,0 +1,100 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	. "github.com/daos-stack/daos/src/control/common"
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/ioserver"
+)
+
+func TestInstanceQuery(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	cs := defaultMockControlService(t, log)
+	instance, err := cs.harness.GetInstance(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := cs.InstanceQuery(context.TODO(), &pb.InstanceQueryReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEqual(t, len(resp.Instances), len(cs.harness.Instances()), "unexpected number of instances")
+	AssertEqual(t, resp.Instances[0], &pb.InstanceStatus{
+		Index: 0,
+		Rank:  uint32(ioserver.NilRank),
+		State: "stopped",
+	}, "unexpected status for instance without rank")
+
+	exitTime := time.Unix(1500000000, 0)
+	instance.setSuperblock(&Superblock{Rank: ioserver.NewRankPtr(3)})
+	instance._restart = restartState{
+		running:      true,
+		restarts:     2,
+		lastExit:     errors.New("instance exited"),
+		lastExitTime: exitTime,
+	}
+	joinTime := exitTime.Add(time.Minute)
+	instance._state = InstanceStateJoined
+	instance._transitions = []instanceTransition{
+		{
+			from:   InstanceStateReady,
+			to:     InstanceStateJoined,
+			time:   joinTime,
+			reason: "rank 3",
+		},
+	}
+
+	resp, err = cs.InstanceQuery(context.TODO(), &pb.InstanceQueryReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEqual(t, resp.Instances[0], &pb.InstanceStatus{
+		Index:        0,
+		Rank:         3,
+		Running:      true,
+		Restarts:     2,
+		LastExit:     "instance exited",
+		LastExitTime: exitTime.Unix(),
+		State:        "joined",
+		Transitions: []*pb.InstanceTransition{
+			{
+				From:   "ready",
+				To:     "joined",
+				Time:   joinTime.UnixNano(),
+				Reason: "rank 3",
+			},
+		},
+	}, "unexpected status for restarted instance")
+}
@@ -0,0 +1,46 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+// ServerSetLogMasks changes the log level of the server and the log masks of
+// its I/O server instances, optionally for a limited time.
+func (c *ControlService) ServerSetLogMasks(ctx context.Context, req *pb.ServerSetLogMasksReq) (*pb.ServerSetLogMasksResp, error) {
+	if c.logMasks == nil {
+		return nil, errors.New("log level control not available")
+	}
+
+	resp, err := c.logMasks.setLogMasks(req)
+	if err != nil {
+		return nil, errors.WithMessage(err, "set log masks")
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,123 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/netdetect"
+)
+
+// netDetector provides details of the network devices attached to the host.
+type netDetector interface {
+	fabricDeviceNames() ([]string, error)
+	providerDeviceNames(provider string) ([]string, error)
+	deviceAffinity(deviceNames []string) ([]netdetect.DeviceAffinity, error)
+}
+
+// hwlocNetDetector uses hwloc topology data to provide device affinity.
+type hwlocNetDetector struct{}
+
+func (hwlocNetDetector) fabricDeviceNames() ([]string, error) {
+	return netdetect.GetFabricDeviceNames()
+}
+
+func (hwlocNetDetector) providerDeviceNames(provider string) ([]string, error) {
+	return netdetect.GetProviderDeviceNames(provider)
+}
+
+func (hwlocNetDetector) deviceAffinity(deviceNames []string) ([]netdetect.DeviceAffinity, error) {
+	return netdetect.GetAffinityForNetworkDevices(deviceNames)
+}
+
+// NetworkScan lists the network interfaces on the host that may be used as a
+// fabric interface, along with the NUMA node and CPUs local to each.
+//
+// Only interfaces that are up, not loopback and on which libfabric reports
+// the provider to be available are listed. Interfaces are reported for the
+// requested provider or, if none is given, the provider set in the server
+// configuration. Interfaces whose NUMA node can't be determined are skipped.
+func (c *ControlService) NetworkScan(ctx context.Context, req *pb.NetworkScanReq) (*pb.NetworkScanResp, error) {
+	provider := req.GetProvider()
+	if provider == "" {
+		provider = c.fabricProvider
+	}
+
+	names, err := c.netDetect.fabricDeviceNames()
+	if err != nil {
+		return nil, err
+	}
+
+	supported, err := c.netDetect.providerDeviceNames(provider)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "getting devices for provider %s", provider)
+	}
+	names = filterDeviceNames(names, supported)
+
+	affinities, err := c.netDetect.deviceAffinity(names)
+	if err != nil {
+		return nil, errors.WithMessage(err, "getting network device affinity")
+	}
+
+	resp := &pb.NetworkScanResp{}
+	for _, da := range affinities {
+		node, err := netdetect.NUMANodeFromNodeSet(da.NodeSet)
+		if err != nil {
+			c.log.Debugf("skipping device %s: %s", da.DeviceName, err)
+			continue
+		}
+
+		resp.Interfaces = append(resp.Interfaces, &pb.FabricInterface{
+			Provider: provider,
+			Device:   da.DeviceName,
+			Numanode: uint32(node),
+			Cpuset:   da.CPUSet,
+			Nodeset:  da.NodeSet,
+		})
+	}
+
+	c.log.Debugf("NetworkScan found %d fabric interfaces", len(resp.Interfaces))
+
+	return resp, nil
+}
+
+// filterDeviceNames returns the names in names that also appear in supported,
+// preserving the order of names.
+func filterDeviceNames(names, supported []string) []string {
+	supportedSet := make(map[string]struct{}, len(supported))
+	for _, name := range supported {
+		supportedSet[name] = struct{}{}
+	}
+
+	filtered := make([]string, 0, len(names))
+	for _, name := range names {
+		if _, found := supportedSet[name]; found {
+			filtered = append(filtered, name)
+		}
+	}
+
+	return filtered
+}
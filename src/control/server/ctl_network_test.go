@@ -0,0 +1,189 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	. "github.com/daos-stack/daos/src/control/common"
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/netdetect"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+type mockNetDetector struct {
+	names       []string
+	namesErr    error
+	supported   []string
+	providerErr error
+	provider    string
+	affinity    []netdetect.DeviceAffinity
+	affinityErr error
+	requested   []string
+}
+
+func (m *mockNetDetector) fabricDeviceNames() ([]string, error) {
+	return m.names, m.namesErr
+}
+
+func (m *mockNetDetector) providerDeviceNames(provider string) ([]string, error) {
+	m.provider = provider
+	return m.supported, m.providerErr
+}
+
+func (m *mockNetDetector) deviceAffinity(names []string) ([]netdetect.DeviceAffinity, error) {
+	m.requested = names
+	return m.affinity, m.affinityErr
+}
+
+func TestNetworkScan(t *testing.T) {
+	ib0 := netdetect.DeviceAffinity{
+		DeviceName: "ib0",
+		CPUSet:     "0x000000ff,0xffff0000,0x00ffffff",
+		NodeSet:    "0x00000001",
+	}
+	ib1 := netdetect.DeviceAffinity{
+		DeviceName: "ib1",
+		CPUSet:     "0xffffff00,0x0000ffff,0xff000000",
+		NodeSet:    "0x00000002",
+	}
+
+	for name, tt := range map[string]struct {
+		provider   string
+		detector   *mockNetDetector
+		expDevices []string
+		expResp    *pb.NetworkScanResp
+		expErr     error
+	}{
+		"no interfaces": {
+			detector:   &mockNetDetector{},
+			expDevices: []string{},
+			expResp:    &pb.NetworkScanResp{},
+		},
+		"config provider": {
+			detector: &mockNetDetector{
+				names:     []string{"eth0", "ib0", "ib1"},
+				supported: []string{"eth0", "ib0", "ib1"},
+				affinity:  []netdetect.DeviceAffinity{ib0, ib1},
+			},
+			expDevices: []string{"eth0", "ib0", "ib1"},
+			expResp: &pb.NetworkScanResp{
+				Interfaces: []*pb.FabricInterface{
+					{
+						Provider: "ofi+sockets", Device: "ib0", Numanode: 0,
+						Cpuset: ib0.CPUSet, Nodeset: ib0.NodeSet,
+					},
+					{
+						Provider: "ofi+sockets", Device: "ib1", Numanode: 1,
+						Cpuset: ib1.CPUSet, Nodeset: ib1.NodeSet,
+					},
+				},
+			},
+		},
+		"requested provider": {
+			provider: "ofi+verbs;ofi_rxm",
+			detector: &mockNetDetector{
+				names:     []string{"eth0", "ib0", "ib1"},
+				supported: []string{"ib0"},
+				affinity:  []netdetect.DeviceAffinity{ib0},
+			},
+			expDevices: []string{"ib0"},
+			expResp: &pb.NetworkScanResp{
+				Interfaces: []*pb.FabricInterface{
+					{
+						Provider: "ofi+verbs;ofi_rxm", Device: "ib0", Numanode: 0,
+						Cpuset: ib0.CPUSet, Nodeset: ib0.NodeSet,
+					},
+				},
+			},
+		},
+		"device names fail": {
+			detector: &mockNetDetector{namesErr: errors.New("no interfaces")},
+			expErr:   errors.New("no interfaces"),
+		},
+		"provider not available": {
+			detector: &mockNetDetector{
+				names: []string{"eth0", "ib0"},
+			},
+			expDevices: []string{},
+			expResp:    &pb.NetworkScanResp{},
+		},
+		"provider lookup fails": {
+			detector: &mockNetDetector{providerErr: errors.New("fi_getinfo failed")},
+			expErr:   errors.New("getting devices for provider ofi+sockets: fi_getinfo failed"),
+		},
+		"affinity fails": {
+			detector: &mockNetDetector{affinityErr: errors.New("hwloc failed")},
+			expErr:   errors.New("getting network device affinity: hwloc failed"),
+		},
+		"unknown NUMA node": {
+			detector: &mockNetDetector{
+				names:     []string{"ib0", "ib1"},
+				supported: []string{"ib0", "ib1"},
+				affinity: []netdetect.DeviceAffinity{
+					{DeviceName: "ib0", NodeSet: "0x00000000"},
+					ib1,
+				},
+			},
+			expDevices: []string{"ib0", "ib1"},
+			expResp: &pb.NetworkScanResp{
+				Interfaces: []*pb.FabricInterface{
+					{
+						Provider: "ofi+sockets", Device: "ib1", Numanode: 1,
+						Cpuset: ib1.CPUSet, Nodeset: ib1.NodeSet,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			cs := defaultMockControlService(t, log)
+			cs.fabricProvider = "ofi+sockets"
+			cs.netDetect = tt.detector
+
+			resp, err := cs.NetworkScan(context.TODO(), &pb.NetworkScanReq{Provider: tt.provider})
+			if tt.expErr != nil {
+				ExpectError(t, err, tt.expErr.Error(), name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expProvider := tt.provider
+			if expProvider == "" {
+				expProvider = cs.fabricProvider
+			}
+			AssertEqual(t, tt.detector.provider, expProvider, "unexpected provider")
+			AssertEqual(t, tt.detector.requested, tt.expDevices, "unexpected devices requested")
+			AssertEqual(t, resp, tt.expResp, "unexpected response")
+		})
+	}
+}
@@ -41,6 +41,7 @@ type StorageControlService struct {
 	scm             *scmStorage
 	drpc            drpc.DomainSocketClient
 	instanceStorage []ioserver.StorageConfig
+	runFio          fioRunner
 }
 
 // DefaultStorageControlService returns a initialized *StorageControlService
@@ -57,10 +58,15 @@ func DefaultStorageControlService(log logging.Logger, cfg *Configuration) (*Stor
 		nrHugePages: cfg.NrHugepages,
 	}
 
+	sockDir := cfg.SocketDir
+	if len(cfg.Servers) > 0 {
+		sockDir = cfg.Servers[defaultManagementInstance].SocketDir
+	}
+
 	return NewStorageControlService(log,
 		newNvmeStorage(log, cfg.NvmeShmID, spdkScript, cfg.ext),
 		newScmStorage(log, cfg.ext), cfg.Servers,
-		getDrpcClientConnection(cfg.SocketDir)), nil
+		getDrpcClientConnection(sockDir)), nil
 }
 
 // NewStorageControlService returns an initialized *StorageControlService
@@ -78,6 +84,7 @@ func NewStorageControlService(log logging.Logger, nvme *nvmeStorage, scm *scmSto
 		scm:             scm,
 		drpc:            drpc,
 		instanceStorage: instanceStorage,
+		runFio:          runFio,
 	}
 }
 
@@ -306,27 +306,80 @@ func (c *ControlService) StorageUpdate(req *pb.StorageUpdateReq, stream pb.MgmtC
 	return nil
 }
 
-// TODO: implement gRPC burn-in feature in nvme and scm subsystems
-// Burnin delegates to Storage implementation's Burnin methods to prepare
-// storage for use by DAOS data plane.
+// StorageBurnIn runs fio burn-in jobs against the NVMe controllers and SCM
+// mounts configured for each I/O server instance, to verify devices before
+// they are used by DAOS.
 //
-// Send response containing multiple results of burn-in operations on scm mounts
-// and nvme controllers.
+// Progress reported by fio is streamed as it is received and a response
+// containing the result state and parsed statistics is sent for each device
+// on completion. Device specific failures are reported within the results.
 func (c *ControlService) StorageBurnIn(req *pb.StorageBurnInReq, stream pb.MgmtCtl_StorageBurnInServer) error {
-
 	c.log.Debug("received StorageBurnIn RPC; proceeding to instance storage burnin")
 
-	return errors.New("StorageBurnIn not implemented")
-	//	for i := range c.config.Servers {
-	//		c.nvme.BurnIn(i, req.Nvme, resp)
-	//		c.scm.BurnIn(i, req.Scm, resp)
-	//	}
+	// burn-in overwrites device contents and requires exclusive access
+	if c.harness.IsStarted() {
+		return errors.New("cannot run burn-in on storage with running I/O server instances")
+	}
+
+	if req.GetNvme() == nil && req.GetScm() == nil {
+		return errors.New("no nvme or scm burn-in requested")
+	}
+
+	var nvmeConfig, scmConfig string
+	var err error
+	if req.GetNvme() != nil {
+		if nvmeConfig, err = c.resolveFioConfig(req.GetNvme().GetFioconfig()); err != nil {
+			return err
+		}
+	}
+	if req.GetScm() != nil {
+		if scmConfig, err = c.resolveFioConfig(req.GetScm().GetFioconfig()); err != nil {
+			return err
+		}
+	}
+
+	for _, stCfg := range c.instanceStorage {
+		if nvmeConfig != "" {
+			for _, pciAddr := range stCfg.Bdev.GetNvmeDevs() {
+				resp := &pb.StorageBurnInResp{}
+				state := &pb.ResponseState{}
+				// hardcode first Namespace on controller for the moment
+				result, err := c.burnInNvme(stream, pciAddr, 1, nvmeConfig)
+				if err != nil {
+					state = newState(c.log, pb.ResponseStatus_CTRL_ERR_NVME,
+						err.Error(), "", "nvme burn-in "+pciAddr)
+				} else {
+					resp.Results = append(resp.Results, result)
+				}
+				resp.Crets = append(resp.Crets,
+					&pb.NvmeControllerResult{Pciaddr: pciAddr, State: state})
+
+				if err := stream.Send(resp); err != nil {
+					return errors.WithMessagef(err, "sending response (%+v)", resp)
+				}
+			}
+		}
 
-	//	if err := stream.Send(resp); err != nil {
-	//		return errors.WithMessagef(err, "sending response (%+v)", resp)
-	//	}
+		if scmConfig != "" && stCfg.SCM.MountPoint != "" {
+			resp := &pb.StorageBurnInResp{}
+			state := &pb.ResponseState{}
+			result, err := c.burnInScm(stream, stCfg.SCM, scmConfig)
+			if err != nil {
+				state = newState(c.log, pb.ResponseStatus_CTRL_ERR_SCM,
+					err.Error(), "", "scm burn-in "+stCfg.SCM.MountPoint)
+			} else {
+				resp.Results = append(resp.Results, result)
+			}
+			resp.Mrets = append(resp.Mrets,
+				&pb.ScmMountResult{Mntpoint: stCfg.SCM.MountPoint, State: state})
+
+			if err := stream.Send(resp); err != nil {
+				return errors.WithMessagef(err, "sending response (%+v)", resp)
+			}
+		}
+	}
 
-	//	return nil
+	return nil
 }
 
 // FetchFioConfigPaths retrieves any configuration files in fio_plugin directory
@@ -351,50 +404,3 @@ func (c *ControlService) FetchFioConfigPaths(
 
 	return nil
 }
-
-// TODO: to be used during the limitation of burnin feature
-//// BurnInNvme runs burn-in validation on NVMe Namespace and returns cmd output
-//// in a stream to the gRPC consumer.
-//func (c *controlService) BurnInNvme(
-//	req *pb.BurnInNvmeReq, stream pb.MgmtCtl_BurnInNvmeServer) error {
-//	// retrieve command components
-//	cmdName, args, env, err := c.nvme.BurnIn(
-//		req.GetPciaddr(),
-//		// hardcode first Namespace on controller for the moment
-//		1,
-//		req.Path.Path)
-//	if err != nil {
-//		return err
-//	}
-//	// construct command executer and init env/reader
-//	cmd := exec.Command(cmdName, args...)
-//	cmd.Env = os.Environ()
-//	cmd.Env = append(cmd.Env, env)
-//	var stderr bytes.Buffer
-//	cmd.Stderr = &stderr
-//	cmdReader, err := cmd.StdoutPipe()
-//	if err != nil {
-//		return errors.Errorf("Error creating StdoutPipe for Cmd %v", err)
-//	}
-//	// run text scanner as goroutine
-//	scanner := bufio.NewScanner(cmdReader)
-//	go func() {
-//		for scanner.Scan() {
-//			stream.Send(&pb.BurnInNvmeReport{Report: scanner.Text()})
-//		}
-//	}()
-//	// start command and wait for finish
-//	err = cmd.Start()
-//	if err != nil {
-//		return errors.Errorf(
-//			"Error starting Cmd: %s, Args: %v, Env: %s (%v)",
-//			cmdName, args, env, err)
-//	}
-//	err = cmd.Wait()
-//	if err != nil {
-//		return errors.Errorf(
-//			"Error waiting for completion of Cmd: %s, Args: %v, Env: %s (%v, %q)",
-//			cmdName, args, env, err, stderr.String())
-//	}
-//	return nil
-//}
@@ -31,6 +31,8 @@ import (
 	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	"github.com/daos-stack/daos/src/control/drpc"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/system"
 )
 
 var jsonDBRelPath = "share/daos/control/mgmtinit_db.json"
@@ -40,11 +42,18 @@ var jsonDBRelPath = "share/daos/control/mgmtinit_db.json"
 type ControlService struct {
 	StorageControlService
 	harness           *IOServerHarness
+	membership        *system.Membership
+	sysdb             *system.Database
+	transportConfig   *security.TransportConfig
 	drpc              drpc.DomainSocketClient
 	supportedFeatures FeatureMap
+	fabricProvider    string
+	netDetect         netDetector
+	reloader          *configReloader
+	logMasks          *logMasksSetter
 }
 
-func NewControlService(l logging.Logger, h *IOServerHarness, cfg *Configuration) (*ControlService, error) {
+func NewControlService(l logging.Logger, h *IOServerHarness, cfg *Configuration, m *system.Membership, db *system.Database) (*ControlService, error) {
 	scs, err := DefaultStorageControlService(l, cfg)
 	if err != nil {
 		return nil, err
@@ -58,8 +67,13 @@ func NewControlService(l logging.Logger, h *IOServerHarness, cfg *Configuration)
 	return &ControlService{
 		StorageControlService: *scs,
 		harness:               h,
+		membership:            m,
+		sysdb:                 db,
+		transportConfig:       cfg.TransportConfig,
 		drpc:                  scs.drpc,
 		supportedFeatures:     fMap,
+		fabricProvider:        cfg.Fabric.Provider,
+		netDetect:             hwlocNetDetector{},
 	}, nil
 }
 
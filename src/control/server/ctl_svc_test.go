@@ -30,6 +30,7 @@ import (
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server/ioserver"
 	"github.com/daos-stack/daos/src/control/server/storage"
+	"github.com/daos-stack/daos/src/control/system"
 )
 
 func defaultMockControlService(t *testing.T, log logging.Logger) *ControlService {
@@ -49,7 +50,10 @@ func mockControlService(t *testing.T, log logging.Logger, cfg *Configuration) *C
 		harness: &IOServerHarness{
 			log: log,
 		},
+		membership:      system.NewMembership(log),
+		transportConfig: cfg.TransportConfig,
 	}
+	cs.sysdb = system.NewDatabase(log, cs.membership, &system.DatabaseConfig{})
 
 	for _, srvCfg := range cfg.Servers {
 		bp, err := storage.NewBdevProvider(log, "", &srvCfg.Storage.Bdev)
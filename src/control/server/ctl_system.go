@@ -0,0 +1,320 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"net"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	memberProbeTimeout = 2 * time.Second
+)
+
+// probeMemberAddr checks the control plane of a system member can be
+// reached. Replaced in tests.
+var probeMemberAddr system.ProbeFn = func(ctx context.Context, addr string) error {
+	dialer := net.Dialer{Timeout: memberProbeTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
+
+// memberToPB converts a system member to its protobuf representation.
+func memberToPB(member *system.Member) *pb.SystemMember {
+	pbMember := &pb.SystemMember{
+		Rank:  member.Rank,
+		Uuid:  member.UUID,
+		Uri:   member.URI,
+		Addr:  member.Addr,
+		State: member.State.String(),
+	}
+	if !member.LastSeen.IsZero() {
+		pbMember.LastSeen = member.LastSeen.Unix()
+	}
+	pbMember.HeartbeatLatency = int64(member.HeartbeatLatency)
+
+	return pbMember
+}
+
+// SystemQuery returns details of members of the DAOS system as recorded in
+// the system database, after checking they can be reached.
+func (c *ControlService) SystemQuery(ctx context.Context, req *pb.SystemQueryReq) (*pb.SystemQueryResp, error) {
+	mi, err := c.harness.GetManagementInstance()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkIsMSReplica(mi); err != nil {
+		return nil, err
+	}
+
+	c.log.Debugf("ControlService.SystemQuery dispatch, req:%+v\n", *req)
+
+	c.membership.Refresh(ctx, probeMemberAddr)
+	members, err := c.membership.Members(req.Ranks...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.SystemQueryResp{}
+	for _, member := range members {
+		resp.Members = append(resp.Members, memberToPB(member))
+	}
+
+	return resp, nil
+}
+
+// SystemHeartbeat records the outcome of the latest heartbeat of an I/O
+// server instance, as reported by the control plane managing it.
+func (c *ControlService) SystemHeartbeat(ctx context.Context, req *pb.SystemHeartbeatReq) (*pb.SystemHeartbeatResp, error) {
+	mi, err := c.harness.GetManagementInstance()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkIsMSReplica(mi); err != nil {
+		return nil, err
+	}
+
+	if err := c.checkMemberPeer(ctx, req.Rank); err != nil {
+		return nil, err
+	}
+
+	if !req.Responsive {
+		c.log.Errorf("rank %d (%s) missed %d heartbeats", req.Rank, req.Uuid, req.Missed)
+	}
+	err = c.membership.Heartbeat(req.Rank, req.Uuid, req.Responsive, time.Duration(req.Latency))
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+
+	return &pb.SystemHeartbeatResp{}, nil
+}
+
+// checkMemberPeer verifies that a request about the member with the given
+// rank was sent by the control plane managing it.
+func (c *ControlService) checkMemberPeer(ctx context.Context, rank uint32) error {
+	member, err := c.membership.Get(rank)
+	if err != nil {
+		return err
+	}
+	memberAddr, err := net.ResolveTCPAddr("tcp", member.Addr)
+	if err != nil {
+		return errors.Wrapf(err, "rank %d address", rank)
+	}
+
+	peerAddr, err := serverPeerAddr(ctx, c.transportConfig)
+	if err != nil {
+		return err
+	}
+	if !peerAddr.IP.Equal(memberAddr.IP) {
+		return status.Errorf(codes.PermissionDenied, "peer %s is not rank %d (%s)",
+			peerAddr.IP, rank, member.Addr)
+	}
+
+	return nil
+}
+
+// rankInstance associates a managed instance with its rank.
+type rankInstance struct {
+	rank uint32
+	srv  *IOServerInstance
+}
+
+// rankInstances returns the managed instances which have been assigned one
+// of the given ranks, or all instances with a rank if none are given.
+func (c *ControlService) rankInstances(ranks []uint32) []rankInstance {
+	var selected []rankInstance
+
+	for _, srv := range c.harness.Instances() {
+		r, err := srv.GetRank()
+		if err != nil {
+			continue
+		}
+		rank := uint32(r)
+
+		wanted := len(ranks) == 0
+		for _, want := range ranks {
+			if want == rank {
+				wanted = true
+				break
+			}
+		}
+		if wanted {
+			selected = append(selected, rankInstance{rank, srv})
+		}
+	}
+
+	return selected
+}
+
+// doRankActions performs the given action concurrently on each instance and
+// returns the results ordered by rank.
+func doRankActions(targets []rankInstance, action string, fn func(rankInstance) (string, error)) []*pb.RankResult {
+	ch := make(chan *pb.RankResult, len(targets))
+	for _, target := range targets {
+		go func(target rankInstance) {
+			result := &pb.RankResult{Rank: target.rank, Action: action}
+			msg, err := fn(target)
+			if err != nil {
+				result.Errored = true
+				msg = err.Error()
+			}
+			result.Msg = msg
+			ch <- result
+		}(target)
+	}
+
+	results := make([]*pb.RankResult, 0, len(targets))
+	for range targets {
+		results = append(results, <-ch)
+	}
+	sort.Slice(results, func(i, j int) bool { return results[i].Rank < results[j].Rank })
+
+	return results
+}
+
+// SystemStop stops managed I/O server instances with the requested ranks.
+//
+// Either only instances hosting MS replicas or only those not hosting
+// replicas are stopped so that callers can stop replicas last.
+func (c *ControlService) SystemStop(ctx context.Context, req *pb.SystemStopReq) (*pb.SystemStopResp, error) {
+	c.log.Debugf("ControlService.SystemStop dispatch, req:%+v\n", *req)
+
+	var targets []rankInstance
+	for _, target := range c.rankInstances(req.Ranks) {
+		if target.srv.IsMSReplica() == req.Replicas {
+			targets = append(targets, target)
+		}
+	}
+
+	resp := &pb.SystemStopResp{
+		Results: doRankActions(targets, "stop", func(target rankInstance) (string, error) {
+			if target.srv.IsStopped() {
+				return "already stopped", nil
+			}
+			if err := c.harness.StopInstance(ctx, target.srv, req.Force); err != nil {
+				return "", err
+			}
+			return "stopped", nil
+		}),
+	}
+
+	c.log.Debugf("ControlService.SystemStop dispatch, resp:%+v\n", *resp)
+
+	return resp, nil
+}
+
+// SystemStart starts previously stopped I/O server instances with the
+// requested ranks.
+func (c *ControlService) SystemStart(ctx context.Context, req *pb.SystemStartReq) (*pb.SystemStartResp, error) {
+	c.log.Debugf("ControlService.SystemStart dispatch, req:%+v\n", *req)
+
+	resp := &pb.SystemStartResp{
+		Results: doRankActions(c.rankInstances(req.Ranks), "start", func(target rankInstance) (string, error) {
+			if !target.srv.isStopRequested() {
+				return "already running", nil
+			}
+			if err := c.harness.StartInstance(ctx, target.srv); err != nil {
+				return "", err
+			}
+			return "started", nil
+		}),
+	}
+
+	c.log.Debugf("ControlService.SystemStart dispatch, resp:%+v\n", *resp)
+
+	return resp, nil
+}
+
+// checkReplicaPeer verifies that a system database request was sent by
+// another replica, the one named in the request if any.
+func (c *ControlService) checkReplicaPeer(ctx context.Context, replica string) error {
+	return checkAccessPointPeer(ctx, c.transportConfig, c.sysdb.Replicas(), replica)
+}
+
+// SystemDbVote responds to a vote request from a candidate system database
+// leader.
+func (c *ControlService) SystemDbVote(ctx context.Context, req *pb.SystemDbVoteReq) (*pb.SystemDbVoteResp, error) {
+	if err := c.checkReplicaPeer(ctx, req.Candidate); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sysdb.HandleVoteRequest(&system.VoteRequest{
+		Term:         req.Term,
+		Candidate:    req.Candidate,
+		LastLogIndex: req.LastLogIndex,
+		LastLogTerm:  req.LastLogTerm,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SystemDbVoteResp{Term: resp.Term, Granted: resp.Granted}, nil
+}
+
+// SystemDbAppend stores system database log entries replicated by the
+// leader.
+func (c *ControlService) SystemDbAppend(ctx context.Context, req *pb.SystemDbAppendReq) (*pb.SystemDbAppendResp, error) {
+	if err := c.checkReplicaPeer(ctx, req.Leader); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sysdb.HandleAppendRequest(appendRequestFromPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.SystemDbAppendResp{
+		Term:      resp.Term,
+		Success:   resp.Success,
+		LastIndex: resp.LastIndex,
+	}, nil
+}
+
+// SystemDbSubmit applies a system database update forwarded by a follower.
+func (c *ControlService) SystemDbSubmit(ctx context.Context, req *pb.SystemDbSubmitReq) (*pb.SystemDbSubmitResp, error) {
+	c.log.Debugf("ControlService.SystemDbSubmit dispatch, op:%s\n", req.Op)
+
+	if err := c.checkReplicaPeer(ctx, ""); err != nil {
+		return nil, err
+	}
+
+	if err := c.sysdb.HandleSubmitRequest(ctx, &system.SubmitRequest{Op: req.Op, Data: req.Data}); err != nil {
+		return nil, err
+	}
+
+	return &pb.SystemDbSubmitResp{}, nil
+}
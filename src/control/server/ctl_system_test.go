@@ -0,0 +1,291 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	. "github.com/daos-stack/daos/src/control/common"
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/server/ioserver"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestSystemQuery(t *testing.T) {
+	lastSeen := time.Unix(1500000000, 0)
+	members := []*system.Member{
+		{Rank: 0, UUID: "uuid0", URI: "uri0", Addr: "up", State: system.MemberStateJoined, LastSeen: lastSeen},
+		{Rank: 1, UUID: "uuid1", URI: "uri1", Addr: "down", State: system.MemberStateJoined, LastSeen: lastSeen,
+			HeartbeatLatency: time.Millisecond},
+		{Rank: 2, UUID: "uuid2", URI: "uri2", Addr: "down", State: system.MemberStateExcluded},
+	}
+
+	for name, tt := range map[string]struct {
+		notReplica bool
+		ranks      []uint32
+		expMembers []*pb.SystemMember
+		expErr     error
+	}{
+		"not access point": {
+			notReplica: true,
+			expErr:     status.Error(codes.FailedPrecondition, "instance is not an access point"),
+		},
+		"all members": {
+			expMembers: []*pb.SystemMember{
+				{Rank: 0, Uuid: "uuid0", Uri: "uri0", Addr: "up", State: "Joined"},
+				{Rank: 1, Uuid: "uuid1", Uri: "uri1", Addr: "down", State: "Missing", LastSeen: lastSeen.Unix(),
+					HeartbeatLatency: int64(time.Millisecond)},
+				{Rank: 2, Uuid: "uuid2", Uri: "uri2", Addr: "down", State: "Excluded"},
+			},
+		},
+		"single rank": {
+			ranks: []uint32{1},
+			expMembers: []*pb.SystemMember{
+				{Rank: 1, Uuid: "uuid1", Uri: "uri1", Addr: "down", State: "Missing", LastSeen: lastSeen.Unix(),
+					HeartbeatLatency: int64(time.Millisecond)},
+			},
+		},
+		"unknown rank": {
+			ranks:  []uint32{3},
+			expErr: errors.New("rank 3 not a system member"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			origProbe := probeMemberAddr
+			defer func() { probeMemberAddr = origProbe }()
+			probeMemberAddr = func(ctx context.Context, addr string) error {
+				if addr == "down" {
+					return errors.New("unreachable")
+				}
+				return nil
+			}
+
+			cs := defaultMockControlService(t, log)
+			mi, err := cs.harness.GetManagementInstance()
+			if err != nil {
+				t.Fatal(err)
+			}
+			mi.setSuperblock(&Superblock{MS: !tt.notReplica})
+			mi.msClient = newMgmtSvcClient(context.TODO(), log, mgmtSvcClientCfg{
+				AccessPoints: []string{"localhost"},
+			})
+			for _, m := range members {
+				copied := *m
+				cs.membership.Join(&copied)
+			}
+
+			resp, err := cs.SystemQuery(context.TODO(), &pb.SystemQueryReq{Ranks: tt.ranks})
+			if tt.expErr != nil {
+				ExpectError(t, err, tt.expErr.Error(), name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// last seen time of reachable members is updated on query
+			for _, m := range resp.Members {
+				if m.State == "Joined" {
+					AssertTrue(t, m.LastSeen > lastSeen.Unix(), "last seen not updated")
+					m.LastSeen = 0
+				}
+			}
+			AssertEqual(t, resp.Members, tt.expMembers, "unexpected members")
+		})
+	}
+}
+
+func TestSystemHeartbeat(t *testing.T) {
+	const memberAddr = "10.0.0.2:10001"
+
+	for name, tt := range map[string]struct {
+		notReplica bool
+		peer       string
+		req        *pb.SystemHeartbeatReq
+		expState   system.MemberState
+		expLatency time.Duration
+		expErr     error
+	}{
+		"not access point": {
+			notReplica: true,
+			req:        &pb.SystemHeartbeatReq{Rank: 0, Uuid: "uuid0", Responsive: true},
+			expErr:     status.Error(codes.FailedPrecondition, "instance is not an access point"),
+		},
+		"responsive": {
+			req:        &pb.SystemHeartbeatReq{Rank: 0, Uuid: "uuid0", Responsive: true, Latency: int64(time.Millisecond)},
+			expState:   system.MemberStateJoined,
+			expLatency: time.Millisecond,
+		},
+		"unresponsive": {
+			req:      &pb.SystemHeartbeatReq{Rank: 0, Uuid: "uuid0", Missed: 3},
+			expState: system.MemberStateUnresponsive,
+		},
+		"unknown rank": {
+			req:    &pb.SystemHeartbeatReq{Rank: 1, Uuid: "uuid0", Responsive: true},
+			expErr: errors.New("rank 1 not a system member"),
+		},
+		"uuid mismatch": {
+			req:    &pb.SystemHeartbeatReq{Rank: 0, Uuid: "uuid1", Missed: 3},
+			expErr: status.Error(codes.PermissionDenied, "rank 0 is not uuid1"),
+		},
+		"sent by other server": {
+			peer:   "10.0.0.3:10001",
+			req:    &pb.SystemHeartbeatReq{Rank: 0, Uuid: "uuid0", Missed: 3},
+			expErr: status.Error(codes.PermissionDenied, "peer 10.0.0.3 is not rank 0 (10.0.0.2:10001)"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			cs := defaultMockControlService(t, log)
+			cs.transportConfig = &security.TransportConfig{AllowInsecure: true}
+			mi, err := cs.harness.GetManagementInstance()
+			if err != nil {
+				t.Fatal(err)
+			}
+			mi.setSuperblock(&Superblock{MS: !tt.notReplica})
+			mi.msClient = newMgmtSvcClient(context.TODO(), log, mgmtSvcClientCfg{
+				AccessPoints: []string{"localhost"},
+			})
+			cs.membership.Join(&system.Member{
+				Rank: 0, UUID: "uuid0", Addr: memberAddr, State: system.MemberStateJoined,
+			})
+
+			if tt.peer == "" {
+				tt.peer = memberAddr
+			}
+			ctx := joinPeerContext(t, context.TODO(), tt.peer)
+
+			_, err = cs.SystemHeartbeat(ctx, tt.req)
+			if tt.expErr != nil {
+				ExpectError(t, err, tt.expErr.Error(), name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			member, err := cs.membership.Get(tt.req.Rank)
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEqual(t, member.State, tt.expState, "unexpected member state")
+			AssertEqual(t, member.HeartbeatLatency, tt.expLatency, "unexpected heartbeat latency")
+		})
+	}
+}
+
+func TestSystemStopStart(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	cs := defaultMockControlService(t, log)
+	r := ioserver.NewRunner(log, ioserver.NewConfig())
+	if err := cs.harness.AddInstance(NewIOServerInstance(cs.harness.ext, log, nil, nil, r)); err != nil {
+		t.Fatal(err)
+	}
+	instances := cs.harness.Instances()
+	instances[0].setSuperblock(&Superblock{Rank: ioserver.NewRankPtr(0), MS: true})
+	instances[1].setSuperblock(&Superblock{Rank: ioserver.NewRankPtr(1)})
+
+	// harness not yet started
+	stopResp, err := cs.SystemStop(context.TODO(), &pb.SystemStopReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEqual(t, stopResp.Results, []*pb.RankResult{
+		{Rank: 1, Action: "stop", Errored: true, Msg: "can't stop instance: harness not started"},
+	}, "unexpected results stopping unstarted harness")
+
+	cs.harness.started = true
+
+	// non-replicas are expected to be stopped before replicas
+	for _, tt := range []struct {
+		name       string
+		req        *pb.SystemStopReq
+		expResults []*pb.RankResult
+	}{
+		{
+			"stop non-replicas",
+			&pb.SystemStopReq{},
+			[]*pb.RankResult{{Rank: 1, Action: "stop", Msg: "stopped"}},
+		},
+		{
+			"stop non-replicas again",
+			&pb.SystemStopReq{Ranks: []uint32{0, 1}},
+			[]*pb.RankResult{{Rank: 1, Action: "stop", Msg: "already stopped"}},
+		},
+		{
+			"stop replicas",
+			&pb.SystemStopReq{Replicas: true, Force: true},
+			[]*pb.RankResult{{Rank: 0, Action: "stop", Msg: "stopped"}},
+		},
+	} {
+		stopResp, err := cs.SystemStop(context.TODO(), tt.req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEqual(t, stopResp.Results, tt.expResults, tt.name)
+	}
+
+	for _, srv := range instances {
+		AssertTrue(t, srv.IsStopped(), "expected instance to be stopped")
+
+		// stand in for the harness monitor servicing start requests
+		go func(srv *IOServerInstance) {
+			result := <-srv.startRequest
+			srv.setStopRequested(false)
+			result <- nil
+		}(srv)
+	}
+
+	startResp, err := cs.SystemStart(context.TODO(), &pb.SystemStartReq{Ranks: []uint32{1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEqual(t, startResp.Results, []*pb.RankResult{
+		{Rank: 1, Action: "start", Msg: "started"},
+	}, "unexpected results starting rank 1")
+
+	startResp, err = cs.SystemStart(context.TODO(), &pb.SystemStartReq{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEqual(t, startResp.Results, []*pb.RankResult{
+		{Rank: 0, Action: "start", Msg: "started"},
+		{Rank: 1, Action: "start", Msg: "already running"},
+	}, "unexpected results starting all ranks")
+}
@@ -24,8 +24,11 @@
 package server
 
 import (
+	"net"
 	"os"
 	"path/filepath"
+	"syscall"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
@@ -67,6 +70,50 @@ func checkSocketDir(sockDir string) error {
 	return nil
 }
 
+// instanceSocketDirSetup ensures that the socket directory assigned to an
+// I/O server instance exists. Per-instance directories are created within
+// the top-level socket directory, which must already exist.
+func instanceSocketDirSetup(topDir, instanceDir string) error {
+	if err := checkSocketDir(topDir); err != nil {
+		return err
+	}
+	if instanceDir == topDir {
+		return nil
+	}
+
+	if err := os.MkdirAll(instanceDir, 0755); err != nil {
+		return errors.Wrapf(err, "unable to create instance socket directory %s",
+			instanceDir)
+	}
+
+	return checkSocketDir(instanceDir)
+}
+
+// CheckServerStopped returns an error unless it can be established that no
+// daos_server is listening on the dRPC socket in sockDir.
+func CheckServerStopped(sockDir string) error {
+	sockPath := filepath.Join(sockDir, sockFileName)
+
+	conn, err := net.DialTimeout("unix", sockPath, time.Second)
+	if err == nil {
+		conn.Close()
+		return errors.Errorf("daos_server is running (listening on %s)", sockPath)
+	}
+
+	// A missing socket, or one left behind by a server which has exited,
+	// means no server is running.
+	if opErr, ok := err.(*net.OpError); ok {
+		if sysErr, ok := opErr.Err.(*os.SyscallError); ok {
+			switch sysErr.Err {
+			case syscall.ENOENT, syscall.ECONNREFUSED:
+				return nil
+			}
+		}
+	}
+
+	return errors.Wrapf(err, "unable to check for a running daos_server on %s", sockPath)
+}
+
 // drpcSetup checks socket directory exists, specifies socket path and starts drpc server.
 func drpcSetup(sockDir string, iosrv *IOServerInstance, tc *security.TransportConfig) error {
 	if err := checkSocketDir(sockDir); err != nil {
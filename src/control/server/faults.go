@@ -0,0 +1,81 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
+)
+
+// FaultJoinRankChanged indicates that the instance attempted to rejoin the
+// system with a rank other than the one it was assigned.
+func FaultJoinRankChanged(rank uint32) *fault.Fault {
+	return systemFault(code.SystemJoinRankChanged,
+		fmt.Sprintf("instance was previously assigned a rank other than %d", rank),
+		"remove the rank from the server config or correct it with 'daos_server superblock repair'")
+}
+
+// FaultJoinRankTaken indicates that the rank requested by the instance is
+// assigned to another system member.
+func FaultJoinRankTaken(rank uint32) *fault.Fault {
+	return systemFault(code.SystemJoinRankTaken,
+		fmt.Sprintf("rank %d is already assigned to another system member", rank),
+		"assign a unique rank to each I/O server in the server config, or remove the rank to have one allocated")
+}
+
+// FaultJoinExcluded indicates that the instance has been excluded from the
+// system.
+func FaultJoinExcluded(rank uint32) *fault.Fault {
+	return systemFault(code.SystemJoinExcluded,
+		fmt.Sprintf("rank %d is excluded from the system", rank),
+		fault.ResolutionNone)
+}
+
+// FaultJoinRejected indicates that the management service rejected the join
+// request with a status which won't change if it is retried.
+func FaultJoinRejected(status int32) *fault.Fault {
+	return systemFault(code.SystemJoinRejected,
+		fmt.Sprintf("join request rejected by the management service (status %d)", status),
+		fault.ResolutionUnknown)
+}
+
+// FaultJoinTimeout indicates that the instance couldn't join the system
+// before the join deadline.
+func FaultJoinTimeout(timeout time.Duration, lastErr error) *fault.Fault {
+	return systemFault(code.SystemJoinTimeout,
+		fmt.Sprintf("unable to join the system within %s: %v", timeout, lastErr),
+		"check that the access points in the server config are reachable and running, or increase join_timeout")
+}
+
+func systemFault(c code.Code, desc, res string) *fault.Fault {
+	return &fault.Fault{
+		Domain:      "system",
+		Code:        c,
+		Description: desc,
+		Resolution:  res,
+	}
+}
@@ -0,0 +1,230 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+// DeviceCheckPolicy determines how an instance responds at start to storage
+// devices which don't match those recorded in its superblock.
+type DeviceCheckPolicy string
+
+const (
+	// DeviceCheckStrict refuses to start the instance.
+	DeviceCheckStrict DeviceCheckPolicy = "strict"
+	// DeviceCheckWarn logs the mismatch and starts the instance.
+	DeviceCheckWarn DeviceCheckPolicy = "warn"
+	// DeviceCheckOff skips the check.
+	DeviceCheckOff DeviceCheckPolicy = "off"
+)
+
+// Validate checks the device check policy is known.
+func (p DeviceCheckPolicy) Validate() error {
+	switch p {
+	case DeviceCheckStrict, DeviceCheckWarn, DeviceCheckOff:
+		return nil
+	}
+	return errors.Errorf("device_check: unknown policy %q (expected %s, %s or %s)",
+		p, DeviceCheckStrict, DeviceCheckWarn, DeviceCheckOff)
+}
+
+// NvmeIdentity identifies an NVMe SSD controller.
+type NvmeIdentity struct {
+	PciAddr string `yaml:"pci_addr"`
+	Serial  string `yaml:"serial"`
+}
+
+// DeviceFingerprint records the identities of the storage devices assigned
+// to an instance.
+type DeviceFingerprint struct {
+	Nvme     []NvmeIdentity `yaml:"nvme,omitempty"`
+	PmemUUID string         `yaml:"pmem_uuid,omitempty"`
+}
+
+// mismatches returns descriptions of the differences between the recorded
+// fingerprint and the one found by a fresh scan.
+func (fp *DeviceFingerprint) mismatches(found *DeviceFingerprint) []string {
+	var diffs []string
+
+	foundSerials := make(map[string]string)
+	for _, id := range found.Nvme {
+		foundSerials[id.PciAddr] = id.Serial
+	}
+	for _, id := range fp.Nvme {
+		serial, ok := foundSerials[id.PciAddr]
+		switch {
+		case !ok || serial == "":
+			diffs = append(diffs, fmt.Sprintf("nvme %s: serial %s not found", id.PciAddr, id.Serial))
+		case serial != id.Serial:
+			diffs = append(diffs, fmt.Sprintf("nvme %s: serial %s, found %s", id.PciAddr, id.Serial, serial))
+		}
+	}
+
+	if fp.PmemUUID != found.PmemUUID {
+		foundUUID := found.PmemUUID
+		if foundUUID == "" {
+			foundUUID = "none"
+		}
+		diffs = append(diffs, fmt.Sprintf("pmem namespace %s, found %s", fp.PmemUUID, foundUUID))
+	}
+
+	return diffs
+}
+
+// deviceScanner looks up the identities of locally attached storage devices.
+type deviceScanner interface {
+	// nvmeSerials returns the serial numbers of the NVMe controllers
+	// present, keyed by PCI address.
+	nvmeSerials() (map[string]string, error)
+	// pmemUUID returns the UUID of the PMem namespace backing the given
+	// block device, or an empty string if it isn't present.
+	pmemUUID(blockdev string) (string, error)
+}
+
+// nvmeSerials implements deviceScanner using a fresh probe of NVMe
+// controllers.
+func (c *StorageControlService) nvmeSerials() (map[string]string, error) {
+	ctrlrs, err := c.nvme.scanControllers()
+	if err != nil {
+		return nil, err
+	}
+	serials := make(map[string]string, len(ctrlrs))
+	for _, ctrlr := range ctrlrs {
+		serials[ctrlr.Pciaddr] = ctrlr.Serial
+	}
+	return serials, nil
+}
+
+// pmemUUID implements deviceScanner using a fresh listing of PMem namespaces.
+func (c *StorageControlService) pmemUUID(blockdev string) (string, error) {
+	pmems, err := c.scm.prep.GetNamespaces()
+	if err != nil {
+		return "", err
+	}
+	for _, pmem := range pmems {
+		if pmem.Blockdev == filepath.Base(blockdev) {
+			return pmem.UUID, nil
+		}
+	}
+	return "", nil
+}
+
+// setDeviceCheck sets the scanner used to fingerprint the instance's storage
+// devices and how mismatches are handled at start.
+func (srv *IOServerInstance) setDeviceCheck(ds deviceScanner, policy DeviceCheckPolicy) {
+	srv.Lock()
+	defer srv.Unlock()
+	srv.devices = ds
+	srv.deviceCheck = policy
+}
+
+func (srv *IOServerInstance) getDeviceCheck() (deviceScanner, DeviceCheckPolicy) {
+	srv.RLock()
+	defer srv.RUnlock()
+	return srv.devices, srv.deviceCheck
+}
+
+// scanDevices returns the fingerprint of the storage devices currently
+// assigned to the instance, or nil if there is no scanner or no devices
+// which can be identified.
+func (srv *IOServerInstance) scanDevices() (*DeviceFingerprint, error) {
+	ds, _ := srv.getDeviceCheck()
+	if ds == nil {
+		return nil, nil
+	}
+
+	scmCfg, err := srv.scmConfig()
+	if err != nil {
+		return nil, err
+	}
+	bdevCfg, err := srv.bdevConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	fp := &DeviceFingerprint{}
+	if (bdevCfg.Class == storage.BdevClassNone || bdevCfg.Class == storage.BdevClassNvme) &&
+		len(bdevCfg.DeviceList) > 0 {
+		// a single probe covers all of the instance's SSDs
+		serials, err := ds.nvmeSerials()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to scan NVMe controllers")
+		}
+		for _, addr := range bdevCfg.DeviceList {
+			fp.Nvme = append(fp.Nvme, NvmeIdentity{PciAddr: addr, Serial: serials[addr]})
+		}
+	}
+	if scmCfg.Class == storage.ScmClassDCPM && len(scmCfg.DeviceList) > 0 {
+		if fp.PmemUUID, err = ds.pmemUUID(scmCfg.DeviceList[0]); err != nil {
+			return nil, errors.Wrap(err, "failed to scan PMem namespaces")
+		}
+	}
+
+	if len(fp.Nvme) == 0 && fp.PmemUUID == "" {
+		return nil, nil
+	}
+	return fp, nil
+}
+
+// checkDevices compares the storage devices recorded in the instance
+// superblock against a fresh scan, applying the device check policy to any
+// mismatch.
+func (srv *IOServerInstance) checkDevices() error {
+	ds, policy := srv.getDeviceCheck()
+	sb := srv.getSuperblock()
+	if ds == nil || policy == DeviceCheckOff || sb == nil || sb.Devices == nil {
+		return nil
+	}
+
+	var diffs []string
+	found, err := srv.scanDevices()
+	switch {
+	case err != nil:
+		diffs = []string{err.Error()}
+	case found == nil:
+		diffs = sb.Devices.mismatches(&DeviceFingerprint{})
+	default:
+		diffs = sb.Devices.mismatches(found)
+	}
+	if len(diffs) == 0 {
+		return nil
+	}
+
+	if policy == DeviceCheckWarn {
+		for _, diff := range diffs {
+			srv.log.Errorf("instance %d: storage device mismatch: %s", srv.Index, diff)
+		}
+		return nil
+	}
+
+	return errors.Errorf("storage devices don't match those recorded in superblock: %s",
+		strings.Join(diffs, "; "))
+}
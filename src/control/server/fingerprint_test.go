@@ -0,0 +1,229 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	. "github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/spdk"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/ioserver"
+)
+
+type mockDeviceScanner struct {
+	serials   map[string]string
+	pmems     map[string]string
+	nvmeErr   error
+	nvmeScans int
+}
+
+func (m *mockDeviceScanner) nvmeSerials() (map[string]string, error) {
+	m.nvmeScans++
+	if m.nvmeErr != nil {
+		return nil, m.nvmeErr
+	}
+	return m.serials, nil
+}
+
+func (m *mockDeviceScanner) pmemUUID(blockdev string) (string, error) {
+	return m.pmems[blockdev], nil
+}
+
+func newMockDeviceScanner() *mockDeviceScanner {
+	return &mockDeviceScanner{
+		serials: map[string]string{
+			"0000:81:00.0": "SN-A",
+			"0000:82:00.0": "SN-B",
+		},
+		pmems: map[string]string{
+			"/dev/pmem0": "b7a3c1d2-5e6f-4a8b-9c0d-1e2f3a4b5c6d",
+		},
+	}
+}
+
+func newFingerprintTestInstance(log logging.Logger) *IOServerInstance {
+	cfg := ioserver.NewConfig().
+		WithScmClass("dcpm").
+		WithScmDeviceList("/dev/pmem0").
+		WithBdevClass("nvme").
+		WithBdevDeviceList("0000:81:00.0", "0000:82:00.0")
+
+	return NewIOServerInstance(&mockExt{}, log, nil, nil, ioserver.NewRunner(log, cfg))
+}
+
+func TestInstanceScanDevices(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	srv := newFingerprintTestInstance(log)
+
+	fp, err := srv.scanDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fp != nil {
+		t.Fatalf("expected no fingerprint without a scanner, got %+v", fp)
+	}
+
+	ds := newMockDeviceScanner()
+	srv.setDeviceCheck(ds, DeviceCheckStrict)
+	fp, err = srv.scanDevices()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEqual(t, ds.nvmeScans, 1, "NVMe scans")
+
+	expFP := &DeviceFingerprint{
+		Nvme: []NvmeIdentity{
+			{PciAddr: "0000:81:00.0", Serial: "SN-A"},
+			{PciAddr: "0000:82:00.0", Serial: "SN-B"},
+		},
+		PmemUUID: "b7a3c1d2-5e6f-4a8b-9c0d-1e2f3a4b5c6d",
+	}
+	if diff := cmp.Diff(expFP, fp); diff != "" {
+		t.Fatalf("unexpected fingerprint (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestInstanceCheckDevices(t *testing.T) {
+	for name, tc := range map[string]struct {
+		policy   DeviceCheckPolicy
+		scanner  func(*mockDeviceScanner)
+		noRecord bool
+		expErrIn string
+		expLogIn string
+	}{
+		"match": {
+			policy: DeviceCheckStrict,
+		},
+		"nothing recorded": {
+			policy:   DeviceCheckStrict,
+			scanner:  func(m *mockDeviceScanner) { m.serials["0000:81:00.0"] = "SN-C" },
+			noRecord: true,
+		},
+		"swapped ssd": {
+			policy:   DeviceCheckStrict,
+			scanner:  func(m *mockDeviceScanner) { m.serials["0000:81:00.0"] = "SN-C" },
+			expErrIn: "nvme 0000:81:00.0: serial SN-A, found SN-C",
+		},
+		"moved ssd": {
+			policy: DeviceCheckStrict,
+			scanner: func(m *mockDeviceScanner) {
+				m.serials["0000:83:00.0"] = m.serials["0000:82:00.0"]
+				delete(m.serials, "0000:82:00.0")
+			},
+			expErrIn: "nvme 0000:82:00.0: serial SN-B not found",
+		},
+		"replaced pmem namespace": {
+			policy:   DeviceCheckStrict,
+			scanner:  func(m *mockDeviceScanner) { m.pmems["/dev/pmem0"] = "c0ffee00-0000-4000-8000-000000000000" },
+			expErrIn: "pmem namespace b7a3c1d2-5e6f-4a8b-9c0d-1e2f3a4b5c6d, found c0ffee00",
+		},
+		"scan failure": {
+			policy:   DeviceCheckStrict,
+			scanner:  func(m *mockDeviceScanner) { m.nvmeErr = errors.New("spdk failed") },
+			expErrIn: "spdk failed",
+		},
+		"swapped ssd (warn)": {
+			policy:   DeviceCheckWarn,
+			scanner:  func(m *mockDeviceScanner) { m.serials["0000:81:00.0"] = "SN-C" },
+			expLogIn: "storage device mismatch: nvme 0000:81:00.0: serial SN-A, found SN-C",
+		},
+		"swapped ssd (off)": {
+			policy:  DeviceCheckOff,
+			scanner: func(m *mockDeviceScanner) { m.serials["0000:81:00.0"] = "SN-C" },
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			ds := newMockDeviceScanner()
+			srv := newFingerprintTestInstance(log)
+			srv.setDeviceCheck(ds, tc.policy)
+
+			sb, err := srv.newSuperblock(&mgmtInfo{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.noRecord {
+				sb.Devices = nil
+			}
+			srv.setSuperblock(sb)
+
+			if tc.scanner != nil {
+				tc.scanner(ds)
+			}
+
+			err = srv.checkDevices()
+			if tc.expErrIn != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expErrIn) {
+					t.Fatalf("expected error containing %q, got %v", tc.expErrIn, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertTrue(t, strings.Contains(buf.String(), tc.expLogIn), "expected log output")
+		})
+	}
+}
+
+func TestStorageControlServiceNvmeSerials(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	const pciAddr = "0000:81:00.0"
+	spdkNvme := &mockSpdkNvme{
+		log:        log,
+		initCtrlrs: []spdk.Controller{NewMockController(pciAddr, "1.0.0", "model", "SN-A", 0)},
+	}
+	c := &StorageControlService{
+		log:  log,
+		nvme: newMockNvmeStorage(log, &mockExt{}, defaultMockSpdkEnv(), spdkNvme, false),
+	}
+	if err := c.nvme.Discover(); err != nil {
+		t.Fatal(err)
+	}
+
+	// ssd swapped after the startup scan
+	spdkNvme.initCtrlrs = []spdk.Controller{NewMockController(pciAddr, "1.0.0", "model", "SN-C", 0)}
+
+	serials, err := c.nvmeSerials()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEqual(t, serials, map[string]string{pciAddr: "SN-C"}, "serials")
+
+	spdkNvme.discoverRet = errors.New("spdk failed")
+	if _, err := c.nvmeSerials(); err == nil || !strings.Contains(err.Error(), "spdk failed") {
+		t.Fatalf("expected scan error, got %v", err)
+	}
+}
@@ -25,9 +25,10 @@ package server
 
 import (
 	"context"
-	"os"
-	"strconv"
+	"fmt"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -47,6 +48,7 @@ type IOServerHarness struct {
 	ext       External
 	instances []*IOServerInstance
 	started   bool
+	restart   RestartPolicy
 }
 
 // NewHarness returns an initialized *IOServerHarness
@@ -55,9 +57,16 @@ func NewIOServerHarness(ext External, log logging.Logger) *IOServerHarness {
 		ext:       ext,
 		log:       log,
 		instances: make([]*IOServerInstance, 0, 2),
+		restart:   DefaultRestartPolicy(),
 	}
 }
 
+// WithRestartPolicy sets the policy used to restart failed instances.
+func (h *IOServerHarness) WithRestartPolicy(policy RestartPolicy) *IOServerHarness {
+	h.restart = policy
+	return h
+}
+
 func (h *IOServerHarness) IsStarted() bool {
 	h.RLock()
 	defer h.RUnlock()
@@ -86,68 +95,117 @@ func (h *IOServerHarness) AddInstance(srv *IOServerInstance) error {
 	return nil
 }
 
-// GetManagementInstance returns a managed IO Server instance
-// to be used as a management target.
-func (h *IOServerHarness) GetManagementInstance() (*IOServerInstance, error) {
+// GetInstance returns the managed IO Server instance at the given index.
+func (h *IOServerHarness) GetInstance(idx int) (*IOServerInstance, error) {
 	h.RLock()
 	defer h.RUnlock()
 
-	if len(h.instances) == 0 {
+	if idx < 0 || idx >= len(h.instances) {
+		return nil, errors.Errorf("no instance index %d", idx)
+	}
+
+	return h.instances[idx], nil
+}
+
+// GetInstanceByRank returns the managed IO Server instance which has been
+// assigned the given rank.
+func (h *IOServerHarness) GetInstanceByRank(rank ioserver.Rank) (*IOServerInstance, error) {
+	for _, instance := range h.Instances() {
+		if r, err := instance.GetRank(); err == nil && r == rank {
+			return instance, nil
+		}
+	}
+
+	return nil, errors.Errorf("no instance with rank %s", rank)
+}
+
+// GetManagementInstance returns a managed IO Server instance
+// to be used as a management target.
+//
+// The instance hosting a Management Service replica is preferred,
+// otherwise the instance at the default index is returned.
+func (h *IOServerHarness) GetManagementInstance() (*IOServerInstance, error) {
+	instances := h.Instances()
+	if len(instances) == 0 {
 		return nil, errors.New("harness has no managed instances")
 	}
 
-	if defaultManagementInstance > len(h.instances) {
-		return nil, errors.Errorf("no instance index %d", defaultManagementInstance)
+	for _, instance := range instances {
+		if instance.IsMSReplica() {
+			return instance, nil
+		}
 	}
 
-	// Just pick one for now.
-	return h.instances[defaultManagementInstance], nil
+	return h.GetInstance(defaultManagementInstance)
 }
 
-// CreateSuperblocks creates instance superblocks as needed.
-func (h *IOServerHarness) CreateSuperblocks(recreate bool) error {
+// CreateSuperblocks creates instance superblocks as needed. Superblocks
+// which exist but can't be read are recreated if the policy allows it.
+func (h *IOServerHarness) CreateSuperblocks(recreate SuperblockRecreation) error {
 	if h.IsStarted() {
 		return errors.Errorf("Can't create superblocks with running instances")
 	}
 
 	instances := h.Instances()
 	toCreate := make([]*IOServerInstance, 0, len(instances))
+	toRecreate := make([]*IOServerInstance, 0, len(instances))
 
 	for _, instance := range instances {
 		needsSuperblock, err := instance.NeedsSuperblock()
-		if !needsSuperblock {
+		if err != nil {
+			if !needsSuperblock || !recreate.Enabled {
+				return err
+			}
+			h.log.Errorf("instance %d: %s", instance.Index, err)
+			toRecreate = append(toRecreate, instance)
 			continue
 		}
-		if err != nil && !recreate {
+		if needsSuperblock {
+			toCreate = append(toCreate, instance)
+		}
+	}
+
+	for _, instance := range toCreate {
+		mInfo, err := h.superblockMgmtInfo(instance)
+		if err != nil {
+			return err
+		}
+		if err := instance.CreateSuperblock(mInfo); err != nil {
 			return err
 		}
-		toCreate = append(toCreate, instance)
 	}
 
-	if len(toCreate) == 0 {
+	if len(toRecreate) == 0 {
 		return nil
 	}
 
-	for _, instance := range toCreate {
-		// Only the first I/O server can be an MS replica.
-		if instance.Index == 0 {
-			mInfo, err := getMgmtInfo(instance)
-			if err != nil {
-				return err
-			}
-			if err := instance.CreateSuperblock(mInfo); err != nil {
-				return err
-			}
-		} else {
-			if err := instance.CreateSuperblock(&mgmtInfo{}); err != nil {
-				return err
-			}
+	report := make([]string, 0, len(toRecreate))
+	for _, instance := range toRecreate {
+		mInfo, err := h.superblockMgmtInfo(instance)
+		if err != nil {
+			return err
 		}
+		desc, err := instance.RecreateSuperblock(mInfo, recreate)
+		if err != nil {
+			return errors.Wrapf(err, "instance %d", instance.Index)
+		}
+		report = append(report, fmt.Sprintf("  instance %d: %s", instance.Index, desc))
 	}
+	h.log.Infof("recreated superblocks:\n%s", strings.Join(report, "\n"))
 
 	return nil
 }
 
+// superblockMgmtInfo returns the management service details to record in a
+// new superblock for the given instance.
+func (h *IOServerHarness) superblockMgmtInfo(instance *IOServerInstance) (*mgmtInfo, error) {
+	// Only the first I/O server can be an MS replica.
+	if instance.Index != 0 {
+		return &mgmtInfo{}, nil
+	}
+	return getMgmtInfo(instance)
+}
+
 // AwaitStorageReady blocks until all managed IOServer instances
 // have storage available and ready to be used.
 func (h *IOServerHarness) AwaitStorageReady(ctx context.Context) error {
@@ -165,24 +223,35 @@ func (h *IOServerHarness) AwaitStorageReady(ctx context.Context) error {
 			return errors.Wrap(err, "failed to check storage formatting")
 		}
 
+		reason := "SCM format required"
 		if !needsScmFormat {
 			h.log.Debug("no SCM format required; checking for superblock")
 			needsSuperblock, err := instance.NeedsSuperblock()
 			if err != nil {
+				// An unreadable superblock doesn't need storage
+				// to be formatted, CreateSuperblocks recreates
+				// it if the recreation policy allows.
+				if needsSuperblock {
+					h.log.Debugf("instance %d: %s", instance.Index, err)
+					continue
+				}
 				return errors.Wrap(err, "failed to check instance superblock")
 			}
 			if !needsSuperblock {
 				continue
 			}
+			reason = "superblock missing"
 		}
-		h.log.Debug("SCM format required")
+		h.log.Debug(reason)
+		instance.setState(InstanceStateUnformatted, reason)
 		instance.AwaitStorageReady(ctx)
 	}
 	return ctx.Err()
 }
 
 // Start starts all configured instances and the management
-// service, then waits for them to exit.
+// service, then monitors them and restarts any which exit according
+// to the harness restart policy.
 func (h *IOServerHarness) Start(parent context.Context) error {
 	if h.IsStarted() {
 		return errors.New("can't start: harness already started")
@@ -190,31 +259,40 @@ func (h *IOServerHarness) Start(parent context.Context) error {
 
 	instances := h.Instances()
 	ctx, shutdown := context.WithCancel(parent)
-	defer shutdown()
-	errChan := make(chan error, len(instances))
+	// Instance processes don't inherit the harness context so that they
+	// can be shut down in an orderly fashion once it is done, rather than
+	// being killed as soon as it is cancelled.
+	runCtx, kill := context.WithCancel(context.Background())
+	defer func() {
+		shutdown()
+		h.stopInstances()
+		kill()
+	}()
+	exitChans := make([]chan error, len(instances))
 	// start 'em up
-	for _, instance := range instances {
-		if err := instance.Start(ctx, errChan); err != nil {
+	for i, instance := range instances {
+		exitChans[i] = make(chan error, 1)
+		if err := instance.Start(runCtx, exitChans[i]); err != nil {
 			return err
 		}
 	}
 
 	// ... wait until they say they've started
-	for _, instance := range instances {
+	for i, instance := range instances {
 		select {
 		case <-parent.Done():
 			return parent.Err()
-		case err := <-errChan:
+		case err := <-exitChans[i]:
 			if err != nil {
 				return err
 			}
 		case ready := <-instance.AwaitReady():
-			if pmixless() {
-				h.log.Debug("PMIx-less mode detected")
+			if !instance.pmixMode() {
 				if err := instance.SetRank(ctx, ready); err != nil {
 					return err
 				}
 			}
+			instance.startHeartbeat(ctx)
 		}
 	}
 
@@ -227,59 +305,199 @@ func (h *IOServerHarness) Start(parent context.Context) error {
 	h.Unlock()
 
 	// now monitor them
+	errChan := make(chan error, len(instances))
+	for i, instance := range instances {
+		go h.monitorInstance(ctx, runCtx, instance, exitChans[i], errChan)
+	}
+
+	select {
+	case <-parent.Done():
+		return nil
+	case err := <-errChan:
+		// An instance which can't be restarted shuts them all down.
+		return errors.Wrap(err, "Instance error")
+	}
+}
+
+// monitorInstance waits for the given instance to exit and restarts it
+// according to the harness restart policy. If the policy does not permit
+// a restart, the reason is reported on errChan.
+//
+// Instances which have been deliberately stopped are not restarted until
+// a start request is received.
+func (h *IOServerHarness) monitorInstance(ctx, runCtx context.Context, srv *IOServerInstance, exitChan chan error, errChan chan<- error) {
+	// Restarted processes are stopped by the harness on shutdown.
+	stop := func() {}
+
 	for {
+		var exitErr error
 		select {
-		case <-parent.Done():
-			return nil
-		case err := <-errChan:
-			// If we receive an error from any instance, shut them all down.
-			// TODO: Restart failed instances rather than shutting everything
-			// down.
-			h.log.Errorf("instance error: %s", err)
+		case <-ctx.Done():
+			return
+		case exitErr = <-exitChan:
+		}
+		stop()
+
+		for exitErr != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			if srv.isStopRequested() {
+				srv.recordStop(exitErr)
+				h.log.Infof("instance %d stopped: %s", srv.Index, exitErr)
+
+				var result chan<- error
+				select {
+				case <-ctx.Done():
+					return
+				case result = <-srv.startRequest:
+				}
+				srv.setStopRequested(false)
+
+				stop, exitErr = h.restartInstance(ctx, runCtx, srv, exitChan)
+				result <- exitErr
+				continue
+			}
+
+			h.log.Errorf("instance %d error: %s", srv.Index, exitErr)
+
+			delay, err := srv.recordExit(h.restart, exitErr)
 			if err != nil {
-				return errors.Wrap(err, "Instance error")
+				errChan <- errors.Wrapf(exitErr, "instance %d not restarted (%s)", srv.Index, err)
+				return
+			}
+
+			h.log.Infof("restarting instance %d in %s", srv.Index, delay)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
 			}
+			if srv.isStopRequested() {
+				continue
+			}
+
+			stop, exitErr = h.restartInstance(ctx, runCtx, srv, exitChan)
 		}
+		h.log.Infof("instance %d started", srv.Index)
 	}
 }
 
-// StartManagementService starts the DAOS management service on this node.
-func (h *IOServerHarness) StartManagementService(ctx context.Context) error {
-	h.RLock()
-	defer h.RUnlock()
+// restartInstance starts a previously exited instance and repeats the rank
+// and management service setup performed on harness start. If setup fails,
+// the restarted process is shut down and the failure returned.
+//
+// On success, the returned function releases the restarted process's
+// context once it has exited.
+func (h *IOServerHarness) restartInstance(ctx, runCtx context.Context, srv *IOServerInstance, exitChan chan error) (context.CancelFunc, error) {
+	procCtx, stop := context.WithCancel(runCtx)
+	if err := srv.Start(procCtx, exitChan); err != nil {
+		stop()
+		return func() {}, err
+	}
 
-	for _, instance := range h.instances {
-		if err := instance.StartManagementService(); err != nil {
-			return err
+	var setupErr error
+	select {
+	case <-ctx.Done():
+		return stop, nil
+	case err := <-exitChan:
+		stop()
+		return func() {}, err
+	case ready := <-srv.AwaitReady():
+		if !srv.pmixMode() {
+			setupErr = srv.SetRank(ctx, ready)
+		}
+		if setupErr == nil {
+			setupErr = srv.StartManagementService()
 		}
 	}
 
-	return nil
+	if setupErr != nil {
+		if err := srv.shutdown(ctx, "setup failed", false); err != nil {
+			srv.log.Errorf("instance %d: %s", srv.Index, err)
+		}
+		stop()
+		exitErr := <-exitChan
+		srv.log.Debugf("instance %d stopped after failed setup: %s", srv.Index, exitErr)
+		return func() {}, setupErr
+	}
+	srv.startHeartbeat(ctx)
+
+	return stop, nil
 }
 
-// pmixless returns if we are in PMIx-less or PMIx mode.
-func pmixless() bool {
-	if _, ok := os.LookupEnv("PMIX_RANK"); !ok {
-		return true
+// stopInstances shuts down all running instances in parallel, see
+// IOServerInstance.shutdown().
+func (h *IOServerHarness) stopInstances() {
+	var wg sync.WaitGroup
+	for _, instance := range h.Instances() {
+		if !instance.runner.IsRunning() {
+			continue
+		}
+
+		wg.Add(1)
+		go func(srv *IOServerInstance) {
+			defer wg.Done()
+			if err := srv.shutdown(context.Background(), "harness shutdown", false); err != nil {
+				h.log.Errorf("instance %d: %s", srv.Index, err)
+				srv.setState(InstanceStateErrored, err.Error())
+				return
+			}
+			srv.setState(InstanceStateStopped, "harness shutdown")
+		}(instance)
 	}
-	if _, ok := os.LookupEnv("DAOS_PMIXLESS"); ok {
-		return true
+	wg.Wait()
+}
+
+// StopInstance stops the given instance, see IOServerInstance.Stop().
+// The instance will not be restarted until StartInstance is called.
+func (h *IOServerHarness) StopInstance(ctx context.Context, srv *IOServerInstance, force bool) error {
+	if !h.IsStarted() {
+		return errors.New("can't stop instance: harness not started")
 	}
-	return false
+
+	return srv.Stop(ctx, force)
 }
 
-// pmixRank returns the PMIx rank. If PMIx-less or PMIX_RANK has an unexpected
-// value, it returns an error.
-func pmixRank() (ioserver.Rank, error) {
-	s, ok := os.LookupEnv("PMIX_RANK")
-	if !ok {
-		return ioserver.NilRank, errors.New("not in PMIx mode")
+// StartInstance restarts an instance which has been stopped with
+// StopInstance, blocking until the instance has started and rejoined
+// the system.
+func (h *IOServerHarness) StartInstance(ctx context.Context, srv *IOServerInstance) error {
+	if !h.IsStarted() {
+		return errors.New("can't start instance: harness not started")
 	}
-	r, err := strconv.ParseUint(s, 0, 32)
-	if err != nil {
-		return ioserver.NilRank, errors.Wrap(err, "PMIX_RANK="+s)
+	if !srv.isStopRequested() {
+		return errors.New("instance not stopped")
+	}
+
+	result := make(chan error, 1)
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case srv.startRequest <- result:
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-result:
+		return err
 	}
-	return ioserver.Rank(r), nil
+}
+
+// StartManagementService starts the DAOS management service on this node.
+func (h *IOServerHarness) StartManagementService(ctx context.Context) error {
+	h.RLock()
+	defer h.RUnlock()
+
+	for _, instance := range h.instances {
+		if err := instance.StartManagementService(); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 type mgmtInfo struct {
@@ -298,9 +516,9 @@ func getMgmtInfo(srv *IOServerInstance) (*mgmtInfo, error) {
 	if err != nil {
 		return nil, err
 	}
-	// A temporary workaround to create and start MS before we fully
-	// migrate to PMIx-less mode.
-	if !pmixless() {
+	// In PMIx compatibility mode, the instance launched as PMIx rank 0
+	// creates and bootstraps the management service instead.
+	if srv.pmixMode() {
 		rank, err := pmixRank()
 		if err != nil {
 			return nil, err
@@ -25,27 +25,32 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"net"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
 
 	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	srvpb "github.com/daos-stack/daos/src/control/common/proto/srv"
+	"github.com/daos-stack/daos/src/control/drpc"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
 	"github.com/daos-stack/daos/src/control/server/ioserver"
+	"github.com/daos-stack/daos/src/control/system"
 )
 
-func TestHarnessCreateSuperblocks(t *testing.T) {
-	log, buf := logging.NewTestLogger(t.Name())
-	defer common.ShowBufferOnFailure(t, buf)()
-
-	testDir, err := ioutil.TempDir("", strings.Replace(t.Name(), "/", "-", -1))
-	defer os.RemoveAll(testDir)
-	if err != nil {
-		t.Fatal(err)
-	}
+func newSuperblockTestHarness(t *testing.T, log *logging.LeveledLogger, testDir string) *IOServerHarness {
+	t.Helper()
 
 	ext := &mockExt{
 		isMountPointRet: true,
@@ -73,7 +78,22 @@ func TestHarnessCreateSuperblocks(t *testing.T) {
 		}
 	}
 
-	if err := h.CreateSuperblocks(false); err != nil {
+	return h
+}
+
+func TestHarnessCreateSuperblocks(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)()
+
+	testDir, err := ioutil.TempDir("", strings.Replace(t.Name(), "/", "-", -1))
+	defer os.RemoveAll(testDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := newSuperblockTestHarness(t, log, testDir)
+
+	if err := h.CreateSuperblocks(SuperblockRecreation{}); err != nil {
 		t.Fatal(err)
 	}
 
@@ -100,3 +120,402 @@ func TestHarnessCreateSuperblocks(t *testing.T) {
 		}
 	}
 }
+
+func TestHarnessRecreateSuperblocks(t *testing.T) {
+	const poolUUID = "0a8b6e7e-9d1c-4b2a-8f6d-3c5e7a9b1d2f"
+
+	for name, tc := range map[string]struct {
+		recreate  SuperblockRecreation
+		corrupt   func(t *testing.T, sbPath string)
+		poolFiles bool
+		expErrIn  string
+		expKeep   bool
+		expRank   ioserver.Rank
+	}{
+		"recreation disabled": {
+			expErrIn: "checksum mismatch",
+		},
+		"keep identity": {
+			recreate: SuperblockRecreation{Enabled: true},
+			expKeep:  true,
+			expRank:  5,
+		},
+		"reset rank": {
+			recreate: SuperblockRecreation{Enabled: true, ResetRank: true},
+			expRank:  1,
+		},
+		"unparseable": {
+			recreate: SuperblockRecreation{Enabled: true},
+			corrupt: func(t *testing.T, sbPath string) {
+				if err := ioutil.WriteFile(sbPath, []byte("version: [\n"), 0600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expRank: 1,
+		},
+		"newer version": {
+			recreate: SuperblockRecreation{Enabled: true, Force: true},
+			corrupt: func(t *testing.T, sbPath string) {
+				sb, err := ReadSuperblock(sbPath)
+				if err != nil {
+					t.Fatal(err)
+				}
+				sb.Version = superblockVersion + 1
+				if err := WriteSuperblock(sbPath, sb); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expErrIn: "newer than supported",
+		},
+		"pool files present": {
+			recreate:  SuperblockRecreation{Enabled: true},
+			poolFiles: true,
+			expErrIn:  "refusing to recreate superblock without force",
+		},
+		"pool files present (forced)": {
+			recreate:  SuperblockRecreation{Enabled: true, Force: true},
+			poolFiles: true,
+			expKeep:   true,
+			expRank:   5,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)()
+
+			testDir, err := ioutil.TempDir("", strings.Replace(t.Name(), "/", "-", -1))
+			defer os.RemoveAll(testDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			h := newSuperblockTestHarness(t, log, testDir)
+			if err := h.CreateSuperblocks(SuperblockRecreation{}); err != nil {
+				t.Fatal(err)
+			}
+
+			// damage the second instance's superblock, which had
+			// previously been assigned a different rank
+			instance := h.Instances()[1]
+			oldSB := instance.getSuperblock()
+			sbPath := instance.superblockPath()
+			if tc.corrupt == nil {
+				tc.corrupt = func(t *testing.T, sbPath string) {
+					sb := *oldSB
+					sb.Rank = ioserver.NewRankPtr(5)
+					sb.ValidRank = true
+					data, err := sb.Marshal()
+					if err != nil {
+						t.Fatal(err)
+					}
+					if err := ioutil.WriteFile(sbPath, data, 0600); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+			tc.corrupt(t, sbPath)
+			if tc.poolFiles {
+				poolDir := filepath.Join(filepath.Dir(sbPath), poolUUID)
+				if err := os.MkdirAll(poolDir, 0777); err != nil {
+					t.Fatal(err)
+				}
+				if err := ioutil.WriteFile(filepath.Join(poolDir, "vos-0"), nil, 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, i := range h.Instances() {
+				i.setSuperblock(nil)
+			}
+
+			// As on server start, storage readiness is checked
+			// before superblocks are created.
+			err = h.AwaitStorageReady(context.Background())
+			if err == nil {
+				err = h.CreateSuperblocks(tc.recreate)
+			}
+			if tc.expErrIn != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expErrIn) {
+					t.Fatalf("expected error containing %q, got %v", tc.expErrIn, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			sb, err := ReadSuperblock(sbPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			common.AssertEqual(t, sb.UUID == oldSB.UUID, tc.expKeep, "kept uuid")
+			common.AssertEqual(t, *sb.Rank, tc.expRank, "rank")
+			if !strings.Contains(buf.String(), "recreated superblocks") {
+				t.Fatal("expected recreated superblocks to be reported")
+			}
+		})
+	}
+}
+
+func TestHarnessGetInstance(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)()
+
+	h := NewIOServerHarness(&mockExt{}, log)
+	for idx := 0; idx < 2; idx++ {
+		r := ioserver.NewRunner(log, ioserver.NewConfig())
+		i := NewIOServerInstance(h.ext, log, nil, nil, r)
+		if err := h.AddInstance(i); err != nil {
+			t.Fatal(err)
+		}
+	}
+	instances := h.Instances()
+
+	// no superblocks yet, so fall back to default management instance
+	mi, err := h.GetManagementInstance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, mi, instances[defaultManagementInstance], "unexpected management instance")
+
+	if _, err := h.GetInstanceByRank(0); err == nil {
+		t.Fatal("expected error looking up rank before superblocks set")
+	}
+
+	instances[0].setSuperblock(&Superblock{Rank: ioserver.NewRankPtr(5)})
+	instances[1].setSuperblock(&Superblock{Rank: ioserver.NewRankPtr(3), MS: true})
+
+	for rank, idx := range map[ioserver.Rank]int{5: 0, 3: 1} {
+		i, err := h.GetInstanceByRank(rank)
+		if err != nil {
+			t.Fatal(err)
+		}
+		common.AssertEqual(t, i.Index, idx, "unexpected instance for rank "+rank.String())
+	}
+
+	mi, err = h.GetManagementInstance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	common.AssertEqual(t, mi.Index, 1, "expected MS replica to be management instance")
+
+	if _, err := h.GetInstance(2); err == nil {
+		t.Fatal("expected error for out of range index")
+	}
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// bootstrapDrpcClient stands in for the dRPC server of a started I/O
+// server, accepting SetRank calls and joins forwarded by the management
+// service. Joins are rejected if the rank is held by another server in
+// ranks, which maps ranks to server UUIDs.
+type bootstrapDrpcClient struct {
+	sync.Mutex
+	ranks       map[uint32]string
+	setRanks    []uint32
+	joinedRanks []uint32
+}
+
+func (c *bootstrapDrpcClient) IsConnected() bool {
+	return true
+}
+
+func (c *bootstrapDrpcClient) Connect() error {
+	return nil
+}
+
+func (c *bootstrapDrpcClient) Close() error {
+	return nil
+}
+
+func (c *bootstrapDrpcClient) SendMsg(call *drpc.Call) (*drpc.Response, error) {
+	c.Lock()
+	defer c.Unlock()
+
+	var resp proto.Message
+	switch call.Method {
+	case setRank:
+		req := &mgmtpb.SetRankReq{}
+		if err := proto.Unmarshal(call.Body, req); err != nil {
+			return nil, err
+		}
+		c.setRanks = append(c.setRanks, req.Rank)
+		resp = &mgmtpb.DaosResp{}
+	case join:
+		req := &mgmtpb.JoinReq{}
+		if err := proto.Unmarshal(call.Body, req); err != nil {
+			return nil, err
+		}
+		if uuid, taken := c.ranks[req.Rank]; taken && uuid != req.Uuid {
+			resp = &mgmtpb.JoinResp{Status: derExist}
+			break
+		}
+		if c.ranks == nil {
+			c.ranks = make(map[uint32]string)
+		}
+		c.ranks[req.Rank] = req.Uuid
+		c.joinedRanks = append(c.joinedRanks, req.Rank)
+		resp = &mgmtpb.JoinResp{Rank: req.Rank}
+	default:
+		return nil, errors.Errorf("unexpected dRPC method %d", call.Method)
+	}
+
+	body, err := proto.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return &drpc.Response{Status: drpc.Status_SUCCESS, Body: body}, nil
+}
+
+func (c *bootstrapDrpcClient) calls() (setRanks, joinedRanks []uint32) {
+	c.Lock()
+	defer c.Unlock()
+
+	return append([]uint32{}, c.setRanks...), append([]uint32{}, c.joinedRanks...)
+}
+
+// TestHarnessBootstrapSystem starts a fresh system of several nodes sharing
+// a single access point, without a PMIx launcher.
+func TestHarnessBootstrapSystem(t *testing.T) {
+	const nodeCount = 3
+
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)()
+
+	testDir, err := ioutil.TempDir("", strings.Replace(t.Name(), "/", "-", -1))
+	defer os.RemoveAll(testDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A PMIx rank in the environment is ignored outside of the PMIx
+	// compatibility mode.
+	defer setPMIxRank(t, "1")()
+
+	ctx := context.Background()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	apAddr := lis.Addr().(*net.TCPAddr)
+	accessPoints := []string{apAddr.String()}
+
+	harnesses := make([]*IOServerHarness, nodeCount)
+	drpcClients := make([]*bootstrapDrpcClient, nodeCount)
+	for idx := range harnesses {
+		mnt := fmt.Sprintf("node%d", idx)
+		if err := os.MkdirAll(filepath.Join(testDir, mnt), 0777); err != nil {
+			t.Fatal(err)
+		}
+
+		// Each node listens on the access point port of its own address.
+		self := &net.TCPAddr{IP: net.IPv4(127, 0, 0, byte(idx+1)), Port: apAddr.Port}
+		ext := &mockExt{
+			isMountPointRet: true,
+		}
+		h := NewIOServerHarness(ext, log)
+		cfg := ioserver.NewConfig().
+			WithSystemName(t.Name()).
+			WithScmClass("ram").
+			WithScmMountPoint(mnt)
+		m := newMgmtSvcClient(ctx, log, mgmtSvcClientCfg{
+			AccessPoints:    accessPoints,
+			ControlAddr:     self,
+			TransportConfig: &security.TransportConfig{AllowInsecure: true},
+			JoinTimeout:     10 * time.Second,
+		})
+		i := NewIOServerInstance(ext, log, nil, m, ioserver.NewRunner(log, cfg))
+		i.fsRoot = testDir
+		drpcClients[idx] = &bootstrapDrpcClient{}
+		i.drpcClient = drpcClients[idx]
+		if err := h.AddInstance(i); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := h.CreateSuperblocks(SuperblockRecreation{}); err != nil {
+			t.Fatal(err)
+		}
+		harnesses[idx] = h
+	}
+
+	// Only the access point bootstraps the management service, with rank 0.
+	for idx, h := range harnesses {
+		sb := h.Instances()[0].getSuperblock()
+		isAP := idx == 0
+		common.AssertEqual(t, sb.MS, isAP, fmt.Sprintf("node %d: unexpected MS", idx))
+		common.AssertEqual(t, sb.BootstrapMS, isAP, fmt.Sprintf("node %d: unexpected BootstrapMS", idx))
+		common.AssertEqual(t, sb.ValidRank, isAP, fmt.Sprintf("node %d: unexpected ValidRank", idx))
+		if isAP {
+			common.AssertEqual(t, *sb.Rank, ioserver.Rank(0), "unexpected access point rank")
+		}
+	}
+
+	membership := system.NewMembership(log)
+	sysdb := newTestSystemDB(t, ctx, log, membership, accessPoints, apAddr.String())
+	grpcSrv := grpc.NewServer()
+	mgmtpb.RegisterMgmtSvcServer(grpcSrv, newMgmtSvc(harnesses[0], membership, sysdb))
+	go func() {
+		_ = grpcSrv.Serve(lis)
+	}()
+	defer grpcSrv.Stop()
+
+	ready := func(idx int) *srvpb.NotifyReadyReq {
+		return &srvpb.NotifyReadyReq{
+			Uri:   fmt.Sprintf("ofi+sockets://127.0.0.%d:31416", idx+1),
+			Nctxs: 1,
+		}
+	}
+
+	// The bootstrapping access point holds rank 0 in the management
+	// service from the start.
+	drpcClients[0].ranks = map[uint32]string{
+		0: harnesses[0].Instances()[0].getSuperblock().UUID,
+	}
+
+	// Joins return once the management service has accepted the member,
+	// only the access point registers its rank in the background.
+	for idx, h := range harnesses {
+		if err := h.Instances()[0].SetRank(ctx, ready(idx)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	waitFor(t, "access point registration", func() bool {
+		_, err := membership.Get(0)
+		return err == nil
+	})
+
+	ranks := make(map[ioserver.Rank]bool)
+	for idx, h := range harnesses {
+		i := h.Instances()[0]
+		sb, err := ReadSuperblock(i.superblockPath())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !sb.ValidRank || sb.Rank == nil {
+			t.Fatalf("node %d: rank not recorded in superblock", idx)
+		}
+		rank := *sb.Rank
+		common.AssertTrue(t, !ranks[rank], fmt.Sprintf("node %d: rank %d assigned twice", idx, rank))
+		ranks[rank] = true
+
+		setRanks, _ := drpcClients[idx].calls()
+		common.AssertEqual(t, setRanks, []uint32{uint32(rank)}, fmt.Sprintf("node %d: unexpected SetRank calls", idx))
+
+		member, err := membership.Get(uint32(rank))
+		if err != nil {
+			t.Fatal(err)
+		}
+		common.AssertEqual(t, member.UUID, sb.UUID, fmt.Sprintf("node %d: unexpected member", idx))
+	}
+}
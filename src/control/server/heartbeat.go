@@ -0,0 +1,265 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/drpc"
+)
+
+const (
+	defaultHeartbeatInterval = 5 * time.Second
+	defaultHeartbeatMisses   = 3
+)
+
+// heartbeatConfig controls the liveness checks of a running I/O server.
+type heartbeatConfig struct {
+	interval  time.Duration // zero disables heartbeats
+	maxMisses int           // consecutive misses before unresponsive
+	restart   bool          // kill unresponsive processes
+}
+
+// setHeartbeat sets how the instance is checked for liveness once ready.
+func (srv *IOServerInstance) setHeartbeat(cfg heartbeatConfig) {
+	srv.heartbeat = cfg
+}
+
+// startHeartbeat begins checking the liveness of the instance process,
+// replacing the checks of any previous process. Heartbeats use a dRPC
+// connection of their own so that they don't contend with other calls.
+func (srv *IOServerInstance) startHeartbeat(ctx context.Context) {
+	if srv.heartbeat.interval <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	srv.Lock()
+	if srv._stopHeartbeat != nil {
+		srv._stopHeartbeat()
+	}
+	srv._stopHeartbeat = cancel
+	srv.Unlock()
+
+	hb := newHeartbeatMonitor(srv, getDrpcClientConnection(srv.runner.Config.SocketDir))
+	go hb.run(ctx)
+}
+
+// stopHeartbeat stops checking the liveness of the instance process.
+func (srv *IOServerInstance) stopHeartbeat() {
+	srv.Lock()
+	defer srv.Unlock()
+
+	if srv._stopHeartbeat != nil {
+		srv._stopHeartbeat()
+		srv._stopHeartbeat = nil
+	}
+}
+
+// heartbeatMonitor periodically pings an I/O server over dRPC, marking the
+// instance unresponsive once it has missed too many consecutive heartbeats
+// and reporting the outcome of each heartbeat to the access points.
+type heartbeatMonitor struct {
+	srv    *IOServerInstance
+	cfg    heartbeatConfig
+	client drpc.DomainSocketClient
+
+	pending chan error // result of an unanswered ping
+	sent    time.Time  // time the pending ping was sent
+	misses  int
+	latency time.Duration // latency of the last answered ping
+	resume  InstanceState // state to restore once responsive again
+
+	// latest report not yet sent to the access points
+	reports chan *mgmtpb.SystemHeartbeatReq
+}
+
+func newHeartbeatMonitor(srv *IOServerInstance, client drpc.DomainSocketClient) *heartbeatMonitor {
+	return &heartbeatMonitor{
+		srv:     srv,
+		cfg:     srv.heartbeat,
+		client:  client,
+		reports: make(chan *mgmtpb.SystemHeartbeatReq, 1),
+	}
+}
+
+func (hb *heartbeatMonitor) run(ctx context.Context) {
+	ticker := time.NewTicker(hb.cfg.interval)
+	defer ticker.Stop()
+
+	go hb.sendReports(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			hb.beat(ctx)
+		}
+	}
+}
+
+// responsive returns false once the miss threshold has been reached.
+func (hb *heartbeatMonitor) responsive() bool {
+	return hb.misses < hb.cfg.maxMisses
+}
+
+// beat performs a single liveness check and reports its outcome.
+func (hb *heartbeatMonitor) beat(ctx context.Context) {
+	latency, err := hb.ping(ctx)
+	if ctx.Err() != nil {
+		return
+	}
+
+	switch {
+	case err == nil:
+		if !hb.responsive() {
+			hb.srv.log.Infof("I/O server instance %d responsive after %d missed heartbeats",
+				hb.srv.Index, hb.misses)
+			// The instance may have moved on, e.g. having joined
+			// the system, while it was unresponsive.
+			hb.srv.setStateFrom(InstanceStateUnresponsive, hb.resume,
+				fmt.Sprintf("heartbeat answered in %s", latency))
+		}
+		hb.misses = 0
+		hb.latency = latency
+	default:
+		hb.misses++
+		hb.srv.log.Debugf("I/O server instance %d missed heartbeat (%d/%d): %s",
+			hb.srv.Index, hb.misses, hb.cfg.maxMisses, err)
+		if hb.misses == hb.cfg.maxMisses {
+			hb.unresponsive(ctx, err)
+		}
+	}
+
+	hb.report()
+}
+
+// unresponsive marks the instance unresponsive and, if configured to,
+// kills the process so that the harness restarts it.
+func (hb *heartbeatMonitor) unresponsive(ctx context.Context, lastErr error) {
+	reason := fmt.Sprintf("missed %d heartbeats: %s", hb.misses, lastErr)
+	hb.srv.log.Errorf("I/O server instance %d unresponsive, %s", hb.srv.Index, reason)
+
+	hb.resume = hb.srv.replaceState(InstanceStateUnresponsive, reason)
+
+	if !hb.cfg.restart {
+		return
+	}
+	if err := hb.srv.shutdown(ctx, "unresponsive, "+reason, true); err != nil {
+		hb.srv.log.Errorf("I/O server instance %d: %s", hb.srv.Index, err)
+	}
+}
+
+// ping sends a heartbeat to the I/O server and returns the time it took to
+// be answered. While a previous ping remains unanswered, no other is sent.
+func (hb *heartbeatMonitor) ping(ctx context.Context) (time.Duration, error) {
+	if hb.pending == nil {
+		hb.pending = make(chan error, 1)
+		hb.sent = time.Now()
+		go func(result chan<- error) {
+			result <- callPing(hb.client)
+		}(hb.pending)
+	}
+
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-time.After(hb.cfg.interval):
+		return 0, errors.Errorf("no response after %s", time.Since(hb.sent).Round(time.Millisecond))
+	case err := <-hb.pending:
+		hb.pending = nil
+		return time.Since(hb.sent), err
+	}
+}
+
+// report queues the outcome of the latest heartbeat to be sent to the
+// access points, replacing any earlier report which hasn't been sent yet so
+// that slow access points don't delay the next ping.
+func (hb *heartbeatMonitor) report() {
+	if hb.srv.msClient == nil {
+		return
+	}
+	rank, err := hb.srv.GetRank()
+	if err != nil {
+		return
+	}
+
+	req := &mgmtpb.SystemHeartbeatReq{
+		Rank:       uint32(rank),
+		Uuid:       hb.srv.getSuperblock().UUID,
+		Responsive: hb.responsive(),
+		Latency:    int64(hb.latency),
+		Missed:     uint32(hb.misses),
+	}
+
+	select {
+	case <-hb.reports:
+	default:
+	}
+	hb.reports <- req
+}
+
+// sendReports sends queued heartbeat reports to the access points until
+// the context is cancelled.
+func (hb *heartbeatMonitor) sendReports(ctx context.Context) {
+	for {
+		var req *mgmtpb.SystemHeartbeatReq
+		select {
+		case <-ctx.Done():
+			return
+		case req = <-hb.reports:
+		}
+
+		reportCtx, cancel := context.WithTimeout(ctx, hb.cfg.interval)
+		err := hb.srv.msClient.ReportHeartbeat(reportCtx, req)
+		cancel()
+		if err != nil {
+			hb.srv.log.Debugf("I/O server instance %d: %s", hb.srv.Index, err)
+		}
+	}
+}
+
+func callPing(client drpc.DomainSocketClient) error {
+	dresp, err := makeDrpcCall(client, mgmtModuleID, ping, nil)
+	if err != nil {
+		return err
+	}
+
+	resp := &mgmtpb.DaosResp{}
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return errors.Wrap(err, "unmarshall Ping response")
+	}
+	if resp.Status != 0 {
+		return errors.Errorf("Ping: %d", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,219 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	. "github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/ioserver"
+)
+
+// hangingDrpcClient doesn't answer calls until released, like a wedged
+// I/O server.
+type hangingDrpcClient struct {
+	mockDrpcClient
+	sync.Mutex
+	calls   int
+	release chan struct{}
+}
+
+func (c *hangingDrpcClient) SendMsg(call *drpc.Call) (*drpc.Response, error) {
+	c.Lock()
+	c.calls++
+	c.Unlock()
+
+	<-c.release
+	return c.mockDrpcClient.SendMsg(call)
+}
+
+func (c *hangingDrpcClient) callCount() int {
+	c.Lock()
+	defer c.Unlock()
+	return c.calls
+}
+
+func newTestHeartbeatInstance(t *testing.T, log logging.Logger) *IOServerInstance {
+	t.Helper()
+
+	r := ioserver.NewRunner(log, ioserver.NewConfig())
+	srv := NewIOServerInstance(nil, log, nil, nil, r)
+	srv.setHeartbeat(heartbeatConfig{interval: 10 * time.Millisecond, maxMisses: 2})
+	for _, state := range []InstanceState{
+		InstanceStateStarting, InstanceStateReady, InstanceStateJoined,
+	} {
+		srv.setState(state, "test")
+	}
+
+	return srv
+}
+
+func setPingResponse(t *testing.T, client *mockDrpcClient, status int32) {
+	t.Helper()
+
+	body, err := proto.Marshal(&mgmtpb.DaosResp{Status: status})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.setSendMsgResponse(drpc.Status_SUCCESS, body)
+}
+
+func TestHeartbeatMonitorBeat(t *testing.T) {
+	for name, tc := range map[string]struct {
+		status    int32
+		sendErr   error
+		beats     int
+		expMisses int
+		expState  InstanceState
+	}{
+		"answered": {
+			beats:    3,
+			expState: InstanceStateJoined,
+		},
+		"failure status": {
+			status:    -1,
+			beats:     1,
+			expMisses: 1,
+			expState:  InstanceStateJoined,
+		},
+		"unresponsive": {
+			sendErr:   errors.New("connection refused"),
+			beats:     2,
+			expMisses: 2,
+			expState:  InstanceStateUnresponsive,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			srv := newTestHeartbeatInstance(t, log)
+			client := newMockDrpcClient()
+			setPingResponse(t, client, tc.status)
+			client.SendMsgOutputError = tc.sendErr
+
+			hb := newHeartbeatMonitor(srv, client)
+			for i := 0; i < tc.beats; i++ {
+				hb.beat(context.Background())
+			}
+
+			AssertEqual(t, client.SendMsgInputCall.Method, int32(ping), "unexpected dRPC method")
+			AssertEqual(t, hb.misses, tc.expMisses, "unexpected missed heartbeats")
+			AssertEqual(t, hb.latency > 0, tc.expMisses == 0, "unexpected heartbeat latency")
+			state, _ := srv.getState()
+			AssertEqual(t, state, tc.expState, "unexpected instance state")
+		})
+	}
+}
+
+func TestHeartbeatMonitorRecovery(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	srv := newTestHeartbeatInstance(t, log)
+	client := &hangingDrpcClient{release: make(chan struct{})}
+	setPingResponse(t, &client.mockDrpcClient, 0)
+
+	hb := newHeartbeatMonitor(srv, client)
+	hb.beat(context.Background())
+	hb.beat(context.Background())
+
+	state, _ := srv.getState()
+	AssertEqual(t, state, InstanceStateUnresponsive, "unexpected state after missed heartbeats")
+	AssertEqual(t, client.callCount(), 1, "ping resent while previous unanswered")
+
+	close(client.release)
+	hb.beat(context.Background())
+
+	state, transitions := srv.getState()
+	AssertEqual(t, state, InstanceStateJoined, "unexpected state after recovery")
+	AssertEqual(t, hb.misses, 0, "missed heartbeats not reset")
+	last := transitions[len(transitions)-1]
+	AssertEqual(t, last.from, InstanceStateUnresponsive, "unexpected transition")
+	AssertEqual(t, last.to, InstanceStateJoined, "unexpected transition")
+}
+
+// TestHeartbeatMonitorRecoveryAfterJoin checks that an instance which joins
+// while unresponsive is left joined when it becomes responsive, rather than
+// returned to the state it was in beforehand.
+func TestHeartbeatMonitorRecoveryAfterJoin(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	r := ioserver.NewRunner(log, ioserver.NewConfig())
+	srv := NewIOServerInstance(nil, log, nil, nil, r)
+	srv.setHeartbeat(heartbeatConfig{interval: 10 * time.Millisecond, maxMisses: 2})
+	for _, state := range []InstanceState{InstanceStateStarting, InstanceStateReady} {
+		srv.setState(state, "test")
+	}
+	client := &hangingDrpcClient{release: make(chan struct{})}
+	setPingResponse(t, &client.mockDrpcClient, 0)
+
+	hb := newHeartbeatMonitor(srv, client)
+	hb.beat(context.Background())
+	hb.beat(context.Background())
+	AssertEqual(t, hb.resume, InstanceStateReady, "unexpected state to resume")
+
+	srv.setState(InstanceStateJoined, "joined")
+	close(client.release)
+	hb.beat(context.Background())
+
+	state, _ := srv.getState()
+	AssertEqual(t, state, InstanceStateJoined, "unexpected state after recovery")
+	AssertEqual(t, hb.misses, 0, "missed heartbeats not reset")
+	AssertTrue(t, !strings.Contains(buf.String(), "invalid state transition"),
+		"invalid state transition attempted")
+}
+
+func TestHeartbeatMonitorReport(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	srv := newTestHeartbeatInstance(t, log)
+	srv.setSuperblock(&Superblock{UUID: "uuid0", Rank: ioserver.NewRankPtr(1)})
+	srv.msClient = newMgmtSvcClient(context.TODO(), log, mgmtSvcClientCfg{})
+
+	// reports which haven't been sent are replaced rather than blocking
+	// the next ping
+	hb := newHeartbeatMonitor(srv, newMockDrpcClient())
+	for hb.misses = 1; hb.misses <= 3; hb.misses++ {
+		hb.report()
+	}
+
+	AssertEqual(t, len(hb.reports), 1, "unexpected queued reports")
+	req := <-hb.reports
+	AssertEqual(t, req.Rank, uint32(1), "unexpected rank")
+	AssertEqual(t, req.Uuid, "uuid0", "unexpected uuid")
+	AssertEqual(t, req.Missed, uint32(3), "unexpected missed heartbeats")
+}
@@ -25,8 +25,10 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
@@ -39,6 +41,10 @@ import (
 	"github.com/daos-stack/daos/src/control/server/storage"
 )
 
+// prepShutdownTimeout bounds how long an I/O server may take to prepare for
+// shutdown before it is terminated regardless.
+const prepShutdownTimeout = 10 * time.Second
+
 // IOServerInstance encapsulates control-plane specific configuration
 // and functionality for managed I/O server instances. The distinction
 // between this structure and what's in the ioserver package is that the
@@ -57,13 +63,22 @@ type IOServerInstance struct {
 	msClient      *mgmtSvcClient
 	instanceReady chan *srvpb.NotifyReadyReq
 	storageReady  chan struct{}
+	startRequest  chan chan<- error
 	fsRoot        string
 
 	sync.RWMutex
 	// these must be protected by a mutex in order to
 	// avoid racy access.
-	_scmStorageOk bool // cache positive result of NeedsStorageFormat()
-	_superblock   *Superblock
+	_scmStorageOk  bool // cache positive result of NeedsStorageFormat()
+	_superblock    *Superblock
+	_restart       restartState
+	_stopReq       bool // instance deliberately stopped, don't restart
+	_state         InstanceState
+	_transitions   []instanceTransition
+	_stopHeartbeat context.CancelFunc
+	devices        deviceScanner
+	deviceCheck    DeviceCheckPolicy
+	heartbeat      heartbeatConfig
 }
 
 // NewIOServerInstance returns an *IOServerInstance initialized with
@@ -81,6 +96,7 @@ func NewIOServerInstance(ext External, log logging.Logger,
 		drpcClient:    getDrpcClientConnection(r.Config.SocketDir),
 		instanceReady: make(chan *srvpb.NotifyReadyReq),
 		storageReady:  make(chan struct{}),
+		startRequest:  make(chan chan<- error),
 	}
 }
 
@@ -207,12 +223,21 @@ func (srv *IOServerInstance) NeedsScmFormat() (bool, error) {
 // Start checks to make sure that the instance has a valid superblock before
 // performing any required NVMe preparation steps and launching a managed
 // daos_io_server instance.
-func (srv *IOServerInstance) Start(ctx context.Context, errChan chan<- error) error {
+func (srv *IOServerInstance) Start(ctx context.Context, errChan chan<- error) (err error) {
+	defer func() {
+		if err != nil {
+			srv.setState(InstanceStateErrored, err.Error())
+		}
+	}()
+
 	if !srv.hasSuperblock() {
 		if err := srv.ReadSuperblock(); err != nil {
 			return errors.Wrap(err, "start failed; no superblock")
 		}
 	}
+	if err := srv.checkDevices(); err != nil {
+		return errors.Wrap(err, "start failed")
+	}
 	if err := srv.bdevProvider.PrepareDevices(); err != nil {
 		return errors.Wrap(err, "start failed; unable to prepare NVMe device(s)")
 	}
@@ -220,13 +245,130 @@ func (srv *IOServerInstance) Start(ctx context.Context, errChan chan<- error) er
 		return errors.Wrap(err, "start failed; unable to generate NVMe configuration for SPDK")
 	}
 
-	return srv.runner.Start(ctx, errChan)
+	if err := srv.runner.Start(ctx, errChan); err != nil {
+		return err
+	}
+	srv.recordStart()
+	srv.setState(InstanceStateStarting, "process started")
+
+	return nil
+}
+
+// recordStart records that the instance process has been started.
+func (srv *IOServerInstance) recordStart() {
+	srv.Lock()
+	defer srv.Unlock()
+	srv._restart.started(time.Now())
+}
+
+// recordExit records the exit of the instance process and returns the delay
+// before it should be restarted according to the given policy.
+func (srv *IOServerInstance) recordExit(policy RestartPolicy, exitErr error) (time.Duration, error) {
+	srv.stopHeartbeat()
+	srv.setState(InstanceStateErrored, exitErr.Error())
+
+	srv.Lock()
+	defer srv.Unlock()
+	return srv._restart.exited(policy, exitErr, time.Now())
+}
+
+// restartStatus returns a copy of the instance run history.
+func (srv *IOServerInstance) restartStatus() restartState {
+	srv.RLock()
+	defer srv.RUnlock()
+	return srv._restart
+}
+
+// recordStop records the exit of a deliberately stopped instance process.
+func (srv *IOServerInstance) recordStop(exitErr error) {
+	srv.stopHeartbeat()
+	srv.setState(InstanceStateStopped, exitErr.Error())
+
+	srv.Lock()
+	defer srv.Unlock()
+	srv._restart.stopped(exitErr, time.Now())
+}
+
+func (srv *IOServerInstance) setStopRequested(stopReq bool) {
+	srv.Lock()
+	defer srv.Unlock()
+	srv._stopReq = stopReq
+}
+
+func (srv *IOServerInstance) isStopRequested() bool {
+	srv.RLock()
+	defer srv.RUnlock()
+	return srv._stopReq
+}
+
+// IsStopped indicates whether the instance has been deliberately stopped
+// and its process has exited.
+func (srv *IOServerInstance) IsStopped() bool {
+	return srv.isStopRequested() && !srv.runner.IsRunning()
+}
+
+// Stop shuts down the instance process, see shutdown(), and prevents it
+// from being restarted until requested.
+func (srv *IOServerInstance) Stop(ctx context.Context, force bool) error {
+	srv.setStopRequested(true)
+	if !srv.runner.IsRunning() {
+		srv.setState(InstanceStateStopped, "stop requested")
+		return nil
+	}
+
+	return srv.shutdown(ctx, "stop requested", force)
+}
+
+// shutdown stops the instance process in an orderly fashion. The I/O server
+// is asked to prepare for shutdown over dRPC before being sent SIGTERM and
+// is only killed if it has not exited within the configured shutdown
+// timeout. If force is set, the process is killed immediately.
+func (srv *IOServerInstance) shutdown(ctx context.Context, reason string, force bool) error {
+	srv.stopHeartbeat()
+	if !srv.runner.IsRunning() {
+		return nil
+	}
+	srv.setState(InstanceStateStopping, reason)
+
+	if !force {
+		// An I/O server which can't prepare is terminated regardless.
+		if err := srv.prepShutdown(ctx); err != nil {
+			srv.log.Errorf("I/O server instance %d failed to prepare for shutdown: %s",
+				srv.Index, err)
+		}
+	}
+
+	srv.log.Debugf("stopping I/O server instance %d (%s)", srv.Index, reason)
+	if err := srv.runner.Stop(reason, force); err != nil {
+		return errors.Wrap(err, "stop")
+	}
+
+	return nil
+}
+
+// prepShutdown asks the I/O server to prepare for shutdown, giving up if it
+// doesn't respond in time.
+func (srv *IOServerInstance) prepShutdown(ctx context.Context) error {
+	result := make(chan error, 1)
+	go func() {
+		result <- srv.callPrepShutdown()
+	}()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(prepShutdownTimeout):
+		return errors.Errorf("no response after %s", prepShutdownTimeout)
+	case err := <-result:
+		return err
+	}
 }
 
 // NotifyReady receives a ready message from the running IOServer
 // instance.
 func (srv *IOServerInstance) NotifyReady(msg *srvpb.NotifyReadyReq) {
 	srv.log.Debugf("I/O server instance %d ready: %v", srv.Index, msg)
+	srv.setState(InstanceStateReady, "ready notification received")
 
 	go func() {
 		srv.instanceReady <- msg
@@ -250,9 +392,12 @@ func (srv *IOServerInstance) NotifyStorageReady() {
 
 // AwaitStorageReady blocks until the IOServer's storage is ready.
 func (srv *IOServerInstance) AwaitStorageReady(ctx context.Context) {
+	srv.setState(InstanceStateAwaitingFormat, "waiting for storage format")
+
 	select {
 	case <-ctx.Done():
 		srv.log.Infof("I/O server instance %d storage not ready: %s", srv.Index, ctx.Err())
+		srv.setState(InstanceStateStopped, ctx.Err().Error())
 	case <-srv.storageReady:
 		srv.log.Infof("I/O server instance %d storage ready", srv.Index)
 	}
@@ -260,7 +405,13 @@ func (srv *IOServerInstance) AwaitStorageReady(ctx context.Context) {
 
 // SetRank determines the instance rank and sends a SetRank dRPC request
 // to the IOServer.
-func (srv *IOServerInstance) SetRank(ctx context.Context, ready *srvpb.NotifyReadyReq) error {
+func (srv *IOServerInstance) SetRank(ctx context.Context, ready *srvpb.NotifyReadyReq) (err error) {
+	defer func() {
+		if err != nil {
+			srv.setState(InstanceStateErrored, err.Error())
+		}
+	}()
+
 	superblock := srv.getSuperblock()
 	if superblock == nil {
 		return errors.New("nil superblock in SetRank()")
@@ -280,8 +431,6 @@ func (srv *IOServerInstance) SetRank(ctx context.Context, ready *srvpb.NotifyRea
 		})
 		if err != nil {
 			return err
-		} else if resp.State == mgmtpb.JoinResp_OUT {
-			return errors.Errorf("rank %d excluded", resp.Rank)
 		}
 		r = ioserver.Rank(resp.Rank)
 
@@ -294,11 +443,48 @@ func (srv *IOServerInstance) SetRank(ctx context.Context, ready *srvpb.NotifyRea
 				return err
 			}
 		}
+	} else {
+		// The rank of a bootstrapping access point is known without
+		// joining, register it in the system database regardless so
+		// that it can be reported as a PSR. The management service
+		// may not be running yet, so don't wait for the outcome.
+		go srv.registerRank(ctx, &mgmtpb.JoinReq{
+			Uuid:  superblock.UUID,
+			Rank:  uint32(r),
+			Uri:   ready.Uri,
+			Nctxs: ready.Nctxs,
+		})
 	}
 
 	if err := srv.callSetRank(r); err != nil {
 		return err
 	}
+	srv.setState(InstanceStateJoined, fmt.Sprintf("rank %d", r))
+
+	return nil
+}
+
+// registerRank records an instance with a known rank as a system member.
+func (srv *IOServerInstance) registerRank(ctx context.Context, req *mgmtpb.JoinReq) {
+	if _, err := srv.msClient.Join(ctx, req); err != nil {
+		srv.log.Errorf("I/O server instance %d: failed to register rank %d: %s",
+			srv.Index, req.Rank, err)
+	}
+}
+
+func (srv *IOServerInstance) callPrepShutdown() error {
+	dresp, err := makeDrpcCall(srv.drpcClient, mgmtModuleID, prepShutdown, nil)
+	if err != nil {
+		return err
+	}
+
+	resp := &mgmtpb.DaosResp{}
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return errors.Wrap(err, "unmarshall PrepShutdown response")
+	}
+	if resp.Status != 0 {
+		return errors.Errorf("PrepShutdown: %d", resp.Status)
+	}
 
 	return nil
 }
@@ -320,6 +506,47 @@ func (srv *IOServerInstance) callSetRank(rank ioserver.Rank) error {
 	return nil
 }
 
+// SetLogMask changes the log mask of the instance. The mask is recorded in
+// the instance configuration so that it persists across restarts and, if
+// the I/O server is up, applied to the running process over dRPC. An empty
+// mask restores the I/O server default.
+func (srv *IOServerInstance) SetLogMask(mask string) error {
+	srv.runner.SetLogMask(mask)
+
+	if !srv.isReady() {
+		return nil
+	}
+	if mask == "" {
+		mask = ioserver.DefaultLogMask
+	}
+
+	return srv.callSetLogMasks(mask)
+}
+
+// isReady indicates whether the I/O server has reported that it is ready and
+// is therefore able to serve dRPC requests.
+func (srv *IOServerInstance) isReady() bool {
+	state, _ := srv.getState()
+	return state == InstanceStateReady || state == InstanceStateJoined
+}
+
+func (srv *IOServerInstance) callSetLogMasks(masks string) error {
+	dresp, err := makeDrpcCall(srv.drpcClient, mgmtModuleID, setLogMasks, &mgmtpb.SetLogMasksReq{Masks: masks})
+	if err != nil {
+		return err
+	}
+
+	resp := &mgmtpb.DaosResp{}
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return errors.Wrap(err, "unmarshall SetLogMasks response")
+	}
+	if resp.Status != 0 {
+		return errors.Errorf("SetLogMasks: %d", resp.Status)
+	}
+
+	return nil
+}
+
 // StartManagementService starts the DAOS management service replica associated
 // with this instance. If no replica is associated with this instance, this
 // function is a no-op.
@@ -429,6 +656,19 @@ func (srv *IOServerInstance) callSetUp() error {
 	return nil
 }
 
+// GetRank returns the rank recorded in the instance superblock.
+func (srv *IOServerInstance) GetRank() (ioserver.Rank, error) {
+	superblock := srv.getSuperblock()
+	if superblock == nil {
+		return ioserver.NilRank, errors.Errorf("I/O server instance %d: nil superblock", srv.Index)
+	}
+	if superblock.Rank == nil {
+		return ioserver.NilRank, errors.Errorf("I/O server instance %d: rank not assigned", srv.Index)
+	}
+
+	return *superblock.Rank, nil
+}
+
 func (srv *IOServerInstance) IsMSReplica() bool {
 	return srv.hasSuperblock() && srv.getSuperblock().MS
 }
@@ -0,0 +1,217 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"time"
+)
+
+// InstanceState represents the lifecycle state of a managed I/O server
+// instance.
+type InstanceState int
+
+const (
+	// InstanceStateStopped indicates the instance process is not running,
+	// either because it has not been started yet or because it was
+	// deliberately stopped.
+	InstanceStateStopped InstanceState = iota
+	// InstanceStateUnformatted indicates the instance storage needs to be
+	// formatted before the instance can be started.
+	InstanceStateUnformatted
+	// InstanceStateAwaitingFormat indicates the instance is waiting for a
+	// storage format request.
+	InstanceStateAwaitingFormat
+	// InstanceStateStarting indicates the instance process has been started
+	// but has not yet reported that it is ready.
+	InstanceStateStarting
+	// InstanceStateReady indicates the instance process is ready and is
+	// joining the system.
+	InstanceStateReady
+	// InstanceStateJoined indicates the instance has joined the system.
+	InstanceStateJoined
+	// InstanceStateUnresponsive indicates the instance process is running
+	// but has stopped answering heartbeats.
+	InstanceStateUnresponsive
+	// InstanceStateStopping indicates the instance process is being stopped.
+	InstanceStateStopping
+	// InstanceStateErrored indicates the instance failed to start or its
+	// process exited unexpectedly.
+	InstanceStateErrored
+)
+
+// maxInstanceTransitions is the number of most recent state transitions
+// recorded for each instance.
+const maxInstanceTransitions = 16
+
+func (is InstanceState) String() string {
+	switch is {
+	case InstanceStateStopped:
+		return "stopped"
+	case InstanceStateUnformatted:
+		return "unformatted"
+	case InstanceStateAwaitingFormat:
+		return "awaiting-format"
+	case InstanceStateStarting:
+		return "starting"
+	case InstanceStateReady:
+		return "ready"
+	case InstanceStateJoined:
+		return "joined"
+	case InstanceStateUnresponsive:
+		return "unresponsive"
+	case InstanceStateStopping:
+		return "stopping"
+	case InstanceStateErrored:
+		return "errored"
+	default:
+		return "unknown"
+	}
+}
+
+// instanceStateTransitions lists the states which each state may move to.
+var instanceStateTransitions = map[InstanceState][]InstanceState{
+	InstanceStateStopped: {
+		InstanceStateUnformatted, InstanceStateStarting, InstanceStateErrored,
+	},
+	InstanceStateUnformatted: {
+		InstanceStateAwaitingFormat, InstanceStateStarting, InstanceStateErrored,
+	},
+	InstanceStateAwaitingFormat: {
+		InstanceStateStopped, InstanceStateStarting, InstanceStateErrored,
+	},
+	InstanceStateStarting: {
+		InstanceStateReady, InstanceStateStopping, InstanceStateErrored,
+	},
+	InstanceStateReady: {
+		InstanceStateJoined, InstanceStateUnresponsive, InstanceStateStopping,
+		InstanceStateErrored,
+	},
+	InstanceStateJoined: {
+		InstanceStateUnresponsive, InstanceStateStopping, InstanceStateErrored,
+	},
+	InstanceStateUnresponsive: {
+		InstanceStateReady, InstanceStateJoined, InstanceStateStopping,
+		InstanceStateErrored,
+	},
+	InstanceStateStopping: {
+		InstanceStateStopped, InstanceStateErrored,
+	},
+	InstanceStateErrored: {
+		InstanceStateStarting, InstanceStateStopping, InstanceStateStopped,
+	},
+}
+
+// canTransition returns true if the state machine permits a move from this
+// state to the given one.
+func (is InstanceState) canTransition(to InstanceState) bool {
+	for _, state := range instanceStateTransitions[is] {
+		if state == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// instanceTransition records a change of instance state.
+type instanceTransition struct {
+	from   InstanceState
+	to     InstanceState
+	time   time.Time
+	reason string
+}
+
+// setState moves the instance to the given state, recording the transition
+// and its cause. Transitions which the state machine doesn't permit are
+// logged and ignored.
+func (srv *IOServerInstance) setState(state InstanceState, reason string) {
+	srv.Lock()
+	defer srv.Unlock()
+
+	srv._setState(state, reason)
+}
+
+// replaceState moves the instance to the given state as setState does,
+// returning the state it was in beforehand.
+func (srv *IOServerInstance) replaceState(state InstanceState, reason string) InstanceState {
+	srv.Lock()
+	defer srv.Unlock()
+
+	from := srv._state
+	srv._setState(state, reason)
+
+	return from
+}
+
+// setStateFrom moves the instance to the given state as setState does, but
+// only if it is still in the expected state. It returns false otherwise.
+func (srv *IOServerInstance) setStateFrom(expected, state InstanceState, reason string) bool {
+	srv.Lock()
+	defer srv.Unlock()
+
+	if srv._state != expected {
+		return false
+	}
+	srv._setState(state, reason)
+
+	return true
+}
+
+// _setState performs a state transition, the instance lock must be held.
+func (srv *IOServerInstance) _setState(state InstanceState, reason string) {
+	from := srv._state
+	if from == state {
+		return
+	}
+	if !from.canTransition(state) {
+		srv.log.Errorf("I/O server instance %d: invalid state transition %s -> %s (%s)",
+			srv.Index, from, state, reason)
+		return
+	}
+
+	srv._state = state
+	srv._transitions = append(srv._transitions, instanceTransition{
+		from:   from,
+		to:     state,
+		time:   time.Now(),
+		reason: reason,
+	})
+	if len(srv._transitions) > maxInstanceTransitions {
+		srv._transitions = srv._transitions[len(srv._transitions)-maxInstanceTransitions:]
+	}
+
+	srv.log.Debugf("I/O server instance %d: %s -> %s (%s)", srv.Index, from, state, reason)
+}
+
+// getState returns the current instance state and a copy of its most recent
+// transitions, oldest first.
+func (srv *IOServerInstance) getState() (InstanceState, []instanceTransition) {
+	srv.RLock()
+	defer srv.RUnlock()
+
+	transitions := make([]instanceTransition, len(srv._transitions))
+	copy(transitions, srv._transitions)
+
+	return srv._state, transitions
+}
@@ -0,0 +1,120 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	. "github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/ioserver"
+)
+
+func TestIOServerInstanceSetState(t *testing.T) {
+	for name, tc := range map[string]struct {
+		states   []InstanceState
+		expState InstanceState
+		expPath  []InstanceState
+	}{
+		"normal startup": {
+			states: []InstanceState{
+				InstanceStateUnformatted, InstanceStateAwaitingFormat,
+				InstanceStateStarting, InstanceStateReady, InstanceStateJoined,
+			},
+			expState: InstanceStateJoined,
+			expPath: []InstanceState{
+				InstanceStateStopped, InstanceStateUnformatted, InstanceStateAwaitingFormat,
+				InstanceStateStarting, InstanceStateReady, InstanceStateJoined,
+			},
+		},
+		"restart after error": {
+			states: []InstanceState{
+				InstanceStateStarting, InstanceStateErrored, InstanceStateStarting,
+			},
+			expState: InstanceStateStarting,
+			expPath: []InstanceState{
+				InstanceStateStopped, InstanceStateStarting,
+				InstanceStateErrored, InstanceStateStarting,
+			},
+		},
+		"repeated state ignored": {
+			states: []InstanceState{
+				InstanceStateStarting, InstanceStateStarting,
+			},
+			expState: InstanceStateStarting,
+			expPath:  []InstanceState{InstanceStateStopped, InstanceStateStarting},
+		},
+		"invalid transition ignored": {
+			states: []InstanceState{
+				InstanceStateStarting, InstanceStateJoined, InstanceStateReady,
+			},
+			expState: InstanceStateReady,
+			expPath: []InstanceState{
+				InstanceStateStopped, InstanceStateStarting, InstanceStateReady,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			r := ioserver.NewRunner(log, ioserver.NewConfig())
+			srv := NewIOServerInstance(nil, log, nil, nil, r)
+
+			for i, state := range tc.states {
+				srv.setState(state, fmt.Sprintf("step %d", i))
+			}
+
+			state, transitions := srv.getState()
+			AssertEqual(t, state, tc.expState, "unexpected state")
+			AssertEqual(t, len(transitions), len(tc.expPath)-1, "unexpected number of transitions")
+			for i, tr := range transitions {
+				AssertEqual(t, tr.from, tc.expPath[i], "unexpected transition origin")
+				AssertEqual(t, tr.to, tc.expPath[i+1], "unexpected transition destination")
+				AssertFalse(t, tr.time.IsZero(), "transition time not recorded")
+			}
+		})
+	}
+}
+
+func TestIOServerInstanceTransitionHistory(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	r := ioserver.NewRunner(log, ioserver.NewConfig())
+	srv := NewIOServerInstance(nil, log, nil, nil, r)
+
+	restarts := maxInstanceTransitions
+	srv.setState(InstanceStateStarting, "start")
+	for i := 0; i < restarts; i++ {
+		srv.setState(InstanceStateErrored, fmt.Sprintf("exit %d", i))
+		srv.setState(InstanceStateStarting, fmt.Sprintf("restart %d", i))
+	}
+
+	_, transitions := srv.getState()
+	AssertEqual(t, len(transitions), maxInstanceTransitions, "history not trimmed")
+	AssertEqual(t, transitions[len(transitions)-1].reason,
+		fmt.Sprintf("restart %d", restarts-1), "most recent transition not kept")
+}
@@ -0,0 +1,164 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	. "github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/ioserver"
+)
+
+func TestIOServerInstancePrepShutdown(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp    *mgmtpb.DaosResp
+		sendErr error
+		expErr  string
+	}{
+		"success": {
+			resp: &mgmtpb.DaosResp{},
+		},
+		"failure status": {
+			resp:   &mgmtpb.DaosResp{Status: -1},
+			expErr: "PrepShutdown: -1",
+		},
+		"unreachable": {
+			sendErr: errors.New("connection refused"),
+			expErr:  "send message: connection refused",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			r := ioserver.NewRunner(log, ioserver.NewConfig())
+			srv := NewIOServerInstance(nil, log, nil, nil, r)
+
+			client := newMockDrpcClient()
+			if tc.resp != nil {
+				body, err := proto.Marshal(tc.resp)
+				if err != nil {
+					t.Fatal(err)
+				}
+				client.setSendMsgResponse(drpc.Status_SUCCESS, body)
+			}
+			client.SendMsgOutputError = tc.sendErr
+			srv.drpcClient = client
+
+			err := srv.prepShutdown(context.Background())
+			if tc.expErr != "" {
+				ExpectError(t, err, tc.expErr, name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			AssertEqual(t, client.SendMsgInputCall.Method, int32(prepShutdown), "unexpected dRPC method")
+		})
+	}
+}
+
+func TestIOServerInstanceSetLogMask(t *testing.T) {
+	for name, tc := range map[string]struct {
+		state    InstanceState
+		mask     string
+		resp     *mgmtpb.DaosResp
+		expCall  bool
+		expMasks string
+		expErr   string
+	}{
+		"not running": {
+			state: InstanceStateStopped,
+			mask:  "DEBUG",
+		},
+		"joined": {
+			state:    InstanceStateJoined,
+			mask:     "DEBUG",
+			resp:     &mgmtpb.DaosResp{},
+			expCall:  true,
+			expMasks: "DEBUG",
+		},
+		"mask removed": {
+			state:    InstanceStateJoined,
+			resp:     &mgmtpb.DaosResp{},
+			expCall:  true,
+			expMasks: ioserver.DefaultLogMask,
+		},
+		"failure status": {
+			state:   InstanceStateReady,
+			mask:    "DEBUG",
+			resp:    &mgmtpb.DaosResp{Status: -1003},
+			expCall: true,
+			expErr:  "SetLogMasks: -1003",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			r := ioserver.NewRunner(log, ioserver.NewConfig().WithLogMask("ERR"))
+			srv := NewIOServerInstance(nil, log, nil, nil, r)
+			srv._state = tc.state
+
+			client := newMockDrpcClient()
+			if tc.resp != nil {
+				body, err := proto.Marshal(tc.resp)
+				if err != nil {
+					t.Fatal(err)
+				}
+				client.setSendMsgResponse(drpc.Status_SUCCESS, body)
+			}
+			srv.drpcClient = client
+
+			err := srv.SetLogMask(tc.mask)
+			AssertEqual(t, r.LogMask(), tc.mask, "log mask not recorded")
+			if tc.expErr != "" {
+				ExpectError(t, err, tc.expErr, name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !tc.expCall {
+				AssertEqual(t, client.SendMsgInputCall, (*drpc.Call)(nil), "unexpected dRPC call")
+				return
+			}
+			AssertEqual(t, client.SendMsgInputCall.Method, int32(setLogMasks), "unexpected dRPC method")
+			req := &mgmtpb.SetLogMasksReq{}
+			if err := proto.Unmarshal(client.SendMsgInputCall.Body, req); err != nil {
+				t.Fatal(err)
+			}
+			AssertEqual(t, req.Masks, tc.expMasks, "unexpected log masks sent")
+		})
+	}
+}
@@ -25,6 +25,7 @@ package ioserver
 
 import (
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -33,6 +34,10 @@ import (
 
 const (
 	maxHelperStreamCount = 2
+
+	// DefaultLogMask is the log mask I/O servers use when D_LOG_MASK
+	// isn't set.
+	DefaultLogMask = "INFO"
 )
 
 // StorageConfig encapsulates an I/O server's storage configuration.
@@ -136,6 +141,8 @@ type Config struct {
 	Fabric            FabricConfig  `yaml:",inline"`
 	EnvVars           []string      `yaml:"env_vars,omitempty"`
 	Index             int           `yaml:"-"`
+	PMIxCompat        bool          `yaml:"-"`
+	ShutdownTimeout   time.Duration `yaml:"-"`
 }
 
 // NewConfig returns an I/O server config.
@@ -168,6 +175,36 @@ func (c *Config) CmdLineArgs() ([]string, error) {
 	return parseCmdTags(c, shortFlagTag, joinShortArgs, nil)
 }
 
+// pmixEnvPrefixes are the prefixes of environment variables set by PMIx
+// launchers such as orterun.
+var pmixEnvPrefixes = []string{"PMIX_", "OMPI_", "ORTE_"}
+
+// InheritedEnv filters the given daos_server environment down to the
+// variables to be passed on to the I/O server. Unless PMIx compatibility
+// mode is enabled, variables set by a PMIx launcher are dropped so that the
+// I/O server starts without PMIx.
+func (c *Config) InheritedEnv(env []string) []string {
+	if c.PMIxCompat {
+		return env
+	}
+
+	inherited := make([]string, 0, len(env))
+	for _, pair := range env {
+		isPMIx := false
+		for _, prefix := range pmixEnvPrefixes {
+			if strings.HasPrefix(pair, prefix) {
+				isPMIx = true
+				break
+			}
+		}
+		if !isPMIx {
+			inherited = append(inherited, pair)
+		}
+	}
+
+	return inherited
+}
+
 // CmdLineEnv returns a slice of environment variables to be
 // supplied when starting an I/O server instance.
 func (c *Config) CmdLineEnv() ([]string, error) {
@@ -188,6 +225,20 @@ func (c *Config) WithEnvVars(newVars ...string) *Config {
 	return c
 }
 
+// WithPMIxCompat sets whether the instance may be launched in the
+// deprecated PMIx compatibility mode.
+func (c *Config) WithPMIxCompat(compat bool) *Config {
+	c.PMIxCompat = compat
+	return c
+}
+
+// WithShutdownTimeout sets how long the instance is given to exit after
+// SIGTERM before it is killed.
+func (c *Config) WithShutdownTimeout(timeout time.Duration) *Config {
+	c.ShutdownTimeout = timeout
+	return c
+}
+
 // WithRank sets the instance rank.
 func (c *Config) WithRank(r uint32) *Config {
 	c.Rank = NewRankPtr(r)
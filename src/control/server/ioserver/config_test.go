@@ -88,6 +88,38 @@ func TestMergeEnvVars(t *testing.T) {
 	}
 }
 
+func TestInheritedEnv(t *testing.T) {
+	env := []string{
+		"PATH=/usr/bin",
+		"PMIX_RANK=0",
+		"OMPI_COMM_WORLD_RANK=0",
+		"ORTE_HNP_URI=uri",
+		"CRT_TIMEOUT=30",
+	}
+
+	for name, tc := range map[string]struct {
+		pmixCompat bool
+		wantVars   []string
+	}{
+		"launcher vars dropped": {
+			wantVars: []string{"PATH=/usr/bin", "CRT_TIMEOUT=30"},
+		},
+		"launcher vars kept in compat mode": {
+			pmixCompat: true,
+			wantVars:   env,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := NewConfig().WithPMIxCompat(tc.pmixCompat)
+
+			gotVars := cfg.InheritedEnv(env)
+			if diff := cmp.Diff(gotVars, tc.wantVars, cmpOpts()...); diff != "" {
+				t.Fatalf("(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestConstructedConfig(t *testing.T) {
 	goldenPath := "testdata/full.golden"
 
@@ -28,7 +28,9 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"sync"
 	"syscall"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -40,6 +42,13 @@ const (
 
 	// NormalExit indicates that the process exited without error
 	NormalExit ExitStatus = "process exited with 0"
+
+	// DefaultShutdownTimeout is how long a process is given to exit after
+	// SIGTERM before it is killed, if not configured.
+	DefaultShutdownTimeout = 30 * time.Second
+
+	// killTimeout is how long a process is given to exit after SIGKILL.
+	killTimeout = 5 * time.Second
 )
 
 type (
@@ -51,6 +60,11 @@ type (
 	Runner struct {
 		Config *Config
 		log    logging.Logger
+
+		sync.RWMutex
+		process    *os.Process
+		exited     chan struct{} // closed when process exits
+		stopReason string
 	}
 )
 
@@ -76,52 +90,189 @@ func NewRunner(log logging.Logger, config *Config) *Runner {
 	}
 }
 
-func (r *Runner) run(ctx context.Context, args, env []string) error {
+func (r *Runner) run(ctx context.Context, cfg *Config, args, env []string) error {
 	binPath, err := findBinary(ioServerBin)
 	if err != nil {
 		return errors.Wrapf(err, "can't start %s", ioServerBin)
 	}
 
-	cmd := exec.CommandContext(ctx, binPath, args...)
+	cmd := exec.Command(binPath, args...)
 	cmd.Stdout = &cmdLogger{
 		logFn:  r.log.Info,
-		prefix: fmt.Sprintf("%s:%d", ioServerBin, r.Config.Index),
+		prefix: fmt.Sprintf("%s:%d", ioServerBin, cfg.Index),
 	}
 	cmd.Stderr = &cmdLogger{
 		logFn:  r.log.Error,
-		prefix: fmt.Sprintf("%s:%d", ioServerBin, r.Config.Index),
+		prefix: fmt.Sprintf("%s:%d", ioServerBin, cfg.Index),
 	}
-	// FIXME(DAOS-3105): This shouldn't be the default. The command environment
-	// should be constructed from values in the configuration. This probably
-	// can't go away until PMIx support is removed, though.
-	cmd.Env = mergeEnvVars(os.Environ(), env)
+	// TODO(DAOS-3105): The command environment should be constructed
+	// entirely from values in the configuration, the daos_server
+	// environment is only inherited (minus any PMIx launcher settings)
+	// for variables which can't be configured yet.
+	cmd.Env = mergeEnvVars(cfg.InheritedEnv(os.Environ()), env)
 
-	// I/O server should get a SIGKILL if this process dies.
+	// I/O server should shut down if this process dies.
 	cmd.SysProcAttr = &syscall.SysProcAttr{
-		Pdeathsig: syscall.SIGKILL,
+		Pdeathsig: syscall.SIGTERM,
+	}
+
+	r.log.Debugf("%s:%d config: %#v", ioServerBin, cfg.Index, cfg)
+	r.log.Debugf("%s:%d args: %s", ioServerBin, cfg.Index, args)
+	r.log.Debugf("%s:%d env: %s", ioServerBin, cfg.Index, env)
+	r.log.Infof("Starting I/O server instance %d: %s", cfg.Index, binPath)
+	if err := cmd.Start(); err != nil {
+		return errors.Wrapf(err, "%s (instance %d) failed to start", binPath, cfg.Index)
+	}
+	exited := make(chan struct{})
+	r.setProcess(cmd.Process, exited)
+
+	// Orderly shutdown is left to the caller, the context only kills a
+	// process which is still running when it is cancelled.
+	go func() {
+		select {
+		case <-ctx.Done():
+			if err := r.Stop("context canceled", true); err != nil {
+				r.log.Errorf("%s (instance %d): %s", binPath, cfg.Index, err)
+			}
+		case <-exited:
+		}
+	}()
+
+	exitErr := exitStatus(cmd.Wait())
+	reason := r.setProcess(nil, nil)
+	close(exited)
+
+	if reason != "" {
+		return errors.Wrapf(exitErr, "%s (instance %d) exited (%s)", binPath, cfg.Index, reason)
+	}
+	return errors.Wrapf(exitErr, "%s (instance %d) exited", binPath, cfg.Index)
+}
+
+// setProcess records the running process, returning the reason the
+// previous one was stopped for, if any.
+func (r *Runner) setProcess(p *os.Process, exited chan struct{}) string {
+	r.Lock()
+	defer r.Unlock()
+
+	reason := r.stopReason
+	r.process = p
+	r.exited = exited
+	r.stopReason = ""
+
+	return reason
+}
+
+func (r *Runner) setStopReason(reason string) {
+	r.Lock()
+	defer r.Unlock()
+	r.stopReason = reason
+}
+
+func (r *Runner) shutdownTimeout() time.Duration {
+	if r.Config.ShutdownTimeout > 0 {
+		return r.Config.ShutdownTimeout
+	}
+	return DefaultShutdownTimeout
+}
+
+// IsRunning indicates whether the IOServer process is running.
+func (r *Runner) IsRunning() bool {
+	r.RLock()
+	defer r.RUnlock()
+	return r.process != nil
+}
+
+// Signal sends the given signal to the running IOServer process.
+func (r *Runner) Signal(sig os.Signal) error {
+	r.RLock()
+	defer r.RUnlock()
+
+	if r.process == nil {
+		return errors.Errorf("%s (instance %d) not running", ioServerBin, r.Config.Index)
 	}
 
-	r.log.Debugf("%s:%d config: %#v", ioServerBin, r.Config.Index, r.Config)
-	r.log.Debugf("%s:%d args: %s", ioServerBin, r.Config.Index, args)
-	r.log.Debugf("%s:%d env: %s", ioServerBin, r.Config.Index, env)
-	r.log.Infof("Starting I/O server instance %d: %s", r.Config.Index, binPath)
-	return errors.Wrapf(exitStatus(cmd.Run()), "%s (instance %d) exited", binPath, r.Config.Index)
+	return r.process.Signal(sig)
+}
+
+// Stop stops the running IOServer process and waits for it to exit. The
+// process is sent SIGTERM and only killed with SIGKILL if it is still
+// running after the shutdown timeout, or immediately if force is set. The
+// given reason is included in the exit error reported for the process.
+func (r *Runner) Stop(reason string, force bool) error {
+	r.Lock()
+	process, exited := r.process, r.exited
+	if process == nil {
+		r.Unlock()
+		return nil
+	}
+	r.stopReason = reason
+	r.Unlock()
+
+	timeout := r.shutdownTimeout()
+	if !force {
+		if err := process.Signal(syscall.SIGTERM); err != nil && r.IsRunning() {
+			return errors.Wrap(err, "terminate")
+		}
+		select {
+		case <-exited:
+			r.log.Infof("%s (instance %d) stopped (%s)", ioServerBin, r.Config.Index, reason)
+			return nil
+		case <-time.After(timeout):
+		}
+
+		reason = fmt.Sprintf("%s, killed after %s", reason, timeout)
+		r.setStopReason(reason)
+		r.log.Infof("%s (instance %d) still running %s after SIGTERM, killing",
+			ioServerBin, r.Config.Index, timeout)
+	}
+
+	if err := process.Signal(syscall.SIGKILL); err != nil && r.IsRunning() {
+		return errors.Wrap(err, "kill")
+	}
+	select {
+	case <-exited:
+	case <-time.After(killTimeout):
+		return errors.Errorf("%s (instance %d) still running %s after SIGKILL",
+			ioServerBin, r.Config.Index, killTimeout)
+	}
+	r.log.Infof("%s (instance %d) stopped (%s)", ioServerBin, r.Config.Index, reason)
+
+	return nil
+}
+
+// SetLogMask sets the log mask the IOServer process is started with. The
+// mask may be changed while the process is being (re)started.
+func (r *Runner) SetLogMask(mask string) {
+	r.Lock()
+	defer r.Unlock()
+	r.Config.LogMask = mask
+}
+
+// LogMask returns the log mask the IOServer process is started with.
+func (r *Runner) LogMask() string {
+	r.RLock()
+	defer r.RUnlock()
+	return r.Config.LogMask
 }
 
 // Start asynchronously starts the IOServer instance
 // and reports any errors on the output channel
 func (r *Runner) Start(ctx context.Context, errOut chan<- error) error {
-	args, err := r.Config.CmdLineArgs()
+	r.RLock()
+	cfg := *r.Config
+	r.RUnlock()
+
+	args, err := cfg.CmdLineArgs()
 	if err != nil {
 		return err
 	}
-	env, err := r.Config.CmdLineEnv()
+	env, err := cfg.CmdLineEnv()
 	if err != nil {
 		return err
 	}
 
 	go func() {
-		errOut <- r.run(ctx, args, env)
+		errOut <- r.run(ctx, &cfg, args, env)
 	}()
 
 	return nil
@@ -29,9 +29,11 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"path"
 	"path/filepath"
 	"strings"
+	"syscall"
 	"testing"
 	"time"
 
@@ -46,6 +48,8 @@ const (
 	testSep     = "==="
 	testArgsStr = "IOSERVER_TEST_ARGS"
 	testEnvStr  = "IOSERVER_TEST_ENV"
+
+	testIgnoreTerm = "ignoring SIGTERM"
 )
 
 func TestMain(m *testing.M) {
@@ -57,9 +61,17 @@ func TestMain(m *testing.M) {
 		fmt.Printf("%s%s%s\n", testEnvStr, testSep, strings.Join(os.Environ(), " "))
 		fmt.Printf("%s%s%s\n", testArgsStr, testSep, strings.Join(os.Args[1:], " "))
 		os.Exit(0)
+	case "RunnerSignalExit":
+		time.Sleep(30 * time.Second)
+		os.Exit(0)
 	case "RunnerContextExit":
 		time.Sleep(30 * time.Second)
 		os.Exit(1)
+	case "RunnerIgnoreTerm":
+		signal.Ignore(syscall.SIGTERM)
+		fmt.Println(testIgnoreTerm)
+		time.Sleep(30 * time.Second)
+		os.Exit(0)
 	}
 }
 
@@ -111,12 +123,112 @@ func TestRunnerContextExit(t *testing.T) {
 	if err := runner.Start(ctx, errOut); err != nil {
 		t.Fatal(err)
 	}
+	for !runner.IsRunning() {
+		time.Sleep(10 * time.Millisecond)
+	}
 	cancel()
 
 	exitErr := <-errOut
 	if errors.Cause(exitErr) == NormalExit {
 		t.Fatal("expected process to not exit normally")
 	}
+	common.AssertTrue(t, strings.Contains(exitErr.Error(), "(context canceled)"),
+		fmt.Sprintf("expected stop reason in exit error %q", exitErr))
+	common.AssertTrue(t, strings.Contains(exitErr.Error(), "signal: killed"),
+		fmt.Sprintf("expected process to be killed, got %q", exitErr))
+}
+
+func TestRunnerSignalExit(t *testing.T) {
+	createFakeBinary(t)
+
+	// set this to control the behavior in TestMain()
+	os.Setenv(testModeVar, "RunnerSignalExit")
+
+	log, buf := logging.NewTestLogger(t.Name())
+	defer common.ShowBufferOnFailure(t, buf)()
+
+	runner := NewRunner(log, NewConfig())
+	errOut := make(chan error)
+
+	if err := runner.Signal(syscall.SIGTERM); err == nil {
+		t.Fatal("expected error signalling runner which isn't started")
+	}
+
+	if err := runner.Start(context.Background(), errOut); err != nil {
+		t.Fatal(err)
+	}
+	for !runner.IsRunning() {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if err := runner.Signal(syscall.SIGTERM); err != nil {
+		t.Fatal(err)
+	}
+
+	exitErr := <-errOut
+	if errors.Cause(exitErr) == NormalExit {
+		t.Fatal("expected process to not exit normally")
+	}
+	common.AssertTrue(t, !runner.IsRunning(), "expected runner to not be running after exit")
+}
+
+func TestRunnerStop(t *testing.T) {
+	for name, tc := range map[string]struct {
+		mode      string
+		force     bool
+		expReason string
+		expStatus string
+	}{
+		"terminated": {
+			mode:      "RunnerSignalExit",
+			expReason: "(stop requested)",
+			expStatus: "signal: terminated",
+		},
+		"killed after timeout": {
+			mode:      "RunnerIgnoreTerm",
+			expReason: "(stop requested, killed after 100ms)",
+			expStatus: "signal: killed",
+		},
+		"forced": {
+			mode:      "RunnerIgnoreTerm",
+			force:     true,
+			expReason: "(stop requested)",
+			expStatus: "signal: killed",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			createFakeBinary(t)
+
+			// set this to control the behavior in TestMain()
+			os.Setenv(testModeVar, tc.mode)
+
+			log, buf := logging.NewTestLogger(t.Name())
+			defer common.ShowBufferOnFailure(t, buf)()
+
+			runner := NewRunner(log, NewConfig().WithShutdownTimeout(100*time.Millisecond))
+			errOut := make(chan error, 1)
+
+			if err := runner.Stop("stop requested", tc.force); err != nil {
+				t.Fatalf("expected no error stopping runner which isn't started, got %s", err)
+			}
+
+			if err := runner.Start(context.Background(), errOut); err != nil {
+				t.Fatal(err)
+			}
+			for !runner.IsRunning() || tc.mode == "RunnerIgnoreTerm" && !strings.Contains(buf.String(), testIgnoreTerm) {
+				time.Sleep(10 * time.Millisecond)
+			}
+			if err := runner.Stop("stop requested", tc.force); err != nil {
+				t.Fatal(err)
+			}
+			common.AssertTrue(t, !runner.IsRunning(), "expected runner to not be running after stop")
+
+			exitErr := <-errOut
+			common.AssertTrue(t, strings.Contains(exitErr.Error(), tc.expReason),
+				fmt.Sprintf("expected %q in exit error %q", tc.expReason, exitErr))
+			common.AssertTrue(t, strings.Contains(exitErr.Error(), tc.expStatus),
+				fmt.Sprintf("expected %q in exit error %q", tc.expStatus, exitErr))
+		})
+	}
 }
 
 func TestRunnerNormalExit(t *testing.T) {
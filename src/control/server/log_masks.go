@@ -0,0 +1,254 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/ioserver"
+)
+
+// ioServerLogLevels maps control plane log levels to D_LOG_MASK priorities.
+var ioServerLogLevels = map[logging.LogLevel]string{
+	logging.LogLevelDebug: "DEBUG",
+	logging.LogLevelInfo:  "INFO",
+	logging.LogLevelError: "ERR",
+}
+
+// logMasksSetter changes the log level of daos_server and the log masks of
+// its I/O server instances at runtime.
+//
+// Changes with a time limit are reverted once it expires, restoring the
+// daos_server log level and I/O server masks which were in use before the
+// first of any overlapping temporary changes. Changes without a time limit
+// are recorded in the instance configuration so that they persist across
+// restarts.
+type logMasksSetter struct {
+	sync.Mutex
+	log        *logging.LeveledLogger
+	harness    *IOServerHarness
+	prevLevel  logging.LogLevel
+	prevMasks  map[*IOServerInstance]string // I/O server masks to restore
+	revert     *time.Timer
+	generation int // identifies the active revert timer
+}
+
+func newLogMasksSetter(log *logging.LeveledLogger, harness *IOServerHarness) *logMasksSetter {
+	return &logMasksSetter{
+		log:     log,
+		harness: harness,
+	}
+}
+
+// ioServerLogMasks returns the D_LOG_MASK string which sets the given level
+// for the subsystems, or for all subsystems if none are given.
+func ioServerLogMasks(level logging.LogLevel, subsystems []string) string {
+	priority := ioServerLogLevels[level]
+	if len(subsystems) == 0 {
+		return priority
+	}
+
+	masks := make([]string, 0, len(subsystems))
+	for _, subsystem := range subsystems {
+		masks = append(masks, fmt.Sprintf("%s=%s", subsystem, priority))
+	}
+
+	return strings.Join(masks, ",")
+}
+
+// selectInstances returns the instances with the given ranks, or all
+// instances if no ranks are given.
+func (s *logMasksSetter) selectInstances(ranks []uint32) []*IOServerInstance {
+	if len(ranks) == 0 {
+		return s.harness.Instances()
+	}
+
+	var selected []*IOServerInstance
+	for _, rank := range ranks {
+		srv, err := s.harness.GetInstanceByRank(ioserver.Rank(rank))
+		if err != nil {
+			continue // rank not managed by this server
+		}
+		selected = append(selected, srv)
+	}
+
+	return selected
+}
+
+// instanceLogMasks returns the log masks the instance's I/O server uses when
+// no temporary change is in effect.
+func instanceLogMasks(srv *IOServerInstance) string {
+	if masks := srv.runner.LogMask(); masks != "" {
+		return masks
+	}
+	return ioserver.DefaultLogMask
+}
+
+// setInstanceMasks sends masks to each of the instances and reports the
+// outcome. Permanent masks are also recorded for instances which aren't
+// ready, to be applied when they start.
+func setInstanceMasks(instances []*IOServerInstance, masks string, permanent bool) []*pb.InstanceLogMasks {
+	results := make([]*pb.InstanceLogMasks, 0, len(instances))
+	for _, srv := range instances {
+		rank, err := srv.GetRank()
+		if err != nil {
+			rank = ioserver.NilRank
+		}
+		result := &pb.InstanceLogMasks{
+			Index: uint32(srv.Index),
+			Rank:  uint32(rank),
+			Masks: masks,
+		}
+
+		switch {
+		case permanent:
+			err = srv.SetLogMask(masks)
+		case !srv.isReady():
+			state, _ := srv.getState()
+			err = errors.Errorf("instance not ready (%s)", state)
+		default:
+			err = srv.callSetLogMasks(masks)
+		}
+		if err != nil {
+			result.Error = err.Error()
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// setLogMasks applies the requested log level. The daos_server log level is
+// only changed if no I/O server subsystems are specified, and nothing is
+// changed if none of the requested ranks are managed by this server.
+func (s *logMasksSetter) setLogMasks(req *pb.ServerSetLogMasksReq) (*pb.ServerSetLogMasksResp, error) {
+	var level logging.LogLevel
+	if err := level.SetString(req.Level); err != nil {
+		return nil, err
+	}
+	if _, exists := ioServerLogLevels[level]; !exists {
+		return nil, errors.Errorf("log level %s can't be set", level)
+	}
+	for _, subsystem := range req.Subsystems {
+		if subsystem == "" || strings.ContainsAny(subsystem, ",= ") {
+			return nil, errors.Errorf("invalid subsystem %q", subsystem)
+		}
+	}
+	if req.Duration < 0 {
+		return nil, errors.New("duration must not be negative")
+	}
+
+	resp := new(pb.ServerSetLogMasksResp)
+	instances := s.selectInstances(req.Ranks)
+	if len(req.Ranks) > 0 && len(instances) == 0 {
+		return resp, nil
+	}
+
+	s.Lock()
+	defer s.Unlock()
+
+	permanent := req.Duration == 0
+	if permanent {
+		// Nothing a pending revert would restore is changed back
+		// over a permanent change.
+		if len(req.Subsystems) == 0 {
+			s.prevLevel = level
+		}
+		for _, srv := range instances {
+			delete(s.prevMasks, srv)
+		}
+	} else {
+		// A new temporary change supersedes any pending revert, but
+		// the levels in use before the first temporary change are
+		// still the ones to go back to.
+		if s.revert != nil {
+			s.revert.Stop()
+		} else {
+			s.prevLevel = s.log.Level()
+			s.prevMasks = make(map[*IOServerInstance]string)
+		}
+		for _, srv := range instances {
+			if _, recorded := s.prevMasks[srv]; !recorded {
+				s.prevMasks[srv] = instanceLogMasks(srv)
+			}
+		}
+	}
+
+	if len(req.Subsystems) == 0 {
+		s.log.SetLevel(level)
+		resp.ControlLevel = level.String()
+	}
+
+	masks := ioServerLogMasks(level, req.Subsystems)
+	resp.Instances = setInstanceMasks(instances, masks, permanent)
+	s.log.Infof("log level set to %s (I/O server masks %q)", level, masks)
+
+	if !permanent {
+		s.generation++
+		duration := time.Duration(req.Duration)
+		generation := s.generation
+		s.revert = time.AfterFunc(duration, func() {
+			s.restore(generation)
+		})
+		resp.RevertTime = time.Now().Add(duration).UnixNano()
+	}
+
+	return resp, nil
+}
+
+// restore reverts temporary log level changes, unless superseded by a later
+// request.
+func (s *logMasksSetter) restore(generation int) {
+	s.Lock()
+	defer s.Unlock()
+
+	if generation != s.generation {
+		return
+	}
+	s.revert = nil
+
+	s.log.SetLevel(s.prevLevel)
+	s.log.Infof("log level time limit expired, restored log level %s", s.prevLevel)
+
+	// instances which aren't running pick up their configured masks
+	// when started
+	for srv, masks := range s.prevMasks {
+		if !srv.isReady() {
+			continue
+		}
+		if err := srv.callSetLogMasks(masks); err != nil {
+			s.log.Errorf("I/O server instance %d: failed to restore log masks %q: %s",
+				srv.Index, masks, err)
+		}
+	}
+	s.prevMasks = nil
+}
@@ -0,0 +1,280 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	. "github.com/daos-stack/daos/src/control/common"
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/ioserver"
+)
+
+// newTestLogMasksSetter returns a logMasksSetter managing a ready instance
+// with rank 0 and a stopped instance with rank 1, along with the dRPC
+// client of the ready instance.
+func newTestLogMasksSetter(t *testing.T, log *logging.LeveledLogger) (*logMasksSetter, *mockDrpcClient) {
+	t.Helper()
+
+	harness := NewIOServerHarness(defaultMockExt(), log)
+	client := newMockDrpcClient()
+	body, err := proto.Marshal(&pb.DaosResp{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.setSendMsgResponse(drpc.Status_SUCCESS, body)
+
+	for i, state := range []InstanceState{InstanceStateJoined, InstanceStateStopped} {
+		r := ioserver.NewRunner(log, ioserver.NewConfig().WithLogMask("WARN"))
+		srv := NewIOServerInstance(harness.ext, log, nil, nil, r)
+		srv.setSuperblock(&Superblock{Rank: ioserver.NewRankPtr(uint32(i))})
+		srv._state = state
+		srv.drpcClient = client
+		if err := harness.AddInstance(srv); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return newLogMasksSetter(log, harness), client
+}
+
+func sentLogMasks(t *testing.T, client *mockDrpcClient) string {
+	t.Helper()
+
+	if client.SendMsgInputCall == nil {
+		return ""
+	}
+	AssertEqual(t, client.SendMsgInputCall.Method, int32(setLogMasks), "unexpected dRPC method")
+	req := &pb.SetLogMasksReq{}
+	if err := proto.Unmarshal(client.SendMsgInputCall.Body, req); err != nil {
+		t.Fatal(err)
+	}
+
+	return req.Masks
+}
+
+func TestServerSetLogMasks(t *testing.T) {
+	notReady := "instance not ready (stopped)"
+
+	for name, tc := range map[string]struct {
+		req            *pb.ServerSetLogMasksReq
+		expResp        *pb.ServerSetLogMasksResp
+		expLevel       logging.LogLevel
+		expSentMasks   string
+		expConfigMasks []string
+		expErr         string
+	}{
+		"all instances": {
+			req: &pb.ServerSetLogMasksReq{Level: "DEBUG"},
+			expResp: &pb.ServerSetLogMasksResp{
+				ControlLevel: "DEBUG",
+				Instances: []*pb.InstanceLogMasks{
+					{Index: 0, Rank: 0, Masks: "DEBUG"},
+					{Index: 1, Rank: 1, Masks: "DEBUG"},
+				},
+			},
+			expLevel:       logging.LogLevelDebug,
+			expSentMasks:   "DEBUG",
+			expConfigMasks: []string{"DEBUG", "DEBUG"},
+		},
+		"subsystems": {
+			req: &pb.ServerSetLogMasksReq{Level: "error", Subsystems: []string{"mgmt", "rdb"}},
+			expResp: &pb.ServerSetLogMasksResp{
+				Instances: []*pb.InstanceLogMasks{
+					{Index: 0, Rank: 0, Masks: "mgmt=ERR,rdb=ERR"},
+					{Index: 1, Rank: 1, Masks: "mgmt=ERR,rdb=ERR"},
+				},
+			},
+			expLevel:       logging.LogLevelInfo,
+			expSentMasks:   "mgmt=ERR,rdb=ERR",
+			expConfigMasks: []string{"mgmt=ERR,rdb=ERR", "mgmt=ERR,rdb=ERR"},
+		},
+		"selected rank": {
+			req: &pb.ServerSetLogMasksReq{Level: "INFO", Ranks: []uint32{0, 7}},
+			expResp: &pb.ServerSetLogMasksResp{
+				ControlLevel: "INFO",
+				Instances: []*pb.InstanceLogMasks{
+					{Index: 0, Rank: 0, Masks: "INFO"},
+				},
+			},
+			expLevel:       logging.LogLevelInfo,
+			expSentMasks:   "INFO",
+			expConfigMasks: []string{"INFO", "WARN"},
+		},
+		"temporary": {
+			req: &pb.ServerSetLogMasksReq{Level: "DEBUG", Duration: int64(time.Hour)},
+			expResp: &pb.ServerSetLogMasksResp{
+				ControlLevel: "DEBUG",
+				Instances: []*pb.InstanceLogMasks{
+					{Index: 0, Rank: 0, Masks: "DEBUG"},
+					{Index: 1, Rank: 1, Masks: "DEBUG", Error: notReady},
+				},
+			},
+			expLevel:       logging.LogLevelDebug,
+			expSentMasks:   "DEBUG",
+			expConfigMasks: []string{"WARN", "WARN"},
+		},
+		"ranks not managed": {
+			req:            &pb.ServerSetLogMasksReq{Level: "DEBUG", Ranks: []uint32{7}},
+			expResp:        &pb.ServerSetLogMasksResp{},
+			expLevel:       logging.LogLevelInfo,
+			expConfigMasks: []string{"WARN", "WARN"},
+		},
+		"invalid level": {
+			req:    &pb.ServerSetLogMasksReq{Level: "verbose"},
+			expErr: "\"verbose\" is not a valid log level",
+		},
+		"disabled level": {
+			req:    &pb.ServerSetLogMasksReq{Level: "DISABLED"},
+			expErr: "log level DISABLED can't be set",
+		},
+		"invalid subsystem": {
+			req:    &pb.ServerSetLogMasksReq{Level: "DEBUG", Subsystems: []string{"mgmt=ERR"}},
+			expErr: "invalid subsystem \"mgmt=ERR\"",
+		},
+		"negative duration": {
+			req:    &pb.ServerSetLogMasksReq{Level: "DEBUG", Duration: -1},
+			expErr: "duration must not be negative",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+			log.SetLevel(logging.LogLevelInfo)
+
+			cs := &ControlService{}
+			cs.logMasks, _ = newTestLogMasksSetter(t, log)
+			client := cs.logMasks.harness.Instances()[0].drpcClient.(*mockDrpcClient)
+
+			resp, err := cs.ServerSetLogMasks(context.TODO(), tc.req)
+			if cs.logMasks.revert != nil {
+				cs.logMasks.revert.Stop()
+			}
+			if tc.expErr != "" {
+				ExpectError(t, err, "set log masks: "+tc.expErr, name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tc.req.Duration > 0 {
+				AssertTrue(t, resp.RevertTime > 0, "revert time not set")
+				resp.RevertTime = 0
+			}
+			AssertEqual(t, resp, tc.expResp, "unexpected response")
+			AssertEqual(t, log.Level(), tc.expLevel, "unexpected control log level")
+			AssertEqual(t, sentLogMasks(t, client), tc.expSentMasks, "unexpected log masks sent")
+			for i, srv := range cs.logMasks.harness.Instances() {
+				AssertEqual(t, srv.runner.LogMask(), tc.expConfigMasks[i],
+					fmt.Sprintf("unexpected configured log masks for instance %d", i))
+			}
+		})
+	}
+}
+
+// awaitRestore waits for any pending revert of log levels to complete.
+func awaitRestore(t *testing.T, s *logMasksSetter) {
+	t.Helper()
+
+	timeout := time.After(5 * time.Second)
+	for {
+		s.Lock()
+		done := s.revert == nil
+		s.Unlock()
+		if done {
+			return
+		}
+
+		select {
+		case <-timeout:
+			t.Fatal("timed out waiting for log levels to be restored")
+		case <-time.After(time.Millisecond):
+		}
+	}
+}
+
+func TestServerSetLogMasksTimeLimit(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+	log.SetLevel(logging.LogLevelInfo)
+
+	s, client := newTestLogMasksSetter(t, log)
+
+	// overlapping temporary changes restore the original levels
+	for _, level := range []string{"DEBUG", "ERROR"} {
+		resp, err := s.setLogMasks(&pb.ServerSetLogMasksReq{
+			Level:    level,
+			Duration: int64(20 * time.Millisecond),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertTrue(t, resp.RevertTime > time.Now().UnixNano(), "revert time not in the future")
+	}
+	AssertEqual(t, log.Level(), logging.LogLevelError, "unexpected control log level")
+
+	awaitRestore(t, s)
+	AssertEqual(t, log.Level(), logging.LogLevelInfo, "control log level not restored")
+	AssertEqual(t, sentLogMasks(t, client), "WARN", "configured log masks not restored")
+
+	// a permanent change isn't reverted
+	if _, err := s.setLogMasks(&pb.ServerSetLogMasksReq{
+		Level:    "DEBUG",
+		Duration: int64(20 * time.Millisecond),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.setLogMasks(&pb.ServerSetLogMasksReq{Level: "ERROR"}); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	s.Lock()
+	AssertEqual(t, log.Level(), logging.LogLevelError, "permanent control log level reverted")
+	AssertEqual(t, sentLogMasks(t, client), "ERR", "permanent log masks reverted")
+	s.Unlock()
+
+	// temporary changes restore the masks in use before them, which the
+	// permanent change replaced
+	if _, err := s.setLogMasks(&pb.ServerSetLogMasksReq{
+		Level:      "DEBUG",
+		Subsystems: []string{"mgmt"},
+		Duration:   int64(20 * time.Millisecond),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	AssertEqual(t, sentLogMasks(t, client), "mgmt=DEBUG", "temporary log masks not sent")
+
+	awaitRestore(t, s)
+	AssertEqual(t, log.Level(), logging.LogLevelError, "unexpected control log level")
+	AssertEqual(t, sentLogMasks(t, client), "ERR", "previous log masks not restored")
+}
@@ -25,30 +25,65 @@ package server
 import (
 	"fmt"
 	"net"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/fault"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security"
 )
 
 const (
 	retryDelay = 3 * time.Second
+	// leaderQueryTimeout bounds the time spent waiting on each access
+	// point when discovering the Management Service leader.
+	leaderQueryTimeout = 10 * time.Second
+	// dialTimeout bounds the time spent connecting to an access point
+	// before failing over to the next one.
+	dialTimeout = 30 * time.Second
 )
 
+// Statuses returned by the Management Service in response to requests
+// (negated DER_* error numbers).
+const (
+	derInval      = -1003
+	derExist      = -1004
+	derUnreach    = -1006
+	derNomem      = -1009
+	derTimedout   = -1011
+	derBusy       = -1012
+	derAgain      = -1013
+	derProto      = -1014
+	derNotLeader  = -2008
+	derShutdown   = -2017
+	derNotReplica = -2020
+)
+
+var errNotLeader = errors.New("not the management service leader")
+
 type (
 	mgmtSvcClientCfg struct {
 		AccessPoints    []string
 		ControlAddr     *net.TCPAddr
 		TransportConfig *security.TransportConfig
+		JoinTimeout     time.Duration
 	}
 	mgmtSvcClient struct {
 		log logging.Logger
 		cfg mgmtSvcClientCfg
+
+		sync.RWMutex
+		leader string // cached address of the MS leader
+		// connections to each access point kept open for heartbeat
+		// reports, which are sent too often to dial each time
+		heartbeatConns map[string]*grpc.ClientConn
 	}
 )
 
@@ -59,12 +94,67 @@ func newMgmtSvcClient(ctx context.Context, log logging.Logger, cfg mgmtSvcClient
 	}
 }
 
+// isRedirect returns true if err indicates that the request was sent to
+// an instance which is not the Management Service leader.
+func isRedirect(err error) bool {
+	cause := errors.Cause(err)
+
+	return cause == errNotLeader || status.Code(cause) == codes.FailedPrecondition
+}
+
+// joinRetryError indicates that a Join request was rejected with a status
+// which may change if the request is retried.
+type joinRetryError struct {
+	status int32
+}
+
+func (err *joinRetryError) Error() string {
+	return fmt.Sprintf("join rejected with retryable status %d", err.status)
+}
+
+// joinStatusError returns the error corresponding to the non-zero status of
+// a Join response. Requests rejected for reasons which retrying won't fix
+// result in a fault.
+func joinStatusError(req *mgmtpb.JoinReq, status int32) error {
+	switch status {
+	case derNotLeader, derNotReplica:
+		return errNotLeader
+	case derTimedout, derBusy, derAgain, derUnreach, derNomem, derShutdown:
+		return &joinRetryError{status: status}
+	case derProto:
+		return FaultJoinRankChanged(req.Rank)
+	case derExist:
+		return FaultJoinRankTaken(req.Rank)
+	default:
+		return FaultJoinRejected(status)
+	}
+}
+
+// isJoinFatal returns true if err indicates that a Join request shouldn't
+// be retried.
+func isJoinFatal(err error) bool {
+	_, ok := errors.Cause(err).(*fault.Fault)
+	return ok
+}
+
 func (msc *mgmtSvcClient) withConnection(ctx context.Context, fn func(context.Context, string, mgmtpb.MgmtSvcClient) error) error {
 	ap, err := msc.LeaderAddress()
 	if err != nil {
 		return err
 	}
 
+	return msc.withConnectionTo(ctx, ap, fn)
+}
+
+func (msc *mgmtSvcClient) withConnectionTo(ctx context.Context, ap string, fn func(context.Context, string, mgmtpb.MgmtSvcClient) error) error {
+	return msc.dial(ctx, ap, func(conn *grpc.ClientConn) error {
+		return fn(ctx, ap, mgmtpb.NewMgmtSvcClient(conn))
+	})
+}
+
+// dial connects to the given access point and calls fn with the connection,
+// which is closed on return.
+func (msc *mgmtSvcClient) dial(ctx context.Context, ap string, fn func(*grpc.ClientConn) error) error {
 	var opts []grpc.DialOption
 	authDialOption, err := security.DialOptionForTransportConfig(msc.cfg.TransportConfig)
 	if err != nil {
@@ -74,63 +164,225 @@ func (msc *mgmtSvcClient) withConnection(ctx context.Context, fn func(context.Co
 	// Setup Dial Options that will always be included.
 	opts = append(opts, grpc.WithBlock(), grpc.WithBackoffMaxDelay(retryDelay),
 		grpc.WithDefaultCallOptions(grpc.FailFast(false)), authDialOption)
-	conn, err := grpc.DialContext(ctx, ap, opts...)
+	dialCtx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+	conn, err := grpc.DialContext(dialCtx, ap, opts...)
 	if err != nil {
 		return errors.Wrapf(err, "dial %s", ap)
 	}
 	defer conn.Close()
 
-	return fn(ctx, ap, mgmtpb.NewMgmtSvcClient(conn))
+	return fn(conn)
 }
 
+// LeaderAddress returns the address of the Management Service leader as
+// learned from the last successful LeaderQuery, or the first access point
+// if the leader has not yet been discovered.
 func (msc *mgmtSvcClient) LeaderAddress() (string, error) {
 	if len(msc.cfg.AccessPoints) == 0 {
 		return "", errors.New("no access points defined")
 	}
 
-	// TODO: Develop algorithm for determining current leader.
-	// For now, just choose the first address.
+	msc.RLock()
+	defer msc.RUnlock()
+
+	if msc.leader != "" {
+		return msc.leader, nil
+	}
+
 	return msc.cfg.AccessPoints[0], nil
 }
 
-func (msc *mgmtSvcClient) Join(ctx context.Context, req *mgmtpb.JoinReq) (resp *mgmtpb.JoinResp, joinErr error) {
-	joinErr = msc.withConnection(ctx, func(ctx context.Context, ap string, pbClient mgmtpb.MgmtSvcClient) error {
-		prefix := fmt.Sprintf("join(%s, %+v)", ap, *req)
-		msc.log.Debugf(prefix + " begin")
-		defer msc.log.Debugf(prefix + " end")
+// failover replaces an unreachable leader with the access point following
+// it in the list, so that the next request is sent to another replica.
+func (msc *mgmtSvcClient) failover(unreachable string) {
+	msc.Lock()
+	defer msc.Unlock()
+
+	aps := msc.cfg.AccessPoints
+	for i, ap := range aps {
+		if withDefaultPort(ap) == withDefaultPort(unreachable) {
+			msc.leader = aps[(i+1)%len(aps)]
+			msc.log.Debugf("%s unreachable, failing over to %s", unreachable, msc.leader)
+			return
+		}
+	}
+	msc.leader = ""
+}
+
+// LeaderQuery asks each access point in turn for the current Management
+// Service leader and replica set, caching the leader address returned by
+// the first access point to respond.
+func (msc *mgmtSvcClient) LeaderQuery(ctx context.Context, req *mgmtpb.LeaderQueryReq) (resp *mgmtpb.LeaderQueryResp, err error) {
+	if len(msc.cfg.AccessPoints) == 0 {
+		return nil, errors.New("no access points defined")
+	}
+
+	for _, ap := range msc.cfg.AccessPoints {
+		apCtx, cancel := context.WithTimeout(ctx, leaderQueryTimeout)
+		err = msc.withConnectionTo(apCtx, ap, func(ctx context.Context, ap string, pbClient mgmtpb.MgmtSvcClient) (err error) {
+			resp, err = pbClient.LeaderQuery(ctx, req)
+			return
+		})
+		cancel()
+
+		if err == nil && resp.CurrentLeader != "" {
+			msc.log.Debugf("leader query(%s): leader %s", ap, resp.CurrentLeader)
+
+			msc.Lock()
+			msc.leader = resp.CurrentLeader
+			msc.Unlock()
+
+			return resp, nil
+		}
+		msc.log.Debugf("leader query(%s): %v", ap, err)
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	if err == nil {
+		err = errors.New("no leader reported")
+	}
+
+	return nil, errors.Wrap(err, "querying management service leader")
+}
+
+// Join requests that the instance be added to the system, retrying until
+// it is accepted, a fatal error is returned or the join deadline passes.
+func (msc *mgmtSvcClient) Join(parent context.Context, req *mgmtpb.JoinReq) (resp *mgmtpb.JoinResp, joinErr error) {
+	if req.Addr == "" {
+		req.Addr = msc.cfg.ControlAddr.String()
+	}
+
+	ctx := parent
+	if msc.cfg.JoinTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(parent, msc.cfg.JoinTimeout)
+		defer cancel()
+	}
 
-		if req.Addr == "" {
-			req.Addr = msc.cfg.ControlAddr.String()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, msc.joinAborted(parent, ctx, req, joinErr)
+		default:
 		}
 
-		for {
-			select {
-			case <-ctx.Done():
-				return errors.Wrap(ctx.Err(), prefix)
-			default:
+		var reached bool
+		joinErr = msc.withConnection(ctx, func(ctx context.Context, ap string, pbClient mgmtpb.MgmtSvcClient) (err error) {
+			reached = true
+			prefix := fmt.Sprintf("join(%s, %+v)", ap, *req)
+			msc.log.Debugf(prefix + " begin")
+			defer msc.log.Debugf(prefix + " end")
+
+			resp, err = pbClient.Join(ctx, req)
+			switch {
+			case err != nil:
+				return errors.Wrap(err, prefix)
+			case resp.Status != 0:
+				return errors.Wrap(joinStatusError(req, resp.Status), prefix)
+			case resp.State == mgmtpb.JoinResp_OUT:
+				return errors.Wrap(FaultJoinExcluded(resp.Rank), prefix)
 			}
 
-			resp, err := pbClient.Join(ctx, req)
+			return nil
+		})
+		if joinErr == nil {
+			return resp, nil
+		}
+		if isJoinFatal(joinErr) {
+			return nil, joinErr
+		}
+
+		switch {
+		case !reached:
+			msc.log.Debugf("%v", joinErr)
+			// Try another replica if the leader can't be reached.
+			ap, err := msc.LeaderAddress()
 			if err != nil {
-				msc.log.Debugf(prefix+": %v", err)
-			} else {
-				// TODO: Stop retrying upon certain errors (e.g., "not
-				// MS", "rank unavailable", and "excluded").
-				if resp.Status != 0 {
-					msc.log.Debugf(prefix+": %d", resp.Status)
-				} else {
-					return nil
-				}
+				return nil, err
+			}
+			msc.failover(ap)
+		case isRedirect(joinErr):
+			msc.log.Debugf("%v", joinErr)
+			// Follow the redirect if the request landed on an
+			// access point which isn't the current leader.
+			if _, err := msc.LeaderQuery(ctx, &mgmtpb.LeaderQueryReq{}); err != nil {
+				msc.log.Debugf("%v", err)
 			}
+		default:
+			msc.log.Infof("%v; retrying", joinErr)
+		}
+
+		// Delay next retry.
+		select {
+		case <-ctx.Done():
+		case <-time.After(retryDelay):
+		}
+	}
+}
+
+// joinAborted returns the error for a Join abandoned because its context
+// ended, which is a fault if the join deadline passed.
+func (msc *mgmtSvcClient) joinAborted(parent, ctx context.Context, req *mgmtpb.JoinReq, lastErr error) error {
+	if parent.Err() == nil && ctx.Err() == context.DeadlineExceeded {
+		return FaultJoinTimeout(msc.cfg.JoinTimeout, lastErr)
+	}
 
-			// Delay next retry.
-			delayCtx, cancelDelayCtx := context.WithTimeout(ctx, retryDelay)
-			select {
-			case <-delayCtx.Done():
+	return errors.Wrap(ctx.Err(), fmt.Sprintf("join(%+v)", *req))
+}
+
+// heartbeatConn returns the connection used to report heartbeats to the
+// given access point, which is opened on first use. The connection isn't
+// waited on, calls made while the access point is unreachable fail at once.
+func (msc *mgmtSvcClient) heartbeatConn(ap string) (*grpc.ClientConn, error) {
+	msc.Lock()
+	defer msc.Unlock()
+
+	if conn, exists := msc.heartbeatConns[ap]; exists {
+		return conn, nil
+	}
+
+	authDialOption, err := security.DialOptionForTransportConfig(msc.cfg.TransportConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to determine dial option from TransportConfig")
+	}
+	conn, err := grpc.Dial(ap, grpc.WithBackoffMaxDelay(retryDelay), authDialOption)
+	if err != nil {
+		return nil, errors.Wrapf(err, "dial %s", ap)
+	}
+
+	if msc.heartbeatConns == nil {
+		msc.heartbeatConns = make(map[string]*grpc.ClientConn)
+	}
+	msc.heartbeatConns[ap] = conn
+
+	return conn, nil
+}
+
+// ReportHeartbeat sends the outcome of the latest heartbeat of an instance
+// to every access point concurrently. Member liveness isn't recorded in the
+// replicated system database, so each access point keeps track of it
+// separately.
+func (msc *mgmtSvcClient) ReportHeartbeat(ctx context.Context, req *mgmtpb.SystemHeartbeatReq) error {
+	errs := make(chan error, len(msc.cfg.AccessPoints))
+	for _, ap := range msc.cfg.AccessPoints {
+		go func(ap string) {
+			conn, err := msc.heartbeatConn(ap)
+			if err == nil {
+				_, err = mgmtpb.NewMgmtCtlClient(conn).SystemHeartbeat(ctx, req)
 			}
-			cancelDelayCtx()
+			errs <- errors.Wrapf(err, "report heartbeat to %s", ap)
+		}(ap)
+	}
+
+	var lastErr error
+	for range msc.cfg.AccessPoints {
+		if err := <-errs; err != nil {
+			lastErr = err
 		}
-	})
+	}
 
-	return
+	return lastErr
 }
@@ -0,0 +1,265 @@
+//
+// (C) Copyright 2018-2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+
+package server
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	. "github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/fault"
+	"github.com/daos-stack/daos/src/control/fault/code"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+)
+
+func TestMgmtSvcClientFailover(t *testing.T) {
+	aps := []string{"host1", "host2:10001", "host3"}
+
+	for name, tc := range map[string]struct {
+		leader      string
+		unreachable string
+		expLeader   string
+	}{
+		"default leader": {
+			unreachable: "host1",
+			expLeader:   "host2:10001",
+		},
+		"default port": {
+			leader:      "host3:10000",
+			unreachable: "host3:10000",
+			expLeader:   "host1",
+		},
+		"unknown leader": {
+			leader:      "host4",
+			unreachable: "host4",
+			expLeader:   "host1",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			msc := newMgmtSvcClient(context.TODO(), log, mgmtSvcClientCfg{
+				AccessPoints: aps,
+			})
+			msc.leader = tc.leader
+
+			msc.failover(tc.unreachable)
+
+			leader, err := msc.LeaderAddress()
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEqual(t, leader, tc.expLeader, "unexpected leader after failover")
+		})
+	}
+}
+
+func TestIsRedirect(t *testing.T) {
+	for name, tc := range map[string]struct {
+		err    error
+		expRes bool
+	}{
+		"nil":         {},
+		"other error": {err: errors.New("unknown failure")},
+		"not leader":  {err: errors.Wrap(errNotLeader, "join"), expRes: true},
+		"not replica": {
+			err:    status.Error(codes.FailedPrecondition, "instance is not an access point"),
+			expRes: true,
+		},
+		"unavailable": {err: status.Error(codes.Unavailable, "connection refused")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			AssertEqual(t, isRedirect(tc.err), tc.expRes, "unexpected result")
+		})
+	}
+}
+
+func TestJoinStatusError(t *testing.T) {
+	req := &mgmtpb.JoinReq{Uuid: "a", Rank: 3}
+
+	for name, tc := range map[string]struct {
+		status    int32
+		expRetry  bool
+		expLeader bool
+		expCode   code.Code
+	}{
+		"not leader":  {status: derNotLeader, expRetry: true, expLeader: true},
+		"not replica": {status: derNotReplica, expRetry: true, expLeader: true},
+		"busy":        {status: derBusy, expRetry: true},
+		"timed out":   {status: derTimedout, expRetry: true},
+		"rank change": {status: derProto, expCode: code.SystemJoinRankChanged},
+		"rank taken":  {status: derExist, expCode: code.SystemJoinRankTaken},
+		"invalid":     {status: derInval, expCode: code.SystemJoinRejected},
+		"unknown":     {status: -9999, expCode: code.SystemJoinRejected},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := errors.Wrap(joinStatusError(req, tc.status), "join")
+
+			AssertEqual(t, isJoinFatal(err), !tc.expRetry, "unexpected fatal classification")
+			AssertEqual(t, isRedirect(err), tc.expLeader, "unexpected redirect classification")
+			if !tc.expRetry {
+				f, ok := errors.Cause(err).(*fault.Fault)
+				if !ok {
+					t.Fatalf("expected fault, got %T", errors.Cause(err))
+				}
+				AssertEqual(t, f.Code, tc.expCode, "unexpected fault code")
+				AssertTrue(t, fault.HasResolution(err), "expected fault resolution")
+			}
+		})
+	}
+}
+
+// mockJoinServer responds to Join requests with a fixed response.
+type mockJoinServer struct {
+	mgmtpb.MgmtSvcServer
+	sync.Mutex
+	resp  *mgmtpb.JoinResp
+	joins int
+}
+
+func (ms *mockJoinServer) Join(ctx context.Context, req *mgmtpb.JoinReq) (*mgmtpb.JoinResp, error) {
+	ms.Lock()
+	defer ms.Unlock()
+	ms.joins++
+	return ms.resp, nil
+}
+
+func (ms *mockJoinServer) joinCount() int {
+	ms.Lock()
+	defer ms.Unlock()
+	return ms.joins
+}
+
+func TestMgmtSvcClientJoin(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp     *mgmtpb.JoinResp
+		timeout  time.Duration
+		expCode  code.Code
+		expErrIn string
+		expJoins int
+		expRank  uint32
+	}{
+		"success": {
+			resp:     &mgmtpb.JoinResp{Rank: 2},
+			expJoins: 1,
+			expRank:  2,
+		},
+		"rank taken": {
+			resp:     &mgmtpb.JoinResp{Status: derExist},
+			expCode:  code.SystemJoinRankTaken,
+			expJoins: 1,
+		},
+		"excluded": {
+			resp:     &mgmtpb.JoinResp{Rank: 2, State: mgmtpb.JoinResp_OUT},
+			expCode:  code.SystemJoinExcluded,
+			expJoins: 1,
+		},
+		"join deadline": {
+			resp:     &mgmtpb.JoinResp{Status: derBusy},
+			timeout:  100 * time.Millisecond,
+			expCode:  code.SystemJoinTimeout,
+			expErrIn: "retryable status -1012",
+			expJoins: 1,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			ms := &mockJoinServer{resp: tc.resp}
+			srv := grpc.NewServer()
+			mgmtpb.RegisterMgmtSvcServer(srv, ms)
+			go func() {
+				_ = srv.Serve(lis)
+			}()
+			defer srv.Stop()
+
+			msc := newMgmtSvcClient(context.TODO(), log, mgmtSvcClientCfg{
+				AccessPoints:    []string{lis.Addr().String()},
+				ControlAddr:     &net.TCPAddr{},
+				TransportConfig: &security.TransportConfig{AllowInsecure: true},
+				JoinTimeout:     tc.timeout,
+			})
+
+			resp, err := msc.Join(context.Background(), &mgmtpb.JoinReq{Uuid: "a", Rank: 2})
+			AssertEqual(t, ms.joinCount(), tc.expJoins, "unexpected number of join requests")
+			if tc.expCode != code.Unknown {
+				f, ok := errors.Cause(err).(*fault.Fault)
+				if !ok {
+					t.Fatalf("expected fault, got %v", err)
+				}
+				AssertEqual(t, f.Code, tc.expCode, "unexpected fault code")
+				AssertTrue(t, strings.Contains(f.Description, tc.expErrIn), "unexpected fault description")
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEqual(t, resp.Rank, tc.expRank, "unexpected rank")
+		})
+	}
+}
+
+func TestMgmtSvcClientHeartbeatConn(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	msc := newMgmtSvcClient(context.TODO(), log, mgmtSvcClientCfg{
+		TransportConfig: &security.TransportConfig{AllowInsecure: true},
+	})
+
+	// unreachable access points don't delay the connection
+	first, err := msc.heartbeatConn("127.0.0.1:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := msc.heartbeatConn("127.0.0.1:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := msc.heartbeatConn("127.0.0.1:2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	AssertTrue(t, first == again, "expected connection to be reused")
+	AssertTrue(t, first != other, "expected a connection per access point")
+}
@@ -36,18 +36,27 @@ import (
 )
 
 const (
-	mgmtModuleID  = C.DRPC_MODULE_MGMT
-	killRank      = C.DRPC_METHOD_MGMT_KILL_RANK
-	setRank       = C.DRPC_METHOD_MGMT_SET_RANK
-	createMS      = C.DRPC_METHOD_MGMT_CREATE_MS
-	startMS       = C.DRPC_METHOD_MGMT_START_MS
-	join          = C.DRPC_METHOD_MGMT_JOIN
-	getAttachInfo = C.DRPC_METHOD_MGMT_GET_ATTACH_INFO
-	poolCreate    = C.DRPC_METHOD_MGMT_POOL_CREATE
-	poolDestroy   = C.DRPC_METHOD_MGMT_POOL_DESTROY
-	bioHealth     = C.DRPC_METHOD_MGMT_BIO_HEALTH_QUERY
-	setUp         = C.DRPC_METHOD_MGMT_SET_UP
-	smdDevs       = C.DRPC_METHOD_MGMT_SMD_LIST_DEVS
+	mgmtModuleID     = C.DRPC_MODULE_MGMT
+	killRank         = C.DRPC_METHOD_MGMT_KILL_RANK
+	setRank          = C.DRPC_METHOD_MGMT_SET_RANK
+	createMS         = C.DRPC_METHOD_MGMT_CREATE_MS
+	startMS          = C.DRPC_METHOD_MGMT_START_MS
+	join             = C.DRPC_METHOD_MGMT_JOIN
+	getAttachInfo    = C.DRPC_METHOD_MGMT_GET_ATTACH_INFO
+	poolCreate       = C.DRPC_METHOD_MGMT_POOL_CREATE
+	poolDestroy      = C.DRPC_METHOD_MGMT_POOL_DESTROY
+	bioHealth        = C.DRPC_METHOD_MGMT_BIO_HEALTH_QUERY
+	setUp            = C.DRPC_METHOD_MGMT_SET_UP
+	smdDevs          = C.DRPC_METHOD_MGMT_SMD_LIST_DEVS
+	listPools        = C.DRPC_METHOD_MGMT_LIST_POOLS
+	poolQuery        = C.DRPC_METHOD_MGMT_POOL_QUERY
+	poolGetACL       = C.DRPC_METHOD_MGMT_POOL_GET_ACL
+	poolOverwriteACL = C.DRPC_METHOD_MGMT_POOL_OVERWRITE_ACL
+	poolUpdateACL    = C.DRPC_METHOD_MGMT_POOL_UPDATE_ACL
+	poolDeleteACL    = C.DRPC_METHOD_MGMT_POOL_DELETE_ACL
+	prepShutdown     = C.DRPC_METHOD_MGMT_PREP_SHUTDOWN
+	ping             = C.DRPC_METHOD_MGMT_PING
+	setLogMasks      = C.DRPC_METHOD_MGMT_SET_LOG_MASKS
 
 	srvModuleID = C.DRPC_MODULE_SRV
 	notifyReady = C.DRPC_METHOD_SRV_NOTIFY_READY
@@ -24,18 +24,29 @@
 package server
 
 import (
+	"fmt"
 	"net"
 	"os/exec"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
+	"github.com/daos-stack/daos/src/control/common"
 	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/drpc"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/system"
 )
 
 // CheckReplica verifies if this server is supposed to host an MS replica,
@@ -68,14 +79,118 @@ var getInterfaceAddrs = func() ([]net.Addr, error) {
 // points. If bootstrap is true, in which case isReplica must be true, this
 // replica shall bootstrap the MS.
 func checkMgmtSvcReplica(self *net.TCPAddr, accessPoints []string) (isReplica, bootstrap bool, err error) {
-	replicas, err := resolveAccessPoints(accessPoints)
+	idx, err := findAccessPoint(self, accessPoints)
 	if err != nil {
 		return false, false, err
 	}
 
+	// The first replica in the access point list shall bootstrap the MS.
+	return idx >= 0, idx == 0, nil
+}
+
+// isAccessPointAddr returns true if the control-plane address addr refers to
+// one of the access points.
+func isAccessPointAddr(addr string, accessPoints []string) (bool, error) {
+	tcpAddr, err := net.ResolveTCPAddr("tcp", addr)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid address %q", addr)
+	}
+
+	replicas, err := resolveAccessPoints(accessPoints)
+	if err != nil {
+		return false, err
+	}
+
+	for _, replica := range replicas {
+		if replica.Port == tcpAddr.Port && replica.IP.Equal(tcpAddr.IP) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// serverPeerAddr returns the address of the peer a request was received
+// from. Unless certificates aren't in use, the peer must present one issued
+// to the same component as this server's.
+func serverPeerAddr(ctx context.Context, tc *security.TransportConfig) (*net.TCPAddr, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "unable to identify peer")
+	}
+
+	if !tc.AllowInsecure {
+		cn, err := tc.CommonName()
+		if err != nil {
+			return nil, err
+		}
+		tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+		if !ok || len(tlsInfo.State.VerifiedChains) == 0 {
+			return nil, status.Errorf(codes.PermissionDenied, "peer %s has no verified certificate", p.Addr)
+		}
+		if peerCN := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName; peerCN != cn {
+			return nil, status.Errorf(codes.PermissionDenied, "peer %s certificate issued to %q, not %q",
+				p.Addr, peerCN, cn)
+		}
+	}
+
+	peerAddr, ok := p.Addr.(*net.TCPAddr)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "unexpected peer address %s", p.Addr)
+	}
+
+	return peerAddr, nil
+}
+
+// checkAccessPointPeer verifies that a request only made between access
+// points was received from one. Unless certificates aren't in use, the peer
+// must present one issued to the same component as this server's, and it
+// must connect from the address of an access point. If the request names the
+// access point it was sent by, the peer must be that access point.
+func checkAccessPointPeer(ctx context.Context, tc *security.TransportConfig, accessPoints []string, claimed string) error {
+	peerAddr, err := serverPeerAddr(ctx, tc)
+	if err != nil {
+		return err
+	}
+
+	var claimedAddr *net.TCPAddr
+	if claimed != "" {
+		var err error
+		if claimedAddr, err = net.ResolveTCPAddr("tcp", claimed); err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid access point address %q", claimed)
+		}
+		if !claimedAddr.IP.Equal(peerAddr.IP) {
+			return status.Errorf(codes.PermissionDenied, "peer %s is not %s", peerAddr.IP, claimed)
+		}
+	}
+
+	replicas, err := resolveAccessPoints(accessPoints)
+	if err != nil {
+		return err
+	}
+	for _, replica := range replicas {
+		if !replica.IP.Equal(peerAddr.IP) {
+			continue
+		}
+		if claimedAddr == nil || claimedAddr.Port == replica.Port {
+			return nil
+		}
+	}
+
+	return status.Errorf(codes.PermissionDenied, "peer %s is not an access point", peerAddr.IP)
+}
+
+// findAccessPoint returns the index of the access point which refers to
+// this server's management address, or -1 if there isn't one.
+func findAccessPoint(self *net.TCPAddr, accessPoints []string) (int, error) {
+	replicas, err := resolveAccessPoints(accessPoints)
+	if err != nil {
+		return -1, err
+	}
+
 	selves, err := getListenIPs(self)
 	if err != nil {
-		return false, false, err
+		return -1, err
 	}
 
 	// Check each replica against this server's listen IPs.
@@ -85,28 +200,19 @@ func checkMgmtSvcReplica(self *net.TCPAddr, accessPoints []string) (isReplica, b
 		}
 		for _, ip := range selves {
 			if ip.Equal(replicas[i].IP) {
-				// The first replica in the access point list
-				// shall bootstrap the MS.
-				if i == 0 {
-					return true, true, nil
-				}
-				return true, false, nil
+				return i, nil
 			}
 		}
 	}
 
-	return false, false, nil
+	return -1, nil
 }
 
 // resolveAccessPoints resolves the strings in accessPoints into addresses in
 // addrs. If a port isn't specified, assume the default port.
 func resolveAccessPoints(accessPoints []string) (addrs []*net.TCPAddr, err error) {
-	defaultPort := NewConfiguration().ControlPort
 	for _, ap := range accessPoints {
-		if !hasPort(ap) {
-			ap = net.JoinHostPort(ap, strconv.Itoa(defaultPort))
-		}
-		t, err := net.ResolveTCPAddr("tcp", ap)
+		t, err := net.ResolveTCPAddr("tcp", withDefaultPort(ap))
 		if err != nil {
 			return nil, err
 		}
@@ -115,6 +221,16 @@ func resolveAccessPoints(accessPoints []string) (addrs []*net.TCPAddr, err error
 	return addrs, nil
 }
 
+// withDefaultPort returns addr with the default control port appended if a
+// port isn't specified.
+func withDefaultPort(addr string) string {
+	if hasPort(addr) {
+		return addr
+	}
+
+	return net.JoinHostPort(addr, strconv.Itoa(NewConfiguration().ControlPort))
+}
+
 // hasPort checks if addr specifies a port. This only works with IPv4
 // addresses at the moment.
 func hasPort(addr string) bool {
@@ -146,19 +262,30 @@ func getListenIPs(listenAddr *net.TCPAddr) (listenIPs []net.IP, err error) {
 // mgmtSvc implements (the Go portion of) Management Service, satisfying
 // pb.MgmtSvcServer.
 type mgmtSvc struct {
-	log     logging.Logger
-	mutex   sync.Mutex
-	harness *IOServerHarness
+	log        logging.Logger
+	mutex      sync.Mutex
+	harness    *IOServerHarness
+	membership *system.Membership
+	sysdb      *system.Database
 }
 
-func newMgmtSvc(h *IOServerHarness) *mgmtSvc {
+func newMgmtSvc(h *IOServerHarness, m *system.Membership, db *system.Database) *mgmtSvc {
 	return &mgmtSvc{
-		log:     h.log,
-		harness: h,
+		log:        h.log,
+		harness:    h,
+		membership: m,
+		sysdb:      db,
 	}
 }
 
+// GetAttachInfo returns the CaRT PSRs of the system. Access points answer
+// from the system database, listing the joined ranks hosted by access
+// points, and otherwise forward the request to the management instance.
 func (svc *mgmtSvc) GetAttachInfo(ctx context.Context, req *pb.GetAttachInfoReq) (*pb.GetAttachInfoResp, error) {
+	if resp := svc.attachInfo(); resp != nil {
+		return resp, nil
+	}
+
 	mi, err := svc.harness.GetManagementInstance()
 	if err != nil {
 		return nil, err
@@ -179,12 +306,253 @@ func (svc *mgmtSvc) GetAttachInfo(ctx context.Context, req *pb.GetAttachInfoReq)
 	return resp, nil
 }
 
+// attachInfo builds the attach info from the system database, returning nil
+// if this server holds no replica or no access point rank has joined yet.
+func (svc *mgmtSvc) attachInfo() *pb.GetAttachInfoResp {
+	if !svc.sysdb.IsReplica() {
+		return nil
+	}
+
+	members, err := svc.membership.Members()
+	if err != nil {
+		return nil
+	}
+
+	resp := &pb.GetAttachInfoResp{}
+	for _, member := range members {
+		if member.State == system.MemberStateExcluded || member.URI == "" {
+			continue
+		}
+		if !common.Include(svc.sysdb.Replicas(), withDefaultPort(member.Addr)) {
+			continue
+		}
+		resp.Psrs = append(resp.Psrs, &pb.GetAttachInfoResp_Psr{
+			Rank: member.Rank,
+			Uri:  member.URI,
+		})
+	}
+	if len(resp.Psrs) == 0 {
+		return nil
+	}
+
+	return resp
+}
+
+const (
+	// joinForwardedKey marks a join request forwarded to the system
+	// database leader, which must not be forwarded again.
+	joinForwardedKey = "daos-join-forwarded"
+	// joinInstanceKey marks a join request which only updates the system
+	// map held by the management service instance.
+	joinInstanceKey = "daos-join-instance"
+	// leaderQueryForwardedKey marks a leader query forwarded to the
+	// system database leader, which must not be forwarded again.
+	leaderQueryForwardedKey = "daos-leader-query-forwarded"
+
+	// poolQueryParallelism bounds the number of pools queried
+	// concurrently when listing pools.
+	poolQueryParallelism = 8
+)
+
+func withRequestFlag(ctx context.Context, key string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, key, "true")
+}
+
+func hasRequestFlag(ctx context.Context, key string) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md.Get(key)) > 0
+}
+
+// joinAddr returns the control-plane address of a joining server. The host
+// is taken from the address the request was received from, so that a server
+// can't register an address other than its own, and the port from the
+// address the server reports listening on.
+func joinAddr(ctx context.Context, addr string) (string, error) {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", errors.Wrapf(err, "invalid join address %q", addr)
+	}
+
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", errors.New("join request peer address unknown")
+	}
+	tcpAddr, ok := p.Addr.(*net.TCPAddr)
+	if !ok {
+		return "", errors.Errorf("join request from unexpected peer address %s", p.Addr)
+	}
+
+	return net.JoinHostPort(tcpAddr.IP.String(), port), nil
+}
+
+// Join adds a server to the system. Access points record the member in the
+// system database through its leader, once the management service instance
+// has accepted it.
 func (svc *mgmtSvc) Join(ctx context.Context, req *pb.JoinReq) (*pb.JoinResp, error) {
 	mi, err := svc.harness.GetManagementInstance()
 	if err != nil {
 		return nil, err
 	}
 
+	// Requests forwarded by an access point already carry the address
+	// of the joining server, only access points may forward them.
+	if hasRequestFlag(ctx, joinForwardedKey) || hasRequestFlag(ctx, joinInstanceKey) {
+		if err := checkAccessPointPeer(ctx, mi.msClient.cfg.TransportConfig,
+			mi.msClient.cfg.AccessPoints, ""); err != nil {
+			return nil, err
+		}
+	} else if req.Addr, err = joinAddr(ctx, req.Addr); err != nil {
+		return nil, err
+	}
+
+	if !svc.sysdb.IsReplica() || hasRequestFlag(ctx, joinInstanceKey) {
+		return svc.joinInstance(mi, req)
+	}
+
+	if !svc.sysdb.IsLeader() {
+		return svc.forwardJoin(ctx, mi, req)
+	}
+
+	member, err := svc.sysdb.JoinMember(ctx, &system.Member{
+		Rank:           req.Rank,
+		UUID:           req.Uuid,
+		URI:            req.Uri,
+		FabricContexts: req.Nctxs,
+		Addr:           req.Addr,
+		State:          system.MemberStateJoined,
+		LastSeen:       time.Now(),
+	}, func(m *system.Member) error {
+		instanceReq := *req
+		instanceReq.Rank = m.Rank
+		return svc.updateInstance(ctx, mi, &instanceReq)
+	})
+	if err != nil {
+		switch e := errors.Cause(err).(type) {
+		case *system.NotLeaderError:
+			return &pb.JoinResp{Status: derNotLeader}, nil
+		case *system.RankChangedError:
+			svc.log.Errorf("join rejected: %s", err)
+			return &pb.JoinResp{Status: derProto}, nil
+		case *system.RankTakenError:
+			svc.log.Errorf("join rejected: %s", err)
+			return &pb.JoinResp{Status: derExist}, nil
+		case *instanceJoinError:
+			svc.log.Errorf("join rejected: %s", err)
+			// A rank allocated by the system database may still be
+			// held by a member whose join hasn't been recorded,
+			// such as the bootstrapping access point.
+			if e.status == derExist && req.Rank == system.NilRank {
+				return &pb.JoinResp{Status: derAgain}, nil
+			}
+			return &pb.JoinResp{Status: e.status}, nil
+		}
+		return nil, err
+	}
+
+	resp := &pb.JoinResp{Rank: member.Rank}
+	if member.State == system.MemberStateExcluded {
+		resp.State = pb.JoinResp_OUT
+	}
+
+	return resp, nil
+}
+
+// forwardJoin sends the join request on to the system database leader.
+func (svc *mgmtSvc) forwardJoin(ctx context.Context, mi *IOServerInstance, req *pb.JoinReq) (*pb.JoinResp, error) {
+	leader := svc.sysdb.Leader()
+	if leader == "" || hasRequestFlag(ctx, joinForwardedKey) {
+		return &pb.JoinResp{Status: derNotLeader}, nil
+	}
+
+	svc.log.Debugf("forwarding join of %s to system database leader %s", req.Uuid, leader)
+
+	var resp *pb.JoinResp
+	err := mi.msClient.withConnectionTo(withRequestFlag(ctx, joinForwardedKey), leader,
+		func(ctx context.Context, ap string, pbClient pb.MgmtSvcClient) (err error) {
+			resp, err = pbClient.Join(ctx, req)
+			return
+		})
+	if err != nil {
+		return nil, errors.Wrapf(err, "forward join to %s", leader)
+	}
+
+	return resp, nil
+}
+
+// instanceJoinError indicates that the management service rejected a member
+// joining through the system database.
+type instanceJoinError struct {
+	rank   uint32
+	status int32
+}
+
+func (err *instanceJoinError) Error() string {
+	return fmt.Sprintf("management service rejected rank %d: %d", err.rank, err.status)
+}
+
+// updateInstance adds a member being recorded in the system database to the
+// system map held by the management service, through its leader, so that the
+// member is only recorded once the management service has accepted it.
+//
+// The management service leader is elected separately from the system
+// database leader, so each access point is tried in turn, starting with the
+// last known leader, until one accepts the join as the management service
+// leader.
+func (svc *mgmtSvc) updateInstance(ctx context.Context, mi *IOServerInstance, req *pb.JoinReq) error {
+	leader, err := mi.msClient.LeaderAddress()
+	if err != nil {
+		return err
+	}
+	aps := []string{leader}
+	for _, ap := range mi.msClient.cfg.AccessPoints {
+		if withDefaultPort(ap) != withDefaultPort(leader) {
+			aps = append(aps, ap)
+		}
+	}
+
+	var lastErr error
+	for _, ap := range aps {
+		var resp *pb.JoinResp
+		err := mi.msClient.withConnectionTo(withRequestFlag(ctx, joinInstanceKey), ap,
+			func(ctx context.Context, ap string, pbClient pb.MgmtSvcClient) (err error) {
+				resp, err = pbClient.Join(ctx, req)
+				return
+			})
+		switch {
+		case err != nil:
+			lastErr = errors.Wrapf(err, "rank %d not added to management service", req.Rank)
+			if ctx.Err() != nil {
+				return lastErr
+			}
+			continue
+		case resp.Status == derNotLeader, resp.Status == derNotReplica:
+			svc.log.Debugf("%s not the management service leader", ap)
+			lastErr = &instanceJoinError{rank: req.Rank, status: resp.Status}
+			continue
+		case resp.Status != 0:
+			return &instanceJoinError{rank: req.Rank, status: resp.Status}
+		case resp.Rank != req.Rank:
+			return errors.Errorf("management service assigned rank %d to rank %d (%s)",
+				resp.Rank, req.Rank, req.Uuid)
+		}
+
+		return nil
+	}
+
+	return lastErr
+}
+
+// joinInstance forwards the join request to the management service
+// instance, recording the member in the local membership on success.
+func (svc *mgmtSvc) joinInstance(mi *IOServerInstance, req *pb.JoinReq) (*pb.JoinResp, error) {
+	// Access points are added to the management service replicas so
+	// that its metadata survives the loss of the leader.
+	isAP, err := isAccessPointAddr(req.Addr, mi.msClient.cfg.AccessPoints)
+	if err != nil {
+		return nil, err
+	}
+	req.Replica = isAP
+
 	svc.mutex.Lock()
 	dresp, err := makeDrpcCall(mi.drpcClient, mgmtModuleID, join, req)
 	svc.mutex.Unlock()
@@ -197,11 +565,29 @@ func (svc *mgmtSvc) Join(ctx context.Context, req *pb.JoinReq) (*pb.JoinResp, er
 		return nil, errors.Wrap(err, "unmarshal Join response")
 	}
 
+	if resp.Status == 0 && !svc.sysdb.IsReplica() {
+		state := system.MemberStateJoined
+		if resp.State == pb.JoinResp_OUT {
+			state = system.MemberStateExcluded
+		}
+		svc.membership.Join(&system.Member{
+			Rank:           resp.Rank,
+			UUID:           req.Uuid,
+			URI:            req.Uri,
+			FabricContexts: req.Nctxs,
+			Addr:           req.Addr,
+			State:          state,
+			LastSeen:       time.Now(),
+		})
+	}
+
 	return resp, nil
 }
 
 // checkIsMSReplica provides a hint as to who is service leader if instance is
-// not a Management Service replica.
+// not a Management Service replica. The FailedPrecondition status code lets
+// callers distinguish the redirect from other failures and rediscover the
+// leader with a LeaderQuery before retrying.
 func checkIsMSReplica(mi *IOServerInstance) error {
 	msg := "instance is not an access point"
 	if !mi.IsMSReplica() {
@@ -214,12 +600,70 @@ func checkIsMSReplica(mi *IOServerInstance) error {
 			msg += ", try " + leader
 		}
 
-		return errors.New(msg)
+		return status.Error(codes.FailedPrecondition, msg)
 	}
 
 	return nil
 }
 
+// LeaderQuery returns the address of the current Management Service leader,
+// as elected by the system database replicas, together with the addresses of
+// the replicas and those which haven't recently responded to the leader.
+// Replica state is only known to the leader, to which followers forward the
+// query.
+func (svc *mgmtSvc) LeaderQuery(ctx context.Context, req *pb.LeaderQueryReq) (*pb.LeaderQueryResp, error) {
+	mi, err := svc.harness.GetManagementInstance()
+	if err != nil {
+		return nil, err
+	}
+
+	if sb := mi.getSuperblock(); sb != nil && req.System != "" && req.System != sb.System {
+		return nil, errors.Errorf("leader query for wrong system (local: %q, req: %q)",
+			sb.System, req.System)
+	}
+
+	if !svc.sysdb.IsReplica() {
+		return nil, status.Error(codes.FailedPrecondition, "not a system database replica")
+	}
+	leader := svc.sysdb.Leader()
+	if leader == "" {
+		return nil, status.Error(codes.Unavailable, "system database leader not elected")
+	}
+
+	if !svc.sysdb.IsLeader() {
+		return svc.forwardLeaderQuery(ctx, mi, leader, req)
+	}
+
+	down, err := svc.sysdb.DownReplicas()
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.LeaderQueryResp{
+		CurrentLeader: leader,
+		Replicas:      svc.sysdb.Replicas(),
+		DownReplicas:  down,
+	}, nil
+}
+
+func (svc *mgmtSvc) forwardLeaderQuery(ctx context.Context, mi *IOServerInstance, leader string, req *pb.LeaderQueryReq) (*pb.LeaderQueryResp, error) {
+	if hasRequestFlag(ctx, leaderQueryForwardedKey) {
+		return nil, status.Error(codes.Unavailable, "system database leader changed")
+	}
+
+	var resp *pb.LeaderQueryResp
+	err := mi.msClient.withConnectionTo(withRequestFlag(ctx, leaderQueryForwardedKey), leader,
+		func(ctx context.Context, ap string, pbClient pb.MgmtSvcClient) (err error) {
+			resp, err = pbClient.LeaderQuery(ctx, req)
+			return
+		})
+	if err != nil {
+		return nil, errors.Wrapf(err, "forward leader query to %s", leader)
+	}
+
+	return resp, nil
+}
+
 // PoolCreate implements the method defined for the Management Service.
 func (svc *mgmtSvc) PoolCreate(ctx context.Context, req *pb.PoolCreateReq) (*pb.PoolCreateResp, error) {
 	mi, err := svc.harness.GetManagementInstance()
@@ -246,9 +690,30 @@ func (svc *mgmtSvc) PoolCreate(ctx context.Context, req *pb.PoolCreateReq) (*pb.
 
 	svc.log.Debugf("MgmtSvc.PoolCreate dispatch, resp:%+v\n", *resp)
 
+	if resp.Status == 0 && svc.sysdb.IsReplica() {
+		if err := svc.recordPoolService(ctx, resp.Uuid, resp.Svcreps); err != nil {
+			svc.log.Errorf("failed to record pool %s service: %s", resp.Uuid, err)
+		}
+	}
+
 	return resp, nil
 }
 
+// recordPoolService records the ranks hosting a pool service, given as a
+// comma-separated list, in the system database.
+func (svc *mgmtSvc) recordPoolService(ctx context.Context, poolUUID, svcReps string) error {
+	ps := &system.PoolService{PoolUUID: poolUUID}
+	for _, field := range strings.Split(svcReps, ",") {
+		rank, err := strconv.ParseUint(strings.TrimSpace(field), 10, 32)
+		if err != nil {
+			return errors.Wrapf(err, "invalid service replica ranks %q", svcReps)
+		}
+		ps.Replicas = append(ps.Replicas, uint32(rank))
+	}
+
+	return svc.sysdb.UpdatePoolService(ctx, ps)
+}
+
 // PoolDestroy implements the method defined for the Management Service.
 func (svc *mgmtSvc) PoolDestroy(ctx context.Context, req *pb.PoolDestroyReq) (*pb.PoolDestroyResp, error) {
 	mi, err := svc.harness.GetManagementInstance()
@@ -275,52 +740,185 @@ func (svc *mgmtSvc) PoolDestroy(ctx context.Context, req *pb.PoolDestroyReq) (*p
 
 	svc.log.Debugf("MgmtSvc.PoolDestroy dispatch, resp:%+v\n", *resp)
 
+	if resp.Status == 0 && svc.sysdb.IsReplica() {
+		if err := svc.sysdb.RemovePoolService(ctx, req.Uuid); err != nil {
+			svc.log.Errorf("failed to remove pool %s service record: %s", req.Uuid, err)
+		}
+	}
+
 	return resp, nil
 }
 
-// BioHealthQuery implements the method defined for the Management Service.
-func (svc *mgmtSvc) BioHealthQuery(ctx context.Context, req *pb.BioHealthReq) (*pb.BioHealthResp, error) {
+// ListPools implements the method defined for the Management Service.
+//
+// The engine reports pool UUIDs and service replicas; pool sizes are filled
+// in here by querying each pool in turn.
+func (svc *mgmtSvc) ListPools(ctx context.Context, req *pb.ListPoolsReq) (*pb.ListPoolsResp, error) {
 	mi, err := svc.harness.GetManagementInstance()
 	if err != nil {
 		return nil, err
 	}
+	if err := checkIsMSReplica(mi); err != nil {
+		return nil, err
+	}
 
-	svc.log.Debugf("MgmtSvc.BioHealthQuery dispatch, req:%+v\n", *req)
+	svc.log.Debugf("MgmtSvc.ListPools dispatch, req:%+v\n", *req)
 
 	svc.mutex.Lock()
-	dresp, err := makeDrpcCall(mi.drpcClient, mgmtModuleID, bioHealth, req)
+	dresp, err := makeDrpcCall(mi.drpcClient, mgmtModuleID, listPools, req)
 	svc.mutex.Unlock()
 	if err != nil {
 		return nil, err
 	}
 
-	resp := &pb.BioHealthResp{}
+	resp := &pb.ListPoolsResp{}
 	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
-		return nil, errors.Wrap(err, "unmarshal BioHealthQuery response")
+		return nil, errors.Wrap(err, "unmarshal ListPools response")
 	}
 
+	svc.queryPoolSizes(resp.GetPools(), func() drpc.DomainSocketClient {
+		return getDrpcClientConnection(mi.runner.Config.SocketDir)
+	})
+
+	svc.log.Debugf("MgmtSvc.ListPools dispatch, resp:%+v\n", *resp)
+
 	return resp, nil
 }
 
-// SmdListDevs implements the method defined for the Management Service.
-func (svc *mgmtSvc) SmdListDevs(ctx context.Context, req *pb.SmdDevReq) (*pb.SmdDevResp, error) {
+// queryPoolSizes fills in the total SCM and NVMe sizes of each pool, or the
+// error if the pool can't be queried. Pools are queried concurrently, each
+// over a dRPC connection of its own, rather than under svc.mutex so that
+// slow queries don't hold up other management requests.
+func (svc *mgmtSvc) queryPoolSizes(pools []*pb.ListPoolsResp_Pool, newClient func() drpc.DomainSocketClient) {
+	var wg sync.WaitGroup
+	limit := make(chan struct{}, poolQueryParallelism)
+
+	for _, pool := range pools {
+		wg.Add(1)
+		limit <- struct{}{}
+		go func(pool *pb.ListPoolsResp_Pool) {
+			defer func() {
+				<-limit
+				wg.Done()
+			}()
+
+			qresp, err := queryPool(newClient(), &pb.PoolQueryReq{Uuid: pool.GetUuid()})
+			if err == nil && qresp.GetStatus() != 0 {
+				err = errors.Errorf("DAOS returned error code: %d", qresp.GetStatus())
+			}
+			if err != nil {
+				svc.log.Errorf("query pool %s: %s", pool.GetUuid(), err)
+				pool.Error = err.Error()
+				return
+			}
+			pool.Scmbytes = qresp.GetScm().GetTotal()
+			pool.Nvmebytes = qresp.GetNvme().GetTotal()
+		}(pool)
+	}
+
+	wg.Wait()
+}
+
+// queryPool queries a pool through the management service instance.
+func queryPool(client drpc.DomainSocketClient, req *pb.PoolQueryReq) (*pb.PoolQueryResp, error) {
+	dresp, err := makeDrpcCall(client, mgmtModuleID, poolQuery, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.PoolQueryResp{}
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrap(err, "unmarshal PoolQuery response")
+	}
+
+	return resp, nil
+}
+
+// PoolQuery implements the method defined for the Management Service.
+func (svc *mgmtSvc) PoolQuery(ctx context.Context, req *pb.PoolQueryReq) (*pb.PoolQueryResp, error) {
 	mi, err := svc.harness.GetManagementInstance()
 	if err != nil {
 		return nil, err
 	}
+	if err := checkIsMSReplica(mi); err != nil {
+		return nil, err
+	}
 
-	svc.log.Debugf("MgmtSvc.SmdListDevs dispatch, req:%+v\n", *req)
+	svc.log.Debugf("MgmtSvc.PoolQuery dispatch, req:%+v\n", *req)
 
 	svc.mutex.Lock()
-	dresp, err := makeDrpcCall(mi.drpcClient, mgmtModuleID, smdDevs, req)
+	resp, err := queryPool(mi.drpcClient, req)
 	svc.mutex.Unlock()
 	if err != nil {
 		return nil, err
 	}
 
+	svc.log.Debugf("MgmtSvc.PoolQuery dispatch, resp:%+v\n", *resp)
+
+	return resp, nil
+}
+
+// BioHealthQuery implements the method defined for the Management Service.
+//
+// The query is forwarded to each managed I/O server instance in turn until
+// one which owns the requested device returns a successful response.
+func (svc *mgmtSvc) BioHealthQuery(ctx context.Context, req *pb.BioHealthReq) (*pb.BioHealthResp, error) {
+	instances := svc.harness.Instances()
+	if len(instances) == 0 {
+		return nil, errors.New("harness has no managed instances")
+	}
+
+	svc.log.Debugf("MgmtSvc.BioHealthQuery dispatch, req:%+v\n", *req)
+
+	var resp *pb.BioHealthResp
+	for _, i := range instances {
+		svc.mutex.Lock()
+		dresp, err := makeDrpcCall(i.drpcClient, mgmtModuleID, bioHealth, req)
+		svc.mutex.Unlock()
+		if err != nil {
+			return nil, err
+		}
+
+		resp = &pb.BioHealthResp{}
+		if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+			return nil, errors.Wrap(err, "unmarshal BioHealthQuery response")
+		}
+		if resp.Status == 0 {
+			break
+		}
+	}
+
+	return resp, nil
+}
+
+// SmdListDevs implements the method defined for the Management Service.
+//
+// Devices are listed for each managed I/O server instance.
+func (svc *mgmtSvc) SmdListDevs(ctx context.Context, req *pb.SmdDevReq) (*pb.SmdDevResp, error) {
+	instances := svc.harness.Instances()
+	if len(instances) == 0 {
+		return nil, errors.New("harness has no managed instances")
+	}
+
+	svc.log.Debugf("MgmtSvc.SmdListDevs dispatch, req:%+v\n", *req)
+
 	resp := &pb.SmdDevResp{}
-	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
-		return nil, errors.Wrap(err, "unmarshal SmdListDevs response")
+	for _, i := range instances {
+		svc.mutex.Lock()
+		dresp, err := makeDrpcCall(i.drpcClient, mgmtModuleID, smdDevs, req)
+		svc.mutex.Unlock()
+		if err != nil {
+			return nil, err
+		}
+
+		instResp := &pb.SmdDevResp{}
+		if err = proto.Unmarshal(dresp.Body, instResp); err != nil {
+			return nil, errors.Wrap(err, "unmarshal SmdListDevs response")
+		}
+		if instResp.Status != 0 {
+			return instResp, nil
+		}
+		resp.Devices = append(resp.Devices, instResp.Devices...)
 	}
 
 	return resp, nil
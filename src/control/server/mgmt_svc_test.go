@@ -24,9 +24,30 @@
 package server
 
 import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
 	"net"
+	"os"
+	"path/filepath"
 	"strconv"
 	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	. "github.com/daos-stack/daos/src/control/common"
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/system"
 )
 
 func TestHasPort(t *testing.T) {
@@ -130,3 +151,794 @@ func TestCheckMgmtSvcReplica(t *testing.T) {
 		}
 	}
 }
+
+// peerCertificate loads a certificate from the security package test data,
+// as presented by a peer.
+func peerCertificate(t *testing.T, name string) *x509.Certificate {
+	t.Helper()
+
+	data, err := ioutil.ReadFile(filepath.Join("../security/testdata/certs", name+".crt"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		t.Fatalf("no certificate in %s.crt", name)
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return cert
+}
+
+// serverTransportConfig returns a transport configuration using the server
+// certificate from the security package test data. The key is copied so that
+// it has the permissions required to load it.
+func serverTransportConfig(t *testing.T, dir string) *security.TransportConfig {
+	t.Helper()
+
+	key, err := ioutil.ReadFile("../security/testdata/certs/server.key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyPath := filepath.Join(dir, "server.key")
+	if err := ioutil.WriteFile(keyPath, key, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return &security.TransportConfig{
+		CertificateConfig: security.CertificateConfig{
+			CARootPath:      "../security/testdata/certs/daosCA.crt",
+			CertificatePath: "../security/testdata/certs/server.crt",
+			PrivateKeyPath:  keyPath,
+		},
+	}
+}
+
+func TestCheckAccessPointPeer(t *testing.T) {
+	dir, err := ioutil.TempDir("", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	peerAddr := &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 45000}
+	aps := []string{"10.0.0.1", "10.0.0.3"}
+
+	for name, tc := range map[string]struct {
+		secure    bool
+		peer      *peer.Peer
+		claimed   string
+		expErrMsg string
+	}{
+		"access point": {
+			peer: &peer.Peer{Addr: peerAddr},
+		},
+		"not an access point": {
+			peer:      &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.2"), Port: 45000}},
+			expErrMsg: "rpc error: code = PermissionDenied desc = peer 10.0.0.2 is not an access point",
+		},
+		"unknown peer": {
+			expErrMsg: "rpc error: code = PermissionDenied desc = unable to identify peer",
+		},
+		"unexpected peer address": {
+			peer:      &peer.Peer{Addr: &net.UnixAddr{Name: "sock", Net: "unix"}},
+			expErrMsg: "rpc error: code = PermissionDenied desc = unexpected peer address sock",
+		},
+		"claimed replica": {
+			peer:    &peer.Peer{Addr: peerAddr},
+			claimed: "10.0.0.1:10000",
+		},
+		"claimed other replica": {
+			peer:      &peer.Peer{Addr: peerAddr},
+			claimed:   "10.0.0.3:10000",
+			expErrMsg: "rpc error: code = PermissionDenied desc = peer 10.0.0.1 is not 10.0.0.3:10000",
+		},
+		"claimed replica port differs": {
+			peer:      &peer.Peer{Addr: peerAddr},
+			claimed:   "10.0.0.1:10001",
+			expErrMsg: "rpc error: code = PermissionDenied desc = peer 10.0.0.1 is not an access point",
+		},
+		"server certificate": {
+			secure: true,
+			peer: &peer.Peer{
+				Addr: peerAddr,
+				AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+					VerifiedChains: [][]*x509.Certificate{{peerCertificate(t, "server")}},
+				}},
+			},
+		},
+		"agent certificate": {
+			secure: true,
+			peer: &peer.Peer{
+				Addr: peerAddr,
+				AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+					VerifiedChains: [][]*x509.Certificate{{peerCertificate(t, "agent")}},
+				}},
+			},
+			expErrMsg: "rpc error: code = PermissionDenied desc = peer 10.0.0.1:45000 certificate issued to \"agent\", not \"server\"",
+		},
+		"no certificate": {
+			secure:    true,
+			peer:      &peer.Peer{Addr: peerAddr},
+			expErrMsg: "rpc error: code = PermissionDenied desc = peer 10.0.0.1:45000 has no verified certificate",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg := &security.TransportConfig{AllowInsecure: true}
+			if tc.secure {
+				cfg = serverTransportConfig(t, dir)
+			}
+
+			ctx := context.TODO()
+			if tc.peer != nil {
+				ctx = peer.NewContext(ctx, tc.peer)
+			}
+
+			err := checkAccessPointPeer(ctx, cfg, aps, tc.claimed)
+			if tc.expErrMsg != "" {
+				ExpectError(t, err, tc.expErrMsg, name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestMgmtSvcLeaderQuery(t *testing.T) {
+	const self = "host1:10000"
+
+	for name, tc := range map[string]struct {
+		system     string
+		notReplica bool
+		notStarted bool
+		follower   bool
+		forwarded  bool
+		expResp    *pb.LeaderQueryResp
+		expErrMsg  string
+	}{
+		"elected leader": {
+			system: "daos_server",
+			expResp: &pb.LeaderQueryResp{
+				CurrentLeader: self,
+				Replicas:      []string{self},
+			},
+		},
+		"wrong system": {
+			system:    "foo",
+			expErrMsg: "leader query for wrong system (local: \"daos_server\", req: \"foo\")",
+		},
+		"not a replica": {
+			notReplica: true,
+			expErrMsg:  "rpc error: code = FailedPrecondition desc = not a system database replica",
+		},
+		"no leader elected": {
+			notStarted: true,
+			expErrMsg:  "rpc error: code = Unavailable desc = system database leader not elected",
+		},
+		"forwarded to follower": {
+			follower:  true,
+			forwarded: true,
+			expErrMsg: "rpc error: code = Unavailable desc = system database leader changed",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			cs := defaultMockControlService(t, log)
+			mi, err := cs.harness.GetManagementInstance()
+			if err != nil {
+				t.Fatal(err)
+			}
+			mi.setSuperblock(&Superblock{System: "daos_server", MS: true})
+			mi.msClient = newMgmtSvcClient(context.TODO(), log, mgmtSvcClientCfg{
+				AccessPoints: []string{"host1", "host2"},
+			})
+
+			switch {
+			case tc.notReplica:
+			case tc.notStarted:
+				cs.sysdb = system.NewDatabase(log, cs.membership, &system.DatabaseConfig{
+					Replicas: []string{self},
+					Self:     self,
+				})
+			case tc.follower:
+				// Replicas with peers don't campaign until an
+				// election timeout expires, so the append from
+				// another leader is accepted.
+				cs.sysdb = newTestSystemDB(t, ctx, log, cs.membership,
+					[]string{self, "host2:10000"}, self)
+				if _, err := cs.sysdb.HandleAppendRequest(&system.AppendRequest{
+					Term:   1,
+					Leader: "host2:10000",
+				}); err != nil {
+					t.Fatal(err)
+				}
+			default:
+				cs.sysdb = newTestSystemDB(t, ctx, log, cs.membership, []string{self}, self)
+			}
+
+			reqCtx := context.TODO()
+			if tc.forwarded {
+				reqCtx = metadata.NewIncomingContext(reqCtx,
+					metadata.Pairs(leaderQueryForwardedKey, "true"))
+			}
+
+			svc := newMgmtSvc(cs.harness, cs.membership, cs.sysdb)
+			resp, err := svc.LeaderQuery(reqCtx, &pb.LeaderQueryReq{System: tc.system})
+			if tc.expErrMsg != "" {
+				ExpectError(t, err, tc.expErrMsg, name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			AssertEqual(t, resp, tc.expResp, "unexpected leader query response")
+		})
+	}
+}
+
+func TestMgmtSvcJoinReplica(t *testing.T) {
+	for name, tc := range map[string]struct {
+		addr       string
+		expReplica bool
+	}{
+		"access point": {
+			addr:       "10.0.0.1:10000",
+			expReplica: true,
+		},
+		"access point port differs": {
+			addr: "10.0.0.1:10001",
+		},
+		"not an access point": {
+			addr: "10.0.0.2:10000",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			cs := defaultMockControlService(t, log)
+			mi, err := cs.harness.GetManagementInstance()
+			if err != nil {
+				t.Fatal(err)
+			}
+			mi.msClient = newMgmtSvcClient(context.TODO(), log, mgmtSvcClientCfg{
+				AccessPoints: []string{"10.0.0.1"},
+			})
+
+			body, err := proto.Marshal(&pb.JoinResp{Rank: 1})
+			if err != nil {
+				t.Fatal(err)
+			}
+			client := newMockDrpcClient()
+			client.setSendMsgResponse(drpc.Status_SUCCESS, body)
+			mi.drpcClient = client
+
+			svc := newMgmtSvc(cs.harness, cs.membership, cs.sysdb)
+			req := &pb.JoinReq{Uuid: "a", Rank: system.NilRank, Addr: tc.addr}
+			if _, err := svc.Join(joinPeerContext(t, context.TODO(), tc.addr), req); err != nil {
+				t.Fatal(err)
+			}
+
+			sent := &pb.JoinReq{}
+			if err := proto.Unmarshal(client.SendMsgInputCall.Body, sent); err != nil {
+				t.Fatal(err)
+			}
+			AssertEqual(t, sent.Replica, tc.expReplica, "unexpected replica flag sent to the management service")
+		})
+	}
+}
+
+// TestMgmtSvcJoinInstanceNotLeader checks that members are added to the
+// management service through whichever access point's instance is the
+// management service leader, which needn't be the system database leader.
+func TestMgmtSvcJoinInstanceNotLeader(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	listeners := make([]net.Listener, 2)
+	accessPoints := make([]string, len(listeners))
+	for idx := range listeners {
+		lis, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		listeners[idx] = lis
+		accessPoints[idx] = lis.Addr().String()
+	}
+	tc := &security.TransportConfig{AllowInsecure: true}
+
+	// The first access point's instance isn't the management service
+	// leader, the second one accepts the join.
+	notLeader := newMockDrpcClient()
+	body, err := proto.Marshal(&pb.JoinResp{Status: derNotLeader})
+	if err != nil {
+		t.Fatal(err)
+	}
+	notLeader.setSendMsgResponse(drpc.Status_SUCCESS, body)
+	leader := &bootstrapDrpcClient{}
+
+	for idx, client := range []drpc.DomainSocketClient{notLeader, leader} {
+		cs := defaultMockControlService(t, log)
+		cs.transportConfig = tc
+		mi, err := cs.harness.GetManagementInstance()
+		if err != nil {
+			t.Fatal(err)
+		}
+		mi.msClient = newMgmtSvcClient(context.TODO(), log, mgmtSvcClientCfg{
+			AccessPoints:    accessPoints,
+			TransportConfig: tc,
+		})
+		mi.drpcClient = client
+
+		grpcSrv := grpc.NewServer()
+		pb.RegisterMgmtSvcServer(grpcSrv, newMgmtSvc(cs.harness, cs.membership, cs.sysdb))
+		go func(lis net.Listener) {
+			_ = grpcSrv.Serve(lis)
+		}(listeners[idx])
+		defer grpcSrv.Stop()
+	}
+
+	cs := defaultMockControlService(t, log)
+	mi, err := cs.harness.GetManagementInstance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mi.msClient = newMgmtSvcClient(context.TODO(), log, mgmtSvcClientCfg{
+		AccessPoints:    accessPoints,
+		TransportConfig: tc,
+	})
+	svc := newMgmtSvc(cs.harness, cs.membership, cs.sysdb)
+
+	req := &pb.JoinReq{Uuid: "a", Rank: 1, Addr: "10.0.0.2:10001"}
+	if err := svc.updateInstance(context.TODO(), mi, req); err != nil {
+		t.Fatal(err)
+	}
+
+	if notLeader.SendMsgInputCall == nil {
+		t.Fatal("join not sent to the first access point")
+	}
+	_, joinedRanks := leader.calls()
+	AssertEqual(t, joinedRanks, []uint32{1}, "unexpected ranks joined to the management service leader")
+}
+
+func newTestSystemDB(t *testing.T, ctx context.Context, log logging.Logger, m *system.Membership, replicas []string, self string) *system.Database {
+	t.Helper()
+
+	db := system.NewDatabase(log, m, &system.DatabaseConfig{
+		Replicas: replicas,
+		Self:     self,
+	})
+	if err := db.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+// joinPeerContext returns a context for a join request received from the
+// host in the given address.
+func joinPeerContext(t *testing.T, ctx context.Context, addr string) context.Context {
+	t.Helper()
+
+	tcpAddr, err := net.ResolveTCPAddr("tcp", addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tcpAddr.Port = 45000
+
+	return peer.NewContext(ctx, &peer.Peer{Addr: tcpAddr})
+}
+
+func TestMgmtSvcJoin(t *testing.T) {
+	const replica = "10.0.0.1:10000"
+
+	for name, tc := range map[string]struct {
+		notReplica bool
+		notStarted bool
+		joins      []*pb.JoinReq
+		req        *pb.JoinReq
+		peerAddr   net.Addr
+		forwarded  bool
+		msRanks    map[uint32]string
+		drpcResp   *pb.JoinResp
+		expResp    *pb.JoinResp
+		expAddr    string
+		expMissing bool
+		expErrMsg  string
+	}{
+		"new member": {
+			req:     &pb.JoinReq{Uuid: "a", Rank: system.NilRank, Uri: "uri-a", Nctxs: 2, Addr: "10.0.0.2:10001"},
+			expResp: &pb.JoinResp{Rank: 0},
+			expAddr: "10.0.0.2:10001",
+		},
+		"requested rank": {
+			req:     &pb.JoinReq{Uuid: "a", Rank: 5, Uri: "uri-a", Addr: "10.0.0.2:10001"},
+			expResp: &pb.JoinResp{Rank: 5},
+			expAddr: "10.0.0.2:10001",
+		},
+		"rejoin": {
+			joins: []*pb.JoinReq{
+				{Uuid: "a", Rank: system.NilRank, Addr: "10.0.0.2:10001"},
+				{Uuid: "b", Rank: system.NilRank, Addr: "10.0.0.3:10001"},
+			},
+			req:     &pb.JoinReq{Uuid: "a", Rank: system.NilRank, Uri: "uri-a", Addr: "10.0.0.4:10001"},
+			expResp: &pb.JoinResp{Rank: 0},
+			expAddr: "10.0.0.4:10001",
+		},
+		"rank changed": {
+			joins: []*pb.JoinReq{
+				{Uuid: "a", Rank: system.NilRank, Addr: "10.0.0.2:10001"},
+			},
+			req:     &pb.JoinReq{Uuid: "a", Rank: 3, Addr: "10.0.0.2:10001"},
+			expResp: &pb.JoinResp{Status: derProto},
+		},
+		"rank taken": {
+			joins: []*pb.JoinReq{
+				{Uuid: "a", Rank: 3, Addr: "10.0.0.2:10001"},
+			},
+			req:     &pb.JoinReq{Uuid: "b", Rank: 3, Addr: "10.0.0.3:10001"},
+			expResp: &pb.JoinResp{Status: derExist},
+		},
+		"unspecified address": {
+			req:      &pb.JoinReq{Uuid: "a", Rank: system.NilRank, Uri: "uri-a", Addr: "0.0.0.0:10001"},
+			peerAddr: &net.TCPAddr{IP: net.ParseIP("10.0.0.5"), Port: 45000},
+			expResp:  &pb.JoinResp{Rank: 0},
+			expAddr:  "10.0.0.5:10001",
+		},
+		"address differs from peer": {
+			req:      &pb.JoinReq{Uuid: "a", Rank: system.NilRank, Uri: "uri-a", Addr: "10.0.0.9:10001"},
+			peerAddr: &net.TCPAddr{IP: net.ParseIP("10.0.0.5"), Port: 45000},
+			expResp:  &pb.JoinResp{Rank: 0},
+			expAddr:  "10.0.0.5:10001",
+		},
+		"unknown peer": {
+			req:       &pb.JoinReq{Uuid: "a", Rank: system.NilRank, Uri: "uri-a", Addr: "10.0.0.2:10001"},
+			peerAddr:  &net.UnixAddr{Name: "sock", Net: "unix"},
+			expErrMsg: "join request from unexpected peer address sock",
+		},
+		"rejected by management service": {
+			req:        &pb.JoinReq{Uuid: "a", Rank: 0, Uri: "uri-a", Addr: "10.0.0.2:10001"},
+			msRanks:    map[uint32]string{0: "x"},
+			expResp:    &pb.JoinResp{Status: derExist},
+			expMissing: true,
+		},
+		"allocated rank held in management service": {
+			req:        &pb.JoinReq{Uuid: "a", Rank: system.NilRank, Uri: "uri-a", Addr: "10.0.0.2:10001"},
+			msRanks:    map[uint32]string{0: "x"},
+			expResp:    &pb.JoinResp{Status: derAgain},
+			expMissing: true,
+		},
+		"forwarded by other than an access point": {
+			req:       &pb.JoinReq{Uuid: "a", Rank: system.NilRank, Uri: "uri-a", Addr: "10.0.0.2:10001"},
+			forwarded: true,
+			expErrMsg: "rpc error: code = PermissionDenied desc = peer 10.0.0.2 is not an access point",
+		},
+		"no leader": {
+			notStarted: true,
+			req:        &pb.JoinReq{Uuid: "a", Rank: system.NilRank, Addr: "10.0.0.2:10001"},
+			expResp:    &pb.JoinResp{Status: derNotLeader},
+		},
+		"not a replica": {
+			notReplica: true,
+			req:        &pb.JoinReq{Uuid: "a", Rank: system.NilRank, Uri: "uri-a", Addr: "10.0.0.2:10001"},
+			drpcResp:   &pb.JoinResp{Rank: 7},
+			expResp:    &pb.JoinResp{Rank: 7},
+			expAddr:    "10.0.0.2:10001",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			cs := defaultMockControlService(t, log)
+			mi, err := cs.harness.GetManagementInstance()
+			if err != nil {
+				t.Fatal(err)
+			}
+			// The management service is served locally so that
+			// joins are confirmed through the instance.
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			mi.msClient = newMgmtSvcClient(ctx, log, mgmtSvcClientCfg{
+				AccessPoints:    []string{lis.Addr().String()},
+				TransportConfig: &security.TransportConfig{AllowInsecure: true},
+			})
+
+			engine := &bootstrapDrpcClient{ranks: tc.msRanks}
+			mi.drpcClient = engine
+			if tc.drpcResp != nil {
+				body, err := proto.Marshal(tc.drpcResp)
+				if err != nil {
+					t.Fatal(err)
+				}
+				client := newMockDrpcClient()
+				client.setSendMsgResponse(drpc.Status_SUCCESS, body)
+				mi.drpcClient = client
+			}
+
+			switch {
+			case tc.notReplica:
+			case tc.notStarted:
+				cs.sysdb = system.NewDatabase(log, cs.membership, &system.DatabaseConfig{
+					Replicas: []string{replica},
+					Self:     replica,
+				})
+			default:
+				cs.sysdb = newTestSystemDB(t, ctx, log, cs.membership, []string{replica}, replica)
+			}
+
+			svc := newMgmtSvc(cs.harness, cs.membership, cs.sysdb)
+			grpcSrv := grpc.NewServer()
+			pb.RegisterMgmtSvcServer(grpcSrv, svc)
+			go func() {
+				_ = grpcSrv.Serve(lis)
+			}()
+			defer grpcSrv.Stop()
+
+			for _, join := range tc.joins {
+				if _, err := svc.Join(joinPeerContext(t, ctx, join.Addr), join); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			reqCtx := joinPeerContext(t, ctx, tc.req.Addr)
+			if tc.peerAddr != nil {
+				reqCtx = peer.NewContext(ctx, &peer.Peer{Addr: tc.peerAddr})
+			}
+			if tc.forwarded {
+				reqCtx = metadata.NewIncomingContext(reqCtx,
+					metadata.Pairs(joinForwardedKey, "true"))
+			}
+			resp, err := svc.Join(reqCtx, tc.req)
+			if tc.expErrMsg != "" {
+				ExpectError(t, err, tc.expErrMsg, name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			AssertEqual(t, resp, tc.expResp, "unexpected join response")
+			if tc.expMissing {
+				if _, err := cs.membership.GetByUUID(tc.req.Uuid); err == nil {
+					t.Fatal("member recorded despite rejected join")
+				}
+				return
+			}
+			if resp.Status != 0 {
+				return
+			}
+
+			if tc.drpcResp == nil {
+				_, joined := engine.calls()
+				AssertEqual(t, joined[len(joined)-1], resp.Rank,
+					"member not added to management service")
+			}
+
+			member, err := cs.membership.Get(resp.Rank)
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEqual(t, member.UUID, tc.req.Uuid, "unexpected member UUID")
+			AssertEqual(t, member.URI, tc.req.Uri, "unexpected member URI")
+			AssertEqual(t, member.FabricContexts, tc.req.Nctxs, "unexpected member contexts")
+			AssertEqual(t, member.Addr, tc.expAddr, "unexpected member address")
+		})
+	}
+}
+
+func TestMgmtSvcGetAttachInfo(t *testing.T) {
+	replicas := []string{"10.0.0.1:10000", "10.0.0.2:10000", "10.0.0.3:10000"}
+	drpcResp := func() *pb.GetAttachInfoResp {
+		return &pb.GetAttachInfoResp{
+			Psrs: []*pb.GetAttachInfoResp_Psr{{Rank: 9, Uri: "uri-9"}},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		notReplica bool
+		members    system.Members
+		expResp    *pb.GetAttachInfoResp
+	}{
+		"from database": {
+			members: system.Members{
+				{Rank: 0, URI: "uri-0", Addr: "10.0.0.1:10000", State: system.MemberStateJoined},
+				{Rank: 1, URI: "uri-1", Addr: "10.0.0.4:10000", State: system.MemberStateJoined},
+				{Rank: 2, URI: "uri-2", Addr: "10.0.0.2", State: system.MemberStateJoined},
+				{Rank: 3, URI: "uri-3", Addr: "10.0.0.3:10000", State: system.MemberStateExcluded},
+			},
+			expResp: &pb.GetAttachInfoResp{
+				Psrs: []*pb.GetAttachInfoResp_Psr{
+					{Rank: 0, Uri: "uri-0"},
+					{Rank: 2, Uri: "uri-2"},
+				},
+			},
+		},
+		"no access point members": {
+			members: system.Members{
+				{Rank: 1, URI: "uri-1", Addr: "10.0.0.4:10000", State: system.MemberStateJoined},
+			},
+			expResp: drpcResp(),
+		},
+		"not a replica": {
+			notReplica: true,
+			members: system.Members{
+				{Rank: 0, URI: "uri-0", Addr: "10.0.0.1:10000", State: system.MemberStateJoined},
+			},
+			expResp: drpcResp(),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			cs := defaultMockControlService(t, log)
+			mi, err := cs.harness.GetManagementInstance()
+			if err != nil {
+				t.Fatal(err)
+			}
+			body, err := proto.Marshal(drpcResp())
+			if err != nil {
+				t.Fatal(err)
+			}
+			client := newMockDrpcClient()
+			client.setSendMsgResponse(drpc.Status_SUCCESS, body)
+			mi.drpcClient = client
+
+			if !tc.notReplica {
+				cs.sysdb = system.NewDatabase(log, cs.membership, &system.DatabaseConfig{
+					Replicas: replicas,
+					Self:     replicas[0],
+				})
+			}
+			for _, member := range tc.members {
+				cs.membership.Join(member)
+			}
+
+			svc := newMgmtSvc(cs.harness, cs.membership, cs.sysdb)
+			resp, err := svc.GetAttachInfo(context.TODO(), &pb.GetAttachInfoReq{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			AssertEqual(t, resp, tc.expResp, "unexpected attach info")
+		})
+	}
+}
+
+func TestMgmtSvcRecordPoolService(t *testing.T) {
+	const replica = "10.0.0.1:10000"
+
+	for name, tc := range map[string]struct {
+		svcReps   string
+		expRanks  []uint32
+		expErrMsg string
+	}{
+		"single": {
+			svcReps:  "1",
+			expRanks: []uint32{1},
+		},
+		"multiple": {
+			svcReps:  "0,1,2",
+			expRanks: []uint32{0, 1, 2},
+		},
+		"invalid": {
+			svcReps:   "0,x",
+			expErrMsg: `invalid service replica ranks "0,x": strconv.ParseUint: parsing "x": invalid syntax`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			cs := defaultMockControlService(t, log)
+			cs.sysdb = newTestSystemDB(t, ctx, log, cs.membership, []string{replica}, replica)
+
+			svc := newMgmtSvc(cs.harness, cs.membership, cs.sysdb)
+			err := svc.recordPoolService(ctx, "pool", tc.svcReps)
+			if tc.expErrMsg != "" {
+				ExpectError(t, err, tc.expErrMsg, name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ps, err := cs.sysdb.PoolService("pool")
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEqual(t, ps.Replicas, tc.expRanks, "unexpected service ranks")
+		})
+	}
+}
+
+func TestMgmtSvcQueryPoolSizes(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	queryResp := func(uuid string, status int32) []byte {
+		body, err := proto.Marshal(&pb.PoolQueryResp{
+			Uuid:   uuid,
+			Status: status,
+			Scm:    &pb.StorageUsageStats{Total: 1 << 30},
+			Nvme:   &pb.StorageUsageStats{Total: 10 << 30},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return body
+	}
+	responses := map[string]*mockDrpcClient{
+		"ok":          {SendMsgOutputResponse: &drpc.Response{Body: queryResp("ok", 0)}},
+		"bad-status":  {SendMsgOutputResponse: &drpc.Response{Body: queryResp("bad-status", -1006)}},
+		"drpc-failed": {SendMsgOutputError: errors.New("send failed")},
+	}
+
+	var pools []*pb.ListPoolsResp_Pool
+	for i := 0; i < 2*poolQueryParallelism; i++ {
+		pools = append(pools, &pb.ListPoolsResp_Pool{Uuid: "ok"})
+	}
+	pools = append(pools,
+		&pb.ListPoolsResp_Pool{Uuid: "bad-status"},
+		&pb.ListPoolsResp_Pool{Uuid: "drpc-failed"})
+
+	// Each query is made over a client of its own, which answers
+	// according to the pool queried.
+	newClient := func() drpc.DomainSocketClient {
+		return &poolQueryDrpcClient{responses: responses}
+	}
+
+	svc := newMgmtSvc(NewIOServerHarness(&mockExt{}, log), nil, nil)
+	svc.queryPoolSizes(pools, newClient)
+
+	for _, pool := range pools {
+		switch pool.Uuid {
+		case "ok":
+			AssertEqual(t, pool.Scmbytes, uint64(1<<30), "unexpected SCM size")
+			AssertEqual(t, pool.Nvmebytes, uint64(10<<30), "unexpected NVMe size")
+			AssertEqual(t, pool.Error, "", "unexpected error")
+		case "bad-status":
+			AssertEqual(t, pool.Scmbytes, uint64(0), "unexpected SCM size")
+			AssertEqual(t, pool.Error, "DAOS returned error code: -1006", "unexpected error")
+		case "drpc-failed":
+			AssertEqual(t, pool.Error, "send message: send failed", "unexpected error")
+		}
+	}
+}
+
+// poolQueryDrpcClient answers pool queries with the response of the mock
+// client for the queried pool.
+type poolQueryDrpcClient struct {
+	mockDrpcClient
+	responses map[string]*mockDrpcClient
+}
+
+func (c *poolQueryDrpcClient) SendMsg(call *drpc.Call) (*drpc.Response, error) {
+	req := &pb.PoolQueryReq{}
+	if err := proto.Unmarshal(call.Body, req); err != nil {
+		return nil, err
+	}
+	mock := c.responses[req.Uuid]
+
+	return mock.SendMsgOutputResponse, mock.SendMsgOutputError
+}
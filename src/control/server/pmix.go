@@ -0,0 +1,70 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/server/ioserver"
+)
+
+// DEPRECATED: PMIx compatibility mode.
+//
+// I/O server ranks are normally taken from the instance superblock or
+// assigned by the management service on join, the management service is
+// bootstrapped by the first access point and attach info is served from the
+// system database. In the compatibility mode (enabled with pmix_compat in the
+// server config), an instance of a daos_server launched by a PMIx launcher
+// such as orterun instead leaves its rank to PMIx and the instance launched
+// as PMIx rank 0 bootstraps the management service. This mode will be
+// removed in a future release.
+
+const pmixRankEnv = "PMIX_RANK"
+
+// pmixMode returns true if the instance runs in PMIx compatibility mode, i.e.
+// the mode is enabled and daos_server was started by a PMIx launcher.
+func (srv *IOServerInstance) pmixMode() bool {
+	if !srv.runner.Config.PMIxCompat {
+		return false
+	}
+	_, ok := os.LookupEnv(pmixRankEnv)
+	return ok
+}
+
+// pmixRank returns the PMIx rank. If not started by a PMIx launcher or
+// PMIX_RANK has an unexpected value, it returns an error.
+func pmixRank() (ioserver.Rank, error) {
+	s, ok := os.LookupEnv(pmixRankEnv)
+	if !ok {
+		return ioserver.NilRank, errors.New("not in PMIx mode")
+	}
+	r, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return ioserver.NilRank, errors.Wrap(err, pmixRankEnv+"="+s)
+	}
+	return ioserver.Rank(r), nil
+}
@@ -0,0 +1,130 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"context"
+	"net"
+	"os"
+	"testing"
+
+	. "github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/ioserver"
+)
+
+func setPMIxRank(t *testing.T, rank string) func() {
+	t.Helper()
+
+	old, wasSet := os.LookupEnv(pmixRankEnv)
+	var err error
+	if rank == "" {
+		err = os.Unsetenv(pmixRankEnv)
+	} else {
+		err = os.Setenv(pmixRankEnv, rank)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return func() {
+		if wasSet {
+			os.Setenv(pmixRankEnv, old)
+		} else {
+			os.Unsetenv(pmixRankEnv)
+		}
+	}
+}
+
+func TestPMIxMgmtInfo(t *testing.T) {
+	for name, tc := range map[string]struct {
+		pmixCompat   bool
+		pmixRank     string
+		accessPoints []string
+		expPMIx      bool
+		expInfo      *mgmtInfo
+		expErr       bool
+	}{
+		"not launched by pmix": {
+			pmixCompat: true,
+			expInfo:    &mgmtInfo{},
+		},
+		"pmix rank ignored without compat": {
+			pmixRank: "0",
+			expInfo:  &mgmtInfo{},
+		},
+		"pmix rank 0 in compat mode": {
+			pmixCompat: true,
+			pmixRank:   "0",
+			expPMIx:    true,
+			expInfo:    &mgmtInfo{isReplica: true, shouldBootstrap: true},
+		},
+		"pmix rank 1 in compat mode": {
+			pmixCompat: true,
+			pmixRank:   "1",
+			expPMIx:    true,
+			expInfo:    &mgmtInfo{},
+		},
+		"bad pmix rank in compat mode": {
+			pmixCompat: true,
+			pmixRank:   "foo",
+			expPMIx:    true,
+			expErr:     true,
+		},
+		"access point without compat": {
+			pmixRank:     "1",
+			accessPoints: []string{"127.0.0.1:10001"},
+			expInfo:      &mgmtInfo{isReplica: true, shouldBootstrap: true},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			defer setPMIxRank(t, tc.pmixRank)()
+
+			cfg := ioserver.NewConfig().WithPMIxCompat(tc.pmixCompat)
+			r := ioserver.NewRunner(log, cfg)
+			m := newMgmtSvcClient(context.Background(), log, mgmtSvcClientCfg{
+				AccessPoints: tc.accessPoints,
+				ControlAddr:  &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 10001},
+			})
+			srv := NewIOServerInstance(nil, log, nil, m, r)
+
+			AssertEqual(t, srv.pmixMode(), tc.expPMIx, "unexpected pmix mode")
+
+			info, err := getMgmtInfo(srv)
+			if tc.expErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEqual(t, info, tc.expInfo, "unexpected management info")
+		})
+	}
+}
@@ -0,0 +1,145 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultRestartMaxRetries      = 3
+	defaultRestartBackoffBase     = time.Second
+	defaultRestartBackoffMax      = time.Minute
+	defaultRestartCrashLoopWindow = 5 * time.Minute
+)
+
+// RestartPolicy determines how the harness responds to the unexpected exit
+// of a managed I/O server instance.
+type RestartPolicy struct {
+	// MaxRetries is the number of consecutive restarts attempted before
+	// giving up, zero disables automatic restarts.
+	MaxRetries int `yaml:"max_retries"`
+	// BackoffBase is the delay before the first restart attempt, doubled
+	// for each consecutive attempt.
+	BackoffBase time.Duration `yaml:"backoff_base"`
+	// BackoffMax caps the delay between restart attempts.
+	BackoffMax time.Duration `yaml:"backoff_max"`
+	// CrashLoopWindow is how long an instance must run for before its
+	// consecutive restart count is reset.
+	CrashLoopWindow time.Duration `yaml:"crash_loop_window"`
+}
+
+// DefaultRestartPolicy returns the restart policy used when none is
+// specified in the server configuration.
+func DefaultRestartPolicy() RestartPolicy {
+	return RestartPolicy{
+		MaxRetries:      defaultRestartMaxRetries,
+		BackoffBase:     defaultRestartBackoffBase,
+		BackoffMax:      defaultRestartBackoffMax,
+		CrashLoopWindow: defaultRestartCrashLoopWindow,
+	}
+}
+
+// Validate checks the restart policy values are usable.
+func (p RestartPolicy) Validate() error {
+	switch {
+	case p.MaxRetries < 0:
+		return errors.New("restart_policy: max_retries must not be negative")
+	case p.MaxRetries == 0:
+		return nil
+	case p.BackoffBase <= 0:
+		return errors.New("restart_policy: backoff_base must be positive")
+	case p.BackoffMax < p.BackoffBase:
+		return errors.New("restart_policy: backoff_max must not be less than backoff_base")
+	case p.CrashLoopWindow < 0:
+		return errors.New("restart_policy: crash_loop_window must not be negative")
+	}
+
+	return nil
+}
+
+// backoff returns the delay before the given (1-based) consecutive restart
+// attempt.
+func (p RestartPolicy) backoff(attempt int) time.Duration {
+	delay := p.BackoffBase
+	for i := 1; i < attempt && delay < p.BackoffMax; i++ {
+		delay *= 2
+	}
+	if delay > p.BackoffMax {
+		delay = p.BackoffMax
+	}
+
+	return delay
+}
+
+// restartState records the run history of an I/O server instance.
+type restartState struct {
+	running      bool
+	restarts     int // total number of restarts
+	attempts     int // consecutive restarts within the crash loop window
+	lastStart    time.Time
+	lastExit     error
+	lastExitTime time.Time
+}
+
+// started records that the instance was started at the given time.
+func (rs *restartState) started(now time.Time) {
+	rs.running = true
+	rs.lastStart = now
+}
+
+// stopped records that the instance exited at the given time after being
+// deliberately stopped. Deliberate stops do not count toward restarts.
+func (rs *restartState) stopped(exitErr error, now time.Time) {
+	rs.running = false
+	rs.attempts = 0
+	rs.lastExit = exitErr
+	rs.lastExitTime = now
+}
+
+// exited records the exit of the instance at the given time and returns the
+// delay to wait before restarting it. An error is returned if the policy
+// does not permit another restart.
+func (rs *restartState) exited(p RestartPolicy, exitErr error, now time.Time) (time.Duration, error) {
+	rs.running = false
+	rs.lastExit = exitErr
+	rs.lastExitTime = now
+
+	if p.MaxRetries == 0 {
+		return 0, errors.New("automatic restart disabled")
+	}
+	if now.Sub(rs.lastStart) > p.CrashLoopWindow {
+		rs.attempts = 0
+	}
+	if rs.attempts >= p.MaxRetries {
+		return 0, errors.Errorf("exceeded %d consecutive restarts", p.MaxRetries)
+	}
+
+	rs.attempts++
+	rs.restarts++
+
+	return p.backoff(rs.attempts), nil
+}
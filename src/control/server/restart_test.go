@@ -0,0 +1,125 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/common"
+)
+
+func TestRestartPolicyBackoff(t *testing.T) {
+	policy := RestartPolicy{
+		MaxRetries:  10,
+		BackoffBase: time.Second,
+		BackoffMax:  10 * time.Second,
+	}
+
+	for attempt, expDelay := range map[int]time.Duration{
+		1:  time.Second,
+		2:  2 * time.Second,
+		3:  4 * time.Second,
+		4:  8 * time.Second,
+		5:  10 * time.Second,
+		64: 10 * time.Second,
+	} {
+		common.AssertEqual(t, policy.backoff(attempt), expDelay,
+			"unexpected delay for attempt")
+	}
+}
+
+func TestRestartStateExited(t *testing.T) {
+	policy := RestartPolicy{
+		MaxRetries:      2,
+		BackoffBase:     time.Second,
+		BackoffMax:      time.Minute,
+		CrashLoopWindow: time.Minute,
+	}
+	exitErr := errors.New("instance exited")
+	start := time.Now()
+
+	for name, tt := range map[string]struct {
+		policy     RestartPolicy
+		uptimes    []time.Duration // run time before each exit
+		expDelays  []time.Duration
+		expErr     bool
+		expRestart int
+	}{
+		"restarts disabled": {
+			policy:  RestartPolicy{},
+			uptimes: []time.Duration{time.Hour},
+			expErr:  true,
+		},
+		"single crash": {
+			policy:     policy,
+			uptimes:    []time.Duration{time.Second},
+			expDelays:  []time.Duration{time.Second},
+			expRestart: 1,
+		},
+		"crash loop": {
+			policy:     policy,
+			uptimes:    []time.Duration{time.Second, time.Second, time.Second},
+			expDelays:  []time.Duration{time.Second, 2 * time.Second},
+			expErr:     true,
+			expRestart: 2,
+		},
+		"stable between crashes": {
+			policy:     policy,
+			uptimes:    []time.Duration{time.Second, time.Second, time.Hour, time.Second},
+			expDelays:  []time.Duration{time.Second, 2 * time.Second, time.Second, 2 * time.Second},
+			expRestart: 4,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var rs restartState
+			var gotErr error
+			var delays []time.Duration
+
+			now := start
+			for _, uptime := range tt.uptimes {
+				rs.started(now)
+				now = now.Add(uptime)
+
+				var delay time.Duration
+				delay, gotErr = rs.exited(tt.policy, exitErr, now)
+				if gotErr != nil {
+					break
+				}
+				delays = append(delays, delay)
+				now = now.Add(delay)
+			}
+
+			common.AssertEqual(t, gotErr != nil, tt.expErr, "unexpected error result")
+			common.AssertEqual(t, len(delays), len(tt.expDelays), "unexpected restart count")
+			for i := range delays {
+				common.AssertEqual(t, delays[i], tt.expDelays[i], "unexpected delay")
+			}
+			common.AssertEqual(t, rs.restarts, tt.expRestart, "unexpected total restarts")
+			common.AssertEqual(t, rs.running, false, "expected instance not running")
+			common.AssertEqual(t, rs.lastExit, exitErr, "unexpected last exit")
+		})
+	}
+}
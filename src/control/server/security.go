@@ -29,6 +29,7 @@ import "C"
 
 import (
 	"context"
+	"sync"
 
 	"github.com/daos-stack/daos/src/control/drpc"
 	"github.com/daos-stack/daos/src/control/security/acl"
@@ -48,7 +49,9 @@ const (
 // SecurityService contains the data for the service that performs tasks related to
 // security and access control
 type SecurityService struct {
-	drpc drpc.DomainSocketClient
+	harness *IOServerHarness
+	mutex   sync.Mutex
+	drpc    drpc.DomainSocketClient
 }
 
 // processDrpcReponse extracts the AclResponse from the drpc Response, and
@@ -95,6 +98,9 @@ func (s *SecurityService) callDrpcMethodWithMessage(method int32, body proto.Mes
 		return nil, err
 	}
 
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
 	// Forward the request to the I/O server via dRPC
 	err = s.drpc.Connect()
 	if err != nil {
@@ -139,9 +145,58 @@ func (s *SecurityService) DestroyAclEntry(ctx context.Context, entry *acl.Entry)
 	return s.callDrpcMethodWithMessage(methodDestroyAcl, entry)
 }
 
+// poolACLCall forwards a pool ACL request to the management service
+// instance and returns the resulting ACL.
+func (s *SecurityService) poolACLCall(name string, method int32, req proto.Message) (*acl.ACLResp, error) {
+	mi, err := s.harness.GetManagementInstance()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkIsMSReplica(mi); err != nil {
+		return nil, err
+	}
+
+	s.mutex.Lock()
+	dresp, err := makeDrpcCall(s.drpc, mgmtModuleID, method, req)
+	s.mutex.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &acl.ACLResp{}
+	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+		return nil, errors.Wrapf(err, "unmarshal %s response", name)
+	}
+
+	return resp, nil
+}
+
+// PoolGetACL fetches the Access Control List of a DAOS pool.
+func (s *SecurityService) PoolGetACL(ctx context.Context, req *acl.GetACLReq) (*acl.ACLResp, error) {
+	return s.poolACLCall("PoolGetACL", poolGetACL, req)
+}
+
+// PoolOverwriteACL replaces the Access Control List of a DAOS pool.
+func (s *SecurityService) PoolOverwriteACL(ctx context.Context, req *acl.ModifyACLReq) (*acl.ACLResp, error) {
+	return s.poolACLCall("PoolOverwriteACL", poolOverwriteACL, req)
+}
+
+// PoolUpdateACL adds or replaces entries in the Access Control List of a
+// DAOS pool.
+func (s *SecurityService) PoolUpdateACL(ctx context.Context, req *acl.ModifyACLReq) (*acl.ACLResp, error) {
+	return s.poolACLCall("PoolUpdateACL", poolUpdateACL, req)
+}
+
+// PoolDeleteACL removes the entry for a principal from the Access Control
+// List of a DAOS pool.
+func (s *SecurityService) PoolDeleteACL(ctx context.Context, req *acl.DeleteACLReq) (*acl.ACLResp, error) {
+	return s.poolACLCall("PoolDeleteACL", poolDeleteACL, req)
+}
+
 // newSecurityService creates and initializes a new security SecurityService instance
-func newSecurityService(client drpc.DomainSocketClient) *SecurityService {
+func newSecurityService(client drpc.DomainSocketClient, harness *IOServerHarness) *SecurityService {
 	return &SecurityService{
-		drpc: client,
+		harness: harness,
+		drpc:    client,
 	}
 }
@@ -32,6 +32,7 @@ import (
 
 	. "github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/security/acl"
 )
 
@@ -106,7 +107,7 @@ func aclResponseToBytes(resp *acl.Response) []byte {
 
 func TestNewSecurityService(t *testing.T) {
 	expectedClient := newMockDrpcClient()
-	service := newSecurityService(expectedClient)
+	service := newSecurityService(expectedClient, nil)
 
 	AssertTrue(t, service != nil, "NewSecurityService returned nil")
 	AssertEqual(t, service.drpc, expectedClient, "Wrong dRPC client")
@@ -382,3 +383,42 @@ func TestDestroyAclEntry_Success(t *testing.T) {
 	expectedCallBody, _ := proto.Marshal(entry)
 	expectDrpcCall(t, client, moduleID, methodDestroyAcl, expectedCallBody)
 }
+
+func TestPoolGetACL(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	client := newMockDrpcClient()
+	harness := defaultMockControlService(t, log).harness
+	mi, err := harness.GetManagementInstance()
+	if err != nil {
+		t.Fatal(err)
+	}
+	mi.msClient = newMgmtSvcClient(context.TODO(), log, mgmtSvcClientCfg{
+		AccessPoints: []string{"localhost"},
+	})
+	service := newSecurityService(client, harness)
+	req := &acl.GetACLReq{Uuid: "12345678-1234-1234-1234-123456789abc"}
+
+	// instance is not an MS replica, request should not be forwarded
+	_, err = service.PoolGetACL(context.TODO(), req)
+	if err == nil {
+		t.Fatal("expected error from non-replica instance")
+	}
+	AssertTrue(t, client.SendMsgInputCall == nil, "unexpected dRPC call")
+
+	mi.setSuperblock(&Superblock{System: "daos_server", MS: true})
+
+	expectedResp := &acl.ACLResp{Acl: []string{"A::OWNER@:rw"}}
+	respBytes, _ := proto.Marshal(expectedResp)
+	client.setSendMsgResponse(drpc.Status_SUCCESS, respBytes)
+
+	result, err := service.PoolGetACL(context.TODO(), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEqual(t, result.Acl, expectedResp.Acl, "unexpected ACL")
+
+	expectedCallBody, _ := proto.Marshal(req)
+	expectDrpcCall(t, client, mgmtModuleID, poolGetACL, expectedCallBody)
+}
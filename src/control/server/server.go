@@ -40,11 +40,9 @@ import (
 	"github.com/daos-stack/daos/src/control/security/acl"
 	"github.com/daos-stack/daos/src/control/server/ioserver"
 	"github.com/daos-stack/daos/src/control/server/storage"
+	"github.com/daos-stack/daos/src/control/system"
 )
 
-// define supported maximum number of I/O servers
-const maxIoServers = 1
-
 // Start is the entry point for a daos_server instance.
 func Start(log *logging.LeveledLogger, cfg *Configuration) error {
 	// FIXME(mjmac): Temporarily set a global logger
@@ -60,6 +58,11 @@ func Start(log *logging.LeveledLogger, cfg *Configuration) error {
 	// Backup active config.
 	saveActiveConfig(log, cfg)
 
+	if cfg.PMIxCompat {
+		log.Error("pmix_compat is DEPRECATED: I/O server ranks should be assigned by the management service, " +
+			"start daos_server without a PMIx launcher instead")
+	}
+
 	// Create the root context here. All contexts should
 	// inherit from this one so that they can be shut down
 	// from one place.
@@ -71,12 +74,8 @@ func Start(log *logging.LeveledLogger, cfg *Configuration) error {
 		return errors.Wrap(err, "unable to resolve daos_server control address")
 	}
 
-	harness := NewIOServerHarness(&ext{}, log)
-	for i, srvCfg := range cfg.Servers {
-		if i+1 > maxIoServers {
-			break
-		}
-
+	harness := NewIOServerHarness(&ext{}, log).WithRestartPolicy(cfg.RestartPolicy)
+	for _, srvCfg := range cfg.Servers {
 		bp, err := storage.NewBdevProvider(log, srvCfg.Storage.SCM.MountPoint, &srvCfg.Storage.Bdev)
 		if err != nil {
 			return err
@@ -86,6 +85,7 @@ func Start(log *logging.LeveledLogger, cfg *Configuration) error {
 			AccessPoints:    cfg.AccessPoints,
 			ControlAddr:     controlAddr,
 			TransportConfig: cfg.TransportConfig,
+			JoinTimeout:     cfg.JoinTimeout,
 		})
 
 		srv := NewIOServerInstance(harness.ext, log, bp, msClient, ioserver.NewRunner(log, srvCfg))
@@ -93,16 +93,34 @@ func Start(log *logging.LeveledLogger, cfg *Configuration) error {
 			return err
 		}
 
-		// FIXME: Pretty sure each instance is going to need its own
-		// set of socket files -- probably need to do some work in IOServer
-		// to allow us to pass that information via flag.
+		// Each instance has its own socket directory and therefore its
+		// own pair of dRPC sockets.
+		if err := instanceSocketDirSetup(cfg.SocketDir, srvCfg.SocketDir); err != nil {
+			return errors.WithMessagef(err, "I/O server %d socket setup", srv.Index)
+		}
 		if err := drpcSetup(srvCfg.SocketDir, srv, cfg.TransportConfig); err != nil {
-			return errors.WithMessage(err, "dRPC setup")
+			return errors.WithMessagef(err, "I/O server %d dRPC setup", srv.Index)
 		}
 	}
 
+	mi, err := harness.GetManagementInstance()
+	if err != nil {
+		return err
+	}
+
+	// Create the system database, replicated across the access points
+	// and persisted next to the management instance superblock.
+	dbTransport := newSystemDBTransport(log, cfg.TransportConfig)
+	defer dbTransport.Close()
+	dbCfg, err := systemDBConfig(controlAddr, cfg.AccessPoints, mi.systemDBPath(), dbTransport)
+	if err != nil {
+		return errors.Wrap(err, "configure system database")
+	}
+	membership := system.NewMembership(log)
+	sysdb := system.NewDatabase(log, membership, dbCfg)
+
 	// Create and setup control service.
-	controlService, err := NewControlService(log, harness, cfg)
+	controlService, err := NewControlService(log, harness, cfg, membership, sysdb)
 	if err != nil {
 		return errors.Wrap(err, "init control service")
 	}
@@ -111,6 +129,26 @@ func Start(log *logging.LeveledLogger, cfg *Configuration) error {
 	}
 	defer controlService.Teardown()
 
+	// Changes to the config file are applied on SIGHUP or request.
+	reloader, err := newConfigReloader(log, cfg, harness)
+	if err != nil {
+		log.Errorf("config reload unavailable: %s", err)
+	}
+	controlService.reloader = reloader
+	controlService.logMasks = newLogMasksSetter(log, harness)
+
+	// Instances check their storage devices against those recorded in
+	// their superblocks before starting, and are checked for liveness
+	// once running.
+	for _, srv := range harness.Instances() {
+		srv.setDeviceCheck(&controlService.StorageControlService, cfg.DeviceCheck)
+		srv.setHeartbeat(heartbeatConfig{
+			interval:  cfg.HeartbeatInterval,
+			maxMisses: cfg.HeartbeatMisses,
+			restart:   cfg.HeartbeatRestart,
+		})
+	}
+
 	// Create and start listener on management network.
 	lis, err := net.Listen("tcp4", controlAddr.String())
 	if err != nil {
@@ -132,8 +170,8 @@ func Start(log *logging.LeveledLogger, cfg *Configuration) error {
 	// Only provide IO/Agent communication if not attempting to respawn after format,
 	// otherwise, only provide gRPC mgmt control service for hardware provisioning.
 	if !needsRespawn {
-		mgmtpb.RegisterMgmtSvcServer(grpcServer, newMgmtSvc(harness))
-		secServer := newSecurityService(getDrpcClientConnection(cfg.SocketDir))
+		mgmtpb.RegisterMgmtSvcServer(grpcServer, newMgmtSvc(harness, membership, sysdb))
+		secServer := newSecurityService(getDrpcClientConnection(mi.runner.Config.SocketDir), harness)
 		acl.RegisterAccessControlServer(grpcServer, secServer)
 	}
 
@@ -145,12 +183,20 @@ func Start(log *logging.LeveledLogger, cfg *Configuration) error {
 	log.Infof("DAOS control server listening on %s", controlAddr)
 
 	sigChan := make(chan os.Signal)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGTERM)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGQUIT, syscall.SIGTERM, syscall.SIGHUP)
 	go func() {
 		for {
 			select {
 			case sig := <-sigChan:
 				log.Debugf("Caught signal: %s", sig)
+				if sig == syscall.SIGHUP {
+					if reloader == nil {
+						log.Error("config reload unavailable")
+					} else if _, err := reloader.reload(); err != nil {
+						log.Errorf("config reload failed: %s", err)
+					}
+					continue
+				}
 				shutdown()
 			}
 		}
@@ -165,8 +211,7 @@ func Start(log *logging.LeveledLogger, cfg *Configuration) error {
 			return err
 		}
 	}
-	recreate := false // TODO: make this configurable
-	if err := harness.CreateSuperblocks(recreate); err != nil {
+	if err := harness.CreateSuperblocks(cfg.RecreateSuperblocks); err != nil {
 		return err
 	}
 
@@ -182,5 +227,9 @@ func Start(log *logging.LeveledLogger, cfg *Configuration) error {
 		return nil
 	}
 
+	if err := sysdb.Start(ctx); err != nil {
+		return errors.Wrap(err, "start system database")
+	}
+
 	return errors.Wrap(harness.Start(ctx), "DAOS I/O Server exited with error")
 }
@@ -0,0 +1,288 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+// fioRunner executes fio with the given arguments and additional environment,
+// calling progress for each status line written to stdout, and returns the
+// JSON formatted results.
+type fioRunner func(ctx context.Context, fioPath string, args []string, env string,
+	progress func(string)) ([]byte, error)
+
+// runFio is the fioRunner implementation which executes fio on the host.
+func runFio(ctx context.Context, fioPath string, args []string, env string,
+	progress func(string)) ([]byte, error) {
+
+	outFile, err := ioutil.TempFile("", "daos_burnin")
+	if err != nil {
+		return nil, errors.Wrap(err, "creating fio output file")
+	}
+	outFile.Close()
+	defer os.Remove(outFile.Name())
+
+	args = append([]string{"--output-format=json", "--output=" + outFile.Name()}, args...)
+
+	cmd := exec.CommandContext(ctx, fioPath, args...)
+	cmd.Env = os.Environ()
+	if env != "" {
+		cmd.Env = append(cmd.Env, env)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, errors.Wrap(err, "creating fio stdout pipe")
+	}
+
+	if err := cmd.Start(); err != nil {
+		return nil, errors.Wrapf(err, "starting %s %v", fioPath, args)
+	}
+
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			progress(line)
+		}
+	}
+	scanErr := scanner.Err()
+
+	// Drain any output left unread after a scan error so that fio doesn't
+	// block writing to the pipe and Wait can return.
+	if _, err := io.Copy(ioutil.Discard, stdout); err != nil && scanErr == nil {
+		scanErr = err
+	}
+
+	if err := cmd.Wait(); err != nil {
+		return nil, errors.Wrapf(err, "running %s %v (%q)", fioPath, args, stderr.String())
+	}
+	if scanErr != nil {
+		return nil, errors.Wrap(scanErr, "reading fio progress")
+	}
+
+	return ioutil.ReadFile(outFile.Name())
+}
+
+// fioLatency holds the latency percentiles (in nanoseconds) of a fio job.
+type fioLatency struct {
+	Percentile map[string]uint64 `json:"percentile"`
+}
+
+// fioJobStats holds the statistics for one I/O direction of a fio job.
+type fioJobStats struct {
+	BW     uint64     `json:"bw"`
+	IOPS   float64    `json:"iops"`
+	ClatNs fioLatency `json:"clat_ns"`
+	LatNs  fioLatency `json:"lat_ns"`
+}
+
+// percentiles returns completion latency percentiles if reported, otherwise
+// total latency percentiles.
+func (fs *fioJobStats) percentiles() map[string]uint64 {
+	if len(fs.ClatNs.Percentile) > 0 {
+		return fs.ClatNs.Percentile
+	}
+	return fs.LatNs.Percentile
+}
+
+// fioJob holds the results of a single fio job.
+type fioJob struct {
+	Name     string      `json:"jobname"`
+	Error    int         `json:"error"`
+	TotalErr uint64      `json:"total_err"`
+	Read     fioJobStats `json:"read"`
+	Write    fioJobStats `json:"write"`
+}
+
+// fioOutput is the subset of fio JSON output used to report burn-in results.
+type fioOutput struct {
+	Jobs []fioJob `json:"jobs"`
+}
+
+// aggregateStats sums throughput over all jobs and takes the worst latency
+// reported by any job at each percentile.
+func aggregateStats(stats []fioJobStats) (*pb.BurnInStats, error) {
+	agg := &pb.BurnInStats{}
+	worst := make(map[float64]uint64)
+
+	for _, js := range stats {
+		agg.Iops += js.IOPS
+		agg.Bw += js.BW
+
+		for key, nsec := range js.percentiles() {
+			pct, err := strconv.ParseFloat(key, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid latency percentile %q", key)
+			}
+			if nsec > worst[pct] {
+				worst[pct] = nsec
+			}
+		}
+	}
+
+	for pct, nsec := range worst {
+		agg.Latency = append(agg.Latency, &pb.LatencyPercentile{Percentile: pct, Nsec: nsec})
+	}
+	sort.Slice(agg.Latency, func(i, j int) bool {
+		return agg.Latency[i].Percentile < agg.Latency[j].Percentile
+	})
+
+	return agg, nil
+}
+
+// parseFioResults converts JSON formatted fio output into a burn-in result
+// for the given device.
+func parseFioResults(device string, data []byte) (*pb.BurnInResult, error) {
+	// fio may emit notes before the JSON document
+	start := bytes.IndexByte(data, '{')
+	if start < 0 {
+		return nil, errors.New("no fio JSON output")
+	}
+
+	var out fioOutput
+	if err := json.Unmarshal(data[start:], &out); err != nil {
+		return nil, errors.Wrap(err, "parsing fio JSON output")
+	}
+	if len(out.Jobs) == 0 {
+		return nil, errors.New("no jobs in fio output")
+	}
+
+	result := &pb.BurnInResult{Device: device}
+	reads := make([]fioJobStats, 0, len(out.Jobs))
+	writes := make([]fioJobStats, 0, len(out.Jobs))
+	for _, job := range out.Jobs {
+		result.Errors += job.TotalErr
+		if job.Error != 0 && job.TotalErr == 0 {
+			result.Errors++
+		}
+		reads = append(reads, job.Read)
+		writes = append(writes, job.Write)
+	}
+
+	var err error
+	if result.Read, err = aggregateStats(reads); err != nil {
+		return nil, err
+	}
+	if result.Write, err = aggregateStats(writes); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// resolveFioConfig returns the absolute path of a fio job file, which may be
+// given as a file name relative to the SPDK fio_plugin directory.
+func (c *StorageControlService) resolveFioConfig(config *pb.FilePath) (string, error) {
+	path := config.GetPath()
+	if path == "" {
+		return "", errors.New("no fio config file specified")
+	}
+
+	if !filepath.IsAbs(path) {
+		pluginDir, err := c.nvme.ext.getAbsInstallPath(spdkFioPluginDir)
+		if err != nil {
+			return "", err
+		}
+		path = filepath.Join(pluginDir, path)
+	}
+
+	exists, err := c.nvme.ext.exists(path)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", errors.Errorf("fio config file %s not found", path)
+	}
+
+	return path, nil
+}
+
+// burnInDevice runs fio against a single device, streaming progress to the
+// client, and returns the parsed results.
+func (c *StorageControlService) burnInDevice(stream pb.MgmtCtl_StorageBurnInServer,
+	device string, fioPath string, args []string, env string) (*pb.BurnInResult, error) {
+
+	var sendErr error
+	progress := func(status string) {
+		if sendErr != nil {
+			return
+		}
+		sendErr = stream.Send(&pb.StorageBurnInResp{
+			Progress: &pb.BurnInProgress{Device: device, Status: status},
+		})
+	}
+
+	c.log.Infof("starting burn-in of %s\n", device)
+	out, err := c.runFio(stream.Context(), fioPath, args, env, progress)
+	if sendErr != nil {
+		return nil, errors.WithMessage(sendErr, "sending burn-in progress")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return parseFioResults(device, out)
+}
+
+// burnInNvme runs the fio job file against a namespace on an NVMe controller
+// using the SPDK fio plugin.
+func (c *StorageControlService) burnInNvme(stream pb.MgmtCtl_StorageBurnInServer,
+	pciAddr string, nsID int32, configPath string) (*pb.BurnInResult, error) {
+
+	fioPath, args, env, err := c.nvme.BurnIn(pciAddr, nsID, configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.burnInDevice(stream, pciAddr, fioPath, args, env)
+}
+
+// burnInScm runs the fio job file against files in the SCM mount.
+func (c *StorageControlService) burnInScm(stream pb.MgmtCtl_StorageBurnInServer,
+	cfg storage.ScmConfig, configPath string) (*pb.BurnInResult, error) {
+
+	fioPath, args, err := c.scm.BurnIn(cfg, configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.burnInDevice(stream, cfg.MountPoint, fioPath, args, "")
+}
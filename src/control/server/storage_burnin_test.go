@@ -0,0 +1,365 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	. "github.com/daos-stack/daos/src/control/common"
+	pb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/storage"
+)
+
+const mockFioOutput = `note: both iodepth >= 1 and synchronous I/O engine are selected
+{
+  "fio version" : "fio-3.3",
+  "jobs" : [
+    {
+      "jobname" : "job0",
+      "error" : 0,
+      "total_err" : 0,
+      "read" : {
+        "bw" : 1000,
+        "iops" : 250.5,
+        "clat_ns" : {
+          "percentile" : {"50.000000" : 1000, "99.000000" : 5000}
+        }
+      },
+      "write" : {
+        "bw" : 2000,
+        "iops" : 500,
+        "clat_ns" : {},
+        "lat_ns" : {
+          "percentile" : {"50.000000" : 2000}
+        }
+      }
+    },
+    {
+      "jobname" : "job1",
+      "error" : 5,
+      "read" : {
+        "bw" : 3000,
+        "iops" : 749.5,
+        "clat_ns" : {
+          "percentile" : {"50.000000" : 1500, "99.000000" : 4000}
+        }
+      },
+      "write" : {
+        "bw" : 0,
+        "iops" : 0
+      }
+    }
+  ]
+}`
+
+func TestParseFioResults(t *testing.T) {
+	for name, tt := range map[string]struct {
+		output    string
+		expResult *pb.BurnInResult
+		expErr    error
+	}{
+		"aggregated jobs": {
+			output: mockFioOutput,
+			expResult: &pb.BurnInResult{
+				Device: "dev0",
+				Read: &pb.BurnInStats{
+					Iops: 1000,
+					Bw:   4000,
+					Latency: []*pb.LatencyPercentile{
+						{Percentile: 50, Nsec: 1500},
+						{Percentile: 99, Nsec: 5000},
+					},
+				},
+				Write: &pb.BurnInStats{
+					Iops: 500,
+					Bw:   2000,
+					Latency: []*pb.LatencyPercentile{
+						{Percentile: 50, Nsec: 2000},
+					},
+				},
+				Errors: 1,
+			},
+		},
+		"no json": {
+			output: "fio: failed to open job file",
+			expErr: errors.New("no fio JSON output"),
+		},
+		"no jobs": {
+			output: `{"jobs": []}`,
+			expErr: errors.New("no jobs in fio output"),
+		},
+		"bad percentile": {
+			output: `{"jobs": [{"read": {"clat_ns": {"percentile": {"high": 1}}}}]}`,
+			expErr: errors.New(`invalid latency percentile "high": strconv.ParseFloat: parsing "high": invalid syntax`),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			result, err := parseFioResults("dev0", []byte(tt.output))
+			if tt.expErr != nil {
+				ExpectError(t, err, tt.expErr.Error(), name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			AssertEqual(t, result, tt.expResult, "unexpected result")
+		})
+	}
+}
+
+// mockStorageBurnInServer provides mocking for server side streaming,
+// implement send method and record sent burn-in responses.
+type mockStorageBurnInServer struct {
+	grpc.ServerStream
+	Results []*pb.StorageBurnInResp
+}
+
+func (m *mockStorageBurnInServer) Send(resp *pb.StorageBurnInResp) error {
+	m.Results = append(m.Results, resp)
+	return nil
+}
+
+func (m *mockStorageBurnInServer) Context() context.Context {
+	return context.TODO()
+}
+
+func TestRunFio(t *testing.T) {
+	for name, tt := range map[string]struct {
+		script      string
+		expProgress []string
+		expErr      error
+	}{
+		"progress and results": {
+			script: "echo jobs: 1; echo; echo done; " +
+				"for a in \"$@\"; do case $a in --output=*) echo '{}' > ${a#--output=};; esac; done",
+			expProgress: []string{"jobs: 1", "done"},
+		},
+		"progress line too long": {
+			script:      "head -c 100000 /dev/zero | tr '\\0' x; echo; echo done",
+			expProgress: []string{},
+			expErr:      errors.New("reading fio progress: bufio.Scanner: token too long"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", strings.Replace(t.Name(), "/", "-", -1))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			fioPath := filepath.Join(tmpDir, "fio")
+			script := "#!/bin/sh\n" + tt.script + "\n"
+			if err := ioutil.WriteFile(fioPath, []byte(script), 0755); err != nil {
+				t.Fatal(err)
+			}
+
+			progress := []string{}
+			out, err := runFio(context.TODO(), fioPath, nil, "",
+				func(line string) { progress = append(progress, line) })
+			AssertEqual(t, progress, tt.expProgress, "unexpected progress")
+			if tt.expErr != nil {
+				ExpectError(t, err, tt.expErr.Error(), name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			AssertEqual(t, strings.TrimSpace(string(out)), "{}", "unexpected results")
+		})
+	}
+}
+
+func TestStorageBurnIn(t *testing.T) {
+	pciAddr := "0000:81:00.0"
+	scmMount := "/mnt/daos"
+	fioConfig := &pb.FilePath{Path: "/foo/bar/conf.fio"}
+	expResult := func(device string) *pb.BurnInResult {
+		result, err := parseFioResults(device, []byte(mockFioOutput))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result
+	}
+	progress := func(device string) *pb.StorageBurnInResp {
+		return &pb.StorageBurnInResp{
+			Progress: &pb.BurnInProgress{Device: device, Status: "Jobs: 1 (f=1)"},
+		}
+	}
+
+	for name, tt := range map[string]struct {
+		req          *pb.StorageBurnInReq
+		configExists bool
+		fioErr       error
+		expFioArgs   [][]string
+		expResps     []*pb.StorageBurnInResp
+		expErr       error
+	}{
+		"nothing requested": {
+			req:          &pb.StorageBurnInReq{},
+			configExists: true,
+			expErr:       errors.New("no nvme or scm burn-in requested"),
+		},
+		"missing config": {
+			req:    &pb.StorageBurnInReq{Nvme: &pb.BurninNvmeReq{Fioconfig: fioConfig}},
+			expErr: errors.New("fio config file /foo/bar/conf.fio not found"),
+		},
+		"empty config path": {
+			req: &pb.StorageBurnInReq{
+				Nvme: &pb.BurninNvmeReq{Fioconfig: &pb.FilePath{Path: ""}},
+			},
+			configExists: true,
+			expErr:       errors.New("no fio config file specified"),
+		},
+		"nvme": {
+			req:          &pb.StorageBurnInReq{Nvme: &pb.BurninNvmeReq{Fioconfig: fioConfig}},
+			configExists: true,
+			expFioArgs: [][]string{
+				{
+					"--filename=trtype=PCIe traddr=0000.81.00.0 ns=1",
+					"--ioengine=spdk", "--eta=always", "--eta-newline=10",
+					fioConfig.Path,
+				},
+			},
+			expResps: []*pb.StorageBurnInResp{
+				progress(pciAddr),
+				{
+					Crets: []*pb.NvmeControllerResult{
+						{Pciaddr: pciAddr, State: &pb.ResponseState{}},
+					},
+					Results: []*pb.BurnInResult{expResult(pciAddr)},
+				},
+			},
+		},
+		"nvme and scm": {
+			req: &pb.StorageBurnInReq{
+				Nvme: &pb.BurninNvmeReq{Fioconfig: fioConfig},
+				Scm:  &pb.BurninScmReq{Fioconfig: fioConfig},
+			},
+			configExists: true,
+			expFioArgs: [][]string{
+				{
+					"--filename=trtype=PCIe traddr=0000.81.00.0 ns=1",
+					"--ioengine=spdk", "--eta=always", "--eta-newline=10",
+					fioConfig.Path,
+				},
+				{
+					"--directory=" + scmMount, "--unlink=1",
+					"--eta=always", "--eta-newline=10", fioConfig.Path,
+				},
+			},
+			expResps: []*pb.StorageBurnInResp{
+				progress(pciAddr),
+				{
+					Crets: []*pb.NvmeControllerResult{
+						{Pciaddr: pciAddr, State: &pb.ResponseState{}},
+					},
+					Results: []*pb.BurnInResult{expResult(pciAddr)},
+				},
+				progress(scmMount),
+				{
+					Mrets: []*pb.ScmMountResult{
+						{Mntpoint: scmMount, State: &pb.ResponseState{}},
+					},
+					Results: []*pb.BurnInResult{expResult(scmMount)},
+				},
+			},
+		},
+		"fio fails": {
+			req:          &pb.StorageBurnInReq{Nvme: &pb.BurninNvmeReq{Fioconfig: fioConfig}},
+			configExists: true,
+			fioErr:       errors.New("fio exited 1"),
+			expFioArgs: [][]string{
+				{
+					"--filename=trtype=PCIe traddr=0000.81.00.0 ns=1",
+					"--ioengine=spdk", "--eta=always", "--eta-newline=10",
+					fioConfig.Path,
+				},
+			},
+			expResps: []*pb.StorageBurnInResp{
+				progress(pciAddr),
+				{
+					Crets: []*pb.NvmeControllerResult{
+						{
+							Pciaddr: pciAddr,
+							State: &pb.ResponseState{
+								Status: pb.ResponseStatus_CTRL_ERR_NVME,
+								Error:  "fio exited 1",
+							},
+						},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			config := newMockStorageConfig(nil, nil, nil, nil,
+				scmMount, storage.ScmClassDCPM, []string{"/dev/pmem0"}, 0,
+				storage.BdevClassNvme, []string{pciAddr}, tt.configExists, false)
+			cs := mockControlService(t, log, config)
+
+			// runs discovery for nvme & scm
+			if err := cs.Setup(); err != nil {
+				t.Fatal(err)
+			}
+
+			var fioArgs [][]string
+			cs.runFio = func(_ context.Context, _ string, args []string, _ string,
+				progress func(string)) ([]byte, error) {
+
+				fioArgs = append(fioArgs, args)
+				progress("Jobs: 1 (f=1)")
+				if tt.fioErr != nil {
+					return nil, tt.fioErr
+				}
+				return []byte(mockFioOutput), nil
+			}
+
+			mock := &mockStorageBurnInServer{}
+			err := cs.StorageBurnIn(tt.req, mock)
+			if tt.expErr != nil {
+				ExpectError(t, err, tt.expErr.Error(), name)
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			AssertEqual(t, fioArgs, tt.expFioArgs, "unexpected fio arguments")
+			AssertEqual(t, mock.Results, tt.expResps, "unexpected responses")
+		})
+	}
+}
@@ -223,6 +223,23 @@ func (n *nvmeStorage) Discover() error {
 	return nil
 }
 
+// scanControllers probes the NVMe controllers currently attached, leaving
+// those cached by Discover untouched so hardware changes made since startup
+// are seen.
+func (n *nvmeStorage) scanControllers() (types.NvmeControllers, error) {
+	// initialises the SPDK environment on first use
+	if err := n.Discover(); err != nil {
+		return nil, err
+	}
+
+	cs, ns, dh, err := n.nvme.Discover()
+	if err != nil {
+		return nil, errors.WithMessage(err, msgSpdkDiscoverFail)
+	}
+
+	return loadControllers(cs, ns, dh), nil
+}
+
 // newCret creates and populates NVMe controller result and logs error
 func newCret(log logging.Logger, op string, pciaddr string, status pb.ResponseStatus, errMsg string,
 	infoMsg string) *pb.NvmeControllerResult {
@@ -412,7 +429,8 @@ func (n *nvmeStorage) Update(cfg storage.BdevConfig, req *pb.UpdateNvmeReq, resu
 }
 
 // BurnIn method implementation for nvmeStorage
-// Doesn't call through go-spdk, returns cmds to be issued over shell
+// Doesn't call through go-spdk, returns fio command arguments and environment
+// to be executed directly (not through a shell).
 func (n *nvmeStorage) BurnIn(pciAddr string, nsID int32, configPath string) (
 	fioPath string, cmds []string, env string, err error) {
 
@@ -442,7 +460,7 @@ func (n *nvmeStorage) BurnIn(pciAddr string, nsID int32, configPath string) (
 	// eta options provided to trigger periodic client responses.
 	cmds = []string{
 		fmt.Sprintf(
-			"--filename=\"trtype=PCIe traddr=%s ns=%d\"",
+			"--filename=trtype=PCIe traddr=%s ns=%d",
 			strings.Replace(pciAddr, ":", ".", -1), nsID),
 		"--ioengine=spdk",
 		"--eta=always",
@@ -950,7 +950,7 @@ func TestBurnInNvme(t *testing.T) {
 	nsID := 1
 	expectedArgs := []string{
 		fmt.Sprintf(
-			"--filename=\"trtype=PCIe traddr=%s ns=%d\"",
+			"--filename=trtype=PCIe traddr=%s ns=%d",
 			strings.Replace(c.Pciaddr, ":", ".", -1), nsID),
 		"--ioengine=spdk",
 		"--eta=always",
@@ -70,9 +70,39 @@ type scmStorage struct {
 
 // TODO: implement remaining methods for scmStorage
 // func (s *scmStorage) Update(req interface{}) interface{} {return nil}
-// func (s *scmStorage) BurnIn(req interface{}) (fioPath string, cmds []string, env string, err error) {
-// return
-// }
+
+// BurnIn method implementation for scmStorage
+// Returns fio command arguments to run the given job file against files
+// created (and removed on completion) in the mounted SCM filesystem. The job
+// file is expected to specify a file-based ioengine and size.
+func (s *scmStorage) BurnIn(cfg storage.ScmConfig, configPath string) (
+	fioPath string, cmds []string, err error) {
+
+	isMount, err := s.ext.isMountPoint(cfg.MountPoint)
+	if err != nil {
+		return
+	}
+	if !isMount {
+		err = errors.Errorf("%s is not mounted", cfg.MountPoint)
+		return
+	}
+
+	fioPath, err = s.ext.getAbsInstallPath(fioExecPath)
+	if err != nil {
+		return
+	}
+
+	cmds = []string{
+		"--directory=" + cfg.MountPoint,
+		"--unlink=1",
+		"--eta=always",
+		"--eta-newline=10",
+		configPath,
+	}
+	s.log.Debugf("BurnIn command string: %s %v", fioPath, cmds)
+
+	return
+}
 
 // Setup implementation for scmStorage providing initial device discovery
 func (s *scmStorage) Setup() error {
@@ -24,9 +24,12 @@
 package server
 
 import (
+	"fmt"
+	"hash/crc32"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
@@ -39,9 +42,21 @@ import (
 const (
 	defaultStoragePath = "/mnt/daos"
 	defaultGroupName   = "daos_io_server"
-	superblockVersion  = 0
+	superblockName     = "superblock"
+	vosFilePrefix      = "vos-"
+	superblockVersion  = 2
 )
 
+// superblockMigrations upgrade a superblock from the version given by the
+// slice index to the next version. A migration must be registered here
+// whenever superblockVersion is incremented.
+var superblockMigrations = []func(*Superblock) error{
+	// 0 -> 1: checksum added, it is set on the next write.
+	func(sb *Superblock) error { return nil },
+	// 1 -> 2: device fingerprint added, only recorded on creation.
+	func(sb *Superblock) error { return nil },
+}
+
 // Superblock is the per-Instance superblock
 type Superblock struct {
 	Version     uint8
@@ -52,6 +67,8 @@ type Superblock struct {
 	MS          bool
 	CreateMS    bool
 	BootstrapMS bool
+	Devices     *DeviceFingerprint `yaml:",omitempty"`
+	Checksum    string
 }
 
 // TODO: Marshal/Unmarshal using a binary representation?
@@ -70,12 +87,101 @@ func (sb *Superblock) Unmarshal(raw []byte) error {
 	return yaml.Unmarshal(raw, sb)
 }
 
-func (srv *IOServerInstance) superblockPath() string {
-	storagePath := srv.runner.Config.Storage.SCM.MountPoint
-	if storagePath == "" {
-		storagePath = defaultStoragePath
+// computeChecksum returns the checksum of the Superblock contents,
+// excluding the Checksum field itself.
+func (sb *Superblock) computeChecksum() (string, error) {
+	tmp := *sb
+	tmp.Checksum = ""
+	data, err := tmp.Marshal()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%08x", crc32.ChecksumIEEE(data)), nil
+}
+
+// checksumOK indicates whether the stored checksum matches the Superblock
+// contents. Superblocks written before checksums were introduced, which are
+// version 0 and were stored without a checksum, have nothing to verify.
+func (sb *Superblock) checksumOK(legacy bool) (bool, error) {
+	if legacy && sb.Version < 1 {
+		return true, nil
+	}
+	sum, err := sb.computeChecksum()
+	if err != nil {
+		return false, err
+	}
+	return sum == sb.Checksum, nil
+}
+
+// validate checks the Superblock for inconsistent contents.
+func (sb *Superblock) validate() error {
+	if _, err := uuid.FromString(sb.UUID); err != nil {
+		return errors.Errorf("invalid uuid %q", sb.UUID)
+	}
+	if sb.System == "" {
+		return errors.New("missing system name")
+	}
+	if sb.ValidRank && sb.Rank == nil {
+		return errors.New("rank marked valid but not set")
+	}
+	return nil
+}
+
+// unsupportedVersionError indicates a Superblock written by a newer
+// version of the software than this one.
+type unsupportedVersionError struct {
+	version uint8
+}
+
+func (e *unsupportedVersionError) Error() string {
+	return fmt.Sprintf("version %d is newer than supported version %d",
+		e.version, superblockVersion)
+}
+
+// isUnsupportedVersion returns true if err was caused by a Superblock
+// version newer than this software supports.
+func isUnsupportedVersion(err error) bool {
+	_, ok := errors.Cause(err).(*unsupportedVersionError)
+	return ok
+}
+
+// checkVersion verifies that the Superblock version is supported.
+func (sb *Superblock) checkVersion() error {
+	if sb.Version > superblockVersion {
+		return &unsupportedVersionError{version: sb.Version}
 	}
-	return filepath.Join(srv.fsRoot, storagePath, "superblock")
+	return nil
+}
+
+// migrate upgrades the Superblock to the current version, returning true
+// if any migration steps were applied.
+func (sb *Superblock) migrate() (bool, error) {
+	if err := sb.checkVersion(); err != nil {
+		return false, err
+	}
+
+	migrated := false
+	for sb.Version < superblockVersion {
+		if err := superblockMigrations[sb.Version](sb); err != nil {
+			return false, errors.Wrapf(err, "migration from version %d failed", sb.Version)
+		}
+		sb.Version++
+		migrated = true
+	}
+	return migrated, nil
+}
+
+// SuperblockPath returns the location of the superblock stored on the
+// given SCM mount point.
+func SuperblockPath(mountPoint string) string {
+	if mountPoint == "" {
+		mountPoint = defaultStoragePath
+	}
+	return filepath.Join(mountPoint, superblockName)
+}
+
+func (srv *IOServerInstance) superblockPath() string {
+	return filepath.Join(srv.fsRoot, SuperblockPath(srv.runner.Config.Storage.SCM.MountPoint))
 }
 
 func (srv *IOServerInstance) setSuperblock(sb *Superblock) {
@@ -113,6 +219,10 @@ func (srv *IOServerInstance) NeedsSuperblock() (bool, error) {
 		return true, nil
 	}
 
+	if isUnsupportedVersion(err) {
+		// never recreated, doing so would downgrade it
+		return false, errors.Wrap(err, "failed to read existing superblock")
+	}
 	if err != nil {
 		return true, errors.Wrap(err, "failed to read existing superblock")
 	}
@@ -120,15 +230,11 @@ func (srv *IOServerInstance) NeedsSuperblock() (bool, error) {
 	return false, nil
 }
 
-// CreateSuperblock creates the superblock for this instance.
-func (srv *IOServerInstance) CreateSuperblock(msInfo *mgmtInfo) error {
-	if err := srv.MountScmDevice(); err != nil {
-		return err
-	}
-
+// newSuperblock generates a superblock for this instance.
+func (srv *IOServerInstance) newSuperblock(msInfo *mgmtInfo) (*Superblock, error) {
 	u, err := uuid.NewV4()
 	if err != nil {
-		return errors.Wrap(err, "Failed to generate instance UUID")
+		return nil, errors.Wrap(err, "Failed to generate instance UUID")
 	}
 
 	systemName := srv.runner.Config.SystemName
@@ -152,11 +258,141 @@ func (srv *IOServerInstance) CreateSuperblock(msInfo *mgmtInfo) error {
 			*superblock.Rank = *srv.runner.Config.Rank
 		}
 	}
+
+	// Without a fingerprint the devices are simply not checked at start,
+	// so don't fail superblock creation if they can't be scanned.
+	if superblock.Devices, err = srv.scanDevices(); err != nil {
+		srv.log.Errorf("instance %d: not recording storage devices: %s", srv.Index, err)
+	}
+
+	return superblock, nil
+}
+
+// CreateSuperblock creates the superblock for this instance.
+func (srv *IOServerInstance) CreateSuperblock(msInfo *mgmtInfo) error {
+	if err := srv.MountScmDevice(); err != nil {
+		return err
+	}
+
+	superblock, err := srv.newSuperblock(msInfo)
+	if err != nil {
+		return err
+	}
 	srv.setSuperblock(superblock)
 
 	return srv.WriteSuperblock()
 }
 
+// SuperblockRecreation controls the regeneration of instance superblocks
+// which can't be read at startup.
+type SuperblockRecreation struct {
+	// Enabled allows unreadable superblocks to be recreated rather than
+	// failing startup.
+	Enabled bool `yaml:"enabled"`
+	// Force allows recreation when the SCM mount holds VOS pool files.
+	Force bool `yaml:"force"`
+	// ResetRank discards the UUID and rank recorded in the old superblock
+	// so that a new rank is assigned when the instance joins.
+	ResetRank bool `yaml:"reset_rank"`
+}
+
+// vosPoolDirs returns the names of pool directories holding VOS files
+// under the given SCM mount point.
+func vosPoolDirs(mountPoint string) ([]string, error) {
+	entries, err := ioutil.ReadDir(mountPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	var pools []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if _, err := uuid.FromString(entry.Name()); err != nil {
+			continue
+		}
+
+		files, err := ioutil.ReadDir(filepath.Join(mountPoint, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			if strings.HasPrefix(file.Name(), vosFilePrefix) {
+				pools = append(pools, entry.Name())
+				break
+			}
+		}
+	}
+
+	return pools, nil
+}
+
+// RecreateSuperblock replaces this instance's unreadable superblock. The
+// UUID and rank are carried over from the old superblock where they can be
+// recovered, unless the recreation policy says otherwise. A description of
+// what was regenerated is returned.
+func (srv *IOServerInstance) RecreateSuperblock(msInfo *mgmtInfo, policy SuperblockRecreation) (string, error) {
+	if err := srv.MountScmDevice(); err != nil {
+		return "", err
+	}
+
+	sbPath := srv.superblockPath()
+	if old, _, err := loadSuperblock(sbPath); err == nil {
+		if err := old.checkVersion(); err != nil {
+			return "", errors.Wrapf(err, "Superblock %s can't be recreated", sbPath)
+		}
+	}
+
+	pools, err := vosPoolDirs(filepath.Dir(sbPath))
+	if err != nil {
+		return "", errors.Wrap(err, "failed to check for pool files")
+	}
+	if len(pools) > 0 && !policy.Force {
+		return "", errors.Errorf("%s holds VOS files for pools %s; refusing to recreate superblock without force",
+			filepath.Dir(sbPath), strings.Join(pools, ","))
+	}
+
+	superblock, err := srv.newSuperblock(msInfo)
+	if err != nil {
+		return "", err
+	}
+
+	idStr, rankStr := "new uuid", "rank unassigned"
+	if superblock.Rank != nil {
+		rankStr = fmt.Sprintf("rank %s from config", superblock.Rank)
+	}
+
+	var old *Superblock
+	if !policy.ResetRank {
+		// the old contents can't be trusted, so only its identity is
+		// recovered and only if it parses
+		old, _, _ = loadSuperblock(sbPath)
+	}
+	if old != nil && uuid.FromStringOrNil(old.UUID) != uuid.Nil {
+		superblock.UUID = old.UUID
+		idStr = "kept uuid"
+
+		if old.Rank != nil {
+			superblock.Rank = old.Rank
+			superblock.ValidRank = old.ValidRank
+			rankStr = fmt.Sprintf("kept rank %s", old.Rank)
+		}
+	}
+
+	srv.setSuperblock(superblock)
+	if err := srv.WriteSuperblock(); err != nil {
+		return "", err
+	}
+
+	desc := fmt.Sprintf("%s (%s %s, %s)", sbPath, idStr, superblock.UUID, rankStr)
+	if len(pools) > 0 {
+		desc += fmt.Sprintf(", forced with pools %s present", strings.Join(pools, ","))
+	}
+
+	return desc, nil
+}
+
 // WriteSuperblock writes the instance's superblock
 // to storage.
 func (srv *IOServerInstance) WriteSuperblock() error {
@@ -178,18 +414,31 @@ func (srv *IOServerInstance) ReadSuperblock() error {
 		return errors.Wrap(err, "failed to mount SCM device")
 	}
 
-	sb, err := ReadSuperblock(srv.superblockPath())
+	sbPath := srv.superblockPath()
+	sb, migrated, err := readSuperblock(sbPath)
 	if err != nil {
 		return errors.Wrap(err, "failed to read instance superblock")
 	}
 	srv.setSuperblock(sb)
 
+	if migrated {
+		srv.log.Infof("%s: upgraded superblock to version %d", sbPath, sb.Version)
+		return srv.WriteSuperblock()
+	}
+
 	return nil
 }
 
 // WriteSuperblock writes a Superblock to storage.
 func WriteSuperblock(sbPath string, sb *Superblock) error {
-	data, err := sb.Marshal()
+	sum, err := sb.computeChecksum()
+	if err != nil {
+		return err
+	}
+	out := *sb
+	out.Checksum = sum
+
+	data, err := out.Marshal()
 	if err != nil {
 		return err
 	}
@@ -198,17 +447,169 @@ func WriteSuperblock(sbPath string, sb *Superblock) error {
 		"Failed to write Superblock to %s", sbPath)
 }
 
-// ReadSuperblock reads a Superblock from storage.
+// ReadSuperblock reads a Superblock from storage, verifying its integrity
+// and upgrading it to the current version if necessary.
 func ReadSuperblock(sbPath string) (*Superblock, error) {
+	sb, _, err := readSuperblock(sbPath)
+	return sb, err
+}
+
+func readSuperblock(sbPath string) (*Superblock, bool, error) {
+	sb, legacy, err := loadSuperblock(sbPath)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := sb.checkVersion(); err != nil {
+		return nil, false, errors.Wrapf(err, "Superblock %s", sbPath)
+	}
+
+	ok, err := sb.checksumOK(legacy)
+	if err != nil {
+		return nil, false, err
+	}
+	if !ok {
+		return nil, false, errors.Errorf("Superblock %s checksum mismatch (run daos_server superblock repair)", sbPath)
+	}
+
+	migrated, err := sb.migrate()
+	if err != nil {
+		return nil, false, errors.Wrapf(err, "Superblock %s", sbPath)
+	}
+
+	if err := sb.validate(); err != nil {
+		return nil, false, errors.Wrapf(err, "Superblock %s is invalid (run daos_server superblock repair)", sbPath)
+	}
+
+	return sb, migrated, nil
+}
+
+// loadSuperblock reads and parses a Superblock without any verification,
+// indicating whether it was stored without a checksum field, as it was
+// before checksums were introduced.
+func loadSuperblock(sbPath string) (*Superblock, bool, error) {
 	data, err := ioutil.ReadFile(sbPath)
 	if err != nil {
-		return nil, errors.Wrapf(err, "Failed to read Superblock from %s", sbPath)
+		return nil, false, errors.Wrapf(err, "Failed to read Superblock from %s", sbPath)
 	}
 
 	sb := &Superblock{}
 	if err := sb.Unmarshal(data); err != nil {
+		return nil, false, errors.Wrapf(err, "Failed to parse Superblock %s", sbPath)
+	}
+
+	fields := make(map[string]interface{})
+	if err := yaml.Unmarshal(data, &fields); err != nil {
+		return nil, false, errors.Wrapf(err, "Failed to parse Superblock %s", sbPath)
+	}
+	_, hasChecksum := fields["checksum"]
+
+	return sb, !hasChecksum, nil
+}
+
+// SuperblockStatus describes the state of a Superblock on storage.
+type SuperblockStatus struct {
+	Superblock  *Superblock
+	ChecksumOK  bool
+	Upgradable  bool
+	Unsupported bool
+	Invalid     error
+}
+
+// OK indicates whether the Superblock can be used as-is.
+func (st *SuperblockStatus) OK() bool {
+	return st.ChecksumOK && !st.Upgradable && !st.Unsupported && st.Invalid == nil
+}
+
+func (st *SuperblockStatus) String() string {
+	var problems []string
+	if !st.ChecksumOK {
+		problems = append(problems, "checksum mismatch")
+	}
+	if st.Unsupported {
+		problems = append(problems, fmt.Sprintf("unsupported version %d", st.Superblock.Version))
+	}
+	if st.Upgradable {
+		problems = append(problems, fmt.Sprintf("needs upgrade from version %d", st.Superblock.Version))
+	}
+	if st.Invalid != nil {
+		problems = append(problems, st.Invalid.Error())
+	}
+	if len(problems) == 0 {
+		return "ok"
+	}
+	return strings.Join(problems, ", ")
+}
+
+// InspectSuperblock reads a Superblock from storage and reports any
+// problems found with it, rather than failing on them.
+func InspectSuperblock(sbPath string) (*SuperblockStatus, error) {
+	sb, legacy, err := loadSuperblock(sbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	st := &SuperblockStatus{
+		Superblock:  sb,
+		Upgradable:  sb.Version < superblockVersion,
+		Unsupported: sb.Version > superblockVersion,
+	}
+	if st.ChecksumOK, err = sb.checksumOK(legacy); err != nil {
+		return nil, err
+	}
+	st.Invalid = sb.validate()
+
+	return st, nil
+}
+
+// SuperblockRepairOpts control how RepairSuperblock fixes a Superblock.
+type SuperblockRepairOpts struct {
+	// Force accepts contents which fail checksum verification.
+	Force bool
+	// Rank overrides the stored rank.
+	Rank *ioserver.Rank
+	// System overrides the stored system name.
+	System string
+}
+
+// RepairSuperblock upgrades and fixes up the Superblock stored at the given
+// path, then rewrites it with a valid checksum.
+func RepairSuperblock(sbPath string, opts SuperblockRepairOpts) (*Superblock, error) {
+	st, err := InspectSuperblock(sbPath)
+	if err != nil {
+		return nil, err
+	}
+	sb := st.Superblock
+
+	if err := sb.checkVersion(); err != nil {
+		return nil, errors.Wrapf(err, "Superblock %s", sbPath)
+	}
+	if !st.ChecksumOK && !opts.Force {
+		return nil, errors.Errorf("Superblock %s checksum mismatch; verify contents and repair with force",
+			sbPath)
+	}
+	if _, err := sb.migrate(); err != nil {
+		return nil, errors.Wrapf(err, "Superblock %s", sbPath)
+	}
+	if opts.System != "" {
+		sb.System = opts.System
+	}
+	if opts.Rank != nil {
+		sb.Rank = ioserver.NewRankPtr(uint32(*opts.Rank))
+		sb.ValidRank = true
+	}
+	if sb.ValidRank && sb.Rank == nil {
+		// the rank will be reassigned when the instance next joins
+		sb.ValidRank = false
+	}
+
+	if err := sb.validate(); err != nil {
+		return nil, errors.Wrapf(err, "Superblock %s can't be repaired", sbPath)
+	}
+
+	if err := WriteSuperblock(sbPath, sb); err != nil {
 		return nil, err
 	}
 
-	return sb, nil
+	return ReadSuperblock(sbPath)
 }
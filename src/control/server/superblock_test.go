@@ -0,0 +1,321 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/server/ioserver"
+)
+
+const testSuperblockUUID = "e0e8c2c2-4a9e-4f1e-9f3c-5d0a4e1c2b3a"
+
+func testSuperblock() *Superblock {
+	return &Superblock{
+		Version:   superblockVersion,
+		UUID:      testSuperblockUUID,
+		System:    "daos_io_server",
+		Rank:      ioserver.NewRankPtr(3),
+		ValidRank: true,
+	}
+}
+
+func writeRawSuperblock(t *testing.T, path string, sb *Superblock) {
+	t.Helper()
+
+	data, err := sb.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// writeLegacySuperblock stores sb in the format used before checksums were
+// introduced, without a checksum field.
+func writeLegacySuperblock(t *testing.T, path string, sb *Superblock) {
+	t.Helper()
+
+	data, err := sb.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lines []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if !strings.HasPrefix(line, "checksum:") {
+			lines = append(lines, line)
+		}
+	}
+	if err := ioutil.WriteFile(path, []byte(strings.Join(lines, "\n")), 0600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSuperblockMigrations(t *testing.T) {
+	if len(superblockMigrations) != superblockVersion {
+		t.Fatalf("%d superblock migrations registered for version %d",
+			len(superblockMigrations), superblockVersion)
+	}
+}
+
+func TestSuperblockRead(t *testing.T) {
+	for name, tc := range map[string]struct {
+		setup    func(t *testing.T, path string)
+		expSB    *Superblock
+		expErrIn string
+	}{
+		"missing": {
+			setup:    func(t *testing.T, path string) {},
+			expErrIn: "no such file",
+		},
+		"current": {
+			setup: func(t *testing.T, path string) {
+				if err := WriteSuperblock(path, testSuperblock()); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expSB: testSuperblock(),
+		},
+		"truncated": {
+			setup: func(t *testing.T, path string) {
+				if err := ioutil.WriteFile(path, []byte("version: 1\nuuid: e0e8"), 0600); err != nil {
+					t.Fatal(err)
+				}
+			},
+			expErrIn: "checksum mismatch",
+		},
+		"hand edited": {
+			setup: func(t *testing.T, path string) {
+				if err := WriteSuperblock(path, testSuperblock()); err != nil {
+					t.Fatal(err)
+				}
+				sb, _, err := loadSuperblock(path)
+				if err != nil {
+					t.Fatal(err)
+				}
+				sb.Rank = ioserver.NewRankPtr(4)
+				writeRawSuperblock(t, path, sb)
+			},
+			expErrIn: "checksum mismatch",
+		},
+		"unsupported version": {
+			setup: func(t *testing.T, path string) {
+				sb := testSuperblock()
+				sb.Version = superblockVersion + 1
+				writeRawSuperblock(t, path, sb)
+			},
+			expErrIn: "newer than supported",
+		},
+		"version 0 upgraded": {
+			setup: func(t *testing.T, path string) {
+				sb := testSuperblock()
+				sb.Version = 0
+				writeLegacySuperblock(t, path, sb)
+			},
+			expSB: testSuperblock(),
+		},
+		"version 0 with checksum field": {
+			setup: func(t *testing.T, path string) {
+				sb := testSuperblock()
+				sb.Version = 0
+				writeRawSuperblock(t, path, sb)
+			},
+			expErrIn: "checksum mismatch",
+		},
+		"checksum field removed": {
+			setup: func(t *testing.T, path string) {
+				writeLegacySuperblock(t, path, testSuperblock())
+			},
+			expErrIn: "checksum mismatch",
+		},
+		"version 0 invalid": {
+			setup: func(t *testing.T, path string) {
+				sb := testSuperblock()
+				sb.Version = 0
+				sb.Rank = nil
+				writeLegacySuperblock(t, path, sb)
+			},
+			expErrIn: "rank marked valid but not set",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", strings.Replace(t.Name(), "/", "-", -1))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			path := filepath.Join(tmpDir, superblockName)
+			tc.setup(t, path)
+
+			sb, err := ReadSuperblock(path)
+			if tc.expErrIn != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expErrIn) {
+					t.Fatalf("expected error containing %q, got %v", tc.expErrIn, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.expSB, sb, cmp.FilterPath(func(p cmp.Path) bool {
+				return p.String() == "Checksum"
+			}, cmp.Ignore())); diff != "" {
+				t.Fatalf("unexpected superblock (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSuperblockRepair(t *testing.T) {
+	for name, tc := range map[string]struct {
+		stored   func() *Superblock
+		checksum bool
+		legacy   bool
+		opts     SuperblockRepairOpts
+		expSB    *Superblock
+		expErrIn string
+	}{
+		"already ok": {
+			stored:   testSuperblock,
+			checksum: true,
+			expSB:    testSuperblock(),
+		},
+		"checksum mismatch": {
+			stored:   testSuperblock,
+			expErrIn: "checksum mismatch",
+		},
+		"checksum mismatch forced": {
+			stored: testSuperblock,
+			opts:   SuperblockRepairOpts{Force: true},
+			expSB:  testSuperblock(),
+		},
+		"lost rank": {
+			stored: func() *Superblock {
+				sb := testSuperblock()
+				sb.Rank = nil
+				return sb
+			},
+			checksum: true,
+			expSB: func() *Superblock {
+				sb := testSuperblock()
+				sb.Rank = nil
+				sb.ValidRank = false
+				return sb
+			}(),
+		},
+		"set rank and system": {
+			stored: func() *Superblock {
+				sb := testSuperblock()
+				sb.Version = 0
+				sb.Rank = nil
+				return sb
+			},
+			legacy: true,
+			opts: SuperblockRepairOpts{
+				Rank:   ioserver.NewRankPtr(7),
+				System: "foo",
+			},
+			expSB: func() *Superblock {
+				sb := testSuperblock()
+				sb.Rank = ioserver.NewRankPtr(7)
+				sb.System = "foo"
+				return sb
+			}(),
+		},
+		"unsupported version": {
+			stored: func() *Superblock {
+				sb := testSuperblock()
+				sb.Version = superblockVersion + 1
+				return sb
+			},
+			opts:     SuperblockRepairOpts{Force: true},
+			expErrIn: "newer than supported",
+		},
+		"bad uuid": {
+			stored: func() *Superblock {
+				sb := testSuperblock()
+				sb.UUID = "foo"
+				return sb
+			},
+			opts:     SuperblockRepairOpts{Force: true},
+			expErrIn: "invalid uuid",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tmpDir, err := ioutil.TempDir("", strings.Replace(t.Name(), "/", "-", -1))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(tmpDir)
+
+			path := filepath.Join(tmpDir, superblockName)
+			sb := tc.stored()
+			if tc.checksum {
+				if sb.Checksum, err = sb.computeChecksum(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tc.legacy {
+				writeLegacySuperblock(t, path, sb)
+			} else {
+				writeRawSuperblock(t, path, sb)
+			}
+
+			gotSB, err := RepairSuperblock(path, tc.opts)
+			if tc.expErrIn != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.expErrIn) {
+					t.Fatalf("expected error containing %q, got %v", tc.expErrIn, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ignoreSum := cmp.FilterPath(func(p cmp.Path) bool {
+				return p.String() == "Checksum"
+			}, cmp.Ignore())
+			if diff := cmp.Diff(tc.expSB, gotSB, ignoreSum); diff != "" {
+				t.Fatalf("unexpected superblock (-want, +got):\n%s\n", diff)
+			}
+
+			st, err := InspectSuperblock(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !st.OK() {
+				t.Fatalf("repaired superblock status: %s", st)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"net"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// systemDBName is the file name of the system database, stored next to the
+// superblock of the management instance.
+const systemDBName = "system_db"
+
+func (srv *IOServerInstance) systemDBPath() string {
+	return filepath.Join(filepath.Dir(srv.superblockPath()), systemDBName)
+}
+
+// systemDBConfig returns the configuration of the system database replica
+// set made up of the access points, identifying this server's replica.
+func systemDBConfig(self *net.TCPAddr, accessPoints []string, path string, transport system.RaftTransport) (*system.DatabaseConfig, error) {
+	cfg := &system.DatabaseConfig{
+		Path:      path,
+		Transport: transport,
+	}
+	for _, ap := range accessPoints {
+		cfg.Replicas = append(cfg.Replicas, withDefaultPort(ap))
+	}
+
+	idx, err := findAccessPoint(self, accessPoints)
+	if err != nil {
+		return nil, err
+	}
+	if idx >= 0 {
+		cfg.Self = cfg.Replicas[idx]
+	}
+
+	return cfg, nil
+}
+
+// systemDBTransport sends system database messages to the control services
+// of the other access points.
+type systemDBTransport struct {
+	log logging.Logger
+	cfg *security.TransportConfig
+
+	sync.Mutex
+	conns map[string]*grpc.ClientConn
+}
+
+func newSystemDBTransport(log logging.Logger, cfg *security.TransportConfig) *systemDBTransport {
+	return &systemDBTransport{
+		log:   log,
+		cfg:   cfg,
+		conns: make(map[string]*grpc.ClientConn),
+	}
+}
+
+// client returns a client for the given replica, reusing any existing
+// connection. Connections are established in the background so that calls
+// to unreachable replicas fail rather than block.
+func (t *systemDBTransport) client(replica string) (mgmtpb.MgmtCtlClient, error) {
+	t.Lock()
+	defer t.Unlock()
+
+	if conn, exists := t.conns[replica]; exists {
+		return mgmtpb.NewMgmtCtlClient(conn), nil
+	}
+
+	authDialOption, err := security.DialOptionForTransportConfig(t.cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to determine dial option from TransportConfig")
+	}
+	conn, err := grpc.Dial(replica, authDialOption)
+	if err != nil {
+		return nil, errors.Wrapf(err, "dial %s", replica)
+	}
+	t.conns[replica] = conn
+
+	return mgmtpb.NewMgmtCtlClient(conn), nil
+}
+
+// Close closes all connections to the other replicas.
+func (t *systemDBTransport) Close() {
+	t.Lock()
+	defer t.Unlock()
+
+	for replica, conn := range t.conns {
+		if err := conn.Close(); err != nil {
+			t.log.Debugf("close connection to %s: %s", replica, err)
+		}
+		delete(t.conns, replica)
+	}
+}
+
+func (t *systemDBTransport) RequestVote(ctx context.Context, replica string, req *system.VoteRequest) (*system.VoteResponse, error) {
+	client, err := t.client(replica)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.SystemDbVote(ctx, &mgmtpb.SystemDbVoteReq{
+		Term:         req.Term,
+		Candidate:    req.Candidate,
+		LastLogIndex: req.LastLogIndex,
+		LastLogTerm:  req.LastLogTerm,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &system.VoteResponse{Term: resp.Term, Granted: resp.Granted}, nil
+}
+
+func (t *systemDBTransport) AppendEntries(ctx context.Context, replica string, req *system.AppendRequest) (*system.AppendResponse, error) {
+	client, err := t.client(replica)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.SystemDbAppend(ctx, appendRequestToPB(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &system.AppendResponse{
+		Term:      resp.Term,
+		Success:   resp.Success,
+		LastIndex: resp.LastIndex,
+	}, nil
+}
+
+func (t *systemDBTransport) Submit(ctx context.Context, replica string, req *system.SubmitRequest) error {
+	client, err := t.client(replica)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.SystemDbSubmit(ctx, &mgmtpb.SystemDbSubmitReq{Op: req.Op, Data: req.Data})
+
+	return err
+}
+
+func appendRequestToPB(req *system.AppendRequest) *mgmtpb.SystemDbAppendReq {
+	pbReq := &mgmtpb.SystemDbAppendReq{
+		Term:         req.Term,
+		Leader:       req.Leader,
+		PrevLogIndex: req.PrevLogIndex,
+		PrevLogTerm:  req.PrevLogTerm,
+		LeaderCommit: req.LeaderCommit,
+	}
+	for _, entry := range req.Entries {
+		pbReq.Entries = append(pbReq.Entries, &mgmtpb.SystemDbEntry{
+			Term:  entry.Term,
+			Index: entry.Index,
+			Op:    entry.Op,
+			Data:  entry.Data,
+		})
+	}
+
+	return pbReq
+}
+
+func appendRequestFromPB(pbReq *mgmtpb.SystemDbAppendReq) *system.AppendRequest {
+	req := &system.AppendRequest{
+		Term:         pbReq.Term,
+		Leader:       pbReq.Leader,
+		PrevLogIndex: pbReq.PrevLogIndex,
+		PrevLogTerm:  pbReq.PrevLogTerm,
+		LeaderCommit: pbReq.LeaderCommit,
+	}
+	for _, entry := range pbReq.Entries {
+		req.Entries = append(req.Entries, &system.LogEntry{
+			Term:  entry.Term,
+			Index: entry.Index,
+			Op:    entry.Op,
+			Data:  entry.Data,
+		})
+	}
+
+	return req
+}
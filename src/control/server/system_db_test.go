@@ -0,0 +1,84 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package server
+
+import (
+	"net"
+	"testing"
+
+	. "github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestSystemDBConfig(t *testing.T) {
+	self := &net.TCPAddr{IP: net.ParseIP("127.0.0.1"), Port: 10000}
+
+	for name, tc := range map[string]struct {
+		aps         []string
+		expReplicas []string
+		expSelf     string
+	}{
+		"bootstrap access point": {
+			aps:         []string{"127.0.0.1"},
+			expReplicas: []string{"127.0.0.1:10000"},
+			expSelf:     "127.0.0.1:10000",
+		},
+		"other access point": {
+			aps:         []string{"127.0.0.2", "127.0.0.1:10000", "127.0.0.3:10001"},
+			expReplicas: []string{"127.0.0.2:10000", "127.0.0.1:10000", "127.0.0.3:10001"},
+			expSelf:     "127.0.0.1:10000",
+		},
+		"not an access point": {
+			aps:         []string{"127.0.0.2", "127.0.0.1:10001", "127.0.0.3"},
+			expReplicas: []string{"127.0.0.2:10000", "127.0.0.1:10001", "127.0.0.3:10000"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			cfg, err := systemDBConfig(self, tc.aps, "/mnt/daos/system_db", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			AssertEqual(t, cfg.Replicas, tc.expReplicas, "unexpected replicas")
+			AssertEqual(t, cfg.Self, tc.expSelf, "unexpected self")
+			AssertEqual(t, cfg.Path, "/mnt/daos/system_db", "unexpected path")
+		})
+	}
+}
+
+func TestSystemDBAppendRequestPB(t *testing.T) {
+	req := &system.AppendRequest{
+		Term:         3,
+		Leader:       "10.0.0.1:10000",
+		PrevLogIndex: 4,
+		PrevLogTerm:  2,
+		Entries: []*system.LogEntry{
+			{Term: 3, Index: 5, Op: "noop"},
+			{Term: 3, Index: 6, Op: "member-join", Data: []byte(`{"Rank":1}`)},
+		},
+		LeaderCommit: 5,
+	}
+
+	AssertEqual(t, appendRequestFromPB(appendRequestToPB(req)), req, "append request changed by conversion")
+}
@@ -0,0 +1,401 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package system
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// NilRank is the rank requested by a server which has not yet been
+// assigned one.
+const NilRank uint32 = math.MaxUint32
+
+const (
+	dbOpNoop          = "noop"
+	dbOpMemberJoin    = "member-join"
+	dbOpPoolSvcUpdate = "pool-svc-update"
+	dbOpPoolSvcRemove = "pool-svc-remove"
+)
+
+// PoolService records the ranks hosting the service replicas of a pool.
+type PoolService struct {
+	PoolUUID string
+	Replicas []uint32
+}
+
+// DatabaseConfig describes the replica set of a system database.
+type DatabaseConfig struct {
+	// Replicas holds the control-plane addresses of the access points.
+	Replicas []string
+	// Self is the address of this replica, empty if this server is not
+	// an access point.
+	Self string
+	// Path is the file in which the replica log is persisted, the log
+	// is only held in memory if empty.
+	Path string
+	// Transport sends consensus messages to the other replicas.
+	Transport RaftTransport
+}
+
+// Database is the control-plane record of the DAOS system: the ranks,
+// addresses and fabric URIs of its members together with the locations of
+// pool services. Updates are made through the leader of the access points
+// and replicated to the others before being applied, so that any replica
+// can answer queries from its local copy.
+//
+// Member reachability is observed independently by each replica through
+// Membership.Refresh and isn't replicated.
+type Database struct {
+	log        logging.Logger
+	membership *Membership
+	replicas   []string
+	transport  RaftTransport
+	raft       *raftNode
+	joinMutex  sync.Mutex // serializes rank allocation
+
+	sync.RWMutex
+	pools    map[string]*PoolService
+	nextRank uint32
+}
+
+// NewDatabase returns a Database which applies membership updates to m. The
+// database is only replicated if cfg.Self is one of cfg.Replicas.
+func NewDatabase(log logging.Logger, m *Membership, cfg *DatabaseConfig) *Database {
+	db := &Database{
+		log:        log,
+		membership: m,
+		replicas:   cfg.Replicas,
+		transport:  cfg.Transport,
+		pools:      make(map[string]*PoolService),
+	}
+
+	for _, replica := range cfg.Replicas {
+		if cfg.Self != "" && replica == cfg.Self {
+			db.raft = newRaftNode(log, cfg.Self, cfg.Replicas,
+				&raftStore{path: cfg.Path}, cfg.Transport, db.apply)
+			break
+		}
+	}
+
+	return db
+}
+
+// Start loads the persisted log and starts replication, it is a no-op if
+// this server is not a database replica.
+func (db *Database) Start(ctx context.Context) error {
+	if !db.IsReplica() {
+		return nil
+	}
+
+	return db.raft.start(ctx)
+}
+
+// IsReplica returns true if this server holds a replica of the database.
+func (db *Database) IsReplica() bool {
+	return db.raft != nil
+}
+
+// IsLeader returns true if this server holds the leading database replica.
+func (db *Database) IsLeader() bool {
+	return db.IsReplica() && db.raft.isLeader()
+}
+
+// Leader returns the address of the leading database replica, if known.
+func (db *Database) Leader() string {
+	if !db.IsReplica() {
+		return ""
+	}
+
+	return db.raft.leaderAddr()
+}
+
+// Replicas returns the addresses of the database replicas.
+func (db *Database) Replicas() []string {
+	return append([]string{}, db.replicas...)
+}
+
+// DownReplicas returns the addresses of the replicas which haven't
+// responded to the leader recently. Replica state is only known to the
+// leader, a NotLeaderError is returned by other replicas.
+func (db *Database) DownReplicas() ([]string, error) {
+	if err := db.checkReplica(); err != nil {
+		return nil, err
+	}
+
+	return db.raft.downPeers()
+}
+
+func (db *Database) checkReplica() error {
+	if !db.IsReplica() {
+		return errors.New("not a system database replica")
+	}
+
+	return nil
+}
+
+// HandleVoteRequest responds to a leader election vote request received
+// from another replica.
+func (db *Database) HandleVoteRequest(req *VoteRequest) (*VoteResponse, error) {
+	if err := db.checkReplica(); err != nil {
+		return nil, err
+	}
+
+	return db.raft.handleVote(req)
+}
+
+// HandleAppendRequest stores log entries replicated by the leader.
+func (db *Database) HandleAppendRequest(req *AppendRequest) (*AppendResponse, error) {
+	if err := db.checkReplica(); err != nil {
+		return nil, err
+	}
+
+	return db.raft.handleAppend(req)
+}
+
+// HandleSubmitRequest applies an update forwarded by another replica.
+// Member joins are validated against the leader's state and so can't be
+// forwarded.
+func (db *Database) HandleSubmitRequest(ctx context.Context, req *SubmitRequest) error {
+	if err := db.checkReplica(); err != nil {
+		return err
+	}
+	if !canForward(req.Op) {
+		return errors.Errorf("system database update %q can't be forwarded", req.Op)
+	}
+
+	return db.raft.submit(ctx, req.Op, req.Data)
+}
+
+func canForward(op string) bool {
+	return op == dbOpPoolSvcUpdate || op == dbOpPoolSvcRemove
+}
+
+// submit makes an update through the leader, forwarding it if this replica
+// isn't the leader and the update can be forwarded. Forwarded updates may
+// not yet have been applied locally on return.
+func (db *Database) submit(ctx context.Context, op string, payload interface{}) error {
+	if err := db.checkReplica(); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return errors.Wrapf(err, "marshal system database update %q", op)
+	}
+
+	err = db.raft.submit(ctx, op, data)
+	if notLeader, ok := err.(*NotLeaderError); ok && canForward(op) && notLeader.LeaderHint != "" {
+		return errors.Wrapf(db.transport.Submit(ctx, notLeader.LeaderHint, &SubmitRequest{Op: op, Data: data}),
+			"forward system database update %q to %s", op, notLeader.LeaderHint)
+	}
+
+	return err
+}
+
+// RankChangedError indicates that a member attempted to rejoin with a rank
+// other than the one it was assigned.
+type RankChangedError struct {
+	UUID     string
+	Old, New uint32
+}
+
+func (err *RankChangedError) Error() string {
+	return fmt.Sprintf("rank of %s cannot change: %d -> %d", err.UUID, err.Old, err.New)
+}
+
+// RankTakenError indicates that a new member requested a rank which is
+// already assigned to another member.
+type RankTakenError struct {
+	Rank uint32
+	UUID string
+}
+
+func (err *RankTakenError) Error() string {
+	return fmt.Sprintf("rank %d requested by %s already taken", err.Rank, err.UUID)
+}
+
+// JoinMember records a member joining the system and returns the member as
+// recorded. A member rejoining with a known UUID keeps its rank, new members
+// are assigned the requested rank or, if NilRank, the next unused rank.
+// Excluded members are returned unchanged. Only the leader can add members.
+//
+// If confirm isn't nil it's called with the member as it will be recorded,
+// before the update is committed, so that the join can be checked with
+// another party. The join is abandoned if confirm returns an error.
+func (db *Database) JoinMember(ctx context.Context, member *Member, confirm func(*Member) error) (*Member, error) {
+	if err := db.checkReplica(); err != nil {
+		return nil, err
+	}
+
+	db.joinMutex.Lock()
+	defer db.joinMutex.Unlock()
+
+	// Make sure all earlier joins have been applied before checking
+	// the membership.
+	if err := db.raft.barrier(ctx); err != nil {
+		return nil, err
+	}
+
+	joined := *member
+	if cur, err := db.membership.GetByUUID(member.UUID); err == nil {
+		if member.Rank != NilRank && member.Rank != cur.Rank {
+			return nil, &RankChangedError{UUID: member.UUID, Old: cur.Rank, New: member.Rank}
+		}
+		if cur.State == MemberStateExcluded {
+			return cur, nil
+		}
+		joined.Rank = cur.Rank
+	} else {
+		if member.Rank == NilRank {
+			joined.Rank = db.allocRank()
+		} else if _, err := db.membership.Get(member.Rank); err == nil {
+			return nil, &RankTakenError{Rank: member.Rank, UUID: member.UUID}
+		}
+	}
+
+	if confirm != nil {
+		if err := confirm(&joined); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := db.submit(ctx, dbOpMemberJoin, &joined); err != nil {
+		return nil, err
+	}
+
+	return db.membership.Get(joined.Rank)
+}
+
+// allocRank returns the lowest rank from the next rank onwards that is not
+// held by a member.
+func (db *Database) allocRank() uint32 {
+	db.RLock()
+	rank := db.nextRank
+	db.RUnlock()
+
+	for {
+		if _, err := db.membership.Get(rank); err != nil {
+			return rank
+		}
+		rank++
+	}
+}
+
+// UpdatePoolService records the ranks hosting the service of a pool.
+// Followers forward the update to the leader.
+func (db *Database) UpdatePoolService(ctx context.Context, ps *PoolService) error {
+	return db.submit(ctx, dbOpPoolSvcUpdate, ps)
+}
+
+// RemovePoolService removes the record of a pool service.
+func (db *Database) RemovePoolService(ctx context.Context, poolUUID string) error {
+	return db.submit(ctx, dbOpPoolSvcRemove, poolUUID)
+}
+
+// PoolService returns a copy of the record of the service of the given pool.
+func (db *Database) PoolService(poolUUID string) (*PoolService, error) {
+	db.RLock()
+	defer db.RUnlock()
+
+	ps, exists := db.pools[poolUUID]
+	if !exists {
+		return nil, errors.Errorf("unable to find pool service %s", poolUUID)
+	}
+
+	return copyPoolService(ps), nil
+}
+
+// PoolServices returns copies of the records of all pool services, sorted
+// by pool UUID.
+func (db *Database) PoolServices() []*PoolService {
+	db.RLock()
+	defer db.RUnlock()
+
+	services := make([]*PoolService, 0, len(db.pools))
+	for _, ps := range db.pools {
+		services = append(services, copyPoolService(ps))
+	}
+	sort.Slice(services, func(i, j int) bool {
+		return services[i].PoolUUID < services[j].PoolUUID
+	})
+
+	return services
+}
+
+func copyPoolService(ps *PoolService) *PoolService {
+	return &PoolService{
+		PoolUUID: ps.PoolUUID,
+		Replicas: append([]uint32{}, ps.Replicas...),
+	}
+}
+
+// apply updates the database state with a committed log entry. Entries are
+// validated before submission so they are applied unconditionally.
+func (db *Database) apply(entry *LogEntry) {
+	var err error
+
+	switch entry.Op {
+	case dbOpNoop:
+	case dbOpMemberJoin:
+		member := &Member{}
+		if err = json.Unmarshal(entry.Data, member); err == nil {
+			db.membership.Join(member)
+
+			db.Lock()
+			if member.Rank >= db.nextRank {
+				db.nextRank = member.Rank + 1
+			}
+			db.Unlock()
+		}
+	case dbOpPoolSvcUpdate:
+		ps := &PoolService{}
+		if err = json.Unmarshal(entry.Data, ps); err == nil {
+			db.Lock()
+			db.pools[ps.PoolUUID] = ps
+			db.Unlock()
+		}
+	case dbOpPoolSvcRemove:
+		var poolUUID string
+		if err = json.Unmarshal(entry.Data, &poolUUID); err == nil {
+			db.Lock()
+			delete(db.pools, poolUUID)
+			db.Unlock()
+		}
+	default:
+		err = errors.Errorf("unknown operation %q", entry.Op)
+	}
+
+	if err != nil {
+		db.log.Errorf("system database: failed to apply entry %d: %s", entry.Index, err)
+	}
+}
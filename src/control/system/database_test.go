@@ -0,0 +1,684 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package system
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	. "github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// mockCluster connects database replicas in memory, allowing replicas to be
+// cut off from the others.
+type mockCluster struct {
+	sync.Mutex
+	dbs  map[string]*Database
+	down map[string]bool
+}
+
+func (mc *mockCluster) replica(from, to string) (*Database, error) {
+	mc.Lock()
+	defer mc.Unlock()
+
+	if mc.down[from] || mc.down[to] {
+		return nil, errors.Errorf("%s unreachable from %s", to, from)
+	}
+	db, exists := mc.dbs[to]
+	if !exists {
+		return nil, errors.Errorf("unknown replica %s", to)
+	}
+
+	return db, nil
+}
+
+func (mc *mockCluster) setDown(addr string, down bool) {
+	mc.Lock()
+	defer mc.Unlock()
+
+	mc.down[addr] = down
+}
+
+// mockTransport delivers consensus messages sent by one replica.
+type mockTransport struct {
+	cluster *mockCluster
+	from    string
+}
+
+func (mt *mockTransport) RequestVote(_ context.Context, replica string, req *VoteRequest) (*VoteResponse, error) {
+	db, err := mt.cluster.replica(mt.from, replica)
+	if err != nil {
+		return nil, err
+	}
+
+	return db.HandleVoteRequest(req)
+}
+
+// mockMaxMsgSize stands in for the gRPC message size limit.
+const mockMaxMsgSize = 4 << 20
+
+func (mt *mockTransport) AppendEntries(_ context.Context, replica string, req *AppendRequest) (*AppendResponse, error) {
+	db, err := mt.cluster.replica(mt.from, replica)
+	if err != nil {
+		return nil, err
+	}
+
+	var size int
+	for _, entry := range req.Entries {
+		size += len(entry.Op) + len(entry.Data)
+	}
+	if size > mockMaxMsgSize {
+		return nil, errors.Errorf("append request of %d bytes exceeds limit", size)
+	}
+
+	return db.HandleAppendRequest(req)
+}
+
+func (mt *mockTransport) Submit(ctx context.Context, replica string, req *SubmitRequest) error {
+	db, err := mt.cluster.replica(mt.from, replica)
+	if err != nil {
+		return err
+	}
+
+	return db.HandleSubmitRequest(ctx, req)
+}
+
+func newTestDatabase(log logging.Logger, cluster *mockCluster, self string, replicas []string, path string) *Database {
+	db := NewDatabase(log, NewMembership(log), &DatabaseConfig{
+		Replicas:  replicas,
+		Self:      self,
+		Path:      path,
+		Transport: &mockTransport{cluster: cluster, from: self},
+	})
+	if db.raft != nil {
+		db.raft.heartbeat = 10 * time.Millisecond
+		db.raft.election = 50 * time.Millisecond
+	}
+	cluster.Lock()
+	cluster.dbs[self] = db
+	cluster.Unlock()
+
+	return db
+}
+
+func newTestCluster(t *testing.T, log logging.Logger, ctx context.Context, size int, dir string) (*mockCluster, []string) {
+	t.Helper()
+
+	cluster := &mockCluster{
+		dbs:  make(map[string]*Database),
+		down: make(map[string]bool),
+	}
+
+	var replicas []string
+	for i := 0; i < size; i++ {
+		replicas = append(replicas, fmt.Sprintf("10.0.0.%d:10000", i+1))
+	}
+	for i, replica := range replicas {
+		var path string
+		if dir != "" {
+			path = filepath.Join(dir, fmt.Sprintf("system_db.%d", i))
+		}
+		db := newTestDatabase(log, cluster, replica, replicas, path)
+		if err := db.Start(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return cluster, replicas
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// waitLeader waits for one of the replicas which are not down to be elected
+// leader.
+func waitLeader(t *testing.T, cluster *mockCluster) *Database {
+	t.Helper()
+
+	var leader *Database
+	waitFor(t, "leader election", func() bool {
+		cluster.Lock()
+		defer cluster.Unlock()
+
+		for addr, db := range cluster.dbs {
+			if !cluster.down[addr] && db.IsLeader() {
+				leader = db
+				return true
+			}
+		}
+		return false
+	})
+
+	return leader
+}
+
+// waitDownReplicas waits for the leader to report the given replicas down.
+func waitDownReplicas(t *testing.T, leader *Database, expDown []string) {
+	t.Helper()
+
+	waitFor(t, fmt.Sprintf("down replicas %v", expDown), func() bool {
+		down, err := leader.DownReplicas()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return reflect.DeepEqual(down, expDown)
+	})
+}
+
+func mockJoiner(uuid string, rank uint32) *Member {
+	return &Member{
+		Rank:           rank,
+		UUID:           uuid,
+		URI:            "uri-" + uuid,
+		FabricContexts: 2,
+		Addr:           "127.0.0.1:10001",
+		State:          MemberStateJoined,
+	}
+}
+
+func mktempDir(t *testing.T) (string, func()) {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", t.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return dir, func() { os.RemoveAll(dir) }
+}
+
+func TestDatabaseJoinMember(t *testing.T) {
+	for name, tc := range map[string]struct {
+		joins    []*Member
+		join     *Member
+		confirm  func(*Member) error
+		expRank  uint32
+		expState MemberState
+		expErr   error
+	}{
+		"first member": {
+			join:     mockJoiner("a", NilRank),
+			expRank:  0,
+			expState: MemberStateJoined,
+		},
+		"next rank": {
+			joins:    []*Member{mockJoiner("a", NilRank), mockJoiner("b", 3)},
+			join:     mockJoiner("c", NilRank),
+			expRank:  4,
+			expState: MemberStateJoined,
+		},
+		"requested rank": {
+			joins:    []*Member{mockJoiner("a", NilRank)},
+			join:     mockJoiner("b", 2),
+			expRank:  2,
+			expState: MemberStateJoined,
+		},
+		"rejoin keeps rank": {
+			joins:    []*Member{mockJoiner("a", NilRank), mockJoiner("b", NilRank)},
+			join:     mockJoiner("a", NilRank),
+			expRank:  0,
+			expState: MemberStateJoined,
+		},
+		"rank changed": {
+			joins:  []*Member{mockJoiner("a", NilRank)},
+			join:   mockJoiner("a", 1),
+			expErr: errors.New("rank of a cannot change: 0 -> 1"),
+		},
+		"rank taken": {
+			joins:  []*Member{mockJoiner("a", NilRank)},
+			join:   mockJoiner("b", 0),
+			expErr: errors.New("rank 0 requested by b already taken"),
+		},
+		"confirmed": {
+			joins: []*Member{mockJoiner("a", NilRank)},
+			join:  mockJoiner("b", NilRank),
+			confirm: func(m *Member) error {
+				if m.Rank != 1 {
+					return errors.Errorf("confirming rank %d", m.Rank)
+				}
+				return nil
+			},
+			expRank:  1,
+			expState: MemberStateJoined,
+		},
+		"not confirmed": {
+			join: mockJoiner("a", NilRank),
+			confirm: func(*Member) error {
+				return errors.New("rejected")
+			},
+			expErr: errors.New("rejected"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer ShowBufferOnFailure(t, buf)()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			cluster, _ := newTestCluster(t, log, ctx, 1, "")
+			db := waitLeader(t, cluster)
+
+			for _, join := range tc.joins {
+				if _, err := db.JoinMember(ctx, join, nil); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			member, err := db.JoinMember(ctx, tc.join, tc.confirm)
+			if tc.expErr != nil {
+				ExpectError(t, err, tc.expErr.Error(), name)
+				if _, err := db.membership.GetByUUID(tc.join.UUID); err == nil && len(tc.joins) == 0 {
+					t.Fatal("member recorded despite failed join")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			AssertEqual(t, member.Rank, tc.expRank, "unexpected rank")
+			AssertEqual(t, member.State, tc.expState, "unexpected state")
+			AssertEqual(t, member.URI, tc.join.URI, "unexpected URI")
+			AssertEqual(t, member.FabricContexts, tc.join.FabricContexts, "unexpected contexts")
+		})
+	}
+}
+
+func TestDatabaseNotReplica(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	db := NewDatabase(log, NewMembership(log), &DatabaseConfig{
+		Replicas: []string{"10.0.0.1:10000"},
+		Self:     "10.0.0.2:10000",
+	})
+	if err := db.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	AssertTrue(t, !db.IsReplica(), "expected non-replica")
+	AssertTrue(t, !db.IsLeader(), "expected non-leader")
+	AssertEqual(t, db.Leader(), "", "expected unknown leader")
+
+	_, err := db.JoinMember(context.Background(), mockJoiner("a", NilRank), nil)
+	ExpectError(t, err, "not a system database replica", "join on non-replica")
+}
+
+func TestDatabasePoolServices(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cluster, _ := newTestCluster(t, log, ctx, 1, "")
+	db := waitLeader(t, cluster)
+
+	for _, ps := range []*PoolService{
+		{PoolUUID: "p2", Replicas: []uint32{1, 2, 3}},
+		{PoolUUID: "p1", Replicas: []uint32{0}},
+		{PoolUUID: "p3", Replicas: []uint32{4}},
+	} {
+		if err := db.UpdatePoolService(ctx, ps); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.RemovePoolService(ctx, "p3"); err != nil {
+		t.Fatal(err)
+	}
+
+	AssertEqual(t, db.PoolServices(), []*PoolService{
+		{PoolUUID: "p1", Replicas: []uint32{0}},
+		{PoolUUID: "p2", Replicas: []uint32{1, 2, 3}},
+	}, "unexpected pool services")
+
+	ps, err := db.PoolService("p2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps.Replicas[0] = 7
+	ps, err = db.PoolService("p2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEqual(t, ps.Replicas, []uint32{1, 2, 3}, "pool service modified through copy")
+
+	_, err = db.PoolService("p3")
+	ExpectError(t, err, "unable to find pool service p3", "removed pool service")
+}
+
+func TestDatabaseReplication(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	dir, cleanup := mktempDir(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cluster, replicas := newTestCluster(t, log, ctx, 3, dir)
+	leader := waitLeader(t, cluster)
+	waitDownReplicas(t, leader, nil)
+
+	member, err := leader.JoinMember(ctx, mockJoiner("a", NilRank), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEqual(t, member.Rank, uint32(0), "unexpected rank from leader")
+
+	// Updates are rejected by followers, which apply those made through
+	// the leader.
+	for _, replica := range replicas {
+		db := cluster.dbs[replica]
+		if db == leader {
+			continue
+		}
+		_, err := db.JoinMember(ctx, mockJoiner("b", NilRank), nil)
+		if !IsNotLeader(err) {
+			t.Fatalf("expected not leader error from follower, got %v", err)
+		}
+		if _, err := db.DownReplicas(); !IsNotLeader(err) {
+			t.Fatalf("expected not leader error from follower, got %v", err)
+		}
+		waitFor(t, "replication to "+replica, func() bool {
+			_, err := db.membership.GetByUUID("a")
+			return err == nil
+		})
+
+		// Pool service updates are forwarded to the leader.
+		if err := db.UpdatePoolService(ctx, &PoolService{PoolUUID: replica}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := leader.PoolService(replica); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	err = leader.HandleSubmitRequest(ctx, &SubmitRequest{Op: dbOpMemberJoin})
+	ExpectError(t, err, `system database update "member-join" can't be forwarded`,
+		"forwarded member join")
+
+	// A new leader is elected by the remaining majority, which can still
+	// make updates.
+	oldLeader := leader.raft.self
+	cluster.setDown(oldLeader, true)
+	leader = waitLeader(t, cluster)
+	waitDownReplicas(t, leader, []string{oldLeader})
+	member, err = leader.JoinMember(ctx, mockJoiner("b", NilRank), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEqual(t, member.Rank, uint32(1), "unexpected rank from new leader")
+
+	// The old leader catches up when reconnected.
+	cluster.setDown(oldLeader, false)
+	waitFor(t, "catch up of "+oldLeader, func() bool {
+		_, err := cluster.dbs[oldLeader].membership.GetByUUID("b")
+		return err == nil
+	})
+	cancel()
+
+	// Committed updates are restored from the persisted log.
+	var path string
+	for i, replica := range replicas {
+		if replica == oldLeader {
+			path = filepath.Join(dir, fmt.Sprintf("system_db.%d", i))
+		}
+	}
+	restarted := newTestDatabase(log, cluster, oldLeader, replicas, path)
+	if err := restarted.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	members, err := restarted.membership.Members()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEqual(t, len(members), 2, "unexpected members after restart")
+}
+
+// TestDatabaseCatchUp checks that a replica which has missed more updates
+// than fit in a single message catches up, and that updates too large to
+// replicate are rejected.
+func TestDatabaseCatchUp(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	cluster, replicas := newTestCluster(t, log, ctx, 3, "")
+	leader := waitLeader(t, cluster)
+
+	var follower string
+	for _, replica := range replicas {
+		if replica != leader.raft.self {
+			follower = replica
+			break
+		}
+	}
+	cluster.setDown(follower, true)
+
+	// Each update holds about 50KiB, enough of them are made to exceed
+	// the message size limit.
+	replicaRanks := make([]uint32, 10000)
+	for i := range replicaRanks {
+		replicaRanks[i] = 1000
+	}
+	count := mockMaxMsgSize/(5*len(replicaRanks)) + 10
+	for i := 0; i < count; i++ {
+		ps := &PoolService{PoolUUID: fmt.Sprintf("pool-%d", i), Replicas: replicaRanks}
+		if err := leader.UpdatePoolService(ctx, ps); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cluster.setDown(follower, false)
+	waitFor(t, "catch up of "+follower, func() bool {
+		return len(cluster.dbs[follower].PoolServices()) == count
+	})
+
+	tooLarge := &PoolService{PoolUUID: "too-large", Replicas: make([]uint32, raftMaxEntrySize)}
+	err := leader.UpdatePoolService(ctx, tooLarge)
+	ExpectError(t, err, fmt.Sprintf(`system database update "pool-svc-update": size %d exceeds limit %d`,
+		len(`{"PoolUUID":"too-large","Replicas":[]}`)+2*raftMaxEntrySize-1, raftMaxEntrySize),
+		"oversized update")
+}
+
+func TestRaftStoreLoad(t *testing.T) {
+	dir, cleanup := mktempDir(t)
+	defer cleanup()
+
+	noop := func(index uint64) string {
+		return fmt.Sprintf(`{"term":1,"index":%d,"op":"noop"}`, index) + "\n"
+	}
+
+	for name, tc := range map[string]struct {
+		meta       string
+		log        string
+		expEntries int
+		expErr     error
+	}{
+		"empty log": {
+			meta: `{"term":1}`,
+		},
+		"no metadata": {
+			log:        noop(1),
+			expEntries: 1,
+		},
+		"committed entries": {
+			meta:       `{"term":1,"commit":2}`,
+			log:        noop(1) + noop(2) + noop(3),
+			expEntries: 3,
+		},
+		"torn entry": {
+			meta:       `{"term":1,"commit":1}`,
+			log:        noop(1) + `{"term":1,"ind`,
+			expEntries: 1,
+		},
+		"bad index": {
+			meta:   `{"term":1}`,
+			log:    noop(2),
+			expErr: errors.New("entry 1 has index 2"),
+		},
+		"bad commit": {
+			meta:   `{"term":1,"commit":2}`,
+			log:    noop(1),
+			expErr: errors.New("commit index 2 beyond last entry 1"),
+		},
+		"corrupt metadata": {
+			meta:   `{"term":`,
+			expErr: errors.New("failed to parse system database"),
+		},
+		"corrupt entry": {
+			meta:   `{"term":1}`,
+			log:    noop(1) + "{\n" + noop(3),
+			expErr: errors.New("failed to parse system database log"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, strings.Replace(name, " ", "_", -1))
+			if tc.meta != "" {
+				if err := ioutil.WriteFile(path, []byte(tc.meta), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tc.log != "" {
+				if err := ioutil.WriteFile(path+".log", []byte(tc.log), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			state, err := (&raftStore{path: path}).load()
+			if tc.expErr != nil {
+				if err == nil || !strings.Contains(err.Error(), tc.expErr.Error()) {
+					t.Fatalf("expected error containing %q, got %v", tc.expErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			AssertEqual(t, len(state.Entries), tc.expEntries, "unexpected number of entries")
+		})
+	}
+}
+
+func TestRaftStoreAppend(t *testing.T) {
+	dir, cleanup := mktempDir(t)
+	defer cleanup()
+
+	path := filepath.Join(dir, "system_db")
+	entry := func(term, index uint64) *LogEntry {
+		return &LogEntry{Term: term, Index: index, Op: dbOpNoop}
+	}
+
+	rs := &raftStore{path: path}
+	if _, err := rs.load(); err != nil {
+		t.Fatal(err)
+	}
+	if err := rs.appendAt(1, []*LogEntry{entry(1, 1), entry(1, 2), entry(1, 3)}); err != nil {
+		t.Fatal(err)
+	}
+	if err := rs.appendAt(4, []*LogEntry{entry(1, 4)}); err != nil {
+		t.Fatal(err)
+	}
+	// Conflicting entries are replaced.
+	if err := rs.appendAt(3, []*LogEntry{entry(2, 3)}); err != nil {
+		t.Fatal(err)
+	}
+	err := rs.appendAt(5, []*LogEntry{entry(2, 5)})
+	ExpectError(t, err, fmt.Sprintf("system database log %s.log: can't append at index 5 (last 3)", path),
+		"append beyond last entry")
+	if err := rs.saveMeta(&raftMeta{Term: 2, VotedFor: "a", Commit: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	state, err := (&raftStore{path: path}).load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEqual(t, state, &raftState{
+		raftMeta: raftMeta{Term: 2, VotedFor: "a", Commit: 2},
+		Entries:  []*LogEntry{entry(1, 1), entry(1, 2), entry(2, 3)},
+	}, "unexpected state after reload")
+}
+
+func TestRaftPersistFailure(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	dir, cleanup := mktempDir(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// The store's directory doesn't exist, so nothing can be persisted.
+	store := &raftStore{path: filepath.Join(dir, "missing", "system_db")}
+	r := newRaftNode(log, "a", []string{"a", "b"}, store, nil, func(*LogEntry) {})
+	if err := r.start(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := r.handleVote(&VoteRequest{Term: 1, Candidate: "b"})
+	if err == nil {
+		t.Fatal("expected vote to fail")
+	}
+	_, err = r.handleAppend(&AppendRequest{
+		Term:    1,
+		Leader:  "b",
+		Entries: []*LogEntry{{Term: 1, Index: 1, Op: dbOpNoop}},
+	})
+	if err == nil {
+		t.Fatal("expected append to fail")
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	AssertEqual(t, r.state.Term, uint64(0), "term changed without being persisted")
+	AssertEqual(t, len(r.state.Entries), 0, "entries appended without being persisted")
+}
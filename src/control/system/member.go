@@ -0,0 +1,267 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package system
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// MemberState represents the activity state of a DAOS system member.
+type MemberState int
+
+const (
+	// MemberStateUnknown indicates the member state has not been determined.
+	MemberStateUnknown MemberState = iota
+	// MemberStateJoined indicates the member has joined the system and its
+	// control plane was last found to be reachable.
+	MemberStateJoined
+	// MemberStateExcluded indicates the member has been excluded from the
+	// system map.
+	MemberStateExcluded
+	// MemberStateMissing indicates the member joined the system but its
+	// control plane can no longer be reached.
+	MemberStateMissing
+	// MemberStateUnresponsive indicates the member's control plane can be
+	// reached but its I/O server has stopped answering heartbeats.
+	MemberStateUnresponsive
+)
+
+func (ms MemberState) String() string {
+	switch ms {
+	case MemberStateJoined:
+		return "Joined"
+	case MemberStateExcluded:
+		return "Excluded"
+	case MemberStateMissing:
+		return "Missing"
+	case MemberStateUnresponsive:
+		return "Unresponsive"
+	default:
+		return "Unknown"
+	}
+}
+
+// Member refers to a data-plane instance that is a member of this DAOS
+// system running on a host with the specified control-plane address.
+type Member struct {
+	Rank           uint32
+	UUID           string
+	URI            string
+	FabricContexts uint32
+	Addr           string
+	State          MemberState
+	LastSeen       time.Time
+	// latency of the last successful heartbeat, zero if none reported
+	HeartbeatLatency time.Duration
+}
+
+func (sm *Member) String() string {
+	return fmt.Sprintf("%s/%d/%s", sm.Addr, sm.Rank, sm.State)
+}
+
+// Members is a type alias for a slice of member references.
+type Members []*Member
+
+// ProbeFn checks whether the control plane at the given address can be
+// reached.
+type ProbeFn func(ctx context.Context, addr string) error
+
+// Membership tracks details of system members.
+type Membership struct {
+	sync.RWMutex
+	log     logging.Logger
+	members map[uint32]*Member
+}
+
+// NewMembership returns an initialized, empty Membership.
+func NewMembership(log logging.Logger) *Membership {
+	return &Membership{
+		log:     log,
+		members: make(map[uint32]*Member),
+	}
+}
+
+// Join adds the member to the membership or, if its rank already exists,
+// replaces the existing entry. Returns true if the rank was not previously
+// a member.
+func (m *Membership) Join(member *Member) bool {
+	m.Lock()
+	defer m.Unlock()
+
+	_, exists := m.members[member.Rank]
+	m.members[member.Rank] = member
+	if exists {
+		m.log.Debugf("rank %d rejoined system (%s)", member.Rank, member)
+	} else {
+		m.log.Debugf("rank %d joined system (%s)", member.Rank, member)
+	}
+
+	return !exists
+}
+
+// Get returns a copy of the member with the given rank.
+func (m *Membership) Get(rank uint32) (*Member, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	member, exists := m.members[rank]
+	if !exists {
+		return nil, errors.Errorf("rank %d not a system member", rank)
+	}
+	copied := *member
+
+	return &copied, nil
+}
+
+// GetByUUID returns a copy of the member with the given UUID.
+func (m *Membership) GetByUUID(uuid string) (*Member, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	for _, member := range m.members {
+		if member.UUID == uuid {
+			copied := *member
+			return &copied, nil
+		}
+	}
+
+	return nil, errors.Errorf("%s not a system member", uuid)
+}
+
+// Members returns copies of the members with the given ranks, or of all
+// members if no ranks are specified, sorted by rank.
+func (m *Membership) Members(ranks ...uint32) (Members, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	// Sort a copy so that the caller's slice is left untouched.
+	ranks = append([]uint32{}, ranks...)
+	if len(ranks) == 0 {
+		for rank := range m.members {
+			ranks = append(ranks, rank)
+		}
+	}
+	sort.Slice(ranks, func(i, j int) bool { return ranks[i] < ranks[j] })
+
+	members := make(Members, 0, len(ranks))
+	for _, rank := range ranks {
+		member, exists := m.members[rank]
+		if !exists {
+			return nil, errors.Errorf("rank %d not a system member", rank)
+		}
+		copied := *member
+		members = append(members, &copied)
+	}
+
+	return members, nil
+}
+
+// Refresh probes the control plane of each member which has not been
+// excluded and updates its state and last-seen time accordingly.
+func (m *Membership) Refresh(ctx context.Context, probe ProbeFn) {
+	m.RLock()
+	toProbe := make(Members, 0, len(m.members))
+	for _, member := range m.members {
+		if member.State != MemberStateExcluded {
+			toProbe = append(toProbe, member)
+		}
+	}
+	m.RUnlock()
+
+	type result struct {
+		rank uint32
+		err  error
+	}
+	results := make(chan result, len(toProbe))
+	for _, member := range toProbe {
+		go func(rank uint32, addr string) {
+			results <- result{rank, probe(ctx, addr)}
+		}(member.Rank, member.Addr)
+	}
+
+	for range toProbe {
+		res := <-results
+
+		m.Lock()
+		if member, exists := m.members[res.rank]; exists {
+			if res.err != nil {
+				if member.State != MemberStateMissing {
+					m.log.Debugf("rank %d unreachable: %s", res.rank, res.err)
+				}
+				member.State = MemberStateMissing
+			} else {
+				// only heartbeats show that an unresponsive
+				// member has recovered
+				if member.State != MemberStateUnresponsive {
+					member.State = MemberStateJoined
+				}
+				member.LastSeen = time.Now()
+			}
+		}
+		m.Unlock()
+	}
+}
+
+// Heartbeat records the outcome of the latest heartbeat reported for the
+// member with the given rank and UUID, marking it unresponsive or joined as
+// appropriate. Excluded members keep their state.
+func (m *Membership) Heartbeat(rank uint32, uuid string, responsive bool, latency time.Duration) error {
+	m.Lock()
+	defer m.Unlock()
+
+	member, exists := m.members[rank]
+	if !exists {
+		return errors.Errorf("rank %d not a system member", rank)
+	}
+	if member.UUID != uuid {
+		return errors.Errorf("rank %d is not %s", rank, uuid)
+	}
+
+	if responsive {
+		member.HeartbeatLatency = latency
+		member.LastSeen = time.Now()
+	}
+	if member.State == MemberStateExcluded {
+		return nil
+	}
+
+	switch {
+	case responsive && member.State != MemberStateJoined:
+		m.log.Infof("rank %d responsive, heartbeat latency %s", rank, latency)
+		member.State = MemberStateJoined
+	case !responsive && member.State != MemberStateUnresponsive:
+		m.log.Errorf("rank %d unresponsive", rank)
+		member.State = MemberStateUnresponsive
+	}
+
+	return nil
+}
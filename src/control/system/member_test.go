@@ -0,0 +1,204 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package system
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	. "github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func mockMember(rank uint32, addr string, state MemberState) *Member {
+	return &Member{
+		Rank:  rank,
+		UUID:  "uuid",
+		URI:   "uri",
+		Addr:  addr,
+		State: state,
+	}
+}
+
+func TestMembershipJoin(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	m := NewMembership(log)
+
+	AssertTrue(t, m.Join(mockMember(1, "127.0.0.1:10001", MemberStateJoined)),
+		"expected new member")
+	AssertTrue(t, m.Join(mockMember(0, "127.0.0.2:10001", MemberStateJoined)),
+		"expected new member")
+	AssertTrue(t, !m.Join(mockMember(1, "127.0.0.3:10001", MemberStateJoined)),
+		"expected rejoin of existing member")
+
+	members, err := m.Members()
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEqual(t, members, Members{
+		mockMember(0, "127.0.0.2:10001", MemberStateJoined),
+		mockMember(1, "127.0.0.3:10001", MemberStateJoined),
+	}, "unexpected members")
+
+	member, err := m.Get(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	member.State = MemberStateExcluded
+	member, err = m.Get(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEqual(t, member.State, MemberStateJoined, "membership modified through copy")
+
+	ranks := []uint32{1, 0}
+	members, err = m.Members(ranks...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	AssertEqual(t, members[0].Rank, uint32(0), "members not sorted by rank")
+	AssertEqual(t, ranks, []uint32{1, 0}, "caller's ranks reordered")
+
+	if _, err := m.Members(0, 2); err == nil {
+		t.Fatal("expected error for rank which is not a member")
+	}
+}
+
+func TestMembershipRefresh(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	m := NewMembership(log)
+	m.Join(mockMember(0, "up", MemberStateJoined))
+	m.Join(mockMember(1, "down", MemberStateJoined))
+	m.Join(mockMember(2, "up", MemberStateMissing))
+	m.Join(mockMember(3, "down", MemberStateExcluded))
+	m.Join(mockMember(4, "up", MemberStateUnresponsive))
+
+	probed := make(chan string, 5)
+	m.Refresh(context.TODO(), func(ctx context.Context, addr string) error {
+		probed <- addr
+		if addr == "down" {
+			return errors.New("unreachable")
+		}
+		return nil
+	})
+	AssertEqual(t, len(probed), 4, "excluded member should not be probed")
+
+	for rank, expState := range map[uint32]MemberState{
+		0: MemberStateJoined,
+		1: MemberStateMissing,
+		2: MemberStateJoined,
+		3: MemberStateExcluded,
+		4: MemberStateUnresponsive,
+	} {
+		member, err := m.Get(rank)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEqual(t, member.State, expState, "unexpected state for rank")
+		AssertEqual(t, member.LastSeen.IsZero(), member.Addr != "up",
+			"unexpected last seen time for rank")
+	}
+}
+
+func TestMembershipHeartbeat(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer ShowBufferOnFailure(t, buf)()
+
+	m := NewMembership(log)
+	m.Join(mockMember(0, "addr", MemberStateJoined))
+	m.Join(mockMember(1, "addr", MemberStateExcluded))
+
+	// heartbeats are applied in order, each step building on the last
+	for _, tc := range []struct {
+		desc       string
+		rank       uint32
+		uuid       string
+		responsive bool
+		latency    time.Duration
+		expState   MemberState
+		expLatency time.Duration
+		expErr     string
+	}{
+		{
+			desc:       "responsive",
+			responsive: true,
+			latency:    time.Millisecond,
+			expState:   MemberStateJoined,
+			expLatency: time.Millisecond,
+		},
+		{
+			desc:       "unresponsive keeps last latency",
+			expState:   MemberStateUnresponsive,
+			expLatency: time.Millisecond,
+		},
+		{
+			desc:       "recovered",
+			responsive: true,
+			latency:    2 * time.Millisecond,
+			expState:   MemberStateJoined,
+			expLatency: 2 * time.Millisecond,
+		},
+		{
+			desc:     "excluded keeps state",
+			rank:     1,
+			expState: MemberStateExcluded,
+		},
+		{
+			desc:   "not a member",
+			rank:   2,
+			expErr: "rank 2 not a system member",
+		},
+		{
+			desc:   "uuid mismatch",
+			uuid:   "other",
+			expErr: "rank 0 is not other",
+		},
+	} {
+		if tc.uuid == "" {
+			tc.uuid = "uuid"
+		}
+		err := m.Heartbeat(tc.rank, tc.uuid, tc.responsive, tc.latency)
+		if tc.expErr != "" {
+			ExpectError(t, err, tc.expErr, tc.desc)
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		member, err := m.Get(tc.rank)
+		if err != nil {
+			t.Fatal(err)
+		}
+		AssertEqual(t, member.State, tc.expState, tc.desc+": unexpected state")
+		AssertEqual(t, member.HeartbeatLatency, tc.expLatency, tc.desc+": unexpected latency")
+	}
+}
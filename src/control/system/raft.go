@@ -0,0 +1,978 @@
+//
+// (C) Copyright 2019 Intel Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// GOVERNMENT LICENSE RIGHTS-OPEN SOURCE SOFTWARE
+// The Government's rights to use, modify, reproduce, release, perform, display,
+// or disclose this software are subject to the terms of the Apache License as
+// provided in Contract No. 8F-30005.
+// Any reproduction of computer software, computer software documentation, or
+// portions thereof marked with this legend must also reproduce the markings.
+//
+
+package system
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	raftHeartbeatInterval = 250 * time.Millisecond
+	raftElectionTimeout   = 2 * time.Second
+	raftRPCTimeout        = time.Second
+
+	// Updates are bounded so that any entry fits in a single append
+	// request, and entries are sent in batches well below the default
+	// gRPC message size limit of 4MiB so that a replica which has fallen
+	// behind catches up over several requests.
+	raftMaxEntrySize  = 64 << 10
+	raftMaxAppendSize = 1 << 20
+)
+
+// LogEntry is an entry in the replicated system database log.
+type LogEntry struct {
+	Term  uint64 `json:"term"`
+	Index uint64 `json:"index"`
+	Op    string `json:"op"`
+	Data  []byte `json:"data,omitempty"`
+}
+
+// VoteRequest is sent by a candidate replica to solicit votes when electing
+// a new database leader.
+type VoteRequest struct {
+	Term         uint64
+	Candidate    string
+	LastLogIndex uint64
+	LastLogTerm  uint64
+}
+
+// VoteResponse indicates whether a vote was granted to the candidate.
+type VoteResponse struct {
+	Term    uint64
+	Granted bool
+}
+
+// AppendRequest is sent by the database leader to replicate log entries, or
+// with no entries as a heartbeat.
+type AppendRequest struct {
+	Term         uint64
+	Leader       string
+	PrevLogIndex uint64
+	PrevLogTerm  uint64
+	Entries      []*LogEntry
+	LeaderCommit uint64
+}
+
+// AppendResponse indicates whether the entries were accepted and, if not,
+// the last index held by the replica so that the leader can back up.
+type AppendResponse struct {
+	Term      uint64
+	Success   bool
+	LastIndex uint64
+}
+
+// SubmitRequest forwards a database update to the leader.
+type SubmitRequest struct {
+	Op   string
+	Data []byte
+}
+
+// RaftTransport sends consensus messages and forwarded updates to the
+// database replica with the given control-plane address.
+type RaftTransport interface {
+	RequestVote(ctx context.Context, replica string, req *VoteRequest) (*VoteResponse, error)
+	AppendEntries(ctx context.Context, replica string, req *AppendRequest) (*AppendResponse, error)
+	Submit(ctx context.Context, replica string, req *SubmitRequest) error
+}
+
+// NotLeaderError indicates that an update was submitted to a database replica
+// which is not the current leader.
+type NotLeaderError struct {
+	LeaderHint string
+}
+
+func (err *NotLeaderError) Error() string {
+	msg := "not the system database leader"
+	if err.LeaderHint != "" {
+		msg += ", try " + err.LeaderHint
+	}
+
+	return msg
+}
+
+// IsNotLeader returns true if err indicates that an update was submitted to
+// a replica which is not the leader.
+func IsNotLeader(err error) bool {
+	_, ok := errors.Cause(err).(*NotLeaderError)
+	return ok
+}
+
+type raftRole int
+
+const (
+	raftFollower raftRole = iota
+	raftCandidate
+	raftLeader
+)
+
+func (r raftRole) String() string {
+	switch r {
+	case raftCandidate:
+		return "candidate"
+	case raftLeader:
+		return "leader"
+	default:
+		return "follower"
+	}
+}
+
+// raftMeta is the replica state, other than the log, which must be persisted
+// before responding to any consensus message.
+type raftMeta struct {
+	Term     uint64 `json:"term"`
+	VotedFor string `json:"voted_for"`
+	Commit   uint64 `json:"commit"`
+}
+
+// raftState is the persistent replica state.
+type raftState struct {
+	raftMeta
+	Entries []*LogEntry
+}
+
+// raftStore persists replica state to files, or nowhere if the path is
+// empty. The metadata file at path is replaced atomically, while log entries
+// are appended one per line to a separate file so that an update doesn't
+// rewrite the whole log.
+//
+// The log isn't compacted, every update since the database was created is
+// kept and read back on start. Updates are limited to membership and pool
+// service changes and each is at most raftMaxEntrySize, so the log grows
+// slowly relative to the rate of such changes.
+type raftStore struct {
+	path    string
+	offsets []int64 // offset of each entry in the log file
+	size    int64   // length of the valid part of the log file
+}
+
+func (rs *raftStore) logPath() string {
+	return rs.path + ".log"
+}
+
+func (rs *raftStore) load() (*raftState, error) {
+	state := &raftState{}
+	if rs.path == "" {
+		return state, nil
+	}
+
+	data, err := ioutil.ReadFile(rs.path)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return nil, errors.Wrapf(err, "failed to read system database from %s", rs.path)
+	default:
+		if err := json.Unmarshal(data, &state.raftMeta); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse system database %s", rs.path)
+		}
+	}
+
+	if state.Entries, err = rs.loadLog(); err != nil {
+		return nil, err
+	}
+	if state.Commit > uint64(len(state.Entries)) {
+		return nil, errors.Errorf("system database %s: commit index %d beyond last entry %d",
+			rs.path, state.Commit, len(state.Entries))
+	}
+
+	return state, nil
+}
+
+// loadLog reads the log entries, discarding a final entry which was only
+// partially written. Such an entry was never acknowledged, as entries are
+// synced before responding.
+func (rs *raftStore) loadLog() ([]*LogEntry, error) {
+	rs.offsets = nil
+	rs.size = 0
+
+	data, err := ioutil.ReadFile(rs.logPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to read system database log from %s",
+			rs.logPath())
+	}
+
+	var entries []*LogEntry
+	for rs.size < int64(len(data)) {
+		end := bytes.IndexByte(data[rs.size:], '\n')
+		if end < 0 {
+			break
+		}
+
+		entry := &LogEntry{}
+		if err := json.Unmarshal(data[rs.size:rs.size+int64(end)], entry); err != nil {
+			return nil, errors.Wrapf(err, "failed to parse system database log %s entry %d",
+				rs.logPath(), len(entries)+1)
+		}
+		if entry.Index != uint64(len(entries)+1) {
+			return nil, errors.Errorf("system database log %s: entry %d has index %d",
+				rs.logPath(), len(entries)+1, entry.Index)
+		}
+		entries = append(entries, entry)
+		rs.offsets = append(rs.offsets, rs.size)
+		rs.size += int64(end) + 1
+	}
+
+	return entries, nil
+}
+
+// saveMeta replaces the persisted metadata.
+func (rs *raftStore) saveMeta(meta *raftMeta) error {
+	if rs.path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal system database")
+	}
+
+	return errors.Wrapf(common.WriteFileAtomic(rs.path, data, 0600),
+		"failed to write system database to %s", rs.path)
+}
+
+// appendAt replaces the persisted log entries from index on with entries,
+// returning once they are synced.
+func (rs *raftStore) appendAt(index uint64, entries []*LogEntry) error {
+	if rs.path == "" {
+		return nil
+	}
+	if index < 1 || index > uint64(len(rs.offsets))+1 {
+		return errors.Errorf("system database log %s: can't append at index %d (last %d)",
+			rs.logPath(), index, len(rs.offsets))
+	}
+
+	start := rs.size
+	if index <= uint64(len(rs.offsets)) {
+		start = rs.offsets[index-1]
+	}
+
+	var buf bytes.Buffer
+	offsets := append([]int64{}, rs.offsets[:index-1]...)
+	for _, entry := range entries {
+		data, err := json.Marshal(entry)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal system database log entry")
+		}
+		offsets = append(offsets, start+int64(buf.Len()))
+		buf.Write(data)
+		buf.WriteByte('\n')
+	}
+
+	if err := rs.writeLog(start, buf.Bytes()); err != nil {
+		return errors.Wrapf(err, "failed to write system database log to %s", rs.logPath())
+	}
+	rs.offsets = offsets
+	rs.size = start + int64(buf.Len())
+
+	return nil
+}
+
+// writeLog writes data at offset off in the log file, discarding anything
+// after it, and syncs the file.
+func (rs *raftStore) writeLog(off int64, data []byte) (err error) {
+	_, err = os.Stat(rs.logPath())
+	created := os.IsNotExist(err)
+
+	f, err := os.OpenFile(rs.logPath(), os.O_WRONLY|os.O_CREATE, 0600)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if tmperr := f.Close(); tmperr != nil && err == nil {
+			err = tmperr
+		}
+	}()
+
+	if err = f.Truncate(off); err != nil {
+		return
+	}
+	if _, err = f.WriteAt(data, off); err != nil {
+		return
+	}
+	if err = f.Sync(); err != nil {
+		return
+	}
+	if created {
+		err = common.SyncDir(filepath.Dir(rs.logPath()))
+	}
+
+	return
+}
+
+// raftNode replicates a log of database updates across the access points
+// using a leader-based consensus protocol modelled on Raft. Entries are
+// applied in order once a majority of replicas have stored them.
+type raftNode struct {
+	log       logging.Logger
+	self      string
+	peers     []string
+	store     *raftStore
+	transport RaftTransport
+	apply     func(*LogEntry)
+
+	heartbeat time.Duration
+	election  time.Duration
+	kick      chan struct{}
+	rng       *rand.Rand
+
+	sync.Mutex
+	started     bool
+	state       raftState
+	role        raftRole
+	leader      string
+	lastApplied uint64
+	lastContact time.Time
+	timeout     time.Duration
+	nextIndex   map[string]uint64
+	matchIndex  map[string]uint64
+	lastReply   map[string]time.Time // last response from each peer to the leader
+	applied     chan struct{}        // closed and replaced whenever entries are applied
+}
+
+func newRaftNode(log logging.Logger, self string, replicas []string, store *raftStore, transport RaftTransport, apply func(*LogEntry)) *raftNode {
+	var peers []string
+	for _, replica := range replicas {
+		if replica != self {
+			peers = append(peers, replica)
+		}
+	}
+
+	return &raftNode{
+		log:       log,
+		self:      self,
+		peers:     peers,
+		store:     store,
+		transport: transport,
+		apply:     apply,
+		heartbeat: raftHeartbeatInterval,
+		election:  raftElectionTimeout,
+		kick:      make(chan struct{}, 1),
+		rng:       rand.New(rand.NewSource(time.Now().UnixNano())),
+		applied:   make(chan struct{}),
+	}
+}
+
+// start loads persisted state, applies the entries known to have been
+// committed and then runs the consensus protocol until ctx is canceled.
+func (r *raftNode) start(ctx context.Context) error {
+	state, err := r.store.load()
+	if err != nil {
+		return err
+	}
+
+	r.Lock()
+	r.state = *state
+	r.resetTimeout()
+	r.applyCommitted()
+	r.started = true
+	r.Unlock()
+
+	r.log.Debugf("system database replica %s started at term %d (%d entries, commit %d)",
+		r.self, state.Term, len(state.Entries), state.Commit)
+
+	// A lone replica needn't wait for an election timeout to elect itself.
+	if len(r.peers) == 0 {
+		r.campaign(ctx)
+	}
+
+	go r.run(ctx)
+
+	return nil
+}
+
+func (r *raftNode) run(ctx context.Context) {
+	ticker := time.NewTicker(r.heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-r.kick:
+		}
+
+		r.Lock()
+		role := r.role
+		expired := time.Since(r.lastContact) >= r.timeout
+		r.Unlock()
+
+		switch {
+		case role == raftLeader:
+			r.replicate(ctx)
+		case expired:
+			r.campaign(ctx)
+		}
+	}
+}
+
+func (r *raftNode) quorum() int {
+	return (len(r.peers)+1)/2 + 1
+}
+
+func (r *raftNode) lastIndex() uint64 {
+	return uint64(len(r.state.Entries))
+}
+
+func (r *raftNode) termAt(index uint64) uint64 {
+	if index == 0 || index > r.lastIndex() {
+		return 0
+	}
+
+	return r.state.Entries[index-1].Term
+}
+
+// resetTimeout records contact with the leader and picks a new randomized
+// election timeout so that replicas don't repeatedly split the vote.
+func (r *raftNode) resetTimeout() {
+	r.lastContact = time.Now()
+	r.timeout = r.election + time.Duration(r.rng.Int63n(int64(r.election)))
+}
+
+// saveMeta persists the replica metadata before updating it in memory, so
+// that nothing is acted upon which could be forgotten after a restart.
+func (r *raftNode) saveMeta(meta raftMeta) error {
+	if err := r.store.saveMeta(&meta); err != nil {
+		return err
+	}
+	r.state.raftMeta = meta
+
+	return nil
+}
+
+// appendEntries persists log entries, replacing any from the index of the
+// first on, before appending them in memory.
+func (r *raftNode) appendEntries(entries ...*LogEntry) error {
+	index := entries[0].Index
+	if err := r.store.appendAt(index, entries); err != nil {
+		return err
+	}
+	r.state.Entries = append(r.state.Entries[:index-1], entries...)
+
+	return nil
+}
+
+// stepDown reverts to follower, moving to the given term if it's later than
+// the current one. An error is returned if the new term can't be persisted,
+// in which case the replica remains in the current term.
+func (r *raftNode) stepDown(term uint64) error {
+	if r.role != raftFollower {
+		r.log.Debugf("system database replica %s stepping down in term %d", r.self, term)
+	}
+	r.role = raftFollower
+	if r.leader == r.self {
+		r.leader = ""
+	}
+
+	if term > r.state.Term {
+		return r.saveMeta(raftMeta{Term: term, Commit: r.state.Commit})
+	}
+
+	return nil
+}
+
+func (r *raftNode) campaign(ctx context.Context) {
+	r.Lock()
+	r.resetTimeout()
+	meta := r.state.raftMeta
+	meta.Term++
+	meta.VotedFor = r.self
+	if err := r.saveMeta(meta); err != nil {
+		r.Unlock()
+		r.log.Errorf("system database replica %s not campaigning: %s", r.self, err)
+		return
+	}
+	r.role = raftCandidate
+	r.leader = ""
+	term := r.state.Term
+	req := &VoteRequest{
+		Term:         term,
+		Candidate:    r.self,
+		LastLogIndex: r.lastIndex(),
+		LastLogTerm:  r.termAt(r.lastIndex()),
+	}
+	r.Unlock()
+
+	r.log.Debugf("system database replica %s campaigning in term %d", r.self, term)
+
+	votes := 1
+	responses := make(chan *VoteResponse, len(r.peers))
+	for _, peer := range r.peers {
+		go func(peer string) {
+			rpcCtx, cancel := context.WithTimeout(ctx, raftRPCTimeout)
+			defer cancel()
+
+			resp, err := r.transport.RequestVote(rpcCtx, peer, req)
+			if err != nil {
+				r.log.Debugf("system database vote request to %s: %s", peer, err)
+			}
+			responses <- resp
+		}(peer)
+	}
+
+	for range r.peers {
+		if votes >= r.quorum() {
+			break
+		}
+
+		resp := <-responses
+		if resp == nil {
+			continue
+		}
+
+		r.Lock()
+		if resp.Term > r.state.Term {
+			if err := r.stepDown(resp.Term); err != nil {
+				r.log.Errorf("system database: %s", err)
+			}
+		}
+		r.Unlock()
+		if resp.Granted {
+			votes++
+		}
+	}
+
+	r.Lock()
+	defer r.Unlock()
+
+	if r.role == raftCandidate && r.state.Term == term && votes >= r.quorum() {
+		r.becomeLeader()
+	}
+}
+
+func (r *raftNode) becomeLeader() {
+	r.log.Infof("system database replica %s elected leader in term %d", r.self, r.state.Term)
+
+	r.role = raftLeader
+	r.leader = r.self
+	r.nextIndex = make(map[string]uint64)
+	r.matchIndex = make(map[string]uint64)
+	r.lastReply = make(map[string]time.Time)
+	for _, peer := range r.peers {
+		r.nextIndex[peer] = r.lastIndex() + 1
+	}
+
+	// Entries from earlier terms are only committed indirectly, by
+	// committing an entry from the leader's own term.
+	if _, err := r.appendEntry(dbOpNoop, nil); err != nil {
+		r.log.Errorf("system database replica %s not leading: %s", r.self, err)
+		r.role = raftFollower
+		r.leader = ""
+		return
+	}
+	if err := r.commit(); err != nil {
+		r.log.Errorf("system database: %s", err)
+	}
+	r.notify()
+}
+
+func (r *raftNode) appendEntry(op string, data []byte) (uint64, error) {
+	entry := &LogEntry{
+		Term:  r.state.Term,
+		Index: r.lastIndex() + 1,
+		Op:    op,
+		Data:  data,
+	}
+	if err := r.appendEntries(entry); err != nil {
+		return 0, err
+	}
+
+	return entry.Index, nil
+}
+
+// notify wakes the run loop so that new entries are replicated promptly.
+func (r *raftNode) notify() {
+	select {
+	case r.kick <- struct{}{}:
+	default:
+	}
+}
+
+// appendBatch returns the leading entries which fit in a single append
+// request, always including the first.
+func appendBatch(entries []*LogEntry) []*LogEntry {
+	var size int
+	for i, entry := range entries {
+		size += len(entry.Op) + len(entry.Data)
+		if i > 0 && size > raftMaxAppendSize {
+			return append([]*LogEntry{}, entries[:i]...)
+		}
+	}
+
+	return append([]*LogEntry{}, entries...)
+}
+
+type appendResult struct {
+	peer string
+	req  *AppendRequest
+	resp *AppendResponse
+}
+
+func (r *raftNode) replicate(ctx context.Context) {
+	r.Lock()
+	if r.role != raftLeader {
+		r.Unlock()
+		return
+	}
+	reqs := make(map[string]*AppendRequest)
+	for _, peer := range r.peers {
+		prev := r.nextIndex[peer] - 1
+		req := &AppendRequest{
+			Term:         r.state.Term,
+			Leader:       r.self,
+			PrevLogIndex: prev,
+			PrevLogTerm:  r.termAt(prev),
+			LeaderCommit: r.state.Commit,
+		}
+		req.Entries = appendBatch(r.state.Entries[prev:])
+		reqs[peer] = req
+	}
+	r.Unlock()
+
+	results := make(chan appendResult, len(reqs))
+	for peer, req := range reqs {
+		go func(peer string, req *AppendRequest) {
+			rpcCtx, cancel := context.WithTimeout(ctx, raftRPCTimeout)
+			defer cancel()
+
+			resp, err := r.transport.AppendEntries(rpcCtx, peer, req)
+			if err != nil {
+				r.log.Debugf("system database append to %s: %s", peer, err)
+			}
+			results <- appendResult{peer, req, resp}
+		}(peer, req)
+	}
+
+	for range reqs {
+		res := <-results
+		if res.resp == nil {
+			continue
+		}
+
+		r.Lock()
+		if r.role == raftLeader {
+			r.lastReply[res.peer] = time.Now()
+		}
+		switch {
+		case res.resp.Term > r.state.Term:
+			if err := r.stepDown(res.resp.Term); err != nil {
+				r.log.Errorf("system database: %s", err)
+			}
+		case r.role != raftLeader || res.req.Term != r.state.Term:
+		case res.resp.Success:
+			match := res.req.PrevLogIndex + uint64(len(res.req.Entries))
+			if match > r.matchIndex[res.peer] {
+				r.matchIndex[res.peer] = match
+			}
+			r.nextIndex[res.peer] = r.matchIndex[res.peer] + 1
+		default:
+			next := res.req.PrevLogIndex
+			if res.resp.LastIndex+1 < next {
+				next = res.resp.LastIndex + 1
+			}
+			if next < 1 {
+				next = 1
+			}
+			r.nextIndex[res.peer] = next
+		}
+		r.Unlock()
+	}
+
+	r.Lock()
+	if r.role == raftLeader {
+		if err := r.commit(); err != nil {
+			r.log.Errorf("system database: %s", err)
+		}
+	}
+	r.Unlock()
+}
+
+// commit advances the commit index to the highest entry from the current
+// term stored by a majority of replicas and applies newly committed entries.
+// Entries aren't applied until the new commit index has been persisted.
+func (r *raftNode) commit() error {
+	for index := r.lastIndex(); index > r.state.Commit; index-- {
+		if r.termAt(index) != r.state.Term {
+			break
+		}
+
+		stored := 1
+		for _, peer := range r.peers {
+			if r.matchIndex[peer] >= index {
+				stored++
+			}
+		}
+		if stored >= r.quorum() {
+			meta := r.state.raftMeta
+			meta.Commit = index
+			if err := r.saveMeta(meta); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	r.applyCommitted()
+
+	return nil
+}
+
+func (r *raftNode) applyCommitted() {
+	if r.lastApplied >= r.state.Commit {
+		return
+	}
+
+	for r.lastApplied < r.state.Commit {
+		r.lastApplied++
+		r.apply(r.state.Entries[r.lastApplied-1])
+	}
+	close(r.applied)
+	r.applied = make(chan struct{})
+}
+
+// handleVote responds to a vote request from a candidate replica.
+func (r *raftNode) handleVote(req *VoteRequest) (*VoteResponse, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if !r.started {
+		return nil, errors.New("system database not started")
+	}
+
+	if req.Term > r.state.Term {
+		if err := r.stepDown(req.Term); err != nil {
+			return nil, err
+		}
+	}
+	resp := &VoteResponse{Term: r.state.Term}
+	if req.Term < r.state.Term {
+		return resp, nil
+	}
+
+	lastTerm := r.termAt(r.lastIndex())
+	upToDate := req.LastLogTerm > lastTerm ||
+		(req.LastLogTerm == lastTerm && req.LastLogIndex >= r.lastIndex())
+	if upToDate && (r.state.VotedFor == "" || r.state.VotedFor == req.Candidate) {
+		meta := r.state.raftMeta
+		meta.VotedFor = req.Candidate
+		if err := r.saveMeta(meta); err != nil {
+			return nil, err
+		}
+		r.resetTimeout()
+		resp.Granted = true
+	}
+
+	return resp, nil
+}
+
+// handleAppend stores entries replicated by the leader, discarding any
+// conflicting entries, and applies those the leader reports as committed.
+func (r *raftNode) handleAppend(req *AppendRequest) (*AppendResponse, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if !r.started {
+		return nil, errors.New("system database not started")
+	}
+
+	resp := &AppendResponse{Term: r.state.Term, LastIndex: r.lastIndex()}
+	if req.Term < r.state.Term {
+		return resp, nil
+	}
+	if req.Term > r.state.Term || r.role != raftFollower {
+		if err := r.stepDown(req.Term); err != nil {
+			return nil, err
+		}
+		resp.Term = r.state.Term
+	}
+	if r.leader != req.Leader {
+		r.log.Debugf("system database leader is %s in term %d", req.Leader, req.Term)
+	}
+	r.leader = req.Leader
+	r.resetTimeout()
+
+	if req.PrevLogIndex > r.lastIndex() || r.termAt(req.PrevLogIndex) != req.PrevLogTerm {
+		if req.PrevLogIndex <= r.lastIndex() {
+			resp.LastIndex = req.PrevLogIndex - 1
+		}
+		return resp, nil
+	}
+
+	// Entries already held are skipped, those from the first which is
+	// new or conflicts with the leader's log on replace the rest of the log.
+	for i, entry := range req.Entries {
+		if entry.Index <= r.lastIndex() {
+			if r.termAt(entry.Index) == entry.Term {
+				continue
+			}
+			if entry.Index <= r.state.Commit {
+				return nil, errors.Errorf("system database: leader %s conflicts with committed entry %d",
+					req.Leader, entry.Index)
+			}
+		}
+		if err := r.appendEntries(req.Entries[i:]...); err != nil {
+			return nil, err
+		}
+		break
+	}
+
+	last := req.PrevLogIndex + uint64(len(req.Entries))
+	commit := req.LeaderCommit
+	if commit > last {
+		commit = last
+	}
+	if commit > r.state.Commit {
+		meta := r.state.raftMeta
+		meta.Commit = commit
+		if err := r.saveMeta(meta); err != nil {
+			return nil, err
+		}
+	}
+	r.applyCommitted()
+
+	resp.Success = true
+	resp.LastIndex = last
+
+	return resp, nil
+}
+
+// downPeers returns the peers which haven't responded to the leader within
+// an election timeout, peers are reported down until they first respond.
+func (r *raftNode) downPeers() ([]string, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.role != raftLeader {
+		return nil, &NotLeaderError{LeaderHint: r.leader}
+	}
+
+	var down []string
+	for _, peer := range r.peers {
+		if time.Since(r.lastReply[peer]) >= r.election {
+			down = append(down, peer)
+		}
+	}
+
+	return down, nil
+}
+
+// isLeader returns true if this replica is the current leader.
+func (r *raftNode) isLeader() bool {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.role == raftLeader
+}
+
+// leaderAddr returns the address of the current leader, if known.
+func (r *raftNode) leaderAddr() string {
+	r.Lock()
+	defer r.Unlock()
+
+	return r.leader
+}
+
+// submit appends an update to the log and waits until it has been
+// committed and applied.
+func (r *raftNode) submit(ctx context.Context, op string, data []byte) error {
+	if len(data) > raftMaxEntrySize {
+		return errors.Errorf("system database update %q: size %d exceeds limit %d",
+			op, len(data), raftMaxEntrySize)
+	}
+
+	r.Lock()
+	if r.role != raftLeader {
+		defer r.Unlock()
+		return &NotLeaderError{LeaderHint: r.leader}
+	}
+	term := r.state.Term
+	index, err := r.appendEntry(op, data)
+	if err == nil && len(r.peers) == 0 {
+		err = r.commit()
+	}
+	r.Unlock()
+	if err != nil {
+		return errors.Wrapf(err, "system database update %q", op)
+	}
+
+	r.notify()
+	if err := r.waitApplied(ctx, index); err != nil {
+		return errors.Wrapf(err, "system database update %q", op)
+	}
+
+	r.Lock()
+	defer r.Unlock()
+	if r.termAt(index) != term {
+		return errors.Errorf("system database update %q lost: leadership changed", op)
+	}
+
+	return nil
+}
+
+// barrier waits until all entries in the leader's log have been applied,
+// so that subsequent reads reflect every earlier update.
+func (r *raftNode) barrier(ctx context.Context) error {
+	r.Lock()
+	if r.role != raftLeader {
+		defer r.Unlock()
+		return &NotLeaderError{LeaderHint: r.leader}
+	}
+	index := r.lastIndex()
+	r.Unlock()
+
+	return r.waitApplied(ctx, index)
+}
+
+func (r *raftNode) waitApplied(ctx context.Context, index uint64) error {
+	for {
+		r.Lock()
+		if r.lastApplied >= index {
+			r.Unlock()
+			return nil
+		}
+		applied := r.applied
+		r.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-applied:
+		}
+	}
+}
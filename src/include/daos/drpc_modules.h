@@ -54,6 +54,15 @@ enum drpc_mgmt_method {
 	DRPC_METHOD_MGMT_SET_UP			= 209,
 	DRPC_METHOD_MGMT_BIO_HEALTH_QUERY	= 210,
 	DRPC_METHOD_MGMT_SMD_LIST_DEVS		= 211,
+	DRPC_METHOD_MGMT_LIST_POOLS		= 212,
+	DRPC_METHOD_MGMT_POOL_QUERY		= 213,
+	DRPC_METHOD_MGMT_POOL_GET_ACL		= 214,
+	DRPC_METHOD_MGMT_POOL_OVERWRITE_ACL	= 215,
+	DRPC_METHOD_MGMT_POOL_UPDATE_ACL	= 216,
+	DRPC_METHOD_MGMT_POOL_DELETE_ACL	= 217,
+	DRPC_METHOD_MGMT_PREP_SHUTDOWN		= 218,
+	DRPC_METHOD_MGMT_PING			= 219,
+	DRPC_METHOD_MGMT_SET_LOG_MASKS		= 220,
 
 	NUM_DRPC_MGMT_METHODS			/* Must be last */
 };
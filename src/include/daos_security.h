@@ -428,6 +428,28 @@ daos_acl_principal_to_uid(const char *principal, uid_t *uid);
 int
 daos_acl_principal_to_gid(const char *principal, gid_t *gid);
 
+/**
+ * Parse a principal string identifying an Access Control Entry into its
+ * principal type and name.
+ *
+ * The string is one of the special principals "OWNER@", "GROUP@" or
+ * "EVERYONE@", or a user or group name prefixed with its type, as in
+ * "u:name@domain" or "g:name@domain".
+ *
+ * \param[in]	principal_str	Principal string
+ * \param[out]	type		Principal type
+ * \param[out]	name		Newly allocated principal name, or NULL
+ *				for the special principals
+ *
+ * 
+eturn	0		Success
+ *		-DER_INVAL	Invalid input
+ *		-DER_NOMEM	Could not allocate memory
+ */
+int
+daos_acl_principal_from_str(const char *principal_str,
+			    enum daos_acl_principal_type *type, char **name);
+
 /**
  * Convert an Access Control Entry formatted as a string to a daos_ace
  * structure.
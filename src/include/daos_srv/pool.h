@@ -35,6 +35,7 @@
 #include <daos/pool_map.h>
 #include <daos/rpc.h>
 #include <daos/placement.h>
+#include <daos_security.h>
 #include <daos_srv/vos_types.h>
 
 /*
@@ -141,6 +142,17 @@ int ds_pool_svc_create(const uuid_t pool_uuid, int ntargets,
 		       const int *domains, daos_prop_t *prop,
 		       d_rank_list_t *svc_addrs);
 int ds_pool_svc_destroy(const uuid_t pool_uuid);
+int ds_pool_svc_query(const uuid_t pool_uuid, const d_rank_list_t *ranks,
+		      daos_pool_info_t *pool_info);
+int ds_pool_svc_get_acl_prop(const uuid_t pool_uuid,
+			     const d_rank_list_t *ranks, daos_prop_t **prop);
+int ds_pool_svc_set_prop(const uuid_t pool_uuid, const d_rank_list_t *ranks,
+			 daos_prop_t *prop);
+int ds_pool_svc_update_acl(const uuid_t pool_uuid, const d_rank_list_t *ranks,
+			   struct daos_acl *acl);
+int ds_pool_svc_delete_acl(const uuid_t pool_uuid, const d_rank_list_t *ranks,
+			   enum daos_acl_principal_type type,
+			   const char *principal);
 
 /*
  * Called by dmg on the pool service leader to list all pool handles of a pool.
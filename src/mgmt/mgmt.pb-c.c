@@ -193,7 +193,97 @@ void   mgmt__get_attach_info_resp__free_unpacked
   assert(message->base.descriptor == &mgmt__get_attach_info_resp__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-static const ProtobufCFieldDescriptor mgmt__join_req__field_descriptors[5] =
+void   mgmt__leader_query_req__init
+                     (Mgmt__LeaderQueryReq         *message)
+{
+  static const Mgmt__LeaderQueryReq init_value = MGMT__LEADER_QUERY_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__leader_query_req__get_packed_size
+                     (const Mgmt__LeaderQueryReq *message)
+{
+  assert(message->base.descriptor == &mgmt__leader_query_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__leader_query_req__pack
+                     (const Mgmt__LeaderQueryReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__leader_query_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__leader_query_req__pack_to_buffer
+                     (const Mgmt__LeaderQueryReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__leader_query_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__LeaderQueryReq *
+       mgmt__leader_query_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__LeaderQueryReq *)
+     protobuf_c_message_unpack (&mgmt__leader_query_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__leader_query_req__free_unpacked
+                     (Mgmt__LeaderQueryReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__leader_query_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__leader_query_resp__init
+                     (Mgmt__LeaderQueryResp         *message)
+{
+  static const Mgmt__LeaderQueryResp init_value = MGMT__LEADER_QUERY_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__leader_query_resp__get_packed_size
+                     (const Mgmt__LeaderQueryResp *message)
+{
+  assert(message->base.descriptor == &mgmt__leader_query_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__leader_query_resp__pack
+                     (const Mgmt__LeaderQueryResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__leader_query_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__leader_query_resp__pack_to_buffer
+                     (const Mgmt__LeaderQueryResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__leader_query_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__LeaderQueryResp *
+       mgmt__leader_query_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__LeaderQueryResp *)
+     protobuf_c_message_unpack (&mgmt__leader_query_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__leader_query_resp__free_unpacked
+                     (Mgmt__LeaderQueryResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__leader_query_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+static const ProtobufCFieldDescriptor mgmt__join_req__field_descriptors[6] =
 {
   {
     "uuid",
@@ -255,18 +345,31 @@ static const ProtobufCFieldDescriptor mgmt__join_req__field_descriptors[5] =
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "replica",
+    6,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_BOOL,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__JoinReq, replica),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mgmt__join_req__field_indices_by_name[] = {
   4,   /* field[4] = addr */
   3,   /* field[3] = nctxs */
   1,   /* field[1] = rank */
+  5,   /* field[5] = replica */
   2,   /* field[2] = uri */
   0,   /* field[0] = uuid */
 };
 static const ProtobufCIntRange mgmt__join_req__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 5 }
+  { 0, 6 }
 };
 const ProtobufCMessageDescriptor mgmt__join_req__descriptor =
 {
@@ -276,7 +379,7 @@ const ProtobufCMessageDescriptor mgmt__join_req__descriptor =
   "Mgmt__JoinReq",
   "mgmt",
   sizeof(Mgmt__JoinReq),
-  5,
+  6,
   mgmt__join_req__field_descriptors,
   mgmt__join_req__field_indices_by_name,
   1,  mgmt__join_req__number_ranges,
@@ -515,7 +618,109 @@ const ProtobufCMessageDescriptor mgmt__get_attach_info_resp__descriptor =
   (ProtobufCMessageInit) mgmt__get_attach_info_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCMethodDescriptor mgmt__mgmt_svc__method_descriptors[7] =
+static const ProtobufCFieldDescriptor mgmt__leader_query_req__field_descriptors[1] =
+{
+  {
+    "system",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__LeaderQueryReq, system),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__leader_query_req__field_indices_by_name[] = {
+  0,   /* field[0] = system */
+};
+static const ProtobufCIntRange mgmt__leader_query_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 1 }
+};
+const ProtobufCMessageDescriptor mgmt__leader_query_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.LeaderQueryReq",
+  "LeaderQueryReq",
+  "Mgmt__LeaderQueryReq",
+  "mgmt",
+  sizeof(Mgmt__LeaderQueryReq),
+  1,
+  mgmt__leader_query_req__field_descriptors,
+  mgmt__leader_query_req__field_indices_by_name,
+  1,  mgmt__leader_query_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__leader_query_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__leader_query_resp__field_descriptors[3] =
+{
+  {
+    "current_leader",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__LeaderQueryResp, current_leader),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "replicas",
+    2,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_STRING,
+    offsetof(Mgmt__LeaderQueryResp, n_replicas),
+    offsetof(Mgmt__LeaderQueryResp, replicas),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "down_replicas",
+    3,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_STRING,
+    offsetof(Mgmt__LeaderQueryResp, n_down_replicas),
+    offsetof(Mgmt__LeaderQueryResp, down_replicas),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__leader_query_resp__field_indices_by_name[] = {
+  0,   /* field[0] = current_leader */
+  2,   /* field[2] = down_replicas */
+  1,   /* field[1] = replicas */
+};
+static const ProtobufCIntRange mgmt__leader_query_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 3 }
+};
+const ProtobufCMessageDescriptor mgmt__leader_query_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.LeaderQueryResp",
+  "LeaderQueryResp",
+  "Mgmt__LeaderQueryResp",
+  "mgmt",
+  sizeof(Mgmt__LeaderQueryResp),
+  3,
+  mgmt__leader_query_resp__field_descriptors,
+  mgmt__leader_query_resp__field_indices_by_name,
+  1,  mgmt__leader_query_resp__number_ranges,
+  (ProtobufCMessageInit) mgmt__leader_query_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCMethodDescriptor mgmt__mgmt_svc__method_descriptors[10] =
 {
   { "Join", &mgmt__join_req__descriptor, &mgmt__join_resp__descriptor },
   { "PoolCreate", &mgmt__pool_create_req__descriptor, &mgmt__pool_create_resp__descriptor },
@@ -524,14 +729,20 @@ static const ProtobufCMethodDescriptor mgmt__mgmt_svc__method_descriptors[7] =
   { "BioHealthQuery", &mgmt__bio_health_req__descriptor, &mgmt__bio_health_resp__descriptor },
   { "SmdListDevs", &mgmt__smd_dev_req__descriptor, &mgmt__smd_dev_resp__descriptor },
   { "KillRank", &mgmt__daos_rank__descriptor, &mgmt__daos_resp__descriptor },
+  { "ListPools", &mgmt__list_pools_req__descriptor, &mgmt__list_pools_resp__descriptor },
+  { "PoolQuery", &mgmt__pool_query_req__descriptor, &mgmt__pool_query_resp__descriptor },
+  { "LeaderQuery", &mgmt__leader_query_req__descriptor, &mgmt__leader_query_resp__descriptor },
 };
 const unsigned mgmt__mgmt_svc__method_indices_by_name[] = {
   4,        /* BioHealthQuery */
   3,        /* GetAttachInfo */
   0,        /* Join */
   6,        /* KillRank */
+  9,        /* LeaderQuery */
+  7,        /* ListPools */
   1,        /* PoolCreate */
   2,        /* PoolDestroy */
+  8,        /* PoolQuery */
   5         /* SmdListDevs */
 };
 const ProtobufCServiceDescriptor mgmt__mgmt_svc__descriptor =
@@ -541,7 +752,7 @@ const ProtobufCServiceDescriptor mgmt__mgmt_svc__descriptor =
   "MgmtSvc",
   "Mgmt__MgmtSvc",
   "mgmt",
-  7,
+  10,
   mgmt__mgmt_svc__method_descriptors,
   mgmt__mgmt_svc__method_indices_by_name
 };
@@ -601,6 +812,30 @@ void mgmt__mgmt_svc__kill_rank(ProtobufCService *service,
   assert(service->descriptor == &mgmt__mgmt_svc__descriptor);
   service->invoke(service, 6, (const ProtobufCMessage *) input, (ProtobufCClosure) closure, closure_data);
 }
+void mgmt__mgmt_svc__list_pools(ProtobufCService *service,
+                                const Mgmt__ListPoolsReq *input,
+                                Mgmt__ListPoolsResp_Closure closure,
+                                void *closure_data)
+{
+  assert(service->descriptor == &mgmt__mgmt_svc__descriptor);
+  service->invoke(service, 7, (const ProtobufCMessage *) input, (ProtobufCClosure) closure, closure_data);
+}
+void mgmt__mgmt_svc__pool_query(ProtobufCService *service,
+                                const Mgmt__PoolQueryReq *input,
+                                Mgmt__PoolQueryResp_Closure closure,
+                                void *closure_data)
+{
+  assert(service->descriptor == &mgmt__mgmt_svc__descriptor);
+  service->invoke(service, 8, (const ProtobufCMessage *) input, (ProtobufCClosure) closure, closure_data);
+}
+void mgmt__mgmt_svc__leader_query(ProtobufCService *service,
+                                  const Mgmt__LeaderQueryReq *input,
+                                  Mgmt__LeaderQueryResp_Closure closure,
+                                  void *closure_data)
+{
+  assert(service->descriptor == &mgmt__mgmt_svc__descriptor);
+  service->invoke(service, 9, (const ProtobufCMessage *) input, (ProtobufCClosure) closure, closure_data);
+}
 void mgmt__mgmt_svc__init (Mgmt__MgmtSvc_Service *service,
                            Mgmt__MgmtSvc_ServiceDestroy destroy)
 {
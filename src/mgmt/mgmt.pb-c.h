@@ -10,7 +10,7 @@ PROTOBUF_C__BEGIN_DECLS
 
 #if PROTOBUF_C_VERSION_NUMBER < 1003000
 # error This file was generated by a newer version of protoc-c which is incompatible with your libprotobuf-c headers. Please update your headers.
-#elif 1003000 < PROTOBUF_C_MIN_COMPILER_VERSION
+#elif 1003001 < PROTOBUF_C_MIN_COMPILER_VERSION
 # error This file was generated by an older version of protoc-c which is incompatible with your libprotobuf-c headers. Please regenerate this file with a newer version of protoc-c.
 #endif
 
@@ -23,6 +23,8 @@ typedef struct _Mgmt__JoinResp Mgmt__JoinResp;
 typedef struct _Mgmt__GetAttachInfoReq Mgmt__GetAttachInfoReq;
 typedef struct _Mgmt__GetAttachInfoResp Mgmt__GetAttachInfoResp;
 typedef struct _Mgmt__GetAttachInfoResp__Psr Mgmt__GetAttachInfoResp__Psr;
+typedef struct _Mgmt__LeaderQueryReq Mgmt__LeaderQueryReq;
+typedef struct _Mgmt__LeaderQueryResp Mgmt__LeaderQueryResp;
 
 
 /* --- enums --- */
@@ -67,10 +69,14 @@ struct  _Mgmt__JoinReq
    * Server management address.
    */
   char *addr;
+  /*
+   * Server is an access point hosting a Management Service replica.
+   */
+  protobuf_c_boolean replica;
 };
 #define MGMT__JOIN_REQ__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__join_req__descriptor) \
-    , (char *)protobuf_c_empty_string, 0, (char *)protobuf_c_empty_string, 0, (char *)protobuf_c_empty_string }
+    , (char *)protobuf_c_empty_string, 0, (char *)protobuf_c_empty_string, 0, (char *)protobuf_c_empty_string, 0 }
 
 
 struct  _Mgmt__JoinResp
@@ -136,6 +142,42 @@ struct  _Mgmt__GetAttachInfoResp
     , 0, 0,NULL }
 
 
+struct  _Mgmt__LeaderQueryReq
+{
+  ProtobufCMessage base;
+  /*
+   * System name.
+   */
+  char *system;
+};
+#define MGMT__LEADER_QUERY_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__leader_query_req__descriptor) \
+    , (char *)protobuf_c_empty_string }
+
+
+struct  _Mgmt__LeaderQueryResp
+{
+  ProtobufCMessage base;
+  /*
+   * Control-plane address of the current Management Service leader.
+   */
+  char *current_leader;
+  /*
+   * Control-plane addresses of the Management Service replicas.
+   */
+  size_t n_replicas;
+  char **replicas;
+  /*
+   * Control-plane addresses of the replicas which could not be reached.
+   */
+  size_t n_down_replicas;
+  char **down_replicas;
+};
+#define MGMT__LEADER_QUERY_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__leader_query_resp__descriptor) \
+    , (char *)protobuf_c_empty_string, 0,NULL, 0,NULL }
+
+
 /* Mgmt__JoinReq methods */
 void   mgmt__join_req__init
                      (Mgmt__JoinReq         *message);
@@ -215,6 +257,44 @@ Mgmt__GetAttachInfoResp *
 void   mgmt__get_attach_info_resp__free_unpacked
                      (Mgmt__GetAttachInfoResp *message,
                       ProtobufCAllocator *allocator);
+/* Mgmt__LeaderQueryReq methods */
+void   mgmt__leader_query_req__init
+                     (Mgmt__LeaderQueryReq         *message);
+size_t mgmt__leader_query_req__get_packed_size
+                     (const Mgmt__LeaderQueryReq   *message);
+size_t mgmt__leader_query_req__pack
+                     (const Mgmt__LeaderQueryReq   *message,
+                      uint8_t             *out);
+size_t mgmt__leader_query_req__pack_to_buffer
+                     (const Mgmt__LeaderQueryReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__LeaderQueryReq *
+       mgmt__leader_query_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__leader_query_req__free_unpacked
+                     (Mgmt__LeaderQueryReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__LeaderQueryResp methods */
+void   mgmt__leader_query_resp__init
+                     (Mgmt__LeaderQueryResp         *message);
+size_t mgmt__leader_query_resp__get_packed_size
+                     (const Mgmt__LeaderQueryResp   *message);
+size_t mgmt__leader_query_resp__pack
+                     (const Mgmt__LeaderQueryResp   *message,
+                      uint8_t             *out);
+size_t mgmt__leader_query_resp__pack_to_buffer
+                     (const Mgmt__LeaderQueryResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__LeaderQueryResp *
+       mgmt__leader_query_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__leader_query_resp__free_unpacked
+                     (Mgmt__LeaderQueryResp *message,
+                      ProtobufCAllocator *allocator);
 /* --- per-message closures --- */
 
 typedef void (*Mgmt__JoinReq_Closure)
@@ -232,6 +312,12 @@ typedef void (*Mgmt__GetAttachInfoResp__Psr_Closure)
 typedef void (*Mgmt__GetAttachInfoResp_Closure)
                  (const Mgmt__GetAttachInfoResp *message,
                   void *closure_data);
+typedef void (*Mgmt__LeaderQueryReq_Closure)
+                 (const Mgmt__LeaderQueryReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__LeaderQueryResp_Closure)
+                 (const Mgmt__LeaderQueryResp *message,
+                  void *closure_data);
 
 /* --- services --- */
 
@@ -267,6 +353,18 @@ struct _Mgmt__MgmtSvc_Service
                     const Mgmt__DaosRank *input,
                     Mgmt__DaosResp_Closure closure,
                     void *closure_data);
+  void (*list_pools)(Mgmt__MgmtSvc_Service *service,
+                     const Mgmt__ListPoolsReq *input,
+                     Mgmt__ListPoolsResp_Closure closure,
+                     void *closure_data);
+  void (*pool_query)(Mgmt__MgmtSvc_Service *service,
+                     const Mgmt__PoolQueryReq *input,
+                     Mgmt__PoolQueryResp_Closure closure,
+                     void *closure_data);
+  void (*leader_query)(Mgmt__MgmtSvc_Service *service,
+                       const Mgmt__LeaderQueryReq *input,
+                       Mgmt__LeaderQueryResp_Closure closure,
+                       void *closure_data);
 };
 typedef void (*Mgmt__MgmtSvc_ServiceDestroy)(Mgmt__MgmtSvc_Service *);
 void mgmt__mgmt_svc__init (Mgmt__MgmtSvc_Service *service,
@@ -281,7 +379,10 @@ void mgmt__mgmt_svc__init (Mgmt__MgmtSvc_Service *service,
       function_prefix__ ## get_attach_info,\
       function_prefix__ ## bio_health_query,\
       function_prefix__ ## smd_list_devs,\
-      function_prefix__ ## kill_rank  }
+      function_prefix__ ## kill_rank,\
+      function_prefix__ ## list_pools,\
+      function_prefix__ ## pool_query,\
+      function_prefix__ ## leader_query  }
 void mgmt__mgmt_svc__join(ProtobufCService *service,
                           const Mgmt__JoinReq *input,
                           Mgmt__JoinResp_Closure closure,
@@ -310,6 +411,18 @@ void mgmt__mgmt_svc__kill_rank(ProtobufCService *service,
                                const Mgmt__DaosRank *input,
                                Mgmt__DaosResp_Closure closure,
                                void *closure_data);
+void mgmt__mgmt_svc__list_pools(ProtobufCService *service,
+                                const Mgmt__ListPoolsReq *input,
+                                Mgmt__ListPoolsResp_Closure closure,
+                                void *closure_data);
+void mgmt__mgmt_svc__pool_query(ProtobufCService *service,
+                                const Mgmt__PoolQueryReq *input,
+                                Mgmt__PoolQueryResp_Closure closure,
+                                void *closure_data);
+void mgmt__mgmt_svc__leader_query(ProtobufCService *service,
+                                  const Mgmt__LeaderQueryReq *input,
+                                  Mgmt__LeaderQueryResp_Closure closure,
+                                  void *closure_data);
 
 /* --- descriptors --- */
 
@@ -319,6 +432,8 @@ extern const ProtobufCEnumDescriptor    mgmt__join_resp__state__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__get_attach_info_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__get_attach_info_resp__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__get_attach_info_resp__psr__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__leader_query_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__leader_query_resp__descriptor;
 extern const ProtobufCServiceDescriptor mgmt__mgmt_svc__descriptor;
 
 PROTOBUF_C__END_DECLS
@@ -187,6 +187,282 @@ void   mgmt__pool_destroy_resp__free_unpacked
   assert(message->base.descriptor == &mgmt__pool_destroy_resp__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   mgmt__list_pools_req__init
+                     (Mgmt__ListPoolsReq         *message)
+{
+  static const Mgmt__ListPoolsReq init_value = MGMT__LIST_POOLS_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__list_pools_req__get_packed_size
+                     (const Mgmt__ListPoolsReq *message)
+{
+  assert(message->base.descriptor == &mgmt__list_pools_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__list_pools_req__pack
+                     (const Mgmt__ListPoolsReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__list_pools_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__list_pools_req__pack_to_buffer
+                     (const Mgmt__ListPoolsReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__list_pools_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ListPoolsReq *
+       mgmt__list_pools_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ListPoolsReq *)
+     protobuf_c_message_unpack (&mgmt__list_pools_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__list_pools_req__free_unpacked
+                     (Mgmt__ListPoolsReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__list_pools_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__list_pools_resp__pool__init
+                     (Mgmt__ListPoolsResp__Pool         *message)
+{
+  static const Mgmt__ListPoolsResp__Pool init_value = MGMT__LIST_POOLS_RESP__POOL__INIT;
+  *message = init_value;
+}
+void   mgmt__list_pools_resp__init
+                     (Mgmt__ListPoolsResp         *message)
+{
+  static const Mgmt__ListPoolsResp init_value = MGMT__LIST_POOLS_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__list_pools_resp__get_packed_size
+                     (const Mgmt__ListPoolsResp *message)
+{
+  assert(message->base.descriptor == &mgmt__list_pools_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__list_pools_resp__pack
+                     (const Mgmt__ListPoolsResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__list_pools_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__list_pools_resp__pack_to_buffer
+                     (const Mgmt__ListPoolsResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__list_pools_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__ListPoolsResp *
+       mgmt__list_pools_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__ListPoolsResp *)
+     protobuf_c_message_unpack (&mgmt__list_pools_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__list_pools_resp__free_unpacked
+                     (Mgmt__ListPoolsResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__list_pools_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__pool_query_req__init
+                     (Mgmt__PoolQueryReq         *message)
+{
+  static const Mgmt__PoolQueryReq init_value = MGMT__POOL_QUERY_REQ__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_query_req__get_packed_size
+                     (const Mgmt__PoolQueryReq *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_query_req__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_query_req__pack
+                     (const Mgmt__PoolQueryReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_query_req__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_query_req__pack_to_buffer
+                     (const Mgmt__PoolQueryReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_query_req__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolQueryReq *
+       mgmt__pool_query_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolQueryReq *)
+     protobuf_c_message_unpack (&mgmt__pool_query_req__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_query_req__free_unpacked
+                     (Mgmt__PoolQueryReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_query_req__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__storage_usage_stats__init
+                     (Mgmt__StorageUsageStats         *message)
+{
+  static const Mgmt__StorageUsageStats init_value = MGMT__STORAGE_USAGE_STATS__INIT;
+  *message = init_value;
+}
+size_t mgmt__storage_usage_stats__get_packed_size
+                     (const Mgmt__StorageUsageStats *message)
+{
+  assert(message->base.descriptor == &mgmt__storage_usage_stats__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__storage_usage_stats__pack
+                     (const Mgmt__StorageUsageStats *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__storage_usage_stats__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__storage_usage_stats__pack_to_buffer
+                     (const Mgmt__StorageUsageStats *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__storage_usage_stats__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__StorageUsageStats *
+       mgmt__storage_usage_stats__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__StorageUsageStats *)
+     protobuf_c_message_unpack (&mgmt__storage_usage_stats__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__storage_usage_stats__free_unpacked
+                     (Mgmt__StorageUsageStats *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__storage_usage_stats__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__pool_rebuild_status__init
+                     (Mgmt__PoolRebuildStatus         *message)
+{
+  static const Mgmt__PoolRebuildStatus init_value = MGMT__POOL_REBUILD_STATUS__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_rebuild_status__get_packed_size
+                     (const Mgmt__PoolRebuildStatus *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_rebuild_status__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_rebuild_status__pack
+                     (const Mgmt__PoolRebuildStatus *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_rebuild_status__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_rebuild_status__pack_to_buffer
+                     (const Mgmt__PoolRebuildStatus *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_rebuild_status__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolRebuildStatus *
+       mgmt__pool_rebuild_status__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolRebuildStatus *)
+     protobuf_c_message_unpack (&mgmt__pool_rebuild_status__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_rebuild_status__free_unpacked
+                     (Mgmt__PoolRebuildStatus *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_rebuild_status__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   mgmt__pool_query_resp__init
+                     (Mgmt__PoolQueryResp         *message)
+{
+  static const Mgmt__PoolQueryResp init_value = MGMT__POOL_QUERY_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_query_resp__get_packed_size
+                     (const Mgmt__PoolQueryResp *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_query_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_query_resp__pack
+                     (const Mgmt__PoolQueryResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_query_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_query_resp__pack_to_buffer
+                     (const Mgmt__PoolQueryResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_query_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolQueryResp *
+       mgmt__pool_query_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolQueryResp *)
+     protobuf_c_message_unpack (&mgmt__pool_query_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_query_resp__free_unpacked
+                     (Mgmt__PoolQueryResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_query_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 static const ProtobufCFieldDescriptor mgmt__pool_create_req__field_descriptors[8] =
 {
   {
@@ -482,3 +758,546 @@ const ProtobufCMessageDescriptor mgmt__pool_destroy_resp__descriptor =
   (ProtobufCMessageInit) mgmt__pool_destroy_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor mgmt__list_pools_req__field_descriptors[1] =
+{
+  {
+    "sys",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ListPoolsReq, sys),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__list_pools_req__field_indices_by_name[] = {
+  0,   /* field[0] = sys */
+};
+static const ProtobufCIntRange mgmt__list_pools_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 1 }
+};
+const ProtobufCMessageDescriptor mgmt__list_pools_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ListPoolsReq",
+  "ListPoolsReq",
+  "Mgmt__ListPoolsReq",
+  "mgmt",
+  sizeof(Mgmt__ListPoolsReq),
+  1,
+  mgmt__list_pools_req__field_descriptors,
+  mgmt__list_pools_req__field_indices_by_name,
+  1,  mgmt__list_pools_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__list_pools_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__list_pools_resp__pool__field_descriptors[5] =
+{
+  {
+    "uuid",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ListPoolsResp__Pool, uuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "svcreps",
+    2,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__ListPoolsResp__Pool, n_svcreps),
+    offsetof(Mgmt__ListPoolsResp__Pool, svcreps),
+    NULL,
+    NULL,
+    0 | PROTOBUF_C_FIELD_FLAG_PACKED,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "scmbytes",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ListPoolsResp__Pool, scmbytes),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "nvmebytes",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ListPoolsResp__Pool, nvmebytes),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "error",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ListPoolsResp__Pool, error),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__list_pools_resp__pool__field_indices_by_name[] = {
+  4,   /* field[4] = error */
+  3,   /* field[3] = nvmebytes */
+  2,   /* field[2] = scmbytes */
+  1,   /* field[1] = svcreps */
+  0,   /* field[0] = uuid */
+};
+static const ProtobufCIntRange mgmt__list_pools_resp__pool__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 5 }
+};
+const ProtobufCMessageDescriptor mgmt__list_pools_resp__pool__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ListPoolsResp.Pool",
+  "Pool",
+  "Mgmt__ListPoolsResp__Pool",
+  "mgmt",
+  sizeof(Mgmt__ListPoolsResp__Pool),
+  5,
+  mgmt__list_pools_resp__pool__field_descriptors,
+  mgmt__list_pools_resp__pool__field_indices_by_name,
+  1,  mgmt__list_pools_resp__pool__number_ranges,
+  (ProtobufCMessageInit) mgmt__list_pools_resp__pool__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__list_pools_resp__field_descriptors[2] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__ListPoolsResp, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "pools",
+    2,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_MESSAGE,
+    offsetof(Mgmt__ListPoolsResp, n_pools),
+    offsetof(Mgmt__ListPoolsResp, pools),
+    &mgmt__list_pools_resp__pool__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__list_pools_resp__field_indices_by_name[] = {
+  1,   /* field[1] = pools */
+  0,   /* field[0] = status */
+};
+static const ProtobufCIntRange mgmt__list_pools_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor mgmt__list_pools_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.ListPoolsResp",
+  "ListPoolsResp",
+  "Mgmt__ListPoolsResp",
+  "mgmt",
+  sizeof(Mgmt__ListPoolsResp),
+  2,
+  mgmt__list_pools_resp__field_descriptors,
+  mgmt__list_pools_resp__field_indices_by_name,
+  1,  mgmt__list_pools_resp__number_ranges,
+  (ProtobufCMessageInit) mgmt__list_pools_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_query_req__field_descriptors[1] =
+{
+  {
+    "uuid",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolQueryReq, uuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_query_req__field_indices_by_name[] = {
+  0,   /* field[0] = uuid */
+};
+static const ProtobufCIntRange mgmt__pool_query_req__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 1 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_query_req__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolQueryReq",
+  "PoolQueryReq",
+  "Mgmt__PoolQueryReq",
+  "mgmt",
+  sizeof(Mgmt__PoolQueryReq),
+  1,
+  mgmt__pool_query_req__field_descriptors,
+  mgmt__pool_query_req__field_indices_by_name,
+  1,  mgmt__pool_query_req__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_query_req__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__storage_usage_stats__field_descriptors[5] =
+{
+  {
+    "total",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__StorageUsageStats, total),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "free",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__StorageUsageStats, free),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "min",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__StorageUsageStats, min),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "max",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__StorageUsageStats, max),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "mean",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__StorageUsageStats, mean),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__storage_usage_stats__field_indices_by_name[] = {
+  1,   /* field[1] = free */
+  3,   /* field[3] = max */
+  4,   /* field[4] = mean */
+  2,   /* field[2] = min */
+  0,   /* field[0] = total */
+};
+static const ProtobufCIntRange mgmt__storage_usage_stats__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 5 }
+};
+const ProtobufCMessageDescriptor mgmt__storage_usage_stats__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.StorageUsageStats",
+  "StorageUsageStats",
+  "Mgmt__StorageUsageStats",
+  "mgmt",
+  sizeof(Mgmt__StorageUsageStats),
+  5,
+  mgmt__storage_usage_stats__field_descriptors,
+  mgmt__storage_usage_stats__field_indices_by_name,
+  1,  mgmt__storage_usage_stats__number_ranges,
+  (ProtobufCMessageInit) mgmt__storage_usage_stats__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCEnumValue mgmt__pool_rebuild_status__state__enum_values_by_number[3] =
+{
+  { "IDLE", "MGMT__POOL_REBUILD_STATUS__STATE__IDLE", 0 },
+  { "DONE", "MGMT__POOL_REBUILD_STATUS__STATE__DONE", 1 },
+  { "BUSY", "MGMT__POOL_REBUILD_STATUS__STATE__BUSY", 2 },
+};
+static const ProtobufCIntRange mgmt__pool_rebuild_status__state__value_ranges[] = {
+{0, 0},{0, 3}
+};
+static const ProtobufCEnumValueIndex mgmt__pool_rebuild_status__state__enum_values_by_name[3] =
+{
+  { "BUSY", 2 },
+  { "DONE", 1 },
+  { "IDLE", 0 },
+};
+const ProtobufCEnumDescriptor mgmt__pool_rebuild_status__state__descriptor =
+{
+  PROTOBUF_C__ENUM_DESCRIPTOR_MAGIC,
+  "mgmt.PoolRebuildStatus.State",
+  "State",
+  "Mgmt__PoolRebuildStatus__State",
+  "mgmt",
+  3,
+  mgmt__pool_rebuild_status__state__enum_values_by_number,
+  3,
+  mgmt__pool_rebuild_status__state__enum_values_by_name,
+  1,
+  mgmt__pool_rebuild_status__state__value_ranges,
+  NULL,NULL,NULL,NULL   /* reserved[1234] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_rebuild_status__field_descriptors[4] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolRebuildStatus, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "state",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_ENUM,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolRebuildStatus, state),
+    &mgmt__pool_rebuild_status__state__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "objects",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolRebuildStatus, objects),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "records",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolRebuildStatus, records),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_rebuild_status__field_indices_by_name[] = {
+  2,   /* field[2] = objects */
+  3,   /* field[3] = records */
+  1,   /* field[1] = state */
+  0,   /* field[0] = status */
+};
+static const ProtobufCIntRange mgmt__pool_rebuild_status__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 4 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_rebuild_status__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolRebuildStatus",
+  "PoolRebuildStatus",
+  "Mgmt__PoolRebuildStatus",
+  "mgmt",
+  sizeof(Mgmt__PoolRebuildStatus),
+  4,
+  mgmt__pool_rebuild_status__field_descriptors,
+  mgmt__pool_rebuild_status__field_indices_by_name,
+  1,  mgmt__pool_rebuild_status__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_rebuild_status__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor mgmt__pool_query_resp__field_descriptors[8] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolQueryResp, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "uuid",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolQueryResp, uuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "totaltargets",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolQueryResp, totaltargets),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "activetargets",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolQueryResp, activetargets),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "disabledtargets",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolQueryResp, disabledtargets),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "rebuild",
+    6,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_MESSAGE,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolQueryResp, rebuild),
+    &mgmt__pool_rebuild_status__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "scm",
+    7,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_MESSAGE,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolQueryResp, scm),
+    &mgmt__storage_usage_stats__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "nvme",
+    8,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_MESSAGE,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolQueryResp, nvme),
+    &mgmt__storage_usage_stats__descriptor,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_query_resp__field_indices_by_name[] = {
+  3,   /* field[3] = activetargets */
+  4,   /* field[4] = disabledtargets */
+  7,   /* field[7] = nvme */
+  5,   /* field[5] = rebuild */
+  6,   /* field[6] = scm */
+  0,   /* field[0] = status */
+  2,   /* field[2] = totaltargets */
+  1,   /* field[1] = uuid */
+};
+static const ProtobufCIntRange mgmt__pool_query_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 8 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_query_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolQueryResp",
+  "PoolQueryResp",
+  "Mgmt__PoolQueryResp",
+  "mgmt",
+  sizeof(Mgmt__PoolQueryResp),
+  8,
+  mgmt__pool_query_resp__field_descriptors,
+  mgmt__pool_query_resp__field_indices_by_name,
+  1,  mgmt__pool_query_resp__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_query_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
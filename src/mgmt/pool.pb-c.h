@@ -10,11 +10,7 @@ PROTOBUF_C__BEGIN_DECLS
 
 #if PROTOBUF_C_VERSION_NUMBER < 1003000
 # error This file was generated by a newer version of protoc-c which is incompatible with your libprotobuf-c headers. Please update your headers.
-<<<<<<< HEAD
-#elif 1003002 < PROTOBUF_C_MIN_COMPILER_VERSION
-=======
 #elif 1003001 < PROTOBUF_C_MIN_COMPILER_VERSION
->>>>>>> faf0cf30373f87e356b5d186322ba57a02fb128b
 # error This file was generated by an older version of protoc-c which is incompatible with your libprotobuf-c headers. Please regenerate this file with a newer version of protoc-c.
 #endif
 
@@ -23,10 +19,23 @@ typedef struct _Mgmt__PoolCreateReq Mgmt__PoolCreateReq;
 typedef struct _Mgmt__PoolCreateResp Mgmt__PoolCreateResp;
 typedef struct _Mgmt__PoolDestroyReq Mgmt__PoolDestroyReq;
 typedef struct _Mgmt__PoolDestroyResp Mgmt__PoolDestroyResp;
+typedef struct _Mgmt__ListPoolsReq Mgmt__ListPoolsReq;
+typedef struct _Mgmt__ListPoolsResp Mgmt__ListPoolsResp;
+typedef struct _Mgmt__ListPoolsResp__Pool Mgmt__ListPoolsResp__Pool;
+typedef struct _Mgmt__PoolQueryReq Mgmt__PoolQueryReq;
+typedef struct _Mgmt__StorageUsageStats Mgmt__StorageUsageStats;
+typedef struct _Mgmt__PoolRebuildStatus Mgmt__PoolRebuildStatus;
+typedef struct _Mgmt__PoolQueryResp Mgmt__PoolQueryResp;
 
 
 /* --- enums --- */
 
+typedef enum _Mgmt__PoolRebuildStatus__State {
+  MGMT__POOL_REBUILD_STATUS__STATE__IDLE = 0,
+  MGMT__POOL_REBUILD_STATUS__STATE__DONE = 1,
+  MGMT__POOL_REBUILD_STATUS__STATE__BUSY = 2
+    PROTOBUF_C__FORCE_ENUM_TO_BE_INT_SIZE(MGMT__POOL_REBUILD_STATUS__STATE)
+} Mgmt__PoolRebuildStatus__State;
 
 /* --- messages --- */
 
@@ -133,6 +142,184 @@ struct  _Mgmt__PoolDestroyResp
     , 0 }
 
 
+/*
+ * ListPoolsReq represents a request to list pools on a given DAOS system.
+ */
+struct  _Mgmt__ListPoolsReq
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS system identifier
+   */
+  char *sys;
+};
+#define MGMT__LIST_POOLS_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__list_pools_req__descriptor) \
+    , (char *)protobuf_c_empty_string }
+
+
+struct  _Mgmt__ListPoolsResp__Pool
+{
+  ProtobufCMessage base;
+  /*
+   * uuid of pool
+   */
+  char *uuid;
+  /*
+   * pool service replica ranks
+   */
+  size_t n_svcreps;
+  uint32_t *svcreps;
+  /*
+   * total SCM space across all targets
+   */
+  uint64_t scmbytes;
+  /*
+   * total NVMe space across all targets
+   */
+  uint64_t nvmebytes;
+  /*
+   * error querying the pool sizes, if any
+   */
+  char *error;
+};
+#define MGMT__LIST_POOLS_RESP__POOL__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__list_pools_resp__pool__descriptor) \
+    , (char *)protobuf_c_empty_string, 0,NULL, 0, 0, (char *)protobuf_c_empty_string }
+
+
+/*
+ * ListPoolsResp returns the list of pools in the system.
+ */
+struct  _Mgmt__ListPoolsResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+  /*
+   * pools list
+   */
+  size_t n_pools;
+  Mgmt__ListPoolsResp__Pool **pools;
+};
+#define MGMT__LIST_POOLS_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__list_pools_resp__descriptor) \
+    , 0, 0,NULL }
+
+
+/*
+ * PoolQueryReq represents a pool query request.
+ */
+struct  _Mgmt__PoolQueryReq
+{
+  ProtobufCMessage base;
+  /*
+   * uuid of pool to query
+   */
+  char *uuid;
+};
+#define MGMT__POOL_QUERY_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_query_req__descriptor) \
+    , (char *)protobuf_c_empty_string }
+
+
+/*
+ * StorageUsageStats represents usage statistics for a storage tier.
+ */
+struct  _Mgmt__StorageUsageStats
+{
+  ProtobufCMessage base;
+  uint64_t total;
+  uint64_t free;
+  /*
+   * minimum free space on any single target
+   */
+  uint64_t min;
+  /*
+   * maximum free space on any single target
+   */
+  uint64_t max;
+  /*
+   * mean free space per target
+   */
+  uint64_t mean;
+};
+#define MGMT__STORAGE_USAGE_STATS__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__storage_usage_stats__descriptor) \
+    , 0, 0, 0, 0, 0 }
+
+
+/*
+ * PoolRebuildStatus represents a pool's rebuild status.
+ */
+struct  _Mgmt__PoolRebuildStatus
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+  Mgmt__PoolRebuildStatus__State state;
+  /*
+   * number of objects rebuilt
+   */
+  uint64_t objects;
+  /*
+   * number of records rebuilt
+   */
+  uint64_t records;
+};
+#define MGMT__POOL_REBUILD_STATUS__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_rebuild_status__descriptor) \
+    , 0, MGMT__POOL_REBUILD_STATUS__STATE__IDLE, 0, 0 }
+
+
+/*
+ * PoolQueryResp represents a pool query response.
+ */
+struct  _Mgmt__PoolQueryResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+  /*
+   * uuid of pool
+   */
+  char *uuid;
+  /*
+   * total targets in pool
+   */
+  uint32_t totaltargets;
+  /*
+   * active targets in pool
+   */
+  uint32_t activetargets;
+  /*
+   * number of disabled targets in pool
+   */
+  uint32_t disabledtargets;
+  /*
+   * pool rebuild status
+   */
+  Mgmt__PoolRebuildStatus *rebuild;
+  /*
+   * SCM storage usage stats
+   */
+  Mgmt__StorageUsageStats *scm;
+  /*
+   * NVMe storage usage stats
+   */
+  Mgmt__StorageUsageStats *nvme;
+};
+#define MGMT__POOL_QUERY_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_query_resp__descriptor) \
+    , 0, (char *)protobuf_c_empty_string, 0, 0, 0, NULL, NULL, NULL }
+
+
 /* Mgmt__PoolCreateReq methods */
 void   mgmt__pool_create_req__init
                      (Mgmt__PoolCreateReq         *message);
@@ -209,6 +396,123 @@ Mgmt__PoolDestroyResp *
 void   mgmt__pool_destroy_resp__free_unpacked
                      (Mgmt__PoolDestroyResp *message,
                       ProtobufCAllocator *allocator);
+/* Mgmt__ListPoolsReq methods */
+void   mgmt__list_pools_req__init
+                     (Mgmt__ListPoolsReq         *message);
+size_t mgmt__list_pools_req__get_packed_size
+                     (const Mgmt__ListPoolsReq   *message);
+size_t mgmt__list_pools_req__pack
+                     (const Mgmt__ListPoolsReq   *message,
+                      uint8_t             *out);
+size_t mgmt__list_pools_req__pack_to_buffer
+                     (const Mgmt__ListPoolsReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ListPoolsReq *
+       mgmt__list_pools_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__list_pools_req__free_unpacked
+                     (Mgmt__ListPoolsReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__ListPoolsResp__Pool methods */
+void   mgmt__list_pools_resp__pool__init
+                     (Mgmt__ListPoolsResp__Pool         *message);
+/* Mgmt__ListPoolsResp methods */
+void   mgmt__list_pools_resp__init
+                     (Mgmt__ListPoolsResp         *message);
+size_t mgmt__list_pools_resp__get_packed_size
+                     (const Mgmt__ListPoolsResp   *message);
+size_t mgmt__list_pools_resp__pack
+                     (const Mgmt__ListPoolsResp   *message,
+                      uint8_t             *out);
+size_t mgmt__list_pools_resp__pack_to_buffer
+                     (const Mgmt__ListPoolsResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__ListPoolsResp *
+       mgmt__list_pools_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__list_pools_resp__free_unpacked
+                     (Mgmt__ListPoolsResp *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__PoolQueryReq methods */
+void   mgmt__pool_query_req__init
+                     (Mgmt__PoolQueryReq         *message);
+size_t mgmt__pool_query_req__get_packed_size
+                     (const Mgmt__PoolQueryReq   *message);
+size_t mgmt__pool_query_req__pack
+                     (const Mgmt__PoolQueryReq   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_query_req__pack_to_buffer
+                     (const Mgmt__PoolQueryReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolQueryReq *
+       mgmt__pool_query_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_query_req__free_unpacked
+                     (Mgmt__PoolQueryReq *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__StorageUsageStats methods */
+void   mgmt__storage_usage_stats__init
+                     (Mgmt__StorageUsageStats         *message);
+size_t mgmt__storage_usage_stats__get_packed_size
+                     (const Mgmt__StorageUsageStats   *message);
+size_t mgmt__storage_usage_stats__pack
+                     (const Mgmt__StorageUsageStats   *message,
+                      uint8_t             *out);
+size_t mgmt__storage_usage_stats__pack_to_buffer
+                     (const Mgmt__StorageUsageStats   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__StorageUsageStats *
+       mgmt__storage_usage_stats__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__storage_usage_stats__free_unpacked
+                     (Mgmt__StorageUsageStats *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__PoolRebuildStatus methods */
+void   mgmt__pool_rebuild_status__init
+                     (Mgmt__PoolRebuildStatus         *message);
+size_t mgmt__pool_rebuild_status__get_packed_size
+                     (const Mgmt__PoolRebuildStatus   *message);
+size_t mgmt__pool_rebuild_status__pack
+                     (const Mgmt__PoolRebuildStatus   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_rebuild_status__pack_to_buffer
+                     (const Mgmt__PoolRebuildStatus   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolRebuildStatus *
+       mgmt__pool_rebuild_status__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_rebuild_status__free_unpacked
+                     (Mgmt__PoolRebuildStatus *message,
+                      ProtobufCAllocator *allocator);
+/* Mgmt__PoolQueryResp methods */
+void   mgmt__pool_query_resp__init
+                     (Mgmt__PoolQueryResp         *message);
+size_t mgmt__pool_query_resp__get_packed_size
+                     (const Mgmt__PoolQueryResp   *message);
+size_t mgmt__pool_query_resp__pack
+                     (const Mgmt__PoolQueryResp   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_query_resp__pack_to_buffer
+                     (const Mgmt__PoolQueryResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolQueryResp *
+       mgmt__pool_query_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_query_resp__free_unpacked
+                     (Mgmt__PoolQueryResp *message,
+                      ProtobufCAllocator *allocator);
 /* --- per-message closures --- */
 
 typedef void (*Mgmt__PoolCreateReq_Closure)
@@ -223,6 +527,27 @@ typedef void (*Mgmt__PoolDestroyReq_Closure)
 typedef void (*Mgmt__PoolDestroyResp_Closure)
                  (const Mgmt__PoolDestroyResp *message,
                   void *closure_data);
+typedef void (*Mgmt__ListPoolsReq_Closure)
+                 (const Mgmt__ListPoolsReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__ListPoolsResp__Pool_Closure)
+                 (const Mgmt__ListPoolsResp__Pool *message,
+                  void *closure_data);
+typedef void (*Mgmt__ListPoolsResp_Closure)
+                 (const Mgmt__ListPoolsResp *message,
+                  void *closure_data);
+typedef void (*Mgmt__PoolQueryReq_Closure)
+                 (const Mgmt__PoolQueryReq *message,
+                  void *closure_data);
+typedef void (*Mgmt__StorageUsageStats_Closure)
+                 (const Mgmt__StorageUsageStats *message,
+                  void *closure_data);
+typedef void (*Mgmt__PoolRebuildStatus_Closure)
+                 (const Mgmt__PoolRebuildStatus *message,
+                  void *closure_data);
+typedef void (*Mgmt__PoolQueryResp_Closure)
+                 (const Mgmt__PoolQueryResp *message,
+                  void *closure_data);
 
 /* --- services --- */
 
@@ -233,6 +558,14 @@ extern const ProtobufCMessageDescriptor mgmt__pool_create_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_create_resp__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_destroy_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_destroy_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__list_pools_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__list_pools_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__list_pools_resp__pool__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_query_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__storage_usage_stats__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_rebuild_status__descriptor;
+extern const ProtobufCEnumDescriptor    mgmt__pool_rebuild_status__state__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_query_resp__descriptor;
 
 PROTOBUF_C__END_DECLS
 
@@ -42,6 +42,7 @@
 
 #include "mgmt.pb-c.h"
 #include "srv.pb-c.h"
+#include "../security/acl.pb-c.h"
 #include "srv_internal.h"
 
 const int max_svc_nreplicas = 13;
@@ -358,6 +359,7 @@ process_join_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	mgmt__join_resp__init(resp);
 
 	in.ji_rank = req->rank;
+	in.ji_replica = req->replica;
 	in.ji_server.sr_flags = SERVER_IN;
 	in.ji_server.sr_nctxs = req->nctxs;
 	rc = uuid_parse(req->uuid, in.ji_server.sr_uuid);
@@ -741,6 +743,464 @@ out:
 	D_FREE(resp);
 }
 
+static void
+free_list_pools_resp(Mgmt__ListPoolsResp *resp)
+{
+	int i;
+
+	for (i = 0; i < resp->n_pools; i++) {
+		if (resp->pools[i] != NULL) {
+			if (resp->pools[i]->uuid != NULL)
+				D_FREE(resp->pools[i]->uuid);
+			if (resp->pools[i]->svcreps != NULL)
+				D_FREE(resp->pools[i]->svcreps);
+			D_FREE(resp->pools[i]);
+		}
+	}
+	if (resp->pools != NULL)
+		D_FREE(resp->pools);
+	resp->pools = NULL;
+	resp->n_pools = 0;
+}
+
+static int
+pack_list_pools_resp(Mgmt__ListPoolsResp *resp,
+		     struct mgmt_list_pools_one *pools, size_t npools)
+{
+	Mgmt__ListPoolsResp__Pool	*pool;
+	int				 i, j;
+
+	D_ALLOC_ARRAY(resp->pools, npools);
+	if (resp->pools == NULL)
+		return -DER_NOMEM;
+
+	for (i = 0; i < npools; i++) {
+		D_ALLOC_PTR(pool);
+		if (pool == NULL)
+			return -DER_NOMEM;
+		mgmt__list_pools_resp__pool__init(pool);
+		resp->pools[i] = pool;
+		resp->n_pools++;
+
+		D_ALLOC(pool->uuid, DAOS_UUID_STR_SIZE);
+		if (pool->uuid == NULL)
+			return -DER_NOMEM;
+		uuid_unparse_lower(pools[i].lp_puuid, pool->uuid);
+
+		D_ALLOC_ARRAY(pool->svcreps, pools[i].lp_svc->rl_nr);
+		if (pool->svcreps == NULL)
+			return -DER_NOMEM;
+		for (j = 0; j < pools[i].lp_svc->rl_nr; j++)
+			pool->svcreps[j] = pools[i].lp_svc->rl_ranks[j];
+		pool->n_svcreps = pools[i].lp_svc->rl_nr;
+	}
+
+	return 0;
+}
+
+static void
+process_listpools_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	Mgmt__ListPoolsReq		*req = NULL;
+	Mgmt__ListPoolsResp		*resp = NULL;
+	struct mgmt_list_pools_one	*pools = NULL;
+	size_t				 npools = 0;
+	uint8_t				*body;
+	size_t				 len;
+	int				 rc;
+
+	/* Unpack the inner request from the drpc call body */
+	req = mgmt__list_pools_req__unpack(
+		NULL, drpc_req->body.len, drpc_req->body.data);
+
+	if (req == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILURE;
+		D_ERROR("Failed to unpack req (list pools)\n");
+		return;
+	}
+
+	D_INFO("Received request to list pools\n");
+
+	D_ALLOC_PTR(resp);
+	if (resp == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILURE;
+		D_ERROR("Failed to allocate daos response ref\n");
+		mgmt__list_pools_req__free_unpacked(req, NULL);
+		return;
+	}
+
+	/* Response status is populated with SUCCESS on init. */
+	mgmt__list_pools_resp__init(resp);
+
+	rc = ds_mgmt_list_pools(req->sys, &pools, &npools);
+	if (rc != 0) {
+		D_ERROR("Failed to list pools: %d\n", rc);
+		goto out;
+	}
+
+	rc = pack_list_pools_resp(resp, pools, npools);
+	if (rc != 0) {
+		D_ERROR("Failed to pack pool list: %d\n", rc);
+		free_list_pools_resp(resp);
+	}
+
+	ds_mgmt_free_pool_list(&pools, npools);
+out:
+	resp->status = rc;
+	len = mgmt__list_pools_resp__get_packed_size(resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILURE;
+		D_ERROR("Failed to allocate drpc response body\n");
+	} else {
+		mgmt__list_pools_resp__pack(resp, body);
+		drpc_resp->body.len = len;
+		drpc_resp->body.data = body;
+	}
+
+	mgmt__list_pools_req__free_unpacked(req, NULL);
+	free_list_pools_resp(resp);
+	D_FREE(resp);
+}
+
+static void
+storage_usage_stats_from_space(Mgmt__StorageUsageStats *stats,
+			       struct daos_pool_space *space,
+			       unsigned int media_type)
+{
+	stats->total = space->ps_space.s_total[media_type];
+	stats->free = space->ps_space.s_free[media_type];
+	stats->min = space->ps_free_min[media_type];
+	stats->max = space->ps_free_max[media_type];
+	stats->mean = space->ps_free_mean[media_type];
+}
+
+static void
+pool_rebuild_status_from_info(Mgmt__PoolRebuildStatus *rebuild,
+			      struct daos_rebuild_status *info)
+{
+	rebuild->status = info->rs_errno;
+	if (rebuild->status == 0) {
+		rebuild->objects = info->rs_obj_nr;
+		rebuild->records = info->rs_rec_nr;
+
+		if (info->rs_version == 0)
+			rebuild->state = MGMT__POOL_REBUILD_STATUS__STATE__IDLE;
+		else if (info->rs_done)
+			rebuild->state = MGMT__POOL_REBUILD_STATUS__STATE__DONE;
+		else
+			rebuild->state = MGMT__POOL_REBUILD_STATUS__STATE__BUSY;
+	}
+}
+
+static void
+process_poolquery_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	Mgmt__PoolQueryReq	*req = NULL;
+	Mgmt__PoolQueryResp	 resp = MGMT__POOL_QUERY_RESP__INIT;
+	Mgmt__StorageUsageStats	 scm = MGMT__STORAGE_USAGE_STATS__INIT;
+	Mgmt__StorageUsageStats	 nvme = MGMT__STORAGE_USAGE_STATS__INIT;
+	Mgmt__PoolRebuildStatus	 rebuild = MGMT__POOL_REBUILD_STATUS__INIT;
+	daos_pool_info_t	 pool_info = { 0 };
+	uuid_t			 uuid;
+	uint8_t			*body;
+	size_t			 len;
+	int			 rc;
+
+	/* Unpack the inner request from the drpc call body */
+	req = mgmt__pool_query_req__unpack(
+		NULL, drpc_req->body.len, drpc_req->body.data);
+
+	if (req == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILURE;
+		D_ERROR("Failed to unpack req (query pool)\n");
+		return;
+	}
+
+	D_INFO("Received request to query pool %s\n", req->uuid);
+
+	rc = uuid_parse(req->uuid, uuid);
+	if (rc != 0) {
+		D_ERROR("Unable to parse pool UUID %s: %d\n", req->uuid,
+			rc);
+		rc = -DER_INVAL;
+		goto out;
+	}
+
+	rc = ds_mgmt_pool_query(uuid, &pool_info);
+	if (rc != 0) {
+		D_ERROR("Failed to query pool %s: %d\n", req->uuid, rc);
+		goto out;
+	}
+
+	resp.uuid = req->uuid;
+	resp.totaltargets = pool_info.pi_ntargets;
+	resp.disabledtargets = pool_info.pi_ndisabled;
+	resp.activetargets = pool_info.pi_space.ps_ntargets;
+
+	storage_usage_stats_from_space(&scm, &pool_info.pi_space,
+				       DAOS_MEDIA_SCM);
+	resp.scm = &scm;
+	storage_usage_stats_from_space(&nvme, &pool_info.pi_space,
+				       DAOS_MEDIA_NVME);
+	resp.nvme = &nvme;
+	pool_rebuild_status_from_info(&rebuild, &pool_info.pi_rebuild_st);
+	resp.rebuild = &rebuild;
+
+out:
+	resp.status = rc;
+	len = mgmt__pool_query_resp__get_packed_size(&resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILURE;
+		D_ERROR("Failed to allocate drpc response body\n");
+	} else {
+		mgmt__pool_query_resp__pack(&resp, body);
+		drpc_resp->body.len = len;
+		drpc_resp->body.data = body;
+	}
+
+	/* resp.uuid points into req, so only free req once packed */
+	mgmt__pool_query_req__free_unpacked(req, NULL);
+}
+
+static void
+free_ace_list(char **list, size_t len)
+{
+	size_t i;
+
+	for (i = 0; i < len; i++)
+		D_FREE(list[i]);
+	D_FREE(list);
+}
+
+/*
+ * Convert the ACL in \a access_prop to a list of ACE strings in the short
+ * format accepted by daos_ace_from_str().
+ */
+static int
+prop_to_ace_strs(daos_prop_t *access_prop, char ***ace_strs, size_t *ace_nr)
+{
+	struct daos_prop_entry	*entry;
+	struct daos_acl		*acl;
+	struct daos_ace		*ace;
+	char			**tmp_strs;
+	size_t			 nr = 0;
+	size_t			 i = 0;
+	int			 rc = 0;
+
+	*ace_strs = NULL;
+	*ace_nr = 0;
+
+	entry = daos_prop_entry_get(access_prop, DAOS_PROP_PO_ACL);
+	if (entry == NULL || entry->dpe_val_ptr == NULL)
+		return 0; /* no ACL */
+	acl = entry->dpe_val_ptr;
+
+	ace = daos_acl_get_next_ace(acl, NULL);
+	while (ace != NULL) {
+		nr++;
+		ace = daos_acl_get_next_ace(acl, ace);
+	}
+	if (nr == 0)
+		return 0;
+
+	D_ALLOC_ARRAY(tmp_strs, nr);
+	if (tmp_strs == NULL)
+		return -DER_NOMEM;
+
+	ace = daos_acl_get_next_ace(acl, NULL);
+	for (i = 0; i < nr && ace != NULL; i++) {
+		D_ALLOC(tmp_strs[i], DAOS_ACL_MAX_ACE_STR_LEN + 1);
+		if (tmp_strs[i] == NULL)
+			D_GOTO(err, rc = -DER_NOMEM);
+
+		rc = daos_ace_to_str(ace, tmp_strs[i],
+				     DAOS_ACL_MAX_ACE_STR_LEN + 1);
+		if (rc != 0) {
+			D_ERROR("Failed to convert ACE to string, err=%d\n",
+				rc);
+			D_GOTO(err, rc);
+		}
+
+		ace = daos_acl_get_next_ace(acl, ace);
+	}
+
+	*ace_strs = tmp_strs;
+	*ace_nr = nr;
+	return 0;
+
+err:
+	free_ace_list(tmp_strs, nr);
+	return rc;
+}
+
+static void
+pack_acl_resp(Acl__ACLResp *acl_resp, Drpc__Response *drpc_resp)
+{
+	size_t	len;
+	uint8_t	*body;
+
+	len = acl__aclresp__get_packed_size(acl_resp);
+	D_ALLOC(body, len);
+	if (body == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILURE;
+		D_ERROR("Failed to allocate drpc response body\n");
+	} else {
+		acl__aclresp__pack(acl_resp, body);
+		drpc_resp->body.len = len;
+		drpc_resp->body.data = body;
+	}
+}
+
+static void
+process_pool_get_acl_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	Acl__GetACLReq	*req = NULL;
+	Acl__ACLResp	 resp = ACL__ACLRESP__INIT;
+	daos_prop_t	*access_prop = NULL;
+	uuid_t		 pool_uuid;
+	int		 rc;
+
+	req = acl__get_aclreq__unpack(NULL, drpc_req->body.len,
+				       drpc_req->body.data);
+	if (req == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILURE;
+		D_ERROR("Failed to unpack req (get ACL)\n");
+		return;
+	}
+
+	D_INFO("Received request to get ACL for pool %s\n", req->uuid);
+
+	if (uuid_parse(req->uuid, pool_uuid) != 0) {
+		D_ERROR("Couldn't parse '%s' to UUID\n", req->uuid);
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	rc = ds_mgmt_pool_get_acl(pool_uuid, &access_prop);
+	if (rc != 0) {
+		D_ERROR("Couldn't get pool ACL, rc=%d\n", rc);
+		D_GOTO(out, rc);
+	}
+
+	rc = prop_to_ace_strs(access_prop, &resp.acl, &resp.n_acl);
+
+out:
+	resp.status = rc;
+	pack_acl_resp(&resp, drpc_resp);
+
+	free_ace_list(resp.acl, resp.n_acl);
+	daos_prop_free(access_prop);
+	acl__get_aclreq__free_unpacked(req, NULL);
+}
+
+static void
+process_pool_modify_acl_request(Drpc__Call *drpc_req,
+				Drpc__Response *drpc_resp, bool overwrite)
+{
+	Acl__ModifyACLReq	*req = NULL;
+	Acl__ACLResp		 resp = ACL__ACLRESP__INIT;
+	struct daos_acl		*acl = NULL;
+	daos_prop_t		*access_prop = NULL;
+	uuid_t			 pool_uuid;
+	int			 rc;
+
+	req = acl__modify_aclreq__unpack(NULL, drpc_req->body.len,
+					  drpc_req->body.data);
+	if (req == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILURE;
+		D_ERROR("Failed to unpack req (modify ACL)\n");
+		return;
+	}
+
+	D_INFO("Received request to %s ACL for pool %s\n",
+	       overwrite ? "overwrite" : "update", req->uuid);
+
+	if (uuid_parse(req->uuid, pool_uuid) != 0) {
+		D_ERROR("Couldn't parse '%s' to UUID\n", req->uuid);
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	rc = ace_strs_to_acl(req->acl, req->n_acl, &acl);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+	if (acl == NULL) {
+		if (!overwrite) {
+			D_ERROR("No ACL entries supplied to update\n");
+			D_GOTO(out, rc = -DER_INVAL);
+		}
+
+		/* Overwriting with no entries clears the ACL */
+		acl = daos_acl_create(NULL, 0);
+		if (acl == NULL)
+			D_GOTO(out, rc = -DER_NOMEM);
+	}
+
+	if (overwrite)
+		rc = ds_mgmt_pool_overwrite_acl(pool_uuid, acl, &access_prop);
+	else
+		rc = ds_mgmt_pool_update_acl(pool_uuid, acl, &access_prop);
+	if (rc != 0) {
+		D_ERROR("Couldn't modify pool ACL, rc=%d\n", rc);
+		D_GOTO(out, rc);
+	}
+
+	rc = prop_to_ace_strs(access_prop, &resp.acl, &resp.n_acl);
+
+out:
+	resp.status = rc;
+	pack_acl_resp(&resp, drpc_resp);
+
+	free_ace_list(resp.acl, resp.n_acl);
+	daos_prop_free(access_prop);
+	daos_acl_free(acl);
+	acl__modify_aclreq__free_unpacked(req, NULL);
+}
+
+static void
+process_pool_delete_acl_request(Drpc__Call *drpc_req,
+				Drpc__Response *drpc_resp)
+{
+	Acl__DeleteACLReq	*req = NULL;
+	Acl__ACLResp		 resp = ACL__ACLRESP__INIT;
+	daos_prop_t		*access_prop = NULL;
+	uuid_t			 pool_uuid;
+	int			 rc;
+
+	req = acl__delete_aclreq__unpack(NULL, drpc_req->body.len,
+					  drpc_req->body.data);
+	if (req == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILURE;
+		D_ERROR("Failed to unpack req (delete ACL)\n");
+		return;
+	}
+
+	D_INFO("Received request to delete ACL entry %s for pool %s\n",
+	       req->principal, req->uuid);
+
+	if (uuid_parse(req->uuid, pool_uuid) != 0) {
+		D_ERROR("Couldn't parse '%s' to UUID\n", req->uuid);
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	rc = ds_mgmt_pool_delete_acl(pool_uuid, req->principal, &access_prop);
+	if (rc != 0) {
+		D_ERROR("Couldn't delete pool ACL entry, rc=%d\n", rc);
+		D_GOTO(out, rc);
+	}
+
+	rc = prop_to_ace_strs(access_prop, &resp.acl, &resp.n_acl);
+
+out:
+	resp.status = rc;
+	pack_acl_resp(&resp, drpc_resp);
+
+	free_ace_list(resp.acl, resp.n_acl);
+	daos_prop_free(access_prop);
+	acl__delete_aclreq__free_unpacked(req, NULL);
+}
+
 static void
 process_smdlistdevs_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 {
@@ -928,6 +1388,101 @@ process_setup_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	D_FREE(resp);
 }
 
+static void
+process_prep_shutdown_request(Drpc__Call *drpc_req,
+			      Drpc__Response *drpc_resp)
+{
+	Mgmt__DaosResp	*resp = NULL;
+	int		 rc;
+
+	D_INFO("Received request to prepare for shutdown\n");
+
+	D_ALLOC_PTR(resp);
+	if (resp == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILURE;
+		D_ERROR("Failed to allocate daos response ref\n");
+		return;
+	}
+
+	/* Response status is populated with SUCCESS on init. */
+	mgmt__daos_resp__init(resp);
+
+	/*
+	 * Stop the local MS replica ahead of SIGTERM so that leadership can
+	 * move to another replica while this server shuts down.
+	 */
+	rc = ds_mgmt_svc_stop();
+	if (rc != 0)
+		resp->status = rc;
+
+	pack_daos_response(resp, drpc_resp);
+	D_FREE(resp);
+}
+
+static void
+process_ping_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	Mgmt__DaosResp	*resp = NULL;
+
+	D_DEBUG(DB_MGMT, "Received heartbeat ping\n");
+
+	D_ALLOC_PTR(resp);
+	if (resp == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILURE;
+		D_ERROR("Failed to allocate daos response ref\n");
+		return;
+	}
+
+	/*
+	 * Answering at all shows that the dRPC progress ULT is still being
+	 * scheduled, which is all the control plane needs to know.
+	 */
+	mgmt__daos_resp__init(resp);
+
+	pack_daos_response(resp, drpc_resp);
+	D_FREE(resp);
+}
+
+static void
+process_set_log_masks_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
+{
+	Mgmt__SetLogMasksReq	*req = NULL;
+	Mgmt__DaosResp		*resp = NULL;
+	int			rc;
+
+	/* Unpack the inner request from the drpc call body */
+	req = mgmt__set_log_masks_req__unpack(
+		NULL, drpc_req->body.len, drpc_req->body.data);
+	if (req == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILURE;
+		D_ERROR("Failed to unpack req (set log masks)\n");
+		return;
+	}
+
+	D_INFO("Received request to set log masks to '%s'\n", req->masks);
+
+	D_ALLOC_PTR(resp);
+	if (resp == NULL) {
+		drpc_resp->status = DRPC__STATUS__FAILURE;
+		D_ERROR("Failed to allocate daos response ref\n");
+		mgmt__set_log_masks_req__free_unpacked(req, NULL);
+		return;
+	}
+
+	/* Response status is populated with SUCCESS on init. */
+	mgmt__daos_resp__init(resp);
+
+	rc = d_log_setmasks(req->masks, -1);
+	if (rc < 0) {
+		D_ERROR("Failed to set log masks '%s': %d\n", req->masks, rc);
+		resp->status = -DER_INVAL;
+	}
+
+	mgmt__set_log_masks_req__free_unpacked(req, NULL);
+	pack_daos_response(resp, drpc_resp);
+	D_FREE(resp);
+}
+
 static void
 process_drpc_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 {
@@ -969,6 +1524,35 @@ process_drpc_request(Drpc__Call *drpc_req, Drpc__Response *drpc_resp)
 	case DRPC_METHOD_MGMT_SMD_LIST_DEVS:
 		process_smdlistdevs_request(drpc_req, drpc_resp);
 		break;
+	case DRPC_METHOD_MGMT_LIST_POOLS:
+		process_listpools_request(drpc_req, drpc_resp);
+		break;
+	case DRPC_METHOD_MGMT_POOL_QUERY:
+		process_poolquery_request(drpc_req, drpc_resp);
+		break;
+	case DRPC_METHOD_MGMT_POOL_GET_ACL:
+		process_pool_get_acl_request(drpc_req, drpc_resp);
+		break;
+	case DRPC_METHOD_MGMT_POOL_OVERWRITE_ACL:
+		process_pool_modify_acl_request(drpc_req, drpc_resp,
+						true /* overwrite */);
+		break;
+	case DRPC_METHOD_MGMT_POOL_UPDATE_ACL:
+		process_pool_modify_acl_request(drpc_req, drpc_resp,
+						false /* overwrite */);
+		break;
+	case DRPC_METHOD_MGMT_POOL_DELETE_ACL:
+		process_pool_delete_acl_request(drpc_req, drpc_resp);
+		break;
+	case DRPC_METHOD_MGMT_PREP_SHUTDOWN:
+		process_prep_shutdown_request(drpc_req, drpc_resp);
+		break;
+	case DRPC_METHOD_MGMT_PING:
+		process_ping_request(drpc_req, drpc_resp);
+		break;
+	case DRPC_METHOD_MGMT_SET_LOG_MASKS:
+		process_set_log_masks_request(drpc_req, drpc_resp);
+		break;
 	default:
 		drpc_resp->status = DRPC__STATUS__UNKNOWN_METHOD;
 		D_ERROR("Unknown method\n");
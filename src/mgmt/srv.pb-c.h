@@ -18,6 +18,7 @@ PROTOBUF_C__BEGIN_DECLS
 typedef struct _Mgmt__DaosRank Mgmt__DaosRank;
 typedef struct _Mgmt__DaosResp Mgmt__DaosResp;
 typedef struct _Mgmt__SetRankReq Mgmt__SetRankReq;
+typedef struct _Mgmt__SetLogMasksReq Mgmt__SetLogMasksReq;
 typedef struct _Mgmt__CreateMsReq Mgmt__CreateMsReq;
 
 
@@ -69,6 +70,19 @@ struct  _Mgmt__SetRankReq
     , 0 }
 
 
+struct  _Mgmt__SetLogMasksReq
+{
+  ProtobufCMessage base;
+  /*
+   * D_LOG_MASK formatted log masks
+   */
+  char *masks;
+};
+#define MGMT__SET_LOG_MASKS_REQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__set_log_masks_req__descriptor) \
+    , (char *)protobuf_c_empty_string }
+
+
 struct  _Mgmt__CreateMsReq
 {
   ProtobufCMessage base;
@@ -144,6 +158,25 @@ Mgmt__SetRankReq *
 void   mgmt__set_rank_req__free_unpacked
                      (Mgmt__SetRankReq *message,
                       ProtobufCAllocator *allocator);
+/* Mgmt__SetLogMasksReq methods */
+void   mgmt__set_log_masks_req__init
+                     (Mgmt__SetLogMasksReq         *message);
+size_t mgmt__set_log_masks_req__get_packed_size
+                     (const Mgmt__SetLogMasksReq   *message);
+size_t mgmt__set_log_masks_req__pack
+                     (const Mgmt__SetLogMasksReq   *message,
+                      uint8_t             *out);
+size_t mgmt__set_log_masks_req__pack_to_buffer
+                     (const Mgmt__SetLogMasksReq   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__SetLogMasksReq *
+       mgmt__set_log_masks_req__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__set_log_masks_req__free_unpacked
+                     (Mgmt__SetLogMasksReq *message,
+                      ProtobufCAllocator *allocator);
 /* Mgmt__CreateMsReq methods */
 void   mgmt__create_ms_req__init
                      (Mgmt__CreateMsReq         *message);
@@ -174,6 +207,9 @@ typedef void (*Mgmt__DaosResp_Closure)
 typedef void (*Mgmt__SetRankReq_Closure)
                  (const Mgmt__SetRankReq *message,
                   void *closure_data);
+typedef void (*Mgmt__SetLogMasksReq_Closure)
+                 (const Mgmt__SetLogMasksReq *message,
+                  void *closure_data);
 typedef void (*Mgmt__CreateMsReq_Closure)
                  (const Mgmt__CreateMsReq *message,
                   void *closure_data);
@@ -186,6 +222,7 @@ typedef void (*Mgmt__CreateMsReq_Closure)
 extern const ProtobufCMessageDescriptor mgmt__daos_rank__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__daos_resp__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__set_rank_req__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__set_log_masks_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__create_ms_req__descriptor;
 
 PROTOBUF_C__END_DECLS
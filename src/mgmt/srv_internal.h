@@ -38,6 +38,7 @@
 #include <daos_srv/rdb.h>
 #include <daos_srv/rsvc.h>
 #include <daos_srv/smd.h>
+#include <daos_security.h>
 
 #include "mgmt.pb-c.h"
 #include "rpc.h"
@@ -78,6 +79,7 @@ void ds_mgmt_svc_put_leader(struct mgmt_svc *svc);
 struct mgmt_join_in {
 	uint32_t		ji_rank;
 	struct server_rec	ji_server;
+	bool			ji_replica;	/* add as MS replica */
 };
 struct mgmt_join_out {
 	uint32_t		jo_rank;
@@ -96,6 +98,25 @@ int ds_mgmt_destroy_pool(uuid_t pool_uuid, const char *group, uint32_t force);
 void ds_mgmt_hdlr_pool_create(crt_rpc_t *rpc_req);
 void ds_mgmt_hdlr_pool_destroy(crt_rpc_t *rpc_req);
 
+/* Pool UUID and service replica ranks returned by ds_mgmt_list_pools() */
+struct mgmt_list_pools_one {
+	uuid_t		 lp_puuid;
+	d_rank_list_t	*lp_svc;
+};
+
+int ds_mgmt_list_pools(const char *group, struct mgmt_list_pools_one **poolsp,
+		       size_t *npoolsp);
+void ds_mgmt_free_pool_list(struct mgmt_list_pools_one **poolsp,
+			    size_t npools);
+int ds_mgmt_pool_query(uuid_t pool_uuid, daos_pool_info_t *pool_info);
+int ds_mgmt_pool_get_acl(uuid_t pool_uuid, daos_prop_t **access_prop);
+int ds_mgmt_pool_overwrite_acl(uuid_t pool_uuid, struct daos_acl *acl,
+			       daos_prop_t **result);
+int ds_mgmt_pool_update_acl(uuid_t pool_uuid, struct daos_acl *acl,
+			    daos_prop_t **result);
+int ds_mgmt_pool_delete_acl(uuid_t pool_uuid, const char *principal,
+			    daos_prop_t **result);
+
 /** srv_query.c */
 
 /* Device health stats from bio_dev_state */
@@ -27,6 +27,7 @@
 
 #include <daos_srv/pool.h>
 #include <daos/rpc.h>
+#include <daos_api.h>
 
 #include "srv_internal.h"
 
@@ -302,8 +303,6 @@ out:
 	return rc;
 }
 
-static int ds_mgmt_pool_list(void);
-
 int
 ds_mgmt_create_pool(uuid_t pool_uuid, const char *group, char *tgt_dev,
 		    d_rank_list_t *targets, size_t scm_size, size_t nvme_size,
@@ -423,9 +422,6 @@ ds_mgmt_create_pool(uuid_t pool_uuid, const char *group, char *tgt_dev,
 		ds_pool_svc_destroy(pool_uuid);
 	}
 
-	/* TODO: Remove this in DAOS-2529. */
-	ds_mgmt_pool_list();
-
 out_svcp:
 	if (rc) {
 		d_rank_list_free(*svcp);
@@ -570,9 +566,6 @@ ds_mgmt_destroy_pool(uuid_t pool_uuid, const char *group, uint32_t force)
 		goto out_svc;
 	}
 
-	/* TODO: Remove this in DAOS-2529. */
-	ds_mgmt_pool_list();
-
 	D_DEBUG(DB_MGMT, "Destroying pool "DF_UUID" succeed.\n",
 		DP_UUID(pool_uuid));
 out_svc:
@@ -599,14 +592,19 @@ ds_mgmt_hdlr_pool_destroy(crt_rpc_t *rpc_req)
 		D_ERROR("crt_reply_send failed, rc: %d.\n", rc);
 }
 
+struct list_pools_arg {
+	struct mgmt_list_pools_one	*lpa_pools;
+	size_t				 lpa_npools;
+	size_t				 lpa_cap;
+};
+
 static int
 enum_pool_cb(daos_handle_t ih, d_iov_t *key, d_iov_t *val, void *varg)
 {
-	struct pool_rec	*rec;
-	char		*buf;
-	char		*p;
-	size_t		 size;
-	int		 i;
+	struct list_pools_arg		*arg = varg;
+	struct mgmt_list_pools_one	*pool;
+	struct pool_rec			*rec;
+	int				 i;
 
 	if (key->iov_len != sizeof(uuid_t)) {
 		D_ERROR("invalid key size: key="DF_U64"\n", key->iov_len);
@@ -616,36 +614,108 @@ enum_pool_cb(daos_handle_t ih, d_iov_t *key, d_iov_t *val, void *varg)
 		return -DER_IO;
 	rec = val->iov_buf;
 
-	size = 4096;
-	D_ALLOC(buf, size);
-	if (buf == NULL)
-		return -DER_NOMEM;
-	p = buf;
-	for (i = 0; i < rec->pr_nreplicas; i++) {
-		int n;
-
-		n = snprintf(p, buf + size - p, "%s%u", i == 0 ? "" : ",",
-			     rec->pr_replicas[i]);
-		if (n < 0 || n >= buf + size - p) {
-			D_FREE(buf);
-			return -DER_OVERFLOW;
-		}
-		p += n;
+	/* Skip pools that are still being created or destroyed. */
+	if (!(rec->pr_state & POOL_READY))
+		return 0;
+
+	if (arg->lpa_npools == arg->lpa_cap) {
+		struct mgmt_list_pools_one	*pools;
+		size_t				 cap;
+
+		cap = arg->lpa_cap == 0 ? 16 : arg->lpa_cap * 2;
+		D_REALLOC_ARRAY(pools, arg->lpa_pools, cap);
+		if (pools == NULL)
+			return -DER_NOMEM;
+		arg->lpa_pools = pools;
+		arg->lpa_cap = cap;
 	}
 
-	D_DEBUG(DB_MGMT, "  "DF_UUID": state=%u svc=%s\n",
-		DP_UUID(key->iov_buf), rec->pr_state, buf);
+	pool = &arg->lpa_pools[arg->lpa_npools];
+	uuid_copy(pool->lp_puuid, key->iov_buf);
+	pool->lp_svc = d_rank_list_alloc(rec->pr_nreplicas);
+	if (pool->lp_svc == NULL)
+		return -DER_NOMEM;
+	for (i = 0; i < rec->pr_nreplicas; i++)
+		pool->lp_svc->rl_ranks[i] = rec->pr_replicas[i];
+	arg->lpa_npools++;
+
+	D_DEBUG(DB_MGMT, "  "DF_UUID": state=%u nreplicas=%u\n",
+		DP_UUID(key->iov_buf), rec->pr_state, rec->pr_nreplicas);
 
-	D_FREE(buf);
 	return 0;
 }
 
-/* TODO: To be completed in DAOS-2529; currently only for testing purposes. */
+/**
+ * List the pools recorded in the management service.
+ *
+ * \param[in]	group	DAOS system name (currently ignored)
+ * \param[out]	poolsp	array of pool UUIDs and service replica ranks, to be
+ *			freed with ds_mgmt_free_pool_list()
+ * \param[out]	npoolsp	number of entries in \a poolsp
+ */
 int
-ds_mgmt_pool_list(void)
+ds_mgmt_list_pools(const char *group, struct mgmt_list_pools_one **poolsp,
+		   size_t *npoolsp)
+{
+	struct list_pools_arg	 arg = { 0 };
+	struct mgmt_svc		*svc;
+	struct rdb_tx		 tx;
+	int			 rc;
+
+	rc = ds_mgmt_svc_lookup_leader(&svc, NULL /* hint */);
+	if (rc != 0)
+		goto out;
+
+	rc = rdb_tx_begin(svc->ms_rsvc.s_db, svc->ms_rsvc.s_term, &tx);
+	if (rc != 0)
+		goto out_svc;
+	ABT_rwlock_rdlock(svc->ms_lock);
+
+	D_DEBUG(DB_MGMT, "pools:\n");
+	rc = rdb_tx_iterate(&tx, &svc->ms_pools, false /* !backward */,
+			    enum_pool_cb, &arg);
+
+	ABT_rwlock_unlock(svc->ms_lock);
+	rdb_tx_end(&tx);
+out_svc:
+	ds_mgmt_svc_put_leader(svc);
+out:
+	if (rc != 0) {
+		ds_mgmt_free_pool_list(&arg.lpa_pools, arg.lpa_npools);
+		return rc;
+	}
+
+	*poolsp = arg.lpa_pools;
+	*npoolsp = arg.lpa_npools;
+	return 0;
+}
+
+void
+ds_mgmt_free_pool_list(struct mgmt_list_pools_one **poolsp, size_t npools)
+{
+	size_t i;
+
+	if (*poolsp == NULL)
+		return;
+
+	for (i = 0; i < npools; i++)
+		d_rank_list_free((*poolsp)[i].lp_svc);
+	D_FREE(*poolsp);
+	*poolsp = NULL;
+}
+
+/*
+ * Look up the service replica ranks of a ready pool in the management
+ * service.
+ */
+static int
+pool_svc_ranks_lookup(uuid_t pool_uuid, d_rank_list_t **ranksp)
 {
 	struct mgmt_svc	*svc;
+	struct pool_rec	*rec;
 	struct rdb_tx	 tx;
+	d_rank_list_t	*ranks = NULL;
+	int		 i;
 	int		 rc;
 
 	rc = ds_mgmt_svc_lookup_leader(&svc, NULL /* hint */);
@@ -657,14 +727,162 @@ ds_mgmt_pool_list(void)
 		goto out_svc;
 	ABT_rwlock_rdlock(svc->ms_lock);
 
-	D_DEBUG(DB_MGMT, "pools:\n");
-	rc = rdb_tx_iterate(&tx, &svc->ms_pools, false /* !backward */,
-			    enum_pool_cb, NULL /* arg */);
+	rc = pool_rec_lookup(&tx, svc, pool_uuid, &rec);
+	if (rc == 0 && !(rec->pr_state & POOL_READY))
+		rc = -DER_NONEXIST;
+	if (rc == 0) {
+		ranks = d_rank_list_alloc(rec->pr_nreplicas);
+		if (ranks == NULL)
+			rc = -DER_NOMEM;
+		else
+			for (i = 0; i < rec->pr_nreplicas; i++)
+				ranks->rl_ranks[i] = rec->pr_replicas[i];
+	}
 
 	ABT_rwlock_unlock(svc->ms_lock);
 	rdb_tx_end(&tx);
 out_svc:
 	ds_mgmt_svc_put_leader(svc);
 out:
+	if (rc != 0)
+		D_ERROR("failed to look up pool "DF_UUID": %d\n",
+			DP_UUID(pool_uuid), rc);
+	else
+		*ranksp = ranks;
+	return rc;
+}
+
+/**
+ * Query a pool through its pool service, whose replica ranks are looked up
+ * in the management service.
+ */
+int
+ds_mgmt_pool_query(uuid_t pool_uuid, daos_pool_info_t *pool_info)
+{
+	d_rank_list_t	*ranks;
+	int		 rc;
+
+	rc = pool_svc_ranks_lookup(pool_uuid, &ranks);
+	if (rc != 0)
+		return rc;
+
+	rc = ds_pool_svc_query(pool_uuid, ranks, pool_info);
+	d_rank_list_free(ranks);
+	return rc;
+}
+
+/**
+ * Fetch the ACL, owner and owner group properties of a pool.
+ */
+int
+ds_mgmt_pool_get_acl(uuid_t pool_uuid, daos_prop_t **access_prop)
+{
+	d_rank_list_t	*ranks;
+	int		 rc;
+
+	rc = pool_svc_ranks_lookup(pool_uuid, &ranks);
+	if (rc != 0)
+		return rc;
+
+	rc = ds_pool_svc_get_acl_prop(pool_uuid, ranks, access_prop);
+	d_rank_list_free(ranks);
+	return rc;
+}
+
+/**
+ * Replace the ACL of a pool with \a acl, returning the resulting access
+ * properties in \a result.
+ */
+int
+ds_mgmt_pool_overwrite_acl(uuid_t pool_uuid, struct daos_acl *acl,
+			   daos_prop_t **result)
+{
+	d_rank_list_t	*ranks;
+	daos_prop_t	*prop;
+	int		 rc;
+
+	rc = pool_svc_ranks_lookup(pool_uuid, &ranks);
+	if (rc != 0)
+		return rc;
+
+	prop = daos_prop_alloc(1);
+	if (prop == NULL)
+		D_GOTO(out_ranks, rc = -DER_NOMEM);
+
+	prop->dpp_entries[0].dpe_type = DAOS_PROP_PO_ACL;
+	prop->dpp_entries[0].dpe_val_ptr = daos_acl_dup(acl);
+	if (prop->dpp_entries[0].dpe_val_ptr == NULL)
+		D_GOTO(out_prop, rc = -DER_NOMEM);
+
+	rc = ds_pool_svc_set_prop(pool_uuid, ranks, prop);
+	if (rc != 0)
+		D_GOTO(out_prop, rc);
+
+	rc = ds_pool_svc_get_acl_prop(pool_uuid, ranks, result);
+
+out_prop:
+	daos_prop_free(prop);
+out_ranks:
+	d_rank_list_free(ranks);
+	return rc;
+}
+
+/**
+ * Add or update the entries of \a acl in the ACL of a pool, returning the
+ * resulting access properties in \a result.
+ */
+int
+ds_mgmt_pool_update_acl(uuid_t pool_uuid, struct daos_acl *acl,
+			daos_prop_t **result)
+{
+	d_rank_list_t	*ranks;
+	int		 rc;
+
+	rc = pool_svc_ranks_lookup(pool_uuid, &ranks);
+	if (rc != 0)
+		return rc;
+
+	rc = ds_pool_svc_update_acl(pool_uuid, ranks, acl);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+	rc = ds_pool_svc_get_acl_prop(pool_uuid, ranks, result);
+out:
+	d_rank_list_free(ranks);
+	return rc;
+}
+
+/**
+ * Remove the entry for \a principal (e.g. "u:bob@" or "OWNER@") from the
+ * ACL of a pool, returning the resulting access properties in \a result.
+ */
+int
+ds_mgmt_pool_delete_acl(uuid_t pool_uuid, const char *principal,
+			daos_prop_t **result)
+{
+	d_rank_list_t			*ranks;
+	enum daos_acl_principal_type	 type;
+	char				*name = NULL;
+	int				 rc;
+
+	rc = daos_acl_principal_from_str(principal, &type, &name);
+	if (rc != 0) {
+		D_ERROR("Invalid principal '%s': %d\n", principal, rc);
+		return rc;
+	}
+
+	rc = pool_svc_ranks_lookup(pool_uuid, &ranks);
+	if (rc != 0)
+		D_GOTO(out_name, rc);
+
+	rc = ds_pool_svc_delete_acl(pool_uuid, ranks, type, name);
+	if (rc != 0)
+		D_GOTO(out_ranks, rc);
+
+	rc = ds_pool_svc_get_acl_prop(pool_uuid, ranks, result);
+out_ranks:
+	d_rank_list_free(ranks);
+out_name:
+	D_FREE(name);
 	return rc;
 }
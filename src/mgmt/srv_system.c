@@ -579,6 +579,40 @@ add_server(struct rdb_tx *tx, struct mgmt_svc *svc, uint32_t rank,
 	return 0;
 }
 
+/*
+ * Add \a rank to the Management Service replicas, unless it's already one.
+ * Access points other than the bootstrapping one create an empty replica when
+ * they start, which is populated by the leader once added here.
+ */
+static int
+add_replica(struct mgmt_svc *svc, d_rank_t rank)
+{
+	d_rank_list_t	*ranks;
+	d_rank_list_t	 replicas;
+	bool		 found;
+	int		 i;
+	int		 rc;
+
+	rc = rdb_get_ranks(svc->ms_rsvc.s_db, &ranks);
+	if (rc != 0)
+		return rc;
+	found = daos_rank_list_find(ranks, rank, &i);
+	d_rank_list_free(ranks);
+	if (found)
+		return 0;
+
+	replicas.rl_nr = 1;
+	replicas.rl_ranks = &rank;
+	rc = rdb_add_replicas(svc->ms_rsvc.s_db, &replicas);
+	if (rc != 0) {
+		D_ERROR("failed to add rank %u to MS replicas: %d\n", rank, rc);
+		return rc;
+	}
+
+	D_INFO("rank %u added to MS replicas\n", rank);
+	return 0;
+}
+
 int
 ds_mgmt_join_handler(struct mgmt_join_in *in, struct mgmt_join_out *out)
 {
@@ -704,6 +738,8 @@ ds_mgmt_join_handler(struct mgmt_join_in *in, struct mgmt_join_out *out)
 out_lock:
 	ABT_rwlock_unlock(svc->ms_lock);
 	rdb_tx_end(&tx);
+	if (rc == 0 && in->ji_replica && (out->jo_flags & SERVER_IN))
+		rc = add_replica(svc, out->jo_rank);
 out_svc:
 	ds_mgmt_svc_put_leader(svc);
 out:
@@ -34,6 +34,7 @@
 #include <uuid/uuid.h>
 #include <daos/rpc.h>
 #include <daos/rsvc.h>
+#include <daos_security.h>
 
 /*
  * RPC operation codes
@@ -87,7 +88,16 @@
 		ds_pool_replicas_update_handler, NULL),			\
 	X(POOL_REPLICAS_REMOVE,						\
 		0, &CQF_pool_replicas_remove,				\
-		ds_pool_replicas_update_handler, NULL)
+		ds_pool_replicas_update_handler, NULL),			\
+	X(POOL_PROP_SET,						\
+		0, &CQF_pool_prop_set,					\
+		ds_pool_prop_set_handler, NULL),			\
+	X(POOL_ACL_UPDATE,						\
+		0, &CQF_pool_acl_update,				\
+		ds_pool_acl_update_handler, NULL),			\
+	X(POOL_ACL_DELETE,						\
+		0, &CQF_pool_acl_delete,				\
+		ds_pool_acl_delete_handler, NULL)
 
 #define POOL_PROTO_SRV_RPC_LIST						\
 	X(POOL_TGT_CONNECT,						\
@@ -105,6 +115,13 @@
 	X(POOL_TGT_QUERY,						\
 		0, &CQF_pool_tgt_query,					\
 		ds_pool_tgt_query_handler,				\
+		&ds_pool_tgt_query_co_ops),				\
+	X(POOL_SVC_QUERY,						\
+		0, &CQF_pool_query,					\
+		ds_pool_svc_query_handler, NULL),			\
+	X(POOL_TGT_SVC_QUERY,						\
+		0, &CQF_pool_tgt_query,					\
+		ds_pool_tgt_svc_query_handler,				\
 		&ds_pool_tgt_query_co_ops)
 
 /* Define for RPC enum population below */
@@ -246,6 +263,36 @@ CRT_RPC_DECLARE(pool_replicas_add, DAOS_ISEQ_POOL_MEMBERSHIP,
 CRT_RPC_DECLARE(pool_replicas_remove, DAOS_ISEQ_POOL_MEMBERSHIP,
 		DAOS_OSEQ_POOL_MEMBERSHIP)
 
+#define DAOS_ISEQ_POOL_PROP_SET	/* input fields */		 \
+	((struct pool_op_in)	(psi_op)		CRT_VAR) \
+	((daos_prop_t)		(psi_prop)		CRT_PTR)
+
+#define DAOS_OSEQ_POOL_PROP_SET	/* output fields */		 \
+	((struct pool_op_out)	(pso_op)		CRT_VAR)
+
+CRT_RPC_DECLARE(pool_prop_set, DAOS_ISEQ_POOL_PROP_SET, DAOS_OSEQ_POOL_PROP_SET)
+
+#define DAOS_ISEQ_POOL_ACL_UPDATE /* input fields */		 \
+	((struct pool_op_in)	(pui_op)		CRT_VAR) \
+	((struct daos_acl)	(pui_acl)		CRT_PTR)
+
+#define DAOS_OSEQ_POOL_ACL_UPDATE /* output fields */		 \
+	((struct pool_op_out)	(puo_op)		CRT_VAR)
+
+CRT_RPC_DECLARE(pool_acl_update, DAOS_ISEQ_POOL_ACL_UPDATE,
+		DAOS_OSEQ_POOL_ACL_UPDATE)
+
+#define DAOS_ISEQ_POOL_ACL_DELETE /* input fields */		 \
+	((struct pool_op_in)	(pdi_op)		CRT_VAR) \
+	((d_const_string_t)	(pdi_principal)		CRT_VAR) \
+	((uint8_t)		(pdi_type)		CRT_VAR)
+
+#define DAOS_OSEQ_POOL_ACL_DELETE /* output fields */		 \
+	((struct pool_op_out)	(pdo_op)		CRT_VAR)
+
+CRT_RPC_DECLARE(pool_acl_delete, DAOS_ISEQ_POOL_ACL_DELETE,
+		DAOS_OSEQ_POOL_ACL_DELETE)
+
 /** Target address for each pool target */
 struct pool_target_addr {
 	/** rank of the node where the target resides */
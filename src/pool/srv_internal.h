@@ -93,12 +93,16 @@ void ds_pool_create_handler(crt_rpc_t *rpc);
 void ds_pool_connect_handler(crt_rpc_t *rpc);
 void ds_pool_disconnect_handler(crt_rpc_t *rpc);
 void ds_pool_query_handler(crt_rpc_t *rpc);
+void ds_pool_svc_query_handler(crt_rpc_t *rpc);
 void ds_pool_update_handler(crt_rpc_t *rpc);
 void ds_pool_evict_handler(crt_rpc_t *rpc);
 void ds_pool_svc_stop_handler(crt_rpc_t *rpc);
 void ds_pool_attr_list_handler(crt_rpc_t *rpc);
 void ds_pool_attr_get_handler(crt_rpc_t *rpc);
 void ds_pool_attr_set_handler(crt_rpc_t *rpc);
+void ds_pool_prop_set_handler(crt_rpc_t *rpc);
+void ds_pool_acl_update_handler(crt_rpc_t *rpc);
+void ds_pool_acl_delete_handler(crt_rpc_t *rpc);
 
 /*
  * srv_target.c
@@ -117,6 +121,7 @@ void ds_pool_tgt_update_map_handler(crt_rpc_t *rpc);
 int ds_pool_tgt_update_map_aggregator(crt_rpc_t *source, crt_rpc_t *result,
 				      void *priv);
 void ds_pool_tgt_query_handler(crt_rpc_t *rpc);
+void ds_pool_tgt_svc_query_handler(crt_rpc_t *rpc);
 int ds_pool_tgt_query_aggregator(crt_rpc_t *source, crt_rpc_t *result,
 				 void *priv);
 void ds_pool_child_purge(struct pool_tls *tls);
@@ -726,6 +726,441 @@ out:
 	return rc;
 }
 
+/* Initial pool map buffer size for management queries. */
+#define DS_POOL_QUERY_MAP_NR	128
+
+static int
+query_map_bulk_create(crt_context_t ctx, crt_bulk_t *bulk,
+		      struct pool_buf **buf, unsigned int nr)
+{
+	d_iov_t		iov;
+	d_sg_list_t	sgl;
+	int		rc;
+
+	*buf = pool_buf_alloc(nr);
+	if (*buf == NULL)
+		return -DER_NOMEM;
+
+	d_iov_set(&iov, *buf, pool_buf_size((*buf)->pb_nr));
+	sgl.sg_nr = 1;
+	sgl.sg_nr_out = 0;
+	sgl.sg_iovs = &iov;
+
+	rc = crt_bulk_create(ctx, &sgl, CRT_BULK_RW, bulk);
+	if (rc != 0) {
+		pool_buf_free(*buf);
+		*buf = NULL;
+	}
+
+	return rc;
+}
+
+/*
+ * Count the targets in \a map_buf and how many of them are disabled (down or
+ * down and out).
+ */
+static int
+query_map_targets(struct pool_buf *map_buf, uint32_t map_version,
+		  daos_pool_info_t *pool_info)
+{
+	struct pool_map		*map;
+	struct pool_target	*ts;
+	int			 n;
+	int			 i;
+	int			 rc;
+
+	rc = pool_map_create(map_buf, map_version, &map);
+	if (rc != 0) {
+		D_ERROR("failed to create local pool map: %d\n", rc);
+		return rc;
+	}
+
+	pool_info->pi_ndisabled = 0;
+	n = pool_map_find_target(map, PO_COMP_ID_ALL, &ts);
+	for (i = 0; i < n; i++) {
+		int status = ts[i].ta_comp.co_status;
+
+		if (status == PO_COMP_ST_DOWN || status == PO_COMP_ST_DOWNOUT)
+			pool_info->pi_ndisabled++;
+	}
+	pool_info->pi_ntargets = map_buf->pb_target_nr;
+	pool_info->pi_nnodes = map_buf->pb_node_nr;
+	pool_info->pi_map_ver = map_version;
+
+	pool_map_decref(map);
+	return 0;
+}
+
+/**
+ * Query the pool service of \a pool_uuid on behalf of the management service.
+ * No pool connection is required, the query is made with the server-internal
+ * POOL_SVC_QUERY RPC.
+ *
+ * \param[in]	pool_uuid	pool UUID
+ * \param[in]	ranks		pool service replica ranks
+ * \param[out]	pool_info	target counts, space usage and rebuild status
+ */
+int
+ds_pool_svc_query(const uuid_t pool_uuid, const d_rank_list_t *ranks,
+		  daos_pool_info_t *pool_info)
+{
+	struct rsvc_client	client;
+	struct dss_module_info *info = dss_get_module_info();
+	crt_endpoint_t		ep;
+	crt_rpc_t	       *rpc;
+	struct pool_query_in   *in;
+	struct pool_query_out  *out;
+	struct pool_buf	       *map_buf;
+	unsigned int		map_nr = DS_POOL_QUERY_MAP_NR;
+	int			rc;
+
+	D_DEBUG(DB_MD, DF_UUID": querying pool service\n", DP_UUID(pool_uuid));
+
+	rc = rsvc_client_init(&client, ranks);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+rechoose:
+	ep.ep_grp = NULL;
+	rsvc_client_choose(&client, &ep);
+	rc = pool_req_create(info->dmi_ctx, &ep, POOL_SVC_QUERY, &rpc);
+	if (rc != 0) {
+		D_ERROR(DF_UUID": failed to create pool query rpc: %d\n",
+			DP_UUID(pool_uuid), rc);
+		D_GOTO(out_client, rc);
+	}
+
+	in = crt_req_get(rpc);
+	uuid_copy(in->pqi_op.pi_uuid, pool_uuid);
+	uuid_clear(in->pqi_op.pi_hdl);
+	in->pqi_query_bits = DAOS_PO_QUERY_SPACE | DAOS_PO_QUERY_REBUILD_STATUS;
+
+	rc = query_map_bulk_create(info->dmi_ctx, &in->pqi_map_bulk, &map_buf,
+				   map_nr);
+	if (rc != 0)
+		D_GOTO(out_rpc, rc);
+
+	rc = dss_rpc_send(rpc);
+	out = crt_reply_get(rpc);
+	D_ASSERT(out != NULL);
+	rc = rsvc_client_complete_rpc(&client, &ep, rc,
+				      rc == 0 ? out->pqo_op.po_rc : -DER_IO,
+				      rc == 0 ? &out->pqo_op.po_hint : NULL);
+	if (rc == RSVC_CLIENT_RECHOOSE) {
+		crt_bulk_free(in->pqi_map_bulk);
+		pool_buf_free(map_buf);
+		crt_req_decref(rpc);
+		dss_sleep(1000 /* ms */);
+		D_GOTO(rechoose, rc);
+	}
+
+	rc = out->pqo_op.po_rc;
+	if (rc == -DER_TRUNC) {
+		/* Retry with the map buffer size required by the service. */
+		map_nr = pool_buf_nr(out->pqo_map_buf_size);
+		crt_bulk_free(in->pqi_map_bulk);
+		pool_buf_free(map_buf);
+		crt_req_decref(rpc);
+		D_GOTO(rechoose, rc);
+	} else if (rc != 0) {
+		D_ERROR(DF_UUID": failed to query pool: %d\n",
+			DP_UUID(pool_uuid), rc);
+		D_GOTO(out_bulk, rc);
+	}
+
+	rc = query_map_targets(map_buf, out->pqo_op.po_map_version, pool_info);
+	if (rc != 0)
+		D_GOTO(out_bulk, rc);
+
+	uuid_copy(pool_info->pi_uuid, pool_uuid);
+	pool_info->pi_leader = out->pqo_op.po_hint.sh_rank;
+	pool_info->pi_space = out->pqo_space;
+	pool_info->pi_rebuild_st = out->pqo_rebuild_st;
+	pool_info->pi_bits = DPI_SPACE | DPI_REBUILD_STATUS;
+
+out_bulk:
+	crt_bulk_free(in->pqi_map_bulk);
+	pool_buf_free(map_buf);
+out_rpc:
+	crt_req_decref(rpc);
+out_client:
+	rsvc_client_fini(&client);
+out:
+	return rc;
+}
+
+/**
+ * Fetch the ACL, owner and owner group properties of the pool \a pool_uuid
+ * from its pool service on behalf of the management service.
+ *
+ * \param[in]	pool_uuid	pool UUID
+ * \param[in]	ranks		pool service replica ranks
+ * \param[out]	prop		newly allocated properties
+ */
+int
+ds_pool_svc_get_acl_prop(const uuid_t pool_uuid, const d_rank_list_t *ranks,
+			 daos_prop_t **prop)
+{
+	struct rsvc_client	client;
+	struct dss_module_info *info = dss_get_module_info();
+	crt_endpoint_t		ep;
+	crt_rpc_t	       *rpc;
+	struct pool_query_in   *in;
+	struct pool_query_out  *out;
+	int			rc;
+
+	D_DEBUG(DB_MD, DF_UUID": getting ACL\n", DP_UUID(pool_uuid));
+
+	rc = rsvc_client_init(&client, ranks);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+rechoose:
+	ep.ep_grp = NULL;
+	rsvc_client_choose(&client, &ep);
+	rc = pool_req_create(info->dmi_ctx, &ep, POOL_SVC_QUERY, &rpc);
+	if (rc != 0) {
+		D_ERROR(DF_UUID": failed to create pool query rpc: %d\n",
+			DP_UUID(pool_uuid), rc);
+		D_GOTO(out_client, rc);
+	}
+
+	in = crt_req_get(rpc);
+	uuid_copy(in->pqi_op.pi_uuid, pool_uuid);
+	uuid_clear(in->pqi_op.pi_hdl);
+	in->pqi_map_bulk = CRT_BULK_NULL;
+	in->pqi_query_bits = DAOS_PO_QUERY_PROP_ACL | DAOS_PO_QUERY_PROP_OWNER |
+			     DAOS_PO_QUERY_PROP_OWNER_GROUP;
+
+	rc = dss_rpc_send(rpc);
+	out = crt_reply_get(rpc);
+	D_ASSERT(out != NULL);
+	rc = rsvc_client_complete_rpc(&client, &ep, rc,
+				      rc == 0 ? out->pqo_op.po_rc : -DER_IO,
+				      rc == 0 ? &out->pqo_op.po_hint : NULL);
+	if (rc == RSVC_CLIENT_RECHOOSE) {
+		crt_req_decref(rpc);
+		dss_sleep(1000 /* ms */);
+		D_GOTO(rechoose, rc);
+	}
+
+	rc = out->pqo_op.po_rc;
+	if (rc != 0) {
+		D_ERROR(DF_UUID": failed to get ACL: %d\n",
+			DP_UUID(pool_uuid), rc);
+		D_GOTO(out_rpc, rc);
+	}
+
+	*prop = daos_prop_dup(out->pqo_prop, true /* pool */);
+	if (*prop == NULL)
+		rc = -DER_NOMEM;
+
+out_rpc:
+	crt_req_decref(rpc);
+out_client:
+	rsvc_client_fini(&client);
+out:
+	return rc;
+}
+
+/**
+ * Set properties of the pool \a pool_uuid through its pool service on behalf
+ * of the management service.
+ *
+ * \param[in]	pool_uuid	pool UUID
+ * \param[in]	ranks		pool service replica ranks
+ * \param[in]	prop		properties to set
+ */
+int
+ds_pool_svc_set_prop(const uuid_t pool_uuid, const d_rank_list_t *ranks,
+		     daos_prop_t *prop)
+{
+	struct rsvc_client		client;
+	struct dss_module_info	       *info = dss_get_module_info();
+	crt_endpoint_t			ep;
+	crt_rpc_t		       *rpc;
+	struct pool_prop_set_in	       *in;
+	struct pool_prop_set_out       *out;
+	int				rc;
+
+	D_DEBUG(DB_MD, DF_UUID": setting properties\n", DP_UUID(pool_uuid));
+
+	rc = rsvc_client_init(&client, ranks);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+rechoose:
+	ep.ep_grp = NULL;
+	rsvc_client_choose(&client, &ep);
+	rc = pool_req_create(info->dmi_ctx, &ep, POOL_PROP_SET, &rpc);
+	if (rc != 0) {
+		D_ERROR(DF_UUID": failed to create pool prop set rpc: %d\n",
+			DP_UUID(pool_uuid), rc);
+		D_GOTO(out_client, rc);
+	}
+
+	in = crt_req_get(rpc);
+	uuid_copy(in->psi_op.pi_uuid, pool_uuid);
+	uuid_clear(in->psi_op.pi_hdl);
+	in->psi_prop = prop;
+
+	rc = dss_rpc_send(rpc);
+	out = crt_reply_get(rpc);
+	D_ASSERT(out != NULL);
+	rc = rsvc_client_complete_rpc(&client, &ep, rc,
+				      rc == 0 ? out->pso_op.po_rc : -DER_IO,
+				      rc == 0 ? &out->pso_op.po_hint : NULL);
+	if (rc == RSVC_CLIENT_RECHOOSE) {
+		crt_req_decref(rpc);
+		dss_sleep(1000 /* ms */);
+		D_GOTO(rechoose, rc);
+	}
+
+	rc = out->pso_op.po_rc;
+	if (rc != 0)
+		D_ERROR(DF_UUID": failed to set properties: %d\n",
+			DP_UUID(pool_uuid), rc);
+
+	crt_req_decref(rpc);
+out_client:
+	rsvc_client_fini(&client);
+out:
+	return rc;
+}
+
+/**
+ * Add or update entries in the ACL of the pool \a pool_uuid through its pool
+ * service on behalf of the management service.
+ *
+ * \param[in]	pool_uuid	pool UUID
+ * \param[in]	ranks		pool service replica ranks
+ * \param[in]	acl		ACL entries to add or update
+ */
+int
+ds_pool_svc_update_acl(const uuid_t pool_uuid, const d_rank_list_t *ranks,
+		       struct daos_acl *acl)
+{
+	struct rsvc_client		client;
+	struct dss_module_info	       *info = dss_get_module_info();
+	crt_endpoint_t			ep;
+	crt_rpc_t		       *rpc;
+	struct pool_acl_update_in      *in;
+	struct pool_acl_update_out     *out;
+	int				rc;
+
+	D_DEBUG(DB_MD, DF_UUID": updating ACL\n", DP_UUID(pool_uuid));
+
+	rc = rsvc_client_init(&client, ranks);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+rechoose:
+	ep.ep_grp = NULL;
+	rsvc_client_choose(&client, &ep);
+	rc = pool_req_create(info->dmi_ctx, &ep, POOL_ACL_UPDATE, &rpc);
+	if (rc != 0) {
+		D_ERROR(DF_UUID": failed to create pool ACL update rpc: %d\n",
+			DP_UUID(pool_uuid), rc);
+		D_GOTO(out_client, rc);
+	}
+
+	in = crt_req_get(rpc);
+	uuid_copy(in->pui_op.pi_uuid, pool_uuid);
+	uuid_clear(in->pui_op.pi_hdl);
+	in->pui_acl = acl;
+
+	rc = dss_rpc_send(rpc);
+	out = crt_reply_get(rpc);
+	D_ASSERT(out != NULL);
+	rc = rsvc_client_complete_rpc(&client, &ep, rc,
+				      rc == 0 ? out->puo_op.po_rc : -DER_IO,
+				      rc == 0 ? &out->puo_op.po_hint : NULL);
+	if (rc == RSVC_CLIENT_RECHOOSE) {
+		crt_req_decref(rpc);
+		dss_sleep(1000 /* ms */);
+		D_GOTO(rechoose, rc);
+	}
+
+	rc = out->puo_op.po_rc;
+	if (rc != 0)
+		D_ERROR(DF_UUID": failed to update ACL: %d\n",
+			DP_UUID(pool_uuid), rc);
+
+	crt_req_decref(rpc);
+out_client:
+	rsvc_client_fini(&client);
+out:
+	return rc;
+}
+
+/**
+ * Remove the entry for a principal from the ACL of the pool \a pool_uuid
+ * through its pool service on behalf of the management service.
+ *
+ * \param[in]	pool_uuid	pool UUID
+ * \param[in]	ranks		pool service replica ranks
+ * \param[in]	type		principal type
+ * \param[in]	principal	principal name, or NULL for the special
+ *				principal types
+ */
+int
+ds_pool_svc_delete_acl(const uuid_t pool_uuid, const d_rank_list_t *ranks,
+		       enum daos_acl_principal_type type, const char *principal)
+{
+	struct rsvc_client		client;
+	struct dss_module_info	       *info = dss_get_module_info();
+	crt_endpoint_t			ep;
+	crt_rpc_t		       *rpc;
+	struct pool_acl_delete_in      *in;
+	struct pool_acl_delete_out     *out;
+	int				rc;
+
+	D_DEBUG(DB_MD, DF_UUID": deleting ACL entry\n", DP_UUID(pool_uuid));
+
+	rc = rsvc_client_init(&client, ranks);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+rechoose:
+	ep.ep_grp = NULL;
+	rsvc_client_choose(&client, &ep);
+	rc = pool_req_create(info->dmi_ctx, &ep, POOL_ACL_DELETE, &rpc);
+	if (rc != 0) {
+		D_ERROR(DF_UUID": failed to create pool ACL delete rpc: %d\n",
+			DP_UUID(pool_uuid), rc);
+		D_GOTO(out_client, rc);
+	}
+
+	in = crt_req_get(rpc);
+	uuid_copy(in->pdi_op.pi_uuid, pool_uuid);
+	uuid_clear(in->pdi_op.pi_hdl);
+	in->pdi_type = (uint8_t)type;
+	in->pdi_principal = principal != NULL ? principal : "";
+
+	rc = dss_rpc_send(rpc);
+	out = crt_reply_get(rpc);
+	D_ASSERT(out != NULL);
+	rc = rsvc_client_complete_rpc(&client, &ep, rc,
+				      rc == 0 ? out->pdo_op.po_rc : -DER_IO,
+				      rc == 0 ? &out->pdo_op.po_hint : NULL);
+	if (rc == RSVC_CLIENT_RECHOOSE) {
+		crt_req_decref(rpc);
+		dss_sleep(1000 /* ms */);
+		D_GOTO(rechoose, rc);
+	}
+
+	rc = out->pdo_op.po_rc;
+	if (rc != 0)
+		D_ERROR(DF_UUID": failed to delete ACL entry: %d\n",
+			DP_UUID(pool_uuid), rc);
+
+	crt_req_decref(rpc);
+out_client:
+	rsvc_client_fini(&client);
+out:
+	return rc;
+}
+
 int
 ds_pool_svc_destroy(const uuid_t pool_uuid)
 {
@@ -2106,9 +2541,14 @@ out:
 	crt_reply_send(rpc);
 }
 
+/*
+ * Query the space of the pool targets with \a opc, either POOL_TGT_QUERY on
+ * behalf of the holder of \a pool_hdl or POOL_TGT_SVC_QUERY on behalf of the
+ * management service.
+ */
 static int
-pool_space_query_bcast(crt_context_t ctx, struct pool_svc *svc, uuid_t pool_hdl,
-		       struct daos_pool_space *ps)
+pool_space_query_bcast(crt_context_t ctx, struct pool_svc *svc, int opc,
+		       uuid_t pool_hdl, struct daos_pool_space *ps)
 {
 	struct pool_tgt_query_in	*in;
 	struct pool_tgt_query_out	*out;
@@ -2117,7 +2557,7 @@ pool_space_query_bcast(crt_context_t ctx, struct pool_svc *svc, uuid_t pool_hdl,
 
 	D_DEBUG(DB_MD, DF_UUID": bcasting\n", DP_UUID(svc->ps_uuid));
 
-	rc = bcast_create(ctx, svc, POOL_TGT_QUERY, NULL, &rpc);
+	rc = bcast_create(ctx, svc, opc, NULL, &rpc);
 	if (rc != 0)
 		goto out;
 
@@ -2146,8 +2586,12 @@ out:
 	return rc;
 }
 
-void
-ds_pool_query_handler(crt_rpc_t *rpc)
+/*
+ * Handle a pool query, either from a client holding a pool handle or, if
+ * \a internal, from the management service (see ds_pool_svc_query).
+ */
+static void
+pool_query_handler(crt_rpc_t *rpc, bool internal)
 {
 	struct pool_query_in   *in = crt_req_get(rpc);
 	struct pool_query_out  *out = crt_reply_get(rpc);
@@ -2183,9 +2627,10 @@ ds_pool_query_handler(crt_rpc_t *rpc)
 
 	/* Verify the pool handle. Note: since rebuild will not
 	 * connect the pool, so we only verify the non-rebuild
-	 * pool.
+	 * pool. Internal queries are made without a pool connection.
 	 */
-	if (!is_rebuild_pool(in->pqi_op.pi_uuid, in->pqi_op.pi_hdl)) {
+	if (!internal &&
+	    !is_rebuild_pool(in->pqi_op.pi_uuid, in->pqi_op.pi_hdl)) {
 		d_iov_set(&key, in->pqi_op.pi_hdl, sizeof(uuid_t));
 		d_iov_set(&value, &hdl, sizeof(hdl));
 		rc = rdb_tx_lookup(&tx, &svc->ps_handles, &key, &value);
@@ -2263,6 +2708,10 @@ ds_pool_query_handler(crt_rpc_t *rpc)
 		}
 	}
 
+	/* Property queries from the management service skip the map. */
+	if (in->pqi_map_bulk == CRT_BULK_NULL)
+		D_GOTO(out_map_version, rc);
+
 	rc = read_map_buf(&tx, &svc->ps_root, &map_buf, &map_version);
 	if (rc != 0) {
 		D_ERROR(DF_UUID": failed to read pool map: %d\n",
@@ -2285,7 +2734,10 @@ out_svc:
 	/* See comment above, rebuild doesn't connect the pool */
 	if (rc == 0 && (in->pqi_query_bits & DAOS_PO_QUERY_SPACE) &&
 	    !is_rebuild_pool(in->pqi_op.pi_uuid, in->pqi_op.pi_hdl))
-		rc = pool_space_query_bcast(rpc->cr_ctx, svc, in->pqi_op.pi_hdl,
+		rc = pool_space_query_bcast(rpc->cr_ctx, svc,
+					    internal ? POOL_TGT_SVC_QUERY :
+						       POOL_TGT_QUERY,
+					    in->pqi_op.pi_hdl,
 					    &out->pqo_space);
 	pool_svc_put_leader(svc);
 out:
@@ -2296,6 +2748,19 @@ out:
 	daos_prop_free(prop);
 }
 
+void
+ds_pool_query_handler(crt_rpc_t *rpc)
+{
+	pool_query_handler(rpc, false /* internal */);
+}
+
+/* Server-internal pool query made by the management service. */
+void
+ds_pool_svc_query_handler(crt_rpc_t *rpc)
+{
+	pool_query_handler(rpc, true /* internal */);
+}
+
 
 static int
 replace_failed_replicas(struct pool_svc *svc, struct pool_map *map)
@@ -2869,6 +3334,233 @@ out:
 	crt_reply_send(rpc);
 }
 
+void
+ds_pool_prop_set_handler(crt_rpc_t *rpc)
+{
+	struct pool_prop_set_in	       *in = crt_req_get(rpc);
+	struct pool_prop_set_out       *out = crt_reply_get(rpc);
+	struct pool_svc		       *svc;
+	struct rdb_tx			tx;
+	int				rc;
+
+	D_DEBUG(DF_DSMS, DF_UUID": processing rpc %p\n",
+		DP_UUID(in->psi_op.pi_uuid), rpc);
+
+	if (!daos_prop_valid(in->psi_prop, true /* pool */, true /* input */)) {
+		D_ERROR(DF_UUID": invalid properties input\n",
+			DP_UUID(in->psi_op.pi_uuid));
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	rc = pool_svc_lookup_leader(in->psi_op.pi_uuid, &svc,
+				    &out->pso_op.po_hint);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+	rc = rdb_tx_begin(svc->ps_rsvc.s_db, svc->ps_rsvc.s_term, &tx);
+	if (rc != 0)
+		D_GOTO(out_svc, rc);
+
+	ABT_rwlock_wrlock(svc->ps_lock);
+
+	rc = pool_prop_write(&tx, &svc->ps_root, in->psi_prop);
+	if (rc != 0) {
+		D_ERROR(DF_UUID": failed to write properties: %d\n",
+			DP_UUID(svc->ps_uuid), rc);
+		D_GOTO(out_lock, rc);
+	}
+
+	rc = rdb_tx_commit(&tx);
+
+out_lock:
+	ABT_rwlock_unlock(svc->ps_lock);
+	rdb_tx_end(&tx);
+out_svc:
+	ds_rsvc_set_hint(&svc->ps_rsvc, &out->pso_op.po_hint);
+	pool_svc_put_leader(svc);
+out:
+	out->pso_op.po_rc = rc;
+	D_DEBUG(DF_DSMS, DF_UUID": replying rpc %p: %d\n",
+		DP_UUID(in->psi_op.pi_uuid), rpc, rc);
+	crt_reply_send(rpc);
+}
+
+/*
+ * Merge the entries of \a acl into the ACL property stored in \a tx, replacing
+ * any existing entries for the same principals.
+ */
+static int
+pool_acl_merge(struct rdb_tx *tx, struct pool_svc *svc, struct daos_acl *acl)
+{
+	daos_prop_t		*prop = NULL;
+	struct daos_prop_entry	*entry;
+	struct daos_acl		*merged;
+	struct daos_ace		*ace;
+	int			 rc;
+
+	rc = pool_prop_read(tx, svc, DAOS_PO_QUERY_PROP_ACL, &prop);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+	entry = daos_prop_entry_get(prop, DAOS_PROP_PO_ACL);
+	D_ASSERT(entry != NULL);
+	merged = entry->dpe_val_ptr;
+
+	ace = daos_acl_get_next_ace(acl, NULL);
+	while (ace != NULL) {
+		rc = daos_acl_add_ace(&merged, ace);
+		if (rc != 0)
+			break;
+		ace = daos_acl_get_next_ace(acl, ace);
+	}
+	/* daos_acl_add_ace may have reallocated the ACL */
+	entry->dpe_val_ptr = merged;
+	if (rc != 0) {
+		D_ERROR(DF_UUID": failed to merge ACL entries: %d\n",
+			DP_UUID(svc->ps_uuid), rc);
+		D_GOTO(out, rc);
+	}
+
+	rc = pool_prop_write(tx, &svc->ps_root, prop);
+out:
+	daos_prop_free(prop);
+	return rc;
+}
+
+void
+ds_pool_acl_update_handler(crt_rpc_t *rpc)
+{
+	struct pool_acl_update_in      *in = crt_req_get(rpc);
+	struct pool_acl_update_out     *out = crt_reply_get(rpc);
+	struct pool_svc		       *svc;
+	struct rdb_tx			tx;
+	int				rc;
+
+	D_DEBUG(DF_DSMS, DF_UUID": processing rpc %p\n",
+		DP_UUID(in->pui_op.pi_uuid), rpc);
+
+	if (in->pui_acl == NULL || daos_acl_validate(in->pui_acl) != 0) {
+		D_ERROR(DF_UUID": invalid ACL input\n",
+			DP_UUID(in->pui_op.pi_uuid));
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+
+	rc = pool_svc_lookup_leader(in->pui_op.pi_uuid, &svc,
+				    &out->puo_op.po_hint);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+	rc = rdb_tx_begin(svc->ps_rsvc.s_db, svc->ps_rsvc.s_term, &tx);
+	if (rc != 0)
+		D_GOTO(out_svc, rc);
+
+	ABT_rwlock_wrlock(svc->ps_lock);
+
+	rc = pool_acl_merge(&tx, svc, in->pui_acl);
+	if (rc != 0)
+		D_GOTO(out_lock, rc);
+
+	rc = rdb_tx_commit(&tx);
+
+out_lock:
+	ABT_rwlock_unlock(svc->ps_lock);
+	rdb_tx_end(&tx);
+out_svc:
+	ds_rsvc_set_hint(&svc->ps_rsvc, &out->puo_op.po_hint);
+	pool_svc_put_leader(svc);
+out:
+	out->puo_op.po_rc = rc;
+	D_DEBUG(DF_DSMS, DF_UUID": replying rpc %p: %d\n",
+		DP_UUID(in->pui_op.pi_uuid), rpc, rc);
+	crt_reply_send(rpc);
+}
+
+/*
+ * Remove the entry for a principal from the ACL property stored in \a tx.
+ */
+static int
+pool_acl_remove(struct rdb_tx *tx, struct pool_svc *svc,
+		enum daos_acl_principal_type type, const char *principal)
+{
+	daos_prop_t		*prop = NULL;
+	struct daos_prop_entry	*entry;
+	struct daos_acl		*acl;
+	int			 rc;
+
+	rc = pool_prop_read(tx, svc, DAOS_PO_QUERY_PROP_ACL, &prop);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+	entry = daos_prop_entry_get(prop, DAOS_PROP_PO_ACL);
+	D_ASSERT(entry != NULL);
+	acl = entry->dpe_val_ptr;
+
+	rc = daos_acl_remove_ace(&acl, type, principal);
+	/* daos_acl_remove_ace may have reallocated the ACL */
+	entry->dpe_val_ptr = acl;
+	if (rc != 0) {
+		D_ERROR(DF_UUID": failed to remove ACL entry: %d\n",
+			DP_UUID(svc->ps_uuid), rc);
+		D_GOTO(out, rc);
+	}
+
+	rc = pool_prop_write(tx, &svc->ps_root, prop);
+out:
+	daos_prop_free(prop);
+	return rc;
+}
+
+void
+ds_pool_acl_delete_handler(crt_rpc_t *rpc)
+{
+	struct pool_acl_delete_in      *in = crt_req_get(rpc);
+	struct pool_acl_delete_out     *out = crt_reply_get(rpc);
+	struct pool_svc		       *svc;
+	struct rdb_tx			tx;
+	const char		       *principal = NULL;
+	int				rc;
+
+	D_DEBUG(DF_DSMS, DF_UUID": processing rpc %p\n",
+		DP_UUID(in->pdi_op.pi_uuid), rpc);
+
+	if (in->pdi_type >= NUM_DAOS_ACL_TYPES) {
+		D_ERROR(DF_UUID": invalid principal type %u\n",
+			DP_UUID(in->pdi_op.pi_uuid), in->pdi_type);
+		D_GOTO(out, rc = -DER_INVAL);
+	}
+	if (in->pdi_principal != NULL && *in->pdi_principal != '\0')
+		principal = in->pdi_principal;
+
+	rc = pool_svc_lookup_leader(in->pdi_op.pi_uuid, &svc,
+				    &out->pdo_op.po_hint);
+	if (rc != 0)
+		D_GOTO(out, rc);
+
+	rc = rdb_tx_begin(svc->ps_rsvc.s_db, svc->ps_rsvc.s_term, &tx);
+	if (rc != 0)
+		D_GOTO(out_svc, rc);
+
+	ABT_rwlock_wrlock(svc->ps_lock);
+
+	rc = pool_acl_remove(&tx, svc, in->pdi_type, principal);
+	if (rc != 0)
+		D_GOTO(out_lock, rc);
+
+	rc = rdb_tx_commit(&tx);
+
+out_lock:
+	ABT_rwlock_unlock(svc->ps_lock);
+	rdb_tx_end(&tx);
+out_svc:
+	ds_rsvc_set_hint(&svc->ps_rsvc, &out->pdo_op.po_hint);
+	pool_svc_put_leader(svc);
+out:
+	out->pdo_op.po_rc = rc;
+	D_DEBUG(DF_DSMS, DF_UUID": replying rpc %p: %d\n",
+		DP_UUID(in->pdi_op.pi_uuid), rpc, rc);
+	crt_reply_send(rpc);
+}
+
 void
 ds_pool_attr_get_handler(crt_rpc_t *rpc)
 {
@@ -1005,6 +1005,32 @@ out:
 	crt_reply_send(rpc);
 }
 
+/*
+ * Server-internal target query made by the pool service on behalf of the
+ * management service (see ds_pool_svc_query), which holds no pool handle.
+ */
+void
+ds_pool_tgt_svc_query_handler(crt_rpc_t *rpc)
+{
+	struct pool_tgt_query_in	*in = crt_req_get(rpc);
+	struct pool_tgt_query_out	*out = crt_reply_get(rpc);
+	struct ds_pool			*pool;
+	int				 rc;
+
+	pool = ds_pool_lookup(in->tqi_op.pi_uuid);
+	if (pool == NULL) {
+		D_ERROR("Failed to find pool "DF_UUID"\n",
+			DP_UUID(in->tqi_op.pi_uuid));
+		D_GOTO(out, rc = -DER_NONEXIST);
+	}
+
+	rc = pool_tgt_query(pool, &out->tqo_space);
+	ds_pool_put(pool);
+out:
+	out->tqo_rc = (rc == 0 ? 0 : 1);
+	crt_reply_send(rpc);
+}
+
 int
 ds_pool_tgt_query_aggregator(crt_rpc_t *source, crt_rpc_t *result, void *priv)
 {
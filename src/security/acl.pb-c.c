@@ -142,6 +142,186 @@ void   acl__entry_permissions__free_unpacked
   assert(message->base.descriptor == &acl__entry_permissions__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   acl__get_aclreq__init
+                     (Acl__GetACLReq         *message)
+{
+  static const Acl__GetACLReq init_value = ACL__GET_ACLREQ__INIT;
+  *message = init_value;
+}
+size_t acl__get_aclreq__get_packed_size
+                     (const Acl__GetACLReq *message)
+{
+  assert(message->base.descriptor == &acl__get_aclreq__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t acl__get_aclreq__pack
+                     (const Acl__GetACLReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &acl__get_aclreq__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t acl__get_aclreq__pack_to_buffer
+                     (const Acl__GetACLReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &acl__get_aclreq__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Acl__GetACLReq *
+       acl__get_aclreq__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Acl__GetACLReq *)
+     protobuf_c_message_unpack (&acl__get_aclreq__descriptor,
+                                allocator, len, data);
+}
+void   acl__get_aclreq__free_unpacked
+                     (Acl__GetACLReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &acl__get_aclreq__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   acl__aclresp__init
+                     (Acl__ACLResp         *message)
+{
+  static const Acl__ACLResp init_value = ACL__ACLRESP__INIT;
+  *message = init_value;
+}
+size_t acl__aclresp__get_packed_size
+                     (const Acl__ACLResp *message)
+{
+  assert(message->base.descriptor == &acl__aclresp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t acl__aclresp__pack
+                     (const Acl__ACLResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &acl__aclresp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t acl__aclresp__pack_to_buffer
+                     (const Acl__ACLResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &acl__aclresp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Acl__ACLResp *
+       acl__aclresp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Acl__ACLResp *)
+     protobuf_c_message_unpack (&acl__aclresp__descriptor,
+                                allocator, len, data);
+}
+void   acl__aclresp__free_unpacked
+                     (Acl__ACLResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &acl__aclresp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   acl__modify_aclreq__init
+                     (Acl__ModifyACLReq         *message)
+{
+  static const Acl__ModifyACLReq init_value = ACL__MODIFY_ACLREQ__INIT;
+  *message = init_value;
+}
+size_t acl__modify_aclreq__get_packed_size
+                     (const Acl__ModifyACLReq *message)
+{
+  assert(message->base.descriptor == &acl__modify_aclreq__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t acl__modify_aclreq__pack
+                     (const Acl__ModifyACLReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &acl__modify_aclreq__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t acl__modify_aclreq__pack_to_buffer
+                     (const Acl__ModifyACLReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &acl__modify_aclreq__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Acl__ModifyACLReq *
+       acl__modify_aclreq__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Acl__ModifyACLReq *)
+     protobuf_c_message_unpack (&acl__modify_aclreq__descriptor,
+                                allocator, len, data);
+}
+void   acl__modify_aclreq__free_unpacked
+                     (Acl__ModifyACLReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &acl__modify_aclreq__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
+void   acl__delete_aclreq__init
+                     (Acl__DeleteACLReq         *message)
+{
+  static const Acl__DeleteACLReq init_value = ACL__DELETE_ACLREQ__INIT;
+  *message = init_value;
+}
+size_t acl__delete_aclreq__get_packed_size
+                     (const Acl__DeleteACLReq *message)
+{
+  assert(message->base.descriptor == &acl__delete_aclreq__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t acl__delete_aclreq__pack
+                     (const Acl__DeleteACLReq *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &acl__delete_aclreq__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t acl__delete_aclreq__pack_to_buffer
+                     (const Acl__DeleteACLReq *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &acl__delete_aclreq__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Acl__DeleteACLReq *
+       acl__delete_aclreq__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Acl__DeleteACLReq *)
+     protobuf_c_message_unpack (&acl__delete_aclreq__descriptor,
+                                allocator, len, data);
+}
+void   acl__delete_aclreq__free_unpacked
+                     (Acl__DeleteACLReq *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &acl__delete_aclreq__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 static const ProtobufCFieldDescriptor acl__response__field_descriptors[2] =
 {
   {
@@ -321,6 +501,197 @@ const ProtobufCMessageDescriptor acl__entry_permissions__descriptor =
   (ProtobufCMessageInit) acl__entry_permissions__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor acl__get_aclreq__field_descriptors[1] =
+{
+  {
+    "uuid",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Acl__GetACLReq, uuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned acl__get_aclreq__field_indices_by_name[] = {
+  0,   /* field[0] = uuid */
+};
+static const ProtobufCIntRange acl__get_aclreq__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 1 }
+};
+const ProtobufCMessageDescriptor acl__get_aclreq__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "acl.GetACLReq",
+  "GetACLReq",
+  "Acl__GetACLReq",
+  "acl",
+  sizeof(Acl__GetACLReq),
+  1,
+  acl__get_aclreq__field_descriptors,
+  acl__get_aclreq__field_indices_by_name,
+  1,  acl__get_aclreq__number_ranges,
+  (ProtobufCMessageInit) acl__get_aclreq__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor acl__aclresp__field_descriptors[2] =
+{
+  {
+    "status",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_INT32,
+    0,   /* quantifier_offset */
+    offsetof(Acl__ACLResp, status),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "acl",
+    2,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_STRING,
+    offsetof(Acl__ACLResp, n_acl),
+    offsetof(Acl__ACLResp, acl),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned acl__aclresp__field_indices_by_name[] = {
+  1,   /* field[1] = acl */
+  0,   /* field[0] = status */
+};
+static const ProtobufCIntRange acl__aclresp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor acl__aclresp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "acl.ACLResp",
+  "ACLResp",
+  "Acl__ACLResp",
+  "acl",
+  sizeof(Acl__ACLResp),
+  2,
+  acl__aclresp__field_descriptors,
+  acl__aclresp__field_indices_by_name,
+  1,  acl__aclresp__number_ranges,
+  (ProtobufCMessageInit) acl__aclresp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor acl__modify_aclreq__field_descriptors[2] =
+{
+  {
+    "uuid",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Acl__ModifyACLReq, uuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "acl",
+    2,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_STRING,
+    offsetof(Acl__ModifyACLReq, n_acl),
+    offsetof(Acl__ModifyACLReq, acl),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned acl__modify_aclreq__field_indices_by_name[] = {
+  1,   /* field[1] = acl */
+  0,   /* field[0] = uuid */
+};
+static const ProtobufCIntRange acl__modify_aclreq__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor acl__modify_aclreq__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "acl.ModifyACLReq",
+  "ModifyACLReq",
+  "Acl__ModifyACLReq",
+  "acl",
+  sizeof(Acl__ModifyACLReq),
+  2,
+  acl__modify_aclreq__field_descriptors,
+  acl__modify_aclreq__field_indices_by_name,
+  1,  acl__modify_aclreq__number_ranges,
+  (ProtobufCMessageInit) acl__modify_aclreq__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
+static const ProtobufCFieldDescriptor acl__delete_aclreq__field_descriptors[2] =
+{
+  {
+    "uuid",
+    1,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Acl__DeleteACLReq, uuid),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "principal",
+    2,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Acl__DeleteACLReq, principal),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned acl__delete_aclreq__field_indices_by_name[] = {
+  1,   /* field[1] = principal */
+  0,   /* field[0] = uuid */
+};
+static const ProtobufCIntRange acl__delete_aclreq__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 2 }
+};
+const ProtobufCMessageDescriptor acl__delete_aclreq__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "acl.DeleteACLReq",
+  "DeleteACLReq",
+  "Acl__DeleteACLReq",
+  "acl",
+  sizeof(Acl__DeleteACLReq),
+  2,
+  acl__delete_aclreq__field_descriptors,
+  acl__delete_aclreq__field_indices_by_name,
+  1,  acl__delete_aclreq__number_ranges,
+  (ProtobufCMessageInit) acl__delete_aclreq__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
 static const ProtobufCEnumValue acl__request_status__enum_values_by_number[6] =
 {
   { "ERR_INVALID_UUID", "ACL__REQUEST_STATUS__ERR_INVALID_UUID", -5 },
@@ -451,15 +822,23 @@ const ProtobufCEnumDescriptor acl__flags__descriptor =
   acl__flags__value_ranges,
   NULL,NULL,NULL,NULL   /* reserved[1234] */
 };
-static const ProtobufCMethodDescriptor acl__access_control__method_descriptors[3] =
+static const ProtobufCMethodDescriptor acl__access_control__method_descriptors[7] =
 {
   { "SetPermissions", &acl__entry_permissions__descriptor, &acl__response__descriptor },
   { "GetPermissions", &acl__entry__descriptor, &acl__response__descriptor },
   { "DestroyAclEntry", &acl__entry__descriptor, &acl__response__descriptor },
+  { "PoolGetACL", &acl__get_aclreq__descriptor, &acl__aclresp__descriptor },
+  { "PoolOverwriteACL", &acl__modify_aclreq__descriptor, &acl__aclresp__descriptor },
+  { "PoolUpdateACL", &acl__modify_aclreq__descriptor, &acl__aclresp__descriptor },
+  { "PoolDeleteACL", &acl__delete_aclreq__descriptor, &acl__aclresp__descriptor },
 };
 const unsigned acl__access_control__method_indices_by_name[] = {
   2,        /* DestroyAclEntry */
   1,        /* GetPermissions */
+  6,        /* PoolDeleteACL */
+  3,        /* PoolGetACL */
+  4,        /* PoolOverwriteACL */
+  5,        /* PoolUpdateACL */
   0         /* SetPermissions */
 };
 const ProtobufCServiceDescriptor acl__access_control__descriptor =
@@ -469,7 +848,7 @@ const ProtobufCServiceDescriptor acl__access_control__descriptor =
   "AccessControl",
   "Acl__AccessControl",
   "acl",
-  3,
+  7,
   acl__access_control__method_descriptors,
   acl__access_control__method_indices_by_name
 };
@@ -497,6 +876,38 @@ void acl__access_control__destroy_acl_entry(ProtobufCService *service,
   assert(service->descriptor == &acl__access_control__descriptor);
   service->invoke(service, 2, (const ProtobufCMessage *) input, (ProtobufCClosure) closure, closure_data);
 }
+void acl__access_control__pool_get_acl(ProtobufCService *service,
+                                       const Acl__GetACLReq *input,
+                                       Acl__ACLResp_Closure closure,
+                                       void *closure_data)
+{
+  assert(service->descriptor == &acl__access_control__descriptor);
+  service->invoke(service, 3, (const ProtobufCMessage *) input, (ProtobufCClosure) closure, closure_data);
+}
+void acl__access_control__pool_overwrite_acl(ProtobufCService *service,
+                                             const Acl__ModifyACLReq *input,
+                                             Acl__ACLResp_Closure closure,
+                                             void *closure_data)
+{
+  assert(service->descriptor == &acl__access_control__descriptor);
+  service->invoke(service, 4, (const ProtobufCMessage *) input, (ProtobufCClosure) closure, closure_data);
+}
+void acl__access_control__pool_update_acl(ProtobufCService *service,
+                                          const Acl__ModifyACLReq *input,
+                                          Acl__ACLResp_Closure closure,
+                                          void *closure_data)
+{
+  assert(service->descriptor == &acl__access_control__descriptor);
+  service->invoke(service, 5, (const ProtobufCMessage *) input, (ProtobufCClosure) closure, closure_data);
+}
+void acl__access_control__pool_delete_acl(ProtobufCService *service,
+                                          const Acl__DeleteACLReq *input,
+                                          Acl__ACLResp_Closure closure,
+                                          void *closure_data)
+{
+  assert(service->descriptor == &acl__access_control__descriptor);
+  service->invoke(service, 6, (const ProtobufCMessage *) input, (ProtobufCClosure) closure, closure_data);
+}
 void acl__access_control__init (Acl__AccessControl_Service *service,
                                 Acl__AccessControl_ServiceDestroy destroy)
 {
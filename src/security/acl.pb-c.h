@@ -18,6 +18,10 @@ PROTOBUF_C__BEGIN_DECLS
 typedef struct _Acl__Response Acl__Response;
 typedef struct _Acl__Entry Acl__Entry;
 typedef struct _Acl__EntryPermissions Acl__EntryPermissions;
+typedef struct _Acl__GetACLReq Acl__GetACLReq;
+typedef struct _Acl__ACLResp Acl__ACLResp;
+typedef struct _Acl__ModifyACLReq Acl__ModifyACLReq;
+typedef struct _Acl__DeleteACLReq Acl__DeleteACLReq;
 
 
 /* --- enums --- */
@@ -143,6 +147,86 @@ struct  _Acl__EntryPermissions
     , NULL, 0 }
 
 
+/*
+ * GetACLReq requests the Access Control List of a pool.
+ */
+struct  _Acl__GetACLReq
+{
+  ProtobufCMessage base;
+  /*
+   * uuid of pool
+   */
+  char *uuid;
+};
+#define ACL__GET_ACLREQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&acl__get_aclreq__descriptor) \
+    , (char *)protobuf_c_empty_string }
+
+
+/*
+ * ACLResp returns the resulting Access Control List of a pool.
+ */
+struct  _Acl__ACLResp
+{
+  ProtobufCMessage base;
+  /*
+   * DAOS error code
+   */
+  int32_t status;
+  /*
+   * Access Control Entries in short string format
+   */
+  size_t n_acl;
+  char **acl;
+};
+#define ACL__ACLRESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&acl__aclresp__descriptor) \
+    , 0, 0,NULL }
+
+
+/*
+ * ModifyACLReq supplies Access Control Entries to overwrite or update the
+ * Access Control List of a pool with.
+ */
+struct  _Acl__ModifyACLReq
+{
+  ProtobufCMessage base;
+  /*
+   * uuid of pool
+   */
+  char *uuid;
+  /*
+   * Access Control Entries in short string format
+   */
+  size_t n_acl;
+  char **acl;
+};
+#define ACL__MODIFY_ACLREQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&acl__modify_aclreq__descriptor) \
+    , (char *)protobuf_c_empty_string, 0,NULL }
+
+
+/*
+ * DeleteACLReq identifies the Access Control Entry to remove from the
+ * Access Control List of a pool.
+ */
+struct  _Acl__DeleteACLReq
+{
+  ProtobufCMessage base;
+  /*
+   * uuid of pool
+   */
+  char *uuid;
+  /*
+   * principal of entry e.g. "u:bob@" or "OWNER@"
+   */
+  char *principal;
+};
+#define ACL__DELETE_ACLREQ__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&acl__delete_aclreq__descriptor) \
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string }
+
+
 /* Acl__Response methods */
 void   acl__response__init
                      (Acl__Response         *message);
@@ -200,6 +284,82 @@ Acl__EntryPermissions *
 void   acl__entry_permissions__free_unpacked
                      (Acl__EntryPermissions *message,
                       ProtobufCAllocator *allocator);
+/* Acl__GetACLReq methods */
+void   acl__get_aclreq__init
+                     (Acl__GetACLReq         *message);
+size_t acl__get_aclreq__get_packed_size
+                     (const Acl__GetACLReq   *message);
+size_t acl__get_aclreq__pack
+                     (const Acl__GetACLReq   *message,
+                      uint8_t             *out);
+size_t acl__get_aclreq__pack_to_buffer
+                     (const Acl__GetACLReq   *message,
+                      ProtobufCBuffer     *buffer);
+Acl__GetACLReq *
+       acl__get_aclreq__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   acl__get_aclreq__free_unpacked
+                     (Acl__GetACLReq *message,
+                      ProtobufCAllocator *allocator);
+/* Acl__ACLResp methods */
+void   acl__aclresp__init
+                     (Acl__ACLResp         *message);
+size_t acl__aclresp__get_packed_size
+                     (const Acl__ACLResp   *message);
+size_t acl__aclresp__pack
+                     (const Acl__ACLResp   *message,
+                      uint8_t             *out);
+size_t acl__aclresp__pack_to_buffer
+                     (const Acl__ACLResp   *message,
+                      ProtobufCBuffer     *buffer);
+Acl__ACLResp *
+       acl__aclresp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   acl__aclresp__free_unpacked
+                     (Acl__ACLResp *message,
+                      ProtobufCAllocator *allocator);
+/* Acl__ModifyACLReq methods */
+void   acl__modify_aclreq__init
+                     (Acl__ModifyACLReq         *message);
+size_t acl__modify_aclreq__get_packed_size
+                     (const Acl__ModifyACLReq   *message);
+size_t acl__modify_aclreq__pack
+                     (const Acl__ModifyACLReq   *message,
+                      uint8_t             *out);
+size_t acl__modify_aclreq__pack_to_buffer
+                     (const Acl__ModifyACLReq   *message,
+                      ProtobufCBuffer     *buffer);
+Acl__ModifyACLReq *
+       acl__modify_aclreq__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   acl__modify_aclreq__free_unpacked
+                     (Acl__ModifyACLReq *message,
+                      ProtobufCAllocator *allocator);
+/* Acl__DeleteACLReq methods */
+void   acl__delete_aclreq__init
+                     (Acl__DeleteACLReq         *message);
+size_t acl__delete_aclreq__get_packed_size
+                     (const Acl__DeleteACLReq   *message);
+size_t acl__delete_aclreq__pack
+                     (const Acl__DeleteACLReq   *message,
+                      uint8_t             *out);
+size_t acl__delete_aclreq__pack_to_buffer
+                     (const Acl__DeleteACLReq   *message,
+                      ProtobufCBuffer     *buffer);
+Acl__DeleteACLReq *
+       acl__delete_aclreq__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   acl__delete_aclreq__free_unpacked
+                     (Acl__DeleteACLReq *message,
+                      ProtobufCAllocator *allocator);
 /* --- per-message closures --- */
 
 typedef void (*Acl__Response_Closure)
@@ -211,6 +371,18 @@ typedef void (*Acl__Entry_Closure)
 typedef void (*Acl__EntryPermissions_Closure)
                  (const Acl__EntryPermissions *message,
                   void *closure_data);
+typedef void (*Acl__GetACLReq_Closure)
+                 (const Acl__GetACLReq *message,
+                  void *closure_data);
+typedef void (*Acl__ACLResp_Closure)
+                 (const Acl__ACLResp *message,
+                  void *closure_data);
+typedef void (*Acl__ModifyACLReq_Closure)
+                 (const Acl__ModifyACLReq *message,
+                  void *closure_data);
+typedef void (*Acl__DeleteACLReq_Closure)
+                 (const Acl__DeleteACLReq *message,
+                  void *closure_data);
 
 /* --- services --- */
 
@@ -230,6 +402,22 @@ struct _Acl__AccessControl_Service
                             const Acl__Entry *input,
                             Acl__Response_Closure closure,
                             void *closure_data);
+  void (*pool_get_acl)(Acl__AccessControl_Service *service,
+                       const Acl__GetACLReq *input,
+                       Acl__ACLResp_Closure closure,
+                       void *closure_data);
+  void (*pool_overwrite_acl)(Acl__AccessControl_Service *service,
+                             const Acl__ModifyACLReq *input,
+                             Acl__ACLResp_Closure closure,
+                             void *closure_data);
+  void (*pool_update_acl)(Acl__AccessControl_Service *service,
+                          const Acl__ModifyACLReq *input,
+                          Acl__ACLResp_Closure closure,
+                          void *closure_data);
+  void (*pool_delete_acl)(Acl__AccessControl_Service *service,
+                          const Acl__DeleteACLReq *input,
+                          Acl__ACLResp_Closure closure,
+                          void *closure_data);
 };
 typedef void (*Acl__AccessControl_ServiceDestroy)(Acl__AccessControl_Service *);
 void acl__access_control__init (Acl__AccessControl_Service *service,
@@ -240,7 +428,11 @@ void acl__access_control__init (Acl__AccessControl_Service *service,
     { ACL__ACCESS_CONTROL__BASE_INIT,\
       function_prefix__ ## set_permissions,\
       function_prefix__ ## get_permissions,\
-      function_prefix__ ## destroy_acl_entry  }
+      function_prefix__ ## destroy_acl_entry,\
+      function_prefix__ ## pool_get_acl,\
+      function_prefix__ ## pool_overwrite_acl,\
+      function_prefix__ ## pool_update_acl,\
+      function_prefix__ ## pool_delete_acl  }
 void acl__access_control__set_permissions(ProtobufCService *service,
                                           const Acl__EntryPermissions *input,
                                           Acl__Response_Closure closure,
@@ -253,6 +445,22 @@ void acl__access_control__destroy_acl_entry(ProtobufCService *service,
                                             const Acl__Entry *input,
                                             Acl__Response_Closure closure,
                                             void *closure_data);
+void acl__access_control__pool_get_acl(ProtobufCService *service,
+                                       const Acl__GetACLReq *input,
+                                       Acl__ACLResp_Closure closure,
+                                       void *closure_data);
+void acl__access_control__pool_overwrite_acl(ProtobufCService *service,
+                                             const Acl__ModifyACLReq *input,
+                                             Acl__ACLResp_Closure closure,
+                                             void *closure_data);
+void acl__access_control__pool_update_acl(ProtobufCService *service,
+                                          const Acl__ModifyACLReq *input,
+                                          Acl__ACLResp_Closure closure,
+                                          void *closure_data);
+void acl__access_control__pool_delete_acl(ProtobufCService *service,
+                                          const Acl__DeleteACLReq *input,
+                                          Acl__ACLResp_Closure closure,
+                                          void *closure_data);
 
 /* --- descriptors --- */
 
@@ -263,6 +471,10 @@ extern const ProtobufCEnumDescriptor    acl__flags__descriptor;
 extern const ProtobufCMessageDescriptor acl__response__descriptor;
 extern const ProtobufCMessageDescriptor acl__entry__descriptor;
 extern const ProtobufCMessageDescriptor acl__entry_permissions__descriptor;
+extern const ProtobufCMessageDescriptor acl__get_aclreq__descriptor;
+extern const ProtobufCMessageDescriptor acl__aclresp__descriptor;
+extern const ProtobufCMessageDescriptor acl__modify_aclreq__descriptor;
+extern const ProtobufCMessageDescriptor acl__delete_aclreq__descriptor;
 extern const ProtobufCServiceDescriptor acl__access_control__descriptor;
 
 PROTOBUF_C__END_DECLS